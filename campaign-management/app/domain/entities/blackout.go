@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// Blackout closes collections from StartDate to EndDate both inclusive, at every store when StoreID is 0.
+// UID is the event identifier of blackouts imported from a calendar
+type Blackout struct {
+	ID        valueobjects.BlackoutID
+	StoreID   int64
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+	UID       string
+	CreatedAt time.Time
+	CreatedBy int64
+	UpdatedAt time.Time
+	UpdatedBy int64
+	DeletedAt time.Time
+	DeletedBy int64
+}
+
+// Covers reports whether the calendar date, held as midnight UTC, is blacked out
+func (b Blackout) Covers(date time.Time) bool {
+	return !date.Before(b.StartDate) && !date.After(b.EndDate)
+}
@@ -1,35 +1,93 @@
-package entities
-
-import (
-	"time"
-
-	"campaign-mgmt/app/domain/valueobjects"
-)
-
-type Campaign struct {
-	ID                  valueobjects.CampaignID
-	Title               string
-	OrderStartDate      time.Time
-	OrderEndDate        time.Time
-	CollectionStartDate time.Time
-	CollectionEndDate   time.Time
-	StatusCode          int64
-	CampaignType        valueobjects.CampaignType
-	ListingTitle        string
-	ListingDesc         string
-	ListingImagePath    string
-	OnboardTitle        string
-	OnboardDesc         string
-	OnboardImagePath    string
-	LandingImagePath    string
-	LeadTime            int
-	OfferID             int64
-	TagID               int64
-	IsCampaignPublished bool
-	CreatedAt           time.Time
-	CreatedBy           int64
-	UpdatedAt           time.Time
-	UpdatedBy           int64
-	DeletedAt           time.Time
-	DeletedBy           int64
-}
+package entities
+
+import (
+	"time"
+
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+type Campaign struct {
+	ID                  valueobjects.CampaignID
+	Title               string
+	OrderStartDate      time.Time
+	OrderEndDate        time.Time
+	CollectionStartDate time.Time
+	CollectionEndDate   time.Time
+	Timezone            string
+	StatusCode          int64
+	CampaignType        valueobjects.CampaignType
+	ListingTitle        string
+	ListingDesc         string
+	ListingImagePath    string
+	OnboardTitle        string
+	OnboardDesc         string
+	OnboardImagePath    string
+	LandingImagePath    string
+	LeadTime            int
+	OfferID             int64
+	TagID               int64
+	IsCampaignPublished bool
+	CreatedAt           time.Time
+	CreatedBy           int64
+	UpdatedAt           time.Time
+	UpdatedBy           int64
+	DeletedAt           time.Time
+	DeletedBy           int64
+}
+
+// CampaignListFilter narrows down the campaign list and orders it by SortFields in place of Sort.
+// The order and collection ranges keep the campaigns whose dates overlap them, a zero bound leaves that side open.
+// Cursor continues the list from a page got by cursor in place of Offset, empty for the first page.
+// Query searches the titles and descriptions of the campaigns in full text
+type CampaignListFilter struct {
+	PaginationConfig
+	Cursor         string
+	Query          string
+	CampaignType   string
+	IsPublished    *bool
+	OrderFrom      time.Time
+	OrderTo        time.Time
+	CollectionFrom time.Time
+	CollectionTo   time.Time
+	CreatedBy      int64
+	StoreID        int64
+	ProductID      int64
+}
+
+// StoreCampaignFilter narrows down the campaigns of a store and orders them by SortFields in place of Sort,
+// IsPublished nil lists published and unpublished campaigns and a zero At lists campaigns whatever their dates
+type StoreCampaignFilter struct {
+	PaginationConfig
+	IsPublished *bool
+	At          time.Time
+}
+
+// ProductCampaignFilter narrows down the campaigns selling a product the way StoreCampaignFilter does for a store,
+// a non zero SKUNo lists only the campaigns selling that SKU of the product
+type ProductCampaignFilter struct {
+	StoreCampaignFilter
+	SKUNo int64
+}
+
+// CampaignSummary aggregates the campaigns matching a list filter. Starting and ending soon count the campaigns whose
+// order start or end date falls in the window the summary was got for
+type CampaignSummary struct {
+	Total           int64
+	StatusCounts    []CampaignStatusCount
+	StartingSoon    int64
+	EndingSoon      int64
+	WithoutStores   int64
+	WithoutProducts int64
+}
+
+type CampaignStatusCount struct {
+	StatusCode valueobjects.CampaignStatusCode
+	Count      int64
+}
+
+// CampaignOverlap is another campaign selling a product of the campaign at one of its stores in overlapping order dates
+type CampaignOverlap struct {
+	CampaignID int64
+	ProductID  int64
+	StoreID    int64
+}
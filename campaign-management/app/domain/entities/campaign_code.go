@@ -0,0 +1,10 @@
+package entities
+
+import "campaign-mgmt/app/domain/valueobjects"
+
+type CampaignCode struct {
+	StatusCode  valueobjects.CampaignStatusCode
+	StatusValue string
+	CreatedBy   int64
+	UpdatedBy   int64
+}
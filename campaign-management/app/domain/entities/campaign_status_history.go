@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"time"
+
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+type CampaignStatusHistory struct {
+	ID             valueobjects.StatusHistoryID
+	CampaignID     valueobjects.CampaignID
+	OldStatusCode  int64
+	OldStatusValue string
+	NewStatusCode  int64
+	NewStatusValue string
+	Actor          string
+	ChangedAt      time.Time
+}
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+type CampaignStatusJobRun struct {
+	ID          valueobjects.StatusJobRunID
+	Instance    string
+	StartedAt   time.Time
+	EndedAt     time.Time
+	Published   int64
+	Deactivated int64
+	Error       string
+}
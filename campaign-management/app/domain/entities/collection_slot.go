@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// CollectionSlot is a slot a store offers for collecting the orders of a campaign on a date,
+// StartAt and EndAt are the slot times on that date in the campaign timezone
+type CollectionSlot struct {
+	CampaignID     valueobjects.CampaignID
+	StoreID        int64
+	Date           time.Time
+	StartTime      valueobjects.TimeOfDay
+	EndTime        valueobjects.TimeOfDay
+	StartAt        time.Time
+	EndAt          time.Time
+	Source         valueobjects.SlotSource
+	SlotID         int64
+	Quota          int
+	RemainingQuota int
+}
+
+// Key returns the key reservations of the slot are made with
+func (s CollectionSlot) Key() valueobjects.SlotKey {
+	return valueobjects.SlotKey{Source: s.Source, SlotID: s.SlotID, Date: s.Date}
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// CollectionSlotReservation takes Quantity of the quota of a collection slot on a date. A held
+// reservation gives its quantity back once ExpiresAt passes unless it is confirmed before
+type CollectionSlotReservation struct {
+	ID         valueobjects.ReservationID
+	CampaignID valueobjects.CampaignID
+	StoreID    int64
+	Slot       valueobjects.SlotKey
+	Quantity   int
+	Status     valueobjects.ReservationStatus
+	ExpiresAt  time.Time
+	Reference  string
+	CreatedAt  time.Time
+	CreatedBy  int64
+	UpdatedAt  time.Time
+	UpdatedBy  int64
+}
+
+// IsActive reports whether the reservation takes quota at now
+func (r CollectionSlotReservation) IsActive(now time.Time) bool {
+	switch r.Status {
+	case valueobjects.ReservationStatusConfirmed:
+		return true
+	case valueobjects.ReservationStatusHeld:
+		return r.ExpiresAt.After(now)
+	}
+	return false
+}
+
+// ReservedQuantity is the quota of a collection slot on a date taken by active reservations
+type ReservedQuantity struct {
+	Slot     valueobjects.SlotKey
+	Quantity int
+}
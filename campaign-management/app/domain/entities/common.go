@@ -1,2 +1,2 @@
-package entities
-
+package entities
+
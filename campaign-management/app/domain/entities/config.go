@@ -1,30 +1,77 @@
-package entities
-
-type AppCfg struct {
-	MYSQLConfig      MYSQLConfig
-	PaginationConfig PaginationConfig
-	ValidationParam  ValidationParam
-}
-
-type MYSQLConfig struct {
-	User            string
-	Password        string
-	Host            string
-	Port            string
-	Database        string
-	DBRetryAttempts int
-}
-
-type ValidationParam struct {
-	MaxLeadTime       int
-	MaxDateDifference int
-}
-
-type PaginationConfig struct {
-	Limit  int
-	Page   int
-	Offset int
-	Sort   string
-	Name   string
-	Status int64
-}
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+type AppCfg struct {
+	MYSQLConfig       MYSQLConfig
+	PaginationConfig  PaginationConfig
+	ValidationParam   ValidationParam
+	SchedulerConfig   SchedulerConfig
+	TimezoneConfig    TimezoneConfig
+	ReservationConfig ReservationConfig
+	OverlapConfig     OverlapConfig
+}
+
+type MYSQLConfig struct {
+	User            string
+	Password        string
+	Host            string
+	Port            string
+	Database        string
+	DBRetryAttempts int
+}
+
+type ValidationParam struct {
+	MaxLeadTime       int
+	MaxDateDifference int
+}
+
+// PaginationConfig pages a list ordered by SortFields, Sort is the sort a list falls back to when the request gives none
+type PaginationConfig struct {
+	Limit          int
+	Page           int
+	Offset         int
+	Sort           string
+	SortFields     []SortField
+	Name           string
+	Status         int64
+	StatusValue    string
+	IncludeDeleted bool
+}
+
+// SortField is a column to order a list by, from the largest value first when Desc is set
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// PageCursors are the opaque tokens of the pages before and after a page, empty when there is no such page
+type PageCursors struct {
+	Next string
+	Prev string
+}
+
+type SchedulerConfig struct {
+	Enabled  bool
+	Interval time.Duration
+	LockName string
+}
+
+type TimezoneConfig struct {
+	// BusinessTimezone is used for campaigns created or stored without a timezone
+	BusinessTimezone string
+}
+
+type ReservationConfig struct {
+	// HoldTTL is how long a held slot reservation takes quota unless it is confirmed
+	HoldTTL time.Duration
+}
+
+type OverlapConfig struct {
+	// Mode decides whether a campaign selling a product at a store another campaign sells it at in overlapping
+	// order dates is only reported or rejected
+	Mode valueobjects.OverlapMode
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// SlotTemplate describes a day of weekly slots, StartTime to EndTime cut into slots of SlotMinutes on each of DaysOfWeek
+type SlotTemplate struct {
+	ID              valueobjects.SlotTemplateID
+	Name            string
+	DaysOfWeek      []time.Weekday
+	StartTime       valueobjects.TimeOfDay
+	EndTime         valueobjects.TimeOfDay
+	SlotMinutes     int
+	Quota           int
+	IsSlotAvailable bool
+	CreatedAt       time.Time
+	CreatedBy       int64
+	UpdatedAt       time.Time
+	UpdatedBy       int64
+	DeletedAt       time.Time
+	DeletedBy       int64
+}
+
+// DailySlots returns the weekly slots the template makes for the store
+func (t SlotTemplate) DailySlots(storeID, userID int64) []StoreDailyTimeSlot {
+	slots := []StoreDailyTimeSlot{}
+	if t.SlotMinutes <= 0 {
+		return slots
+	}
+	step := valueobjects.TimeOfDay(t.SlotMinutes)
+	for _, dayOfWeek := range t.DaysOfWeek {
+		for start := t.StartTime; start+step <= t.EndTime; start += step {
+			slots = append(slots, StoreDailyTimeSlot{
+				StoreID:         storeID,
+				DayOfWeek:       dayOfWeek,
+				StartTime:       start,
+				EndTime:         start + step,
+				Quota:           t.Quota,
+				IsSlotAvailable: t.IsSlotAvailable,
+				CreatedBy:       userID,
+			})
+		}
+	}
+	return slots
+}
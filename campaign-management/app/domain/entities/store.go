@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// Store is a store of the registry, ID is the store id campaigns refer to. Only active stores can be added to campaigns
+type Store struct {
+	ID        valueobjects.StoreID
+	Name      string
+	Address   string
+	Region    string
+	Timezone  string
+	IsActive  bool
+	CreatedAt time.Time
+	CreatedBy int64
+	UpdatedAt time.Time
+	UpdatedBy int64
+	DeletedAt time.Time
+	DeletedBy int64
+}
+
+// StoreFilter narrows down the store list, IsActive nil lists active and inactive stores
+type StoreFilter struct {
+	PaginationConfig
+	Region   string
+	IsActive *bool
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// StoreDailyTimeSlot is a collection slot repeated every week on DayOfWeek, times are in the store local time
+type StoreDailyTimeSlot struct {
+	ID              valueobjects.DailyTimeSlotID
+	StoreID         int64
+	DayOfWeek       time.Weekday
+	StartTime       valueobjects.TimeOfDay
+	EndTime         valueobjects.TimeOfDay
+	Quota           int
+	IsSlotAvailable bool
+	CreatedAt       time.Time
+	CreatedBy       int64
+	UpdatedAt       time.Time
+	UpdatedBy       int64
+	DeletedAt       time.Time
+	DeletedBy       int64
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// StoreGroup is a named set of registered stores that can be added to a campaign at once
+type StoreGroup struct {
+	ID          valueobjects.StoreGroupID
+	Name        string
+	Description string
+	StoreIDs    []int64
+	CreatedAt   time.Time
+	CreatedBy   int64
+	UpdatedAt   time.Time
+	UpdatedBy   int64
+	DeletedAt   time.Time
+	DeletedBy   int64
+}
+
+// StoreGroupMember is the membership of a store in a store group
+type StoreGroupMember struct {
+	StoreGroupID valueobjects.StoreGroupID
+	StoreID      int64
+	CreatedBy    int64
+}
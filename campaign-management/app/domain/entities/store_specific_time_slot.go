@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// StoreSpecificTimeSlot is a collection slot of a single date, the slots of a date take the place of the
+// weekly slots of that weekday. A closed slot marks the store shut for the whole date and carries no times
+type StoreSpecificTimeSlot struct {
+	ID        valueobjects.SpecificTimeSlotID
+	StoreID   int64
+	Date      time.Time
+	StartTime valueobjects.TimeOfDay
+	EndTime   valueobjects.TimeOfDay
+	Quota     int
+	IsClosed  bool
+	CreatedAt time.Time
+	CreatedBy int64
+	UpdatedAt time.Time
+	UpdatedBy int64
+	DeletedAt time.Time
+	DeletedBy int64
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name Blackouts --filename blackouts_services.go
+type Blackouts interface {
+	GetList(ctx context.Context, storeID int64, from, to time.Time) ([]entities.Blackout, error)
+	GetListByStores(ctx context.Context, storeIDs []int64, from, to time.Time) ([]entities.Blackout, error)
+	GetByUIDs(ctx context.Context, storeID int64, uids []string) ([]entities.Blackout, error)
+	Get(ctx context.Context, blackoutID valueobjects.BlackoutID) (entities.Blackout, error)
+	CreateMultiple(ctx context.Context, blackouts []entities.Blackout) ([]entities.Blackout, error)
+	Update(ctx context.Context, blackout entities.Blackout) error
+	Delete(ctx context.Context, blackoutID valueobjects.BlackoutID, userID int64) error
+}
@@ -1,17 +1,27 @@
-package services
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-)
-
-//go:generate mockery --name Campaigns --filename campaigns_services.go
-type Campaigns interface {
-	Get(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error)
-	Create(ctx context.Context, campaignDetails entities.Campaign) (entities.Campaign, error)
-	Exists(ctx context.Context, campaignID valueobjects.CampaignID, title string) (bool, error)
-	Update(ctx context.Context, campaignDetails entities.Campaign) error
-	GetList(ctx context.Context, paginationDetails entities.PaginationConfig) ([]entities.Campaign, int64, error)
-	UpdateStatus(ctx context.Context) error
-}
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name Campaigns --filename campaigns_services.go
+type Campaigns interface {
+	Get(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error)
+	Lock(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error)
+	Create(ctx context.Context, campaignDetails entities.Campaign) (entities.Campaign, error)
+	Exists(ctx context.Context, campaignID valueobjects.CampaignID, title string) (bool, error)
+	Update(ctx context.Context, campaignDetails entities.Campaign) error
+	GetList(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, int64, error)
+	GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, entities.PageCursors, int64, error)
+	GetSummary(ctx context.Context, filter entities.CampaignListFilter, soonFrom, soonTo time.Time) (entities.CampaignSummary, error)
+	GetListByStore(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) ([]entities.Campaign, int64, error)
+	GetListByProduct(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) ([]entities.Campaign, int64, error)
+	GetOverlapping(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignOverlap, error)
+	Delete(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error
+	Restore(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error
+	PublishCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error)
+	DeactivateCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error)
+}
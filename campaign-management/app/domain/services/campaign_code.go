@@ -0,0 +1,16 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+)
+
+//go:generate mockery --name CampaignCodes --filename campaign_codes_services.go
+type CampaignCodes interface {
+	GetList(ctx context.Context) ([]entities.CampaignCode, error)
+	Get(ctx context.Context, statusCode valueobjects.CampaignStatusCode) (entities.CampaignCode, error)
+	GetByValue(ctx context.Context, statusValue string) (entities.CampaignCode, error)
+	Create(ctx context.Context, campaignCode entities.CampaignCode) (entities.CampaignCode, error)
+	Update(ctx context.Context, campaignCode entities.CampaignCode) error
+}
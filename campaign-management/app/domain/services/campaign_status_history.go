@@ -0,0 +1,13 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+)
+
+//go:generate mockery --name CampaignStatusHistory --filename campaign_status_history_services.go
+type CampaignStatusHistory interface {
+	CreateMultiple(ctx context.Context, history []entities.CampaignStatusHistory) error
+	GetList(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignStatusHistory, error)
+}
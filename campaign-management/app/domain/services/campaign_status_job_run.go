@@ -0,0 +1,11 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"context"
+)
+
+//go:generate mockery --name CampaignStatusJobRuns --filename campaign_status_job_runs_services.go
+type CampaignStatusJobRuns interface {
+	Create(ctx context.Context, run entities.CampaignStatusJobRun) (entities.CampaignStatusJobRun, error)
+}
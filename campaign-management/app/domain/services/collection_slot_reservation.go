@@ -0,0 +1,22 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name CollectionSlotReservations --filename collection_slot_reservations_services.go
+type CollectionSlotReservations interface {
+	// LockSlot locks the daily or specific time slot row till the end of the transaction in ctx and returns its store
+	LockSlot(ctx context.Context, source valueobjects.SlotSource, slotID int64) (int64, error)
+	// GetReservedQuantities sums the reservations of the store active at now per slot and date
+	GetReservedQuantities(ctx context.Context, storeID int64, from, to, now time.Time) ([]entities.ReservedQuantity, error)
+	Get(ctx context.Context, reservationID valueobjects.ReservationID) (entities.CollectionSlotReservation, error)
+	Create(ctx context.Context, reservation entities.CollectionSlotReservation) (entities.CollectionSlotReservation, error)
+	// Confirm turns a hold not expired at now into a confirmed reservation and reports whether it did
+	Confirm(ctx context.Context, reservationID valueobjects.ReservationID, userID int64, now time.Time) (bool, error)
+	// Release gives back the quota of a reservation not released yet and reports whether it did
+	Release(ctx context.Context, reservationID valueobjects.ReservationID, userID int64) (bool, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+)
+
+//go:generate mockery --name LockService --filename lock_service.go
+type LockService interface {
+	// RunWithLock calls fn only when the named lock is acquired and reports whether it was called
+	RunWithLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+	time "time"
+)
+
+// Blackouts is an autogenerated mock type for the Blackouts type
+type Blackouts struct {
+	mock.Mock
+}
+
+// CreateMultiple provides a mock function with given fields: ctx, blackouts
+func (_m *Blackouts) CreateMultiple(ctx context.Context, blackouts []entities.Blackout) ([]entities.Blackout, error) {
+	ret := _m.Called(ctx, blackouts)
+
+	var r0 []entities.Blackout
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.Blackout) []entities.Blackout); ok {
+		r0 = rf(ctx, blackouts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Blackout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []entities.Blackout) error); ok {
+		r1 = rf(ctx, blackouts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, blackoutID, userID
+func (_m *Blackouts) Delete(ctx context.Context, blackoutID valueobjects.BlackoutID, userID int64) error {
+	ret := _m.Called(ctx, blackoutID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.BlackoutID, int64) error); ok {
+		r0 = rf(ctx, blackoutID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, blackoutID
+func (_m *Blackouts) Get(ctx context.Context, blackoutID valueobjects.BlackoutID) (entities.Blackout, error) {
+	ret := _m.Called(ctx, blackoutID)
+
+	var r0 entities.Blackout
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.BlackoutID) entities.Blackout); ok {
+		r0 = rf(ctx, blackoutID)
+	} else {
+		r0 = ret.Get(0).(entities.Blackout)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.BlackoutID) error); ok {
+		r1 = rf(ctx, blackoutID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUIDs provides a mock function with given fields: ctx, storeID, uids
+func (_m *Blackouts) GetByUIDs(ctx context.Context, storeID int64, uids []string) ([]entities.Blackout, error) {
+	ret := _m.Called(ctx, storeID, uids)
+
+	var r0 []entities.Blackout
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) []entities.Blackout); ok {
+		r0 = rf(ctx, storeID, uids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Blackout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string) error); ok {
+		r1 = rf(ctx, storeID, uids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, storeID, from, to
+func (_m *Blackouts) GetList(ctx context.Context, storeID int64, from time.Time, to time.Time) ([]entities.Blackout, error) {
+	ret := _m.Called(ctx, storeID, from, to)
+
+	var r0 []entities.Blackout
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []entities.Blackout); ok {
+		r0 = rf(ctx, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Blackout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListByStores provides a mock function with given fields: ctx, storeIDs, from, to
+func (_m *Blackouts) GetListByStores(ctx context.Context, storeIDs []int64, from time.Time, to time.Time) ([]entities.Blackout, error) {
+	ret := _m.Called(ctx, storeIDs, from, to)
+
+	var r0 []entities.Blackout
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) []entities.Blackout); ok {
+		r0 = rf(ctx, storeIDs, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Blackout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeIDs, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, blackout
+func (_m *Blackouts) Update(ctx context.Context, blackout entities.Blackout) error {
+	ret := _m.Called(ctx, blackout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Blackout) error); ok {
+		r0 = rf(ctx, blackout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewBlackouts interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewBlackouts creates a new instance of Blackouts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewBlackouts(t mockConstructorTestingTNewBlackouts) *Blackouts {
+	mock := &Blackouts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
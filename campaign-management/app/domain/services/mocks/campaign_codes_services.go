@@ -0,0 +1,132 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// CampaignCodes is an autogenerated mock type for the CampaignCodes type
+type CampaignCodes struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, campaignCode
+func (_m *CampaignCodes) Create(ctx context.Context, campaignCode entities.CampaignCode) (entities.CampaignCode, error) {
+	ret := _m.Called(ctx, campaignCode)
+
+	var r0 entities.CampaignCode
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignCode) entities.CampaignCode); ok {
+		r0 = rf(ctx, campaignCode)
+	} else {
+		r0 = ret.Get(0).(entities.CampaignCode)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignCode) error); ok {
+		r1 = rf(ctx, campaignCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, statusCode
+func (_m *CampaignCodes) Get(ctx context.Context, statusCode valueobjects.CampaignStatusCode) (entities.CampaignCode, error) {
+	ret := _m.Called(ctx, statusCode)
+
+	var r0 entities.CampaignCode
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignStatusCode) entities.CampaignCode); ok {
+		r0 = rf(ctx, statusCode)
+	} else {
+		r0 = ret.Get(0).(entities.CampaignCode)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignStatusCode) error); ok {
+		r1 = rf(ctx, statusCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByValue provides a mock function with given fields: ctx, statusValue
+func (_m *CampaignCodes) GetByValue(ctx context.Context, statusValue string) (entities.CampaignCode, error) {
+	ret := _m.Called(ctx, statusValue)
+
+	var r0 entities.CampaignCode
+	if rf, ok := ret.Get(0).(func(context.Context, string) entities.CampaignCode); ok {
+		r0 = rf(ctx, statusValue)
+	} else {
+		r0 = ret.Get(0).(entities.CampaignCode)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, statusValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx
+func (_m *CampaignCodes) GetList(ctx context.Context) ([]entities.CampaignCode, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entities.CampaignCode
+	if rf, ok := ret.Get(0).(func(context.Context) []entities.CampaignCode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.CampaignCode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, campaignCode
+func (_m *CampaignCodes) Update(ctx context.Context, campaignCode entities.CampaignCode) error {
+	ret := _m.Called(ctx, campaignCode)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignCode) error); ok {
+		r0 = rf(ctx, campaignCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCampaignCodes interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignCodes creates a new instance of CampaignCodes. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignCodes(t mockConstructorTestingTNewCampaignCodes) *CampaignCodes {
+	mock := &CampaignCodes{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
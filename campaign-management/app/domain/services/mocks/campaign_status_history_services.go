@@ -0,0 +1,69 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// CampaignStatusHistory is an autogenerated mock type for the CampaignStatusHistory type
+type CampaignStatusHistory struct {
+	mock.Mock
+}
+
+// CreateMultiple provides a mock function with given fields: ctx, history
+func (_m *CampaignStatusHistory) CreateMultiple(ctx context.Context, history []entities.CampaignStatusHistory) error {
+	ret := _m.Called(ctx, history)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.CampaignStatusHistory) error); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetList provides a mock function with given fields: ctx, campaignID
+func (_m *CampaignStatusHistory) GetList(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignStatusHistory, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 []entities.CampaignStatusHistory
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID) []entities.CampaignStatusHistory); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.CampaignStatusHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCampaignStatusHistory interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignStatusHistory creates a new instance of CampaignStatusHistory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignStatusHistory(t mockConstructorTestingTNewCampaignStatusHistory) *CampaignStatusHistory {
+	mock := &CampaignStatusHistory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
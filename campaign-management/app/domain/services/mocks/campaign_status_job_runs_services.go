@@ -0,0 +1,51 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CampaignStatusJobRuns is an autogenerated mock type for the CampaignStatusJobRuns type
+type CampaignStatusJobRuns struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, run
+func (_m *CampaignStatusJobRuns) Create(ctx context.Context, run entities.CampaignStatusJobRun) (entities.CampaignStatusJobRun, error) {
+	ret := _m.Called(ctx, run)
+
+	var r0 entities.CampaignStatusJobRun
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignStatusJobRun) entities.CampaignStatusJobRun); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Get(0).(entities.CampaignStatusJobRun)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignStatusJobRun) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCampaignStatusJobRuns interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignStatusJobRuns creates a new instance of CampaignStatusJobRuns. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignStatusJobRuns(t mockConstructorTestingTNewCampaignStatusJobRuns) *CampaignStatusJobRuns {
+	mock := &CampaignStatusJobRuns{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
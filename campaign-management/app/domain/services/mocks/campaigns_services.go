@@ -1,153 +1,376 @@
-// Code generated by mockery v2.16.0. DO NOT EDIT.
-
-package mocks
-
-import (
-	entities "campaign-mgmt/app/domain/entities"
-	context "context"
-
-	mock "github.com/stretchr/testify/mock"
-
-	valueobjects "campaign-mgmt/app/domain/valueobjects"
-)
-
-// Campaigns is an autogenerated mock type for the Campaigns type
-type Campaigns struct {
-	mock.Mock
-}
-
-// Create provides a mock function with given fields: ctx, campaignDetails
-func (_m *Campaigns) Create(ctx context.Context, campaignDetails entities.Campaign) (entities.Campaign, error) {
-	ret := _m.Called(ctx, campaignDetails)
-
-	var r0 entities.Campaign
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) entities.Campaign); ok {
-		r0 = rf(ctx, campaignDetails)
-	} else {
-		r0 = ret.Get(0).(entities.Campaign)
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Campaign) error); ok {
-		r1 = rf(ctx, campaignDetails)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Exists provides a mock function with given fields: ctx, campaignID, title
-func (_m *Campaigns) Exists(ctx context.Context, campaignID valueobjects.CampaignID, title string) (bool, error) {
-	ret := _m.Called(ctx, campaignID, title)
-
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID, string) bool); ok {
-		r0 = rf(ctx, campaignID, title)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID, string) error); ok {
-		r1 = rf(ctx, campaignID, title)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Get provides a mock function with given fields: ctx, campaignID
-func (_m *Campaigns) Get(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error) {
-	ret := _m.Called(ctx, campaignID)
-
-	var r0 entities.Campaign
-	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID) entities.Campaign); ok {
-		r0 = rf(ctx, campaignID)
-	} else {
-		r0 = ret.Get(0).(entities.Campaign)
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID) error); ok {
-		r1 = rf(ctx, campaignID)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetList provides a mock function with given fields: ctx, paginationDetails
-func (_m *Campaigns) GetList(ctx context.Context, paginationDetails entities.PaginationConfig) ([]entities.Campaign, int64, error) {
-	ret := _m.Called(ctx, paginationDetails)
-
-	var r0 []entities.Campaign
-	if rf, ok := ret.Get(0).(func(context.Context, entities.PaginationConfig) []entities.Campaign); ok {
-		r0 = rf(ctx, paginationDetails)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.Campaign)
-		}
-	}
-
-	var r1 int64
-	if rf, ok := ret.Get(1).(func(context.Context, entities.PaginationConfig) int64); ok {
-		r1 = rf(ctx, paginationDetails)
-	} else {
-		r1 = ret.Get(1).(int64)
-	}
-
-	var r2 error
-	if rf, ok := ret.Get(2).(func(context.Context, entities.PaginationConfig) error); ok {
-		r2 = rf(ctx, paginationDetails)
-	} else {
-		r2 = ret.Error(2)
-	}
-
-	return r0, r1, r2
-}
-
-// Update provides a mock function with given fields: ctx, campaignDetails
-func (_m *Campaigns) Update(ctx context.Context, campaignDetails entities.Campaign) error {
-	ret := _m.Called(ctx, campaignDetails)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) error); ok {
-		r0 = rf(ctx, campaignDetails)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// UpdateStatus provides a mock function with given fields: ctx
-func (_m *Campaigns) UpdateStatus(ctx context.Context) error {
-	ret := _m.Called(ctx)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-type mockConstructorTestingTNewCampaigns interface {
-	mock.TestingT
-	Cleanup(func())
-}
-
-// NewCampaigns creates a new instance of Campaigns. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewCampaigns(t mockConstructorTestingTNewCampaigns) *Campaigns {
-	mock := &Campaigns{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+	time "time"
+)
+
+// Campaigns is an autogenerated mock type for the Campaigns type
+type Campaigns struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, campaignDetails
+func (_m *Campaigns) Create(ctx context.Context, campaignDetails entities.Campaign) (entities.Campaign, error) {
+	ret := _m.Called(ctx, campaignDetails)
+
+	var r0 entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) entities.Campaign); ok {
+		r0 = rf(ctx, campaignDetails)
+	} else {
+		r0 = ret.Get(0).(entities.Campaign)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Campaign) error); ok {
+		r1 = rf(ctx, campaignDetails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeactivateCampaigns provides a mock function with given fields: ctx, transition, now
+func (_m *Campaigns) DeactivateCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	ret := _m.Called(ctx, transition, now)
+
+	var r0 []valueobjects.CampaignID
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignStatusTransition, time.Time) []valueobjects.CampaignID); ok {
+		r0 = rf(ctx, transition, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]valueobjects.CampaignID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignStatusTransition, time.Time) error); ok {
+		r1 = rf(ctx, transition, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, campaignID, userID
+func (_m *Campaigns) Delete(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error {
+	ret := _m.Called(ctx, campaignID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID, int64) error); ok {
+		r0 = rf(ctx, campaignID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Exists provides a mock function with given fields: ctx, campaignID, title
+func (_m *Campaigns) Exists(ctx context.Context, campaignID valueobjects.CampaignID, title string) (bool, error) {
+	ret := _m.Called(ctx, campaignID, title)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID, string) bool); ok {
+		r0 = rf(ctx, campaignID, title)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID, string) error); ok {
+		r1 = rf(ctx, campaignID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, campaignID
+func (_m *Campaigns) Get(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID) entities.Campaign); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		r0 = ret.Get(0).(entities.Campaign)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, filter
+func (_m *Campaigns) GetList(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter) []entities.Campaign); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Campaign)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, entities.CampaignListFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetListByCursor provides a mock function with given fields: ctx, filter
+func (_m *Campaigns) GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, entities.PageCursors, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter) []entities.Campaign); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Campaign)
+		}
+	}
+
+	var r1 entities.PageCursors
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter) entities.PageCursors); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(entities.PageCursors)
+	}
+
+	var r2 int64
+	if rf, ok := ret.Get(2).(func(context.Context, entities.CampaignListFilter) int64); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Get(2).(int64)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, entities.CampaignListFilter) error); ok {
+		r3 = rf(ctx, filter)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetListByProduct provides a mock function with given fields: ctx, productID, filter
+func (_m *Campaigns) GetListByProduct(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) ([]entities.Campaign, int64, error) {
+	ret := _m.Called(ctx, productID, filter)
+
+	var r0 []entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.ProductCampaignFilter) []entities.Campaign); ok {
+		r0 = rf(ctx, productID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Campaign)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entities.ProductCampaignFilter) int64); ok {
+		r1 = rf(ctx, productID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, entities.ProductCampaignFilter) error); ok {
+		r2 = rf(ctx, productID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetListByStore provides a mock function with given fields: ctx, storeID, filter
+func (_m *Campaigns) GetListByStore(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) ([]entities.Campaign, int64, error) {
+	ret := _m.Called(ctx, storeID, filter)
+
+	var r0 []entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.StoreCampaignFilter) []entities.Campaign); ok {
+		r0 = rf(ctx, storeID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Campaign)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entities.StoreCampaignFilter) int64); ok {
+		r1 = rf(ctx, storeID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64, entities.StoreCampaignFilter) error); ok {
+		r2 = rf(ctx, storeID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetOverlapping provides a mock function with given fields: ctx, campaignID
+func (_m *Campaigns) GetOverlapping(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignOverlap, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 []entities.CampaignOverlap
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID) []entities.CampaignOverlap); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.CampaignOverlap)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSummary provides a mock function with given fields: ctx, filter, soonFrom, soonTo
+func (_m *Campaigns) GetSummary(ctx context.Context, filter entities.CampaignListFilter, soonFrom time.Time, soonTo time.Time) (entities.CampaignSummary, error) {
+	ret := _m.Called(ctx, filter, soonFrom, soonTo)
+
+	var r0 entities.CampaignSummary
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter, time.Time, time.Time) entities.CampaignSummary); ok {
+		r0 = rf(ctx, filter, soonFrom, soonTo)
+	} else {
+		r0 = ret.Get(0).(entities.CampaignSummary)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, filter, soonFrom, soonTo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lock provides a mock function with given fields: ctx, campaignID
+func (_m *Campaigns) Lock(ctx context.Context, campaignID valueobjects.CampaignID) (entities.Campaign, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 entities.Campaign
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID) entities.Campaign); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		r0 = ret.Get(0).(entities.Campaign)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignID) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishCampaigns provides a mock function with given fields: ctx, transition, now
+func (_m *Campaigns) PublishCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	ret := _m.Called(ctx, transition, now)
+
+	var r0 []valueobjects.CampaignID
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignStatusTransition, time.Time) []valueobjects.CampaignID); ok {
+		r0 = rf(ctx, transition, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]valueobjects.CampaignID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.CampaignStatusTransition, time.Time) error); ok {
+		r1 = rf(ctx, transition, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, campaignID, userID
+func (_m *Campaigns) Restore(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error {
+	ret := _m.Called(ctx, campaignID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.CampaignID, int64) error); ok {
+		r0 = rf(ctx, campaignID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, campaignDetails
+func (_m *Campaigns) Update(ctx context.Context, campaignDetails entities.Campaign) error {
+	ret := _m.Called(ctx, campaignDetails)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) error); ok {
+		r0 = rf(ctx, campaignDetails)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCampaigns interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaigns creates a new instance of Campaigns. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaigns(t mockConstructorTestingTNewCampaigns) *Campaigns {
+	mock := &Campaigns{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
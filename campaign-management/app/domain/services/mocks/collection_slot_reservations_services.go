@@ -0,0 +1,161 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+	time "time"
+)
+
+// CollectionSlotReservations is an autogenerated mock type for the CollectionSlotReservations type
+type CollectionSlotReservations struct {
+	mock.Mock
+}
+
+// Confirm provides a mock function with given fields: ctx, reservationID, userID, now
+func (_m *CollectionSlotReservations) Confirm(ctx context.Context, reservationID valueobjects.ReservationID, userID int64, now time.Time) (bool, error) {
+	ret := _m.Called(ctx, reservationID, userID, now)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.ReservationID, int64, time.Time) bool); ok {
+		r0 = rf(ctx, reservationID, userID, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.ReservationID, int64, time.Time) error); ok {
+		r1 = rf(ctx, reservationID, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, reservation
+func (_m *CollectionSlotReservations) Create(ctx context.Context, reservation entities.CollectionSlotReservation) (entities.CollectionSlotReservation, error) {
+	ret := _m.Called(ctx, reservation)
+
+	var r0 entities.CollectionSlotReservation
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CollectionSlotReservation) entities.CollectionSlotReservation); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		r0 = ret.Get(0).(entities.CollectionSlotReservation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CollectionSlotReservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, reservationID
+func (_m *CollectionSlotReservations) Get(ctx context.Context, reservationID valueobjects.ReservationID) (entities.CollectionSlotReservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	var r0 entities.CollectionSlotReservation
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.ReservationID) entities.CollectionSlotReservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(entities.CollectionSlotReservation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.ReservationID) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservedQuantities provides a mock function with given fields: ctx, storeID, from, to, now
+func (_m *CollectionSlotReservations) GetReservedQuantities(ctx context.Context, storeID int64, from time.Time, to time.Time, now time.Time) ([]entities.ReservedQuantity, error) {
+	ret := _m.Called(ctx, storeID, from, to, now)
+
+	var r0 []entities.ReservedQuantity
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, time.Time) []entities.ReservedQuantity); ok {
+		r0 = rf(ctx, storeID, from, to, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReservedQuantity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockSlot provides a mock function with given fields: ctx, source, slotID
+func (_m *CollectionSlotReservations) LockSlot(ctx context.Context, source valueobjects.SlotSource, slotID int64) (int64, error) {
+	ret := _m.Called(ctx, source, slotID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.SlotSource, int64) int64); ok {
+		r0 = rf(ctx, source, slotID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.SlotSource, int64) error); ok {
+		r1 = rf(ctx, source, slotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, reservationID, userID
+func (_m *CollectionSlotReservations) Release(ctx context.Context, reservationID valueobjects.ReservationID, userID int64) (bool, error) {
+	ret := _m.Called(ctx, reservationID, userID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.ReservationID, int64) bool); ok {
+		r0 = rf(ctx, reservationID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.ReservationID, int64) error); ok {
+		r1 = rf(ctx, reservationID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCollectionSlotReservations interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCollectionSlotReservations creates a new instance of CollectionSlotReservations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCollectionSlotReservations(t mockConstructorTestingTNewCollectionSlotReservations) *CollectionSlotReservations {
+	mock := &CollectionSlotReservations{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,50 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LockService is an autogenerated mock type for the LockService type
+type LockService struct {
+	mock.Mock
+}
+
+// RunWithLock provides a mock function with given fields: ctx, name, fn
+func (_m *LockService) RunWithLock(ctx context.Context, name string, fn func(context.Context) error) (bool, error) {
+	ret := _m.Called(ctx, name, fn)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(context.Context) error) bool); ok {
+		r0 = rf(ctx, name, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, func(context.Context) error) error); ok {
+		r1 = rf(ctx, name, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewLockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewLockService creates a new instance of LockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewLockService(t mockConstructorTestingTNewLockService) *LockService {
+	mock := &LockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
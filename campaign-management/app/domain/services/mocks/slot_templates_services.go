@@ -0,0 +1,125 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// SlotTemplates is an autogenerated mock type for the SlotTemplates type
+type SlotTemplates struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, template
+func (_m *SlotTemplates) Create(ctx context.Context, template entities.SlotTemplate) (entities.SlotTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	var r0 entities.SlotTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SlotTemplate) entities.SlotTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(entities.SlotTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.SlotTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, templateID, userID
+func (_m *SlotTemplates) Delete(ctx context.Context, templateID valueobjects.SlotTemplateID, userID int64) error {
+	ret := _m.Called(ctx, templateID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.SlotTemplateID, int64) error); ok {
+		r0 = rf(ctx, templateID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, templateID
+func (_m *SlotTemplates) Get(ctx context.Context, templateID valueobjects.SlotTemplateID) (entities.SlotTemplate, error) {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 entities.SlotTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.SlotTemplateID) entities.SlotTemplate); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		r0 = ret.Get(0).(entities.SlotTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.SlotTemplateID) error); ok {
+		r1 = rf(ctx, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx
+func (_m *SlotTemplates) GetList(ctx context.Context) ([]entities.SlotTemplate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entities.SlotTemplate
+	if rf, ok := ret.Get(0).(func(context.Context) []entities.SlotTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.SlotTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, template
+func (_m *SlotTemplates) Update(ctx context.Context, template entities.SlotTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SlotTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewSlotTemplates interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSlotTemplates creates a new instance of SlotTemplates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSlotTemplates(t mockConstructorTestingTNewSlotTemplates) *SlotTemplates {
+	mock := &SlotTemplates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
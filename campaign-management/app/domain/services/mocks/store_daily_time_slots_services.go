@@ -0,0 +1,172 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+	time "time"
+)
+
+// StoreDailyTimeSlots is an autogenerated mock type for the StoreDailyTimeSlots type
+type StoreDailyTimeSlots struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, slot
+func (_m *StoreDailyTimeSlots) Create(ctx context.Context, slot entities.StoreDailyTimeSlot) (entities.StoreDailyTimeSlot, error) {
+	ret := _m.Called(ctx, slot)
+
+	var r0 entities.StoreDailyTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreDailyTimeSlot) entities.StoreDailyTimeSlot); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		r0 = ret.Get(0).(entities.StoreDailyTimeSlot)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreDailyTimeSlot) error); ok {
+		r1 = rf(ctx, slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMultiple provides a mock function with given fields: ctx, slots
+func (_m *StoreDailyTimeSlots) CreateMultiple(ctx context.Context, slots []entities.StoreDailyTimeSlot) ([]entities.StoreDailyTimeSlot, error) {
+	ret := _m.Called(ctx, slots)
+
+	var r0 []entities.StoreDailyTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.StoreDailyTimeSlot) []entities.StoreDailyTimeSlot); ok {
+		r0 = rf(ctx, slots)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreDailyTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []entities.StoreDailyTimeSlot) error); ok {
+		r1 = rf(ctx, slots)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, storeID, slotID, userID
+func (_m *StoreDailyTimeSlots) Delete(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID, userID int64) error {
+	ret := _m.Called(ctx, storeID, slotID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, valueobjects.DailyTimeSlotID, int64) error); ok {
+		r0 = rf(ctx, storeID, slotID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, storeID, slotID
+func (_m *StoreDailyTimeSlots) Get(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID) (entities.StoreDailyTimeSlot, error) {
+	ret := _m.Called(ctx, storeID, slotID)
+
+	var r0 entities.StoreDailyTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64, valueobjects.DailyTimeSlotID) entities.StoreDailyTimeSlot); ok {
+		r0 = rf(ctx, storeID, slotID)
+	} else {
+		r0 = ret.Get(0).(entities.StoreDailyTimeSlot)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, valueobjects.DailyTimeSlotID) error); ok {
+		r1 = rf(ctx, storeID, slotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, storeID
+func (_m *StoreDailyTimeSlots) GetList(ctx context.Context, storeID int64) ([]entities.StoreDailyTimeSlot, error) {
+	ret := _m.Called(ctx, storeID)
+
+	var r0 []entities.StoreDailyTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entities.StoreDailyTimeSlot); ok {
+		r0 = rf(ctx, storeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreDailyTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, storeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockDay provides a mock function with given fields: ctx, storeID, dayOfWeek
+func (_m *StoreDailyTimeSlots) LockDay(ctx context.Context, storeID int64, dayOfWeek time.Weekday) ([]entities.StoreDailyTimeSlot, error) {
+	ret := _m.Called(ctx, storeID, dayOfWeek)
+
+	var r0 []entities.StoreDailyTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Weekday) []entities.StoreDailyTimeSlot); ok {
+		r0 = rf(ctx, storeID, dayOfWeek)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreDailyTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Weekday) error); ok {
+		r1 = rf(ctx, storeID, dayOfWeek)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, slot
+func (_m *StoreDailyTimeSlots) Update(ctx context.Context, slot entities.StoreDailyTimeSlot) error {
+	ret := _m.Called(ctx, slot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreDailyTimeSlot) error); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewStoreDailyTimeSlots interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreDailyTimeSlots creates a new instance of StoreDailyTimeSlots. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreDailyTimeSlots(t mockConstructorTestingTNewStoreDailyTimeSlots) *StoreDailyTimeSlots {
+	mock := &StoreDailyTimeSlots{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
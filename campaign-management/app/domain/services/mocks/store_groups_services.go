@@ -0,0 +1,204 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// StoreGroups is an autogenerated mock type for the StoreGroups type
+type StoreGroups struct {
+	mock.Mock
+}
+
+// AddMembers provides a mock function with given fields: ctx, members
+func (_m *StoreGroups) AddMembers(ctx context.Context, members []entities.StoreGroupMember) error {
+	ret := _m.Called(ctx, members)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.StoreGroupMember) error); ok {
+		r0 = rf(ctx, members)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, storeGroup
+func (_m *StoreGroups) Create(ctx context.Context, storeGroup entities.StoreGroup) (entities.StoreGroup, error) {
+	ret := _m.Called(ctx, storeGroup)
+
+	var r0 entities.StoreGroup
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreGroup) entities.StoreGroup); ok {
+		r0 = rf(ctx, storeGroup)
+	} else {
+		r0 = ret.Get(0).(entities.StoreGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreGroup) error); ok {
+		r1 = rf(ctx, storeGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, storeGroupID, userID
+func (_m *StoreGroups) Delete(ctx context.Context, storeGroupID valueobjects.StoreGroupID, userID int64) error {
+	ret := _m.Called(ctx, storeGroupID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreGroupID, int64) error); ok {
+		r0 = rf(ctx, storeGroupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Exists provides a mock function with given fields: ctx, storeGroupID, name
+func (_m *StoreGroups) Exists(ctx context.Context, storeGroupID valueobjects.StoreGroupID, name string) (bool, error) {
+	ret := _m.Called(ctx, storeGroupID, name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreGroupID, string) bool); ok {
+		r0 = rf(ctx, storeGroupID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.StoreGroupID, string) error); ok {
+		r1 = rf(ctx, storeGroupID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, storeGroupID
+func (_m *StoreGroups) Get(ctx context.Context, storeGroupID valueobjects.StoreGroupID) (entities.StoreGroup, error) {
+	ret := _m.Called(ctx, storeGroupID)
+
+	var r0 entities.StoreGroup
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreGroupID) entities.StoreGroup); ok {
+		r0 = rf(ctx, storeGroupID)
+	} else {
+		r0 = ret.Get(0).(entities.StoreGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.StoreGroupID) error); ok {
+		r1 = rf(ctx, storeGroupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, storeGroupIDs
+func (_m *StoreGroups) GetByIDs(ctx context.Context, storeGroupIDs []int64) ([]entities.StoreGroup, error) {
+	ret := _m.Called(ctx, storeGroupIDs)
+
+	var r0 []entities.StoreGroup
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []entities.StoreGroup); ok {
+		r0 = rf(ctx, storeGroupIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, storeGroupIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, pagination
+func (_m *StoreGroups) GetList(ctx context.Context, pagination entities.PaginationConfig) ([]entities.StoreGroup, int64, error) {
+	ret := _m.Called(ctx, pagination)
+
+	var r0 []entities.StoreGroup
+	if rf, ok := ret.Get(0).(func(context.Context, entities.PaginationConfig) []entities.StoreGroup); ok {
+		r0 = rf(ctx, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreGroup)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, entities.PaginationConfig) int64); ok {
+		r1 = rf(ctx, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, entities.PaginationConfig) error); ok {
+		r2 = rf(ctx, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RemoveMember provides a mock function with given fields: ctx, storeGroupID, storeID
+func (_m *StoreGroups) RemoveMember(ctx context.Context, storeGroupID valueobjects.StoreGroupID, storeID int64) error {
+	ret := _m.Called(ctx, storeGroupID, storeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreGroupID, int64) error); ok {
+		r0 = rf(ctx, storeGroupID, storeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, storeGroup
+func (_m *StoreGroups) Update(ctx context.Context, storeGroup entities.StoreGroup) error {
+	ret := _m.Called(ctx, storeGroup)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreGroup) error); ok {
+		r0 = rf(ctx, storeGroup)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewStoreGroups interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreGroups creates a new instance of StoreGroups. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreGroups(t mockConstructorTestingTNewStoreGroups) *StoreGroups {
+	mock := &StoreGroups{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+	time "time"
+)
+
+// StoreSpecificTimeSlots is an autogenerated mock type for the StoreSpecificTimeSlots type
+type StoreSpecificTimeSlots struct {
+	mock.Mock
+}
+
+// CreateMultiple provides a mock function with given fields: ctx, slots
+func (_m *StoreSpecificTimeSlots) CreateMultiple(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]entities.StoreSpecificTimeSlot, error) {
+	ret := _m.Called(ctx, slots)
+
+	var r0 []entities.StoreSpecificTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.StoreSpecificTimeSlot) []entities.StoreSpecificTimeSlot); ok {
+		r0 = rf(ctx, slots)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreSpecificTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []entities.StoreSpecificTimeSlot) error); ok {
+		r1 = rf(ctx, slots)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, storeID, slotID, userID
+func (_m *StoreSpecificTimeSlots) Delete(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID, userID int64) error {
+	ret := _m.Called(ctx, storeID, slotID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, valueobjects.SpecificTimeSlotID, int64) error); ok {
+		r0 = rf(ctx, storeID, slotID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, storeID, slotID
+func (_m *StoreSpecificTimeSlots) Get(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID) (entities.StoreSpecificTimeSlot, error) {
+	ret := _m.Called(ctx, storeID, slotID)
+
+	var r0 entities.StoreSpecificTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64, valueobjects.SpecificTimeSlotID) entities.StoreSpecificTimeSlot); ok {
+		r0 = rf(ctx, storeID, slotID)
+	} else {
+		r0 = ret.Get(0).(entities.StoreSpecificTimeSlot)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, valueobjects.SpecificTimeSlotID) error); ok {
+		r1 = rf(ctx, storeID, slotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, storeID, from, to
+func (_m *StoreSpecificTimeSlots) GetList(ctx context.Context, storeID int64, from time.Time, to time.Time) ([]entities.StoreSpecificTimeSlot, error) {
+	ret := _m.Called(ctx, storeID, from, to)
+
+	var r0 []entities.StoreSpecificTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []entities.StoreSpecificTimeSlot); ok {
+		r0 = rf(ctx, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreSpecificTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockDates provides a mock function with given fields: ctx, storeID, from, to
+func (_m *StoreSpecificTimeSlots) LockDates(ctx context.Context, storeID int64, from time.Time, to time.Time) ([]entities.StoreSpecificTimeSlot, error) {
+	ret := _m.Called(ctx, storeID, from, to)
+
+	var r0 []entities.StoreSpecificTimeSlot
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []entities.StoreSpecificTimeSlot); ok {
+		r0 = rf(ctx, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.StoreSpecificTimeSlot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, slot
+func (_m *StoreSpecificTimeSlots) Update(ctx context.Context, slot entities.StoreSpecificTimeSlot) error {
+	ret := _m.Called(ctx, slot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreSpecificTimeSlot) error); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewStoreSpecificTimeSlots interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreSpecificTimeSlots creates a new instance of StoreSpecificTimeSlots. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreSpecificTimeSlots(t mockConstructorTestingTNewStoreSpecificTimeSlots) *StoreSpecificTimeSlots {
+	mock := &StoreSpecificTimeSlots{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// Stores is an autogenerated mock type for the Stores type
+type Stores struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, store
+func (_m *Stores) Create(ctx context.Context, store entities.Store) (entities.Store, error) {
+	ret := _m.Called(ctx, store)
+
+	var r0 entities.Store
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Store) entities.Store); ok {
+		r0 = rf(ctx, store)
+	} else {
+		r0 = ret.Get(0).(entities.Store)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Store) error); ok {
+		r1 = rf(ctx, store)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, storeID, userID
+func (_m *Stores) Delete(ctx context.Context, storeID valueobjects.StoreID, userID int64) error {
+	ret := _m.Called(ctx, storeID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreID, int64) error); ok {
+		r0 = rf(ctx, storeID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, storeID
+func (_m *Stores) Get(ctx context.Context, storeID valueobjects.StoreID) (entities.Store, error) {
+	ret := _m.Called(ctx, storeID)
+
+	var r0 entities.Store
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.StoreID) entities.Store); ok {
+		r0 = rf(ctx, storeID)
+	} else {
+		r0 = ret.Get(0).(entities.Store)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.StoreID) error); ok {
+		r1 = rf(ctx, storeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, storeIDs
+func (_m *Stores) GetByIDs(ctx context.Context, storeIDs []int64) ([]entities.Store, error) {
+	ret := _m.Called(ctx, storeIDs)
+
+	var r0 []entities.Store
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []entities.Store); ok {
+		r0 = rf(ctx, storeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Store)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, storeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, filter
+func (_m *Stores) GetList(ctx context.Context, filter entities.StoreFilter) ([]entities.Store, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []entities.Store
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreFilter) []entities.Store); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Store)
+		}
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreFilter) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, entities.StoreFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update provides a mock function with given fields: ctx, store
+func (_m *Stores) Update(ctx context.Context, store entities.Store) error {
+	ret := _m.Called(ctx, store)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Store) error); ok {
+		r0 = rf(ctx, store)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewStores interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStores creates a new instance of Stores. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStores(t mockConstructorTestingTNewStores) *Stores {
+	mock := &Stores{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
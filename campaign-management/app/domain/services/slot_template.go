@@ -0,0 +1,16 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+)
+
+//go:generate mockery --name SlotTemplates --filename slot_templates_services.go
+type SlotTemplates interface {
+	GetList(ctx context.Context) ([]entities.SlotTemplate, error)
+	Get(ctx context.Context, templateID valueobjects.SlotTemplateID) (entities.SlotTemplate, error)
+	Create(ctx context.Context, template entities.SlotTemplate) (entities.SlotTemplate, error)
+	Update(ctx context.Context, template entities.SlotTemplate) error
+	Delete(ctx context.Context, templateID valueobjects.SlotTemplateID, userID int64) error
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+)
+
+//go:generate mockery --name Stores --filename stores_services.go
+type Stores interface {
+	GetList(ctx context.Context, filter entities.StoreFilter) ([]entities.Store, int64, error)
+	GetByIDs(ctx context.Context, storeIDs []int64) ([]entities.Store, error)
+	Get(ctx context.Context, storeID valueobjects.StoreID) (entities.Store, error)
+	Create(ctx context.Context, store entities.Store) (entities.Store, error)
+	Update(ctx context.Context, store entities.Store) error
+	Delete(ctx context.Context, storeID valueobjects.StoreID, userID int64) error
+}
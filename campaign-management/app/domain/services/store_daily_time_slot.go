@@ -0,0 +1,19 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name StoreDailyTimeSlots --filename store_daily_time_slots_services.go
+type StoreDailyTimeSlots interface {
+	GetList(ctx context.Context, storeID int64) ([]entities.StoreDailyTimeSlot, error)
+	LockDay(ctx context.Context, storeID int64, dayOfWeek time.Weekday) ([]entities.StoreDailyTimeSlot, error)
+	Get(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID) (entities.StoreDailyTimeSlot, error)
+	Create(ctx context.Context, slot entities.StoreDailyTimeSlot) (entities.StoreDailyTimeSlot, error)
+	CreateMultiple(ctx context.Context, slots []entities.StoreDailyTimeSlot) ([]entities.StoreDailyTimeSlot, error)
+	Update(ctx context.Context, slot entities.StoreDailyTimeSlot) error
+	Delete(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID, userID int64) error
+}
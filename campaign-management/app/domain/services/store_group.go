@@ -0,0 +1,20 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+)
+
+//go:generate mockery --name StoreGroups --filename store_groups_services.go
+type StoreGroups interface {
+	GetList(ctx context.Context, pagination entities.PaginationConfig) ([]entities.StoreGroup, int64, error)
+	GetByIDs(ctx context.Context, storeGroupIDs []int64) ([]entities.StoreGroup, error)
+	Get(ctx context.Context, storeGroupID valueobjects.StoreGroupID) (entities.StoreGroup, error)
+	Exists(ctx context.Context, storeGroupID valueobjects.StoreGroupID, name string) (bool, error)
+	Create(ctx context.Context, storeGroup entities.StoreGroup) (entities.StoreGroup, error)
+	Update(ctx context.Context, storeGroup entities.StoreGroup) error
+	Delete(ctx context.Context, storeGroupID valueobjects.StoreGroupID, userID int64) error
+	AddMembers(ctx context.Context, members []entities.StoreGroupMember) error
+	RemoveMember(ctx context.Context, storeGroupID valueobjects.StoreGroupID, storeID int64) error
+}
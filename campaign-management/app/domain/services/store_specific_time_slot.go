@@ -0,0 +1,18 @@
+package services
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"time"
+)
+
+//go:generate mockery --name StoreSpecificTimeSlots --filename store_specific_time_slots_services.go
+type StoreSpecificTimeSlots interface {
+	GetList(ctx context.Context, storeID int64, from, to time.Time) ([]entities.StoreSpecificTimeSlot, error)
+	LockDates(ctx context.Context, storeID int64, from, to time.Time) ([]entities.StoreSpecificTimeSlot, error)
+	Get(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID) (entities.StoreSpecificTimeSlot, error)
+	CreateMultiple(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]entities.StoreSpecificTimeSlot, error)
+	Update(ctx context.Context, slot entities.StoreSpecificTimeSlot) error
+	Delete(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID, userID int64) error
+}
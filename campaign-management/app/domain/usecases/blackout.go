@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name BlackoutUseCases --filename blackout_usecases.go
+type BlackoutUseCases interface {
+	GetBlackouts(ctx context.Context, storeID int64, from, to time.Time) ([]*dto.BlackoutDTO, error)
+	GetBlackout(ctx context.Context, blackoutID int64) (*dto.BlackoutDTO, error)
+	CreateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error)
+	UpdateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error)
+	DeleteBlackout(ctx context.Context, blackoutID, userID int64) error
+	ImportBlackouts(ctx context.Context, storeID int64, blackouts []entities.Blackout) (*dto.BlackoutImportResult, error)
+	CheckCollectionDates(ctx context.Context, storeIDs []int64, collectionStartDate, collectionEndDate time.Time) error
+}
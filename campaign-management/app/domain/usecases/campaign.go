@@ -1,17 +1,26 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/usecases/dto"
-	"context"
-)
-
-//go:generate mockery --name CampaignUseCases --filename campaign_usecases.go
-type CampaignUseCases interface {
-	Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error)
-	Create(ctx context.Context, campaignData entities.Campaign) (*dto.CampaignDTO, error)
-	Exists(ctx context.Context, campaignID int64, title string) (bool, error)
-	Update(ctx context.Context, campaignData entities.Campaign) error
-	UpdateStatus(ctx context.Context) error
-	GetList(ctx context.Context, paginationData entities.PaginationConfig) (*dto.CampaignListResponse, error)
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+)
+
+//go:generate mockery --name CampaignUseCases --filename campaign_usecases.go
+type CampaignUseCases interface {
+	Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error)
+	Create(ctx context.Context, campaignData entities.Campaign) (*dto.CampaignDTO, error)
+	Exists(ctx context.Context, campaignID int64, title string) (bool, error)
+	Update(ctx context.Context, campaignData entities.Campaign) error
+	UpdateStatus(ctx context.Context) (*dto.CampaignStatusUpdateDTO, error)
+	GetList(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error)
+	GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error)
+	GetSummary(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignSummaryResponse, error)
+	GetStoreCampaigns(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) (*dto.CampaignListResponse, error)
+	GetProductCampaigns(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) (*dto.CampaignListResponse, error)
+	GetOverlaps(ctx context.Context, campaignID int64) ([]entities.CampaignOverlap, error)
+	CheckOverlaps(ctx context.Context, campaignID int64, existing []entities.CampaignOverlap) ([]int64, error)
+	Delete(ctx context.Context, campaignID, userID int64) error
+	Restore(ctx context.Context, campaignID, userID int64) error
+	Clone(ctx context.Context, campaignID int64, cloneDetails entities.Campaign) (*dto.CampaignDTO, error)
+}
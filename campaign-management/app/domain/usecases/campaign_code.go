@@ -0,0 +1,14 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+)
+
+//go:generate mockery --name CampaignCodeUseCases --filename campaign_code_usecases.go
+type CampaignCodeUseCases interface {
+	GetList(ctx context.Context) ([]*dto.CampaignCodeDTO, error)
+	Create(ctx context.Context, campaignCode entities.CampaignCode) (*dto.CampaignCodeDTO, error)
+	Update(ctx context.Context, campaignCode entities.CampaignCode) error
+}
@@ -1,17 +1,21 @@
-package usecases
-
-import (
-	"context"
-
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/usecases/dto"
-)
-
-//go:generate mockery --name CampaignProductUseCases --filename campaign_product_usecases.go
-type CampaignProductUseCases interface {
-	AddProducts(ctx context.Context, products []entities.CampaignProduct) ([]*dto.CampaignProducts, error)
-	GetProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error)
-	UpdateProducts(ctx context.Context, products []entities.CampaignProduct) error
-	DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error
-	DeleteAllByCampaignId(ctx context.Context, campaignID int64) error
-}
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name CampaignProductUseCases --filename campaign_product_usecases.go
+type CampaignProductUseCases interface {
+	AddProducts(ctx context.Context, products []entities.CampaignProduct) ([]*dto.CampaignProducts, error)
+	GetProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error)
+	GetProductList(ctx context.Context, campaignID int64, filter entities.CampaignProductFilter) (*dto.CampaignProductListResponse, error)
+	UpdateProducts(ctx context.Context, products []entities.CampaignProduct) error
+	UpdateProduct(ctx context.Context, product entities.CampaignProduct) (*dto.CampaignProducts, error)
+	ReorderProducts(ctx context.Context, campaignID int64, campaignProductIDs []int64, userID int64) ([]*dto.CampaignProducts, error)
+	DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error
+	DeleteAllByCampaignId(ctx context.Context, campaignID int64) error
+	DeleteProduct(ctx context.Context, campaignID, campaignProductID, userID int64) error
+}
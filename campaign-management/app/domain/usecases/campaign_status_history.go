@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+)
+
+//go:generate mockery --name CampaignStatusHistoryUseCases --filename campaign_status_history_usecases.go
+type CampaignStatusHistoryUseCases interface {
+	GetHistory(ctx context.Context, campaignID int64) ([]*dto.CampaignStatusHistoryDTO, error)
+}
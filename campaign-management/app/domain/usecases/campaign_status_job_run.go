@@ -0,0 +1,12 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+)
+
+//go:generate mockery --name CampaignStatusJobRunUseCases --filename campaign_status_job_run_usecases.go
+type CampaignStatusJobRunUseCases interface {
+	Record(ctx context.Context, run entities.CampaignStatusJobRun) error
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name CollectionSlotUseCases --filename collection_slot_usecases.go
+type CollectionSlotUseCases interface {
+	GetAvailableSlots(ctx context.Context, campaignID, storeID int64, from, to time.Time) (*dto.CollectionSlotAvailability, error)
+	ReserveSlot(ctx context.Context, reservation entities.CollectionSlotReservation) (*dto.CollectionSlotReservationDTO, error)
+	ConfirmReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID, userID int64) (*dto.CollectionSlotReservationDTO, error)
+	ReleaseReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID, userID int64) error
+}
@@ -0,0 +1,175 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BlackoutUseCases is an autogenerated mock type for the BlackoutUseCases type
+type BlackoutUseCases struct {
+	mock.Mock
+}
+
+// CheckCollectionDates provides a mock function with given fields: ctx, storeIDs, collectionStartDate, collectionEndDate
+func (_m *BlackoutUseCases) CheckCollectionDates(ctx context.Context, storeIDs []int64, collectionStartDate time.Time, collectionEndDate time.Time) error {
+	ret := _m.Called(ctx, storeIDs, collectionStartDate, collectionEndDate)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, storeIDs, collectionStartDate, collectionEndDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateBlackout provides a mock function with given fields: ctx, blackout
+func (_m *BlackoutUseCases) CreateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error) {
+	ret := _m.Called(ctx, blackout)
+
+	var r0 *dto.BlackoutDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Blackout) *dto.BlackoutDTO); ok {
+		r0 = rf(ctx, blackout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BlackoutDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Blackout) error); ok {
+		r1 = rf(ctx, blackout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlackout provides a mock function with given fields: ctx, blackoutID, userID
+func (_m *BlackoutUseCases) DeleteBlackout(ctx context.Context, blackoutID int64, userID int64) error {
+	ret := _m.Called(ctx, blackoutID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, blackoutID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBlackout provides a mock function with given fields: ctx, blackoutID
+func (_m *BlackoutUseCases) GetBlackout(ctx context.Context, blackoutID int64) (*dto.BlackoutDTO, error) {
+	ret := _m.Called(ctx, blackoutID)
+
+	var r0 *dto.BlackoutDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.BlackoutDTO); ok {
+		r0 = rf(ctx, blackoutID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BlackoutDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, blackoutID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlackouts provides a mock function with given fields: ctx, storeID, from, to
+func (_m *BlackoutUseCases) GetBlackouts(ctx context.Context, storeID int64, from time.Time, to time.Time) ([]*dto.BlackoutDTO, error) {
+	ret := _m.Called(ctx, storeID, from, to)
+
+	var r0 []*dto.BlackoutDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []*dto.BlackoutDTO); ok {
+		r0 = rf(ctx, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.BlackoutDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportBlackouts provides a mock function with given fields: ctx, storeID, blackouts
+func (_m *BlackoutUseCases) ImportBlackouts(ctx context.Context, storeID int64, blackouts []entities.Blackout) (*dto.BlackoutImportResult, error) {
+	ret := _m.Called(ctx, storeID, blackouts)
+
+	var r0 *dto.BlackoutImportResult
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []entities.Blackout) *dto.BlackoutImportResult); ok {
+		r0 = rf(ctx, storeID, blackouts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BlackoutImportResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []entities.Blackout) error); ok {
+		r1 = rf(ctx, storeID, blackouts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBlackout provides a mock function with given fields: ctx, blackout
+func (_m *BlackoutUseCases) UpdateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error) {
+	ret := _m.Called(ctx, blackout)
+
+	var r0 *dto.BlackoutDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Blackout) *dto.BlackoutDTO); ok {
+		r0 = rf(ctx, blackout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BlackoutDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Blackout) error); ok {
+		r1 = rf(ctx, blackout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewBlackoutUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewBlackoutUseCases creates a new instance of BlackoutUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewBlackoutUseCases(t mockConstructorTestingTNewBlackoutUseCases) *BlackoutUseCases {
+	mock := &BlackoutUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
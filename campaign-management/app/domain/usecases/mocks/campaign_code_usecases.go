@@ -0,0 +1,91 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CampaignCodeUseCases is an autogenerated mock type for the CampaignCodeUseCases type
+type CampaignCodeUseCases struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, campaignCode
+func (_m *CampaignCodeUseCases) Create(ctx context.Context, campaignCode entities.CampaignCode) (*dto.CampaignCodeDTO, error) {
+	ret := _m.Called(ctx, campaignCode)
+
+	var r0 *dto.CampaignCodeDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignCode) *dto.CampaignCodeDTO); ok {
+		r0 = rf(ctx, campaignCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignCodeDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignCode) error); ok {
+		r1 = rf(ctx, campaignCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx
+func (_m *CampaignCodeUseCases) GetList(ctx context.Context) ([]*dto.CampaignCodeDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*dto.CampaignCodeDTO
+	if rf, ok := ret.Get(0).(func(context.Context) []*dto.CampaignCodeDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.CampaignCodeDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, campaignCode
+func (_m *CampaignCodeUseCases) Update(ctx context.Context, campaignCode entities.CampaignCode) error {
+	ret := _m.Called(ctx, campaignCode)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignCode) error); ok {
+		r0 = rf(ctx, campaignCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCampaignCodeUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignCodeUseCases creates a new instance of CampaignCodeUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignCodeUseCases(t mockConstructorTestingTNewCampaignCodeUseCases) *CampaignCodeUseCases {
+	mock := &CampaignCodeUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
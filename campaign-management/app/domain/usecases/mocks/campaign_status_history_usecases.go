@@ -0,0 +1,53 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CampaignStatusHistoryUseCases is an autogenerated mock type for the CampaignStatusHistoryUseCases type
+type CampaignStatusHistoryUseCases struct {
+	mock.Mock
+}
+
+// GetHistory provides a mock function with given fields: ctx, campaignID
+func (_m *CampaignStatusHistoryUseCases) GetHistory(ctx context.Context, campaignID int64) ([]*dto.CampaignStatusHistoryDTO, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 []*dto.CampaignStatusHistoryDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*dto.CampaignStatusHistoryDTO); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.CampaignStatusHistoryDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCampaignStatusHistoryUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignStatusHistoryUseCases creates a new instance of CampaignStatusHistoryUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignStatusHistoryUseCases(t mockConstructorTestingTNewCampaignStatusHistoryUseCases) *CampaignStatusHistoryUseCases {
+	mock := &CampaignStatusHistoryUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
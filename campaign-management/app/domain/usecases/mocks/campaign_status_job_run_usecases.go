@@ -0,0 +1,44 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CampaignStatusJobRunUseCases is an autogenerated mock type for the CampaignStatusJobRunUseCases type
+type CampaignStatusJobRunUseCases struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, run
+func (_m *CampaignStatusJobRunUseCases) Record(ctx context.Context, run entities.CampaignStatusJobRun) error {
+	ret := _m.Called(ctx, run)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignStatusJobRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCampaignStatusJobRunUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignStatusJobRunUseCases creates a new instance of CampaignStatusJobRunUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignStatusJobRunUseCases(t mockConstructorTestingTNewCampaignStatusJobRunUseCases) *CampaignStatusJobRunUseCases {
+	mock := &CampaignStatusJobRunUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
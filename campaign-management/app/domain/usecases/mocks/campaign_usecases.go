@@ -1,149 +1,347 @@
-// Code generated by mockery v2.16.0. DO NOT EDIT.
-
-package mocks
-
-import (
-	entities "campaign-mgmt/app/domain/entities"
-	dto "campaign-mgmt/app/usecases/dto"
-	context "context"
-
-	mock "github.com/stretchr/testify/mock"
-)
-
-// CampaignUseCases is an autogenerated mock type for the CampaignUseCases type
-type CampaignUseCases struct {
-	mock.Mock
-}
-
-// Create provides a mock function with given fields: ctx, campaignData
-func (_m *CampaignUseCases) Create(ctx context.Context, campaignData entities.Campaign) (*dto.CampaignDTO, error) {
-	ret := _m.Called(ctx, campaignData)
-
-	var r0 *dto.CampaignDTO
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) *dto.CampaignDTO); ok {
-		r0 = rf(ctx, campaignData)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.CampaignDTO)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Campaign) error); ok {
-		r1 = rf(ctx, campaignData)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Exists provides a mock function with given fields: ctx, campaignID, title
-func (_m *CampaignUseCases) Exists(ctx context.Context, campaignID int64, title string) (bool, error) {
-	ret := _m.Called(ctx, campaignID, title)
-
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
-		r0 = rf(ctx, campaignID, title)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = rf(ctx, campaignID, title)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Get provides a mock function with given fields: ctx, campaignID
-func (_m *CampaignUseCases) Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error) {
-	ret := _m.Called(ctx, campaignID)
-
-	var r0 *dto.CampaignDTO
-	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.CampaignDTO); ok {
-		r0 = rf(ctx, campaignID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.CampaignDTO)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, campaignID)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetList provides a mock function with given fields: ctx, paginationData
-func (_m *CampaignUseCases) GetList(ctx context.Context, paginationData entities.PaginationConfig) (*dto.CampaignListResponse, error) {
-	ret := _m.Called(ctx, paginationData)
-
-	var r0 *dto.CampaignListResponse
-	if rf, ok := ret.Get(0).(func(context.Context, entities.PaginationConfig) *dto.CampaignListResponse); ok {
-		r0 = rf(ctx, paginationData)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.CampaignListResponse)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, entities.PaginationConfig) error); ok {
-		r1 = rf(ctx, paginationData)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Update provides a mock function with given fields: ctx, campaignData
-func (_m *CampaignUseCases) Update(ctx context.Context, campaignData entities.Campaign) error {
-	ret := _m.Called(ctx, campaignData)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) error); ok {
-		r0 = rf(ctx, campaignData)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// UpdateStatus provides a mock function with given fields: ctx
-func (_m *CampaignUseCases) UpdateStatus(ctx context.Context) error {
-	ret := _m.Called(ctx)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-type mockConstructorTestingTNewCampaignUseCases interface {
-	mock.TestingT
-	Cleanup(func())
-}
-
-// NewCampaignUseCases creates a new instance of CampaignUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewCampaignUseCases(t mockConstructorTestingTNewCampaignUseCases) *CampaignUseCases {
-	mock := &CampaignUseCases{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CampaignUseCases is an autogenerated mock type for the CampaignUseCases type
+type CampaignUseCases struct {
+	mock.Mock
+}
+
+// CheckOverlaps provides a mock function with given fields: ctx, campaignID, existing
+func (_m *CampaignUseCases) CheckOverlaps(ctx context.Context, campaignID int64, existing []entities.CampaignOverlap) ([]int64, error) {
+	ret := _m.Called(ctx, campaignID, existing)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []entities.CampaignOverlap) []int64); ok {
+		r0 = rf(ctx, campaignID, existing)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []entities.CampaignOverlap) error); ok {
+		r1 = rf(ctx, campaignID, existing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Clone provides a mock function with given fields: ctx, campaignID, cloneDetails
+func (_m *CampaignUseCases) Clone(ctx context.Context, campaignID int64, cloneDetails entities.Campaign) (*dto.CampaignDTO, error) {
+	ret := _m.Called(ctx, campaignID, cloneDetails)
+
+	var r0 *dto.CampaignDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.Campaign) *dto.CampaignDTO); ok {
+		r0 = rf(ctx, campaignID, cloneDetails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entities.Campaign) error); ok {
+		r1 = rf(ctx, campaignID, cloneDetails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, campaignData
+func (_m *CampaignUseCases) Create(ctx context.Context, campaignData entities.Campaign) (*dto.CampaignDTO, error) {
+	ret := _m.Called(ctx, campaignData)
+
+	var r0 *dto.CampaignDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) *dto.CampaignDTO); ok {
+		r0 = rf(ctx, campaignData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Campaign) error); ok {
+		r1 = rf(ctx, campaignData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, campaignID, userID
+func (_m *CampaignUseCases) Delete(ctx context.Context, campaignID int64, userID int64) error {
+	ret := _m.Called(ctx, campaignID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, campaignID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Exists provides a mock function with given fields: ctx, campaignID, title
+func (_m *CampaignUseCases) Exists(ctx context.Context, campaignID int64, title string) (bool, error) {
+	ret := _m.Called(ctx, campaignID, title)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
+		r0 = rf(ctx, campaignID, title)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, campaignID, title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, campaignID
+func (_m *CampaignUseCases) Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 *dto.CampaignDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.CampaignDTO); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList provides a mock function with given fields: ctx, filter
+func (_m *CampaignUseCases) GetList(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *dto.CampaignListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter) *dto.CampaignListResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListByCursor provides a mock function with given fields: ctx, filter
+func (_m *CampaignUseCases) GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *dto.CampaignListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter) *dto.CampaignListResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverlaps provides a mock function with given fields: ctx, campaignID
+func (_m *CampaignUseCases) GetOverlaps(ctx context.Context, campaignID int64) ([]entities.CampaignOverlap, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	var r0 []entities.CampaignOverlap
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entities.CampaignOverlap); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.CampaignOverlap)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCampaigns provides a mock function with given fields: ctx, productID, filter
+func (_m *CampaignUseCases) GetProductCampaigns(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) (*dto.CampaignListResponse, error) {
+	ret := _m.Called(ctx, productID, filter)
+
+	var r0 *dto.CampaignListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.ProductCampaignFilter) *dto.CampaignListResponse); ok {
+		r0 = rf(ctx, productID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entities.ProductCampaignFilter) error); ok {
+		r1 = rf(ctx, productID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoreCampaigns provides a mock function with given fields: ctx, storeID, filter
+func (_m *CampaignUseCases) GetStoreCampaigns(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) (*dto.CampaignListResponse, error) {
+	ret := _m.Called(ctx, storeID, filter)
+
+	var r0 *dto.CampaignListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int64, entities.StoreCampaignFilter) *dto.CampaignListResponse); ok {
+		r0 = rf(ctx, storeID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, entities.StoreCampaignFilter) error); ok {
+		r1 = rf(ctx, storeID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSummary provides a mock function with given fields: ctx, filter
+func (_m *CampaignUseCases) GetSummary(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignSummaryResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *dto.CampaignSummaryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CampaignListFilter) *dto.CampaignSummaryResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignSummaryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CampaignListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, campaignID, userID
+func (_m *CampaignUseCases) Restore(ctx context.Context, campaignID int64, userID int64) error {
+	ret := _m.Called(ctx, campaignID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, campaignID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, campaignData
+func (_m *CampaignUseCases) Update(ctx context.Context, campaignData entities.Campaign) error {
+	ret := _m.Called(ctx, campaignData)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Campaign) error); ok {
+		r0 = rf(ctx, campaignData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx
+func (_m *CampaignUseCases) UpdateStatus(ctx context.Context) (*dto.CampaignStatusUpdateDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.CampaignStatusUpdateDTO
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.CampaignStatusUpdateDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CampaignStatusUpdateDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCampaignUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCampaignUseCases creates a new instance of CampaignUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCampaignUseCases(t mockConstructorTestingTNewCampaignUseCases) *CampaignUseCases {
+	mock := &CampaignUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,117 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "campaign-mgmt/app/domain/valueobjects"
+)
+
+// CollectionSlotUseCases is an autogenerated mock type for the CollectionSlotUseCases type
+type CollectionSlotUseCases struct {
+	mock.Mock
+}
+
+// ConfirmReservation provides a mock function with given fields: ctx, slot, reservationID, userID
+func (_m *CollectionSlotUseCases) ConfirmReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID int64, userID int64) (*dto.CollectionSlotReservationDTO, error) {
+	ret := _m.Called(ctx, slot, reservationID, userID)
+
+	var r0 *dto.CollectionSlotReservationDTO
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.SlotKey, int64, int64) *dto.CollectionSlotReservationDTO); ok {
+		r0 = rf(ctx, slot, reservationID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CollectionSlotReservationDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.SlotKey, int64, int64) error); ok {
+		r1 = rf(ctx, slot, reservationID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAvailableSlots provides a mock function with given fields: ctx, campaignID, storeID, from, to
+func (_m *CollectionSlotUseCases) GetAvailableSlots(ctx context.Context, campaignID int64, storeID int64, from time.Time, to time.Time) (*dto.CollectionSlotAvailability, error) {
+	ret := _m.Called(ctx, campaignID, storeID, from, to)
+
+	var r0 *dto.CollectionSlotAvailability
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time, time.Time) *dto.CollectionSlotAvailability); ok {
+		r0 = rf(ctx, campaignID, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CollectionSlotAvailability)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, campaignID, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, slot, reservationID, userID
+func (_m *CollectionSlotUseCases) ReleaseReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID int64, userID int64) error {
+	ret := _m.Called(ctx, slot, reservationID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.SlotKey, int64, int64) error); ok {
+		r0 = rf(ctx, slot, reservationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveSlot provides a mock function with given fields: ctx, reservation
+func (_m *CollectionSlotUseCases) ReserveSlot(ctx context.Context, reservation entities.CollectionSlotReservation) (*dto.CollectionSlotReservationDTO, error) {
+	ret := _m.Called(ctx, reservation)
+
+	var r0 *dto.CollectionSlotReservationDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.CollectionSlotReservation) *dto.CollectionSlotReservationDTO); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CollectionSlotReservationDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.CollectionSlotReservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCollectionSlotUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCollectionSlotUseCases creates a new instance of CollectionSlotUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCollectionSlotUseCases(t mockConstructorTestingTNewCollectionSlotUseCases) *CollectionSlotUseCases {
+	mock := &CollectionSlotUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,160 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SlotTemplateUseCases is an autogenerated mock type for the SlotTemplateUseCases type
+type SlotTemplateUseCases struct {
+	mock.Mock
+}
+
+// ApplyTemplate provides a mock function with given fields: ctx, templateID, storeIDs, dryRun, userID
+func (_m *SlotTemplateUseCases) ApplyTemplate(ctx context.Context, templateID int64, storeIDs []int64, dryRun bool, userID int64) (*dto.SlotTemplateApplyResult, error) {
+	ret := _m.Called(ctx, templateID, storeIDs, dryRun, userID)
+
+	var r0 *dto.SlotTemplateApplyResult
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64, bool, int64) *dto.SlotTemplateApplyResult); ok {
+		r0 = rf(ctx, templateID, storeIDs, dryRun, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SlotTemplateApplyResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64, bool, int64) error); ok {
+		r1 = rf(ctx, templateID, storeIDs, dryRun, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTemplate provides a mock function with given fields: ctx, template
+func (_m *SlotTemplateUseCases) CreateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error) {
+	ret := _m.Called(ctx, template)
+
+	var r0 *dto.SlotTemplateDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SlotTemplate) *dto.SlotTemplateDTO); ok {
+		r0 = rf(ctx, template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SlotTemplateDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.SlotTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, templateID, userID
+func (_m *SlotTemplateUseCases) DeleteTemplate(ctx context.Context, templateID int64, userID int64) error {
+	ret := _m.Called(ctx, templateID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, templateID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTemplate provides a mock function with given fields: ctx, templateID
+func (_m *SlotTemplateUseCases) GetTemplate(ctx context.Context, templateID int64) (*dto.SlotTemplateDTO, error) {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 *dto.SlotTemplateDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.SlotTemplateDTO); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SlotTemplateDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplates provides a mock function with given fields: ctx
+func (_m *SlotTemplateUseCases) GetTemplates(ctx context.Context) ([]*dto.SlotTemplateDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*dto.SlotTemplateDTO
+	if rf, ok := ret.Get(0).(func(context.Context) []*dto.SlotTemplateDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.SlotTemplateDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTemplate provides a mock function with given fields: ctx, template
+func (_m *SlotTemplateUseCases) UpdateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error) {
+	ret := _m.Called(ctx, template)
+
+	var r0 *dto.SlotTemplateDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SlotTemplate) *dto.SlotTemplateDTO); ok {
+		r0 = rf(ctx, template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SlotTemplateDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.SlotTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSlotTemplateUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSlotTemplateUseCases creates a new instance of SlotTemplateUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSlotTemplateUseCases(t mockConstructorTestingTNewSlotTemplateUseCases) *SlotTemplateUseCases {
+	mock := &SlotTemplateUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
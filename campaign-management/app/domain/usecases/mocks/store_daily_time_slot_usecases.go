@@ -0,0 +1,137 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoreDailyTimeSlotUseCases is an autogenerated mock type for the StoreDailyTimeSlotUseCases type
+type StoreDailyTimeSlotUseCases struct {
+	mock.Mock
+}
+
+// CreateSlot provides a mock function with given fields: ctx, slot
+func (_m *StoreDailyTimeSlotUseCases) CreateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error) {
+	ret := _m.Called(ctx, slot)
+
+	var r0 *dto.StoreDailyTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreDailyTimeSlot) *dto.StoreDailyTimeSlotDTO); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDailyTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreDailyTimeSlot) error); ok {
+		r1 = rf(ctx, slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSlot provides a mock function with given fields: ctx, storeID, slotID, userID
+func (_m *StoreDailyTimeSlotUseCases) DeleteSlot(ctx context.Context, storeID int64, slotID int64, userID int64) error {
+	ret := _m.Called(ctx, storeID, slotID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, storeID, slotID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSlot provides a mock function with given fields: ctx, storeID, slotID
+func (_m *StoreDailyTimeSlotUseCases) GetSlot(ctx context.Context, storeID int64, slotID int64) (*dto.StoreDailyTimeSlotDTO, error) {
+	ret := _m.Called(ctx, storeID, slotID)
+
+	var r0 *dto.StoreDailyTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *dto.StoreDailyTimeSlotDTO); ok {
+		r0 = rf(ctx, storeID, slotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDailyTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, storeID, slotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSlots provides a mock function with given fields: ctx, storeID
+func (_m *StoreDailyTimeSlotUseCases) GetSlots(ctx context.Context, storeID int64) ([]*dto.StoreDailyTimeSlotDTO, error) {
+	ret := _m.Called(ctx, storeID)
+
+	var r0 []*dto.StoreDailyTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*dto.StoreDailyTimeSlotDTO); ok {
+		r0 = rf(ctx, storeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.StoreDailyTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, storeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSlot provides a mock function with given fields: ctx, slot
+func (_m *StoreDailyTimeSlotUseCases) UpdateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error) {
+	ret := _m.Called(ctx, slot)
+
+	var r0 *dto.StoreDailyTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreDailyTimeSlot) *dto.StoreDailyTimeSlotDTO); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDailyTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreDailyTimeSlot) error); ok {
+		r1 = rf(ctx, slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewStoreDailyTimeSlotUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreDailyTimeSlotUseCases creates a new instance of StoreDailyTimeSlotUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreDailyTimeSlotUseCases(t mockConstructorTestingTNewStoreDailyTimeSlotUseCases) *StoreDailyTimeSlotUseCases {
+	mock := &StoreDailyTimeSlotUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoreGroupUseCases is an autogenerated mock type for the StoreGroupUseCases type
+type StoreGroupUseCases struct {
+	mock.Mock
+}
+
+// AddStores provides a mock function with given fields: ctx, storeGroupID, members
+func (_m *StoreGroupUseCases) AddStores(ctx context.Context, storeGroupID int64, members []entities.StoreGroupMember) (*dto.StoreGroupDTO, error) {
+	ret := _m.Called(ctx, storeGroupID, members)
+
+	var r0 *dto.StoreGroupDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []entities.StoreGroupMember) *dto.StoreGroupDTO); ok {
+		r0 = rf(ctx, storeGroupID, members)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreGroupDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []entities.StoreGroupMember) error); ok {
+		r1 = rf(ctx, storeGroupID, members)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateStoreGroup provides a mock function with given fields: ctx, storeGroup
+func (_m *StoreGroupUseCases) CreateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error) {
+	ret := _m.Called(ctx, storeGroup)
+
+	var r0 *dto.StoreGroupDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreGroup) *dto.StoreGroupDTO); ok {
+		r0 = rf(ctx, storeGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreGroupDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreGroup) error); ok {
+		r1 = rf(ctx, storeGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStoreGroup provides a mock function with given fields: ctx, storeGroupID, userID
+func (_m *StoreGroupUseCases) DeleteStoreGroup(ctx context.Context, storeGroupID int64, userID int64) error {
+	ret := _m.Called(ctx, storeGroupID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, storeGroupID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetStoreGroup provides a mock function with given fields: ctx, storeGroupID
+func (_m *StoreGroupUseCases) GetStoreGroup(ctx context.Context, storeGroupID int64) (*dto.StoreGroupDTO, error) {
+	ret := _m.Called(ctx, storeGroupID)
+
+	var r0 *dto.StoreGroupDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.StoreGroupDTO); ok {
+		r0 = rf(ctx, storeGroupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreGroupDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, storeGroupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStoreGroups provides a mock function with given fields: ctx, pagination
+func (_m *StoreGroupUseCases) GetStoreGroups(ctx context.Context, pagination entities.PaginationConfig) (*dto.StoreGroupListResponse, error) {
+	ret := _m.Called(ctx, pagination)
+
+	var r0 *dto.StoreGroupListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, entities.PaginationConfig) *dto.StoreGroupListResponse); ok {
+		r0 = rf(ctx, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreGroupListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.PaginationConfig) error); ok {
+		r1 = rf(ctx, pagination)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveStore provides a mock function with given fields: ctx, storeGroupID, storeID
+func (_m *StoreGroupUseCases) RemoveStore(ctx context.Context, storeGroupID int64, storeID int64) error {
+	ret := _m.Called(ctx, storeGroupID, storeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, storeGroupID, storeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStoreGroup provides a mock function with given fields: ctx, storeGroup
+func (_m *StoreGroupUseCases) UpdateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error) {
+	ret := _m.Called(ctx, storeGroup)
+
+	var r0 *dto.StoreGroupDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreGroup) *dto.StoreGroupDTO); ok {
+		r0 = rf(ctx, storeGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreGroupDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreGroup) error); ok {
+		r1 = rf(ctx, storeGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewStoreGroupUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreGroupUseCases creates a new instance of StoreGroupUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreGroupUseCases(t mockConstructorTestingTNewStoreGroupUseCases) *StoreGroupUseCases {
+	mock := &StoreGroupUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
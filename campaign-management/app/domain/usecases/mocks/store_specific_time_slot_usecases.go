@@ -0,0 +1,138 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoreSpecificTimeSlotUseCases is an autogenerated mock type for the StoreSpecificTimeSlotUseCases type
+type StoreSpecificTimeSlotUseCases struct {
+	mock.Mock
+}
+
+// CreateSlots provides a mock function with given fields: ctx, slots
+func (_m *StoreSpecificTimeSlotUseCases) CreateSlots(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]*dto.StoreSpecificTimeSlotDTO, error) {
+	ret := _m.Called(ctx, slots)
+
+	var r0 []*dto.StoreSpecificTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, []entities.StoreSpecificTimeSlot) []*dto.StoreSpecificTimeSlotDTO); ok {
+		r0 = rf(ctx, slots)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.StoreSpecificTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []entities.StoreSpecificTimeSlot) error); ok {
+		r1 = rf(ctx, slots)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSlot provides a mock function with given fields: ctx, storeID, slotID, userID
+func (_m *StoreSpecificTimeSlotUseCases) DeleteSlot(ctx context.Context, storeID int64, slotID int64, userID int64) error {
+	ret := _m.Called(ctx, storeID, slotID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, storeID, slotID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSlot provides a mock function with given fields: ctx, storeID, slotID
+func (_m *StoreSpecificTimeSlotUseCases) GetSlot(ctx context.Context, storeID int64, slotID int64) (*dto.StoreSpecificTimeSlotDTO, error) {
+	ret := _m.Called(ctx, storeID, slotID)
+
+	var r0 *dto.StoreSpecificTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *dto.StoreSpecificTimeSlotDTO); ok {
+		r0 = rf(ctx, storeID, slotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreSpecificTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, storeID, slotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSlots provides a mock function with given fields: ctx, storeID, from, to
+func (_m *StoreSpecificTimeSlotUseCases) GetSlots(ctx context.Context, storeID int64, from time.Time, to time.Time) ([]*dto.StoreSpecificTimeSlotDTO, error) {
+	ret := _m.Called(ctx, storeID, from, to)
+
+	var r0 []*dto.StoreSpecificTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []*dto.StoreSpecificTimeSlotDTO); ok {
+		r0 = rf(ctx, storeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.StoreSpecificTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, storeID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSlot provides a mock function with given fields: ctx, slot
+func (_m *StoreSpecificTimeSlotUseCases) UpdateSlot(ctx context.Context, slot entities.StoreSpecificTimeSlot) (*dto.StoreSpecificTimeSlotDTO, error) {
+	ret := _m.Called(ctx, slot)
+
+	var r0 *dto.StoreSpecificTimeSlotDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreSpecificTimeSlot) *dto.StoreSpecificTimeSlotDTO); ok {
+		r0 = rf(ctx, slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreSpecificTimeSlotDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreSpecificTimeSlot) error); ok {
+		r1 = rf(ctx, slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewStoreSpecificTimeSlotUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreSpecificTimeSlotUseCases creates a new instance of StoreSpecificTimeSlotUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreSpecificTimeSlotUseCases(t mockConstructorTestingTNewStoreSpecificTimeSlotUseCases) *StoreSpecificTimeSlotUseCases {
+	mock := &StoreSpecificTimeSlotUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,137 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "campaign-mgmt/app/domain/entities"
+	dto "campaign-mgmt/app/usecases/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoreUseCases is an autogenerated mock type for the StoreUseCases type
+type StoreUseCases struct {
+	mock.Mock
+}
+
+// CreateStore provides a mock function with given fields: ctx, store
+func (_m *StoreUseCases) CreateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error) {
+	ret := _m.Called(ctx, store)
+
+	var r0 *dto.StoreDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Store) *dto.StoreDTO); ok {
+		r0 = rf(ctx, store)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Store) error); ok {
+		r1 = rf(ctx, store)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStore provides a mock function with given fields: ctx, storeID, userID
+func (_m *StoreUseCases) DeleteStore(ctx context.Context, storeID int64, userID int64) error {
+	ret := _m.Called(ctx, storeID, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, storeID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetStore provides a mock function with given fields: ctx, storeID
+func (_m *StoreUseCases) GetStore(ctx context.Context, storeID int64) (*dto.StoreDTO, error) {
+	ret := _m.Called(ctx, storeID)
+
+	var r0 *dto.StoreDTO
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *dto.StoreDTO); ok {
+		r0 = rf(ctx, storeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, storeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStores provides a mock function with given fields: ctx, filter
+func (_m *StoreUseCases) GetStores(ctx context.Context, filter entities.StoreFilter) (*dto.StoreListResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *dto.StoreListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, entities.StoreFilter) *dto.StoreListResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.StoreFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStore provides a mock function with given fields: ctx, store
+func (_m *StoreUseCases) UpdateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error) {
+	ret := _m.Called(ctx, store)
+
+	var r0 *dto.StoreDTO
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Store) *dto.StoreDTO); ok {
+		r0 = rf(ctx, store)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StoreDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Store) error); ok {
+		r1 = rf(ctx, store)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewStoreUseCases interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStoreUseCases creates a new instance of StoreUseCases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStoreUseCases(t mockConstructorTestingTNewStoreUseCases) *StoreUseCases {
+	mock := &StoreUseCases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name SlotTemplateUseCases --filename slot_template_usecases.go
+type SlotTemplateUseCases interface {
+	GetTemplates(ctx context.Context) ([]*dto.SlotTemplateDTO, error)
+	GetTemplate(ctx context.Context, templateID int64) (*dto.SlotTemplateDTO, error)
+	CreateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error)
+	UpdateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error)
+	DeleteTemplate(ctx context.Context, templateID, userID int64) error
+	ApplyTemplate(ctx context.Context, templateID int64, storeIDs []int64, dryRun bool, userID int64) (*dto.SlotTemplateApplyResult, error)
+}
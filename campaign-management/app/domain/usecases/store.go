@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name StoreUseCases --filename store_usecases.go
+type StoreUseCases interface {
+	GetStores(ctx context.Context, filter entities.StoreFilter) (*dto.StoreListResponse, error)
+	GetStore(ctx context.Context, storeID int64) (*dto.StoreDTO, error)
+	CreateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error)
+	UpdateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error)
+	DeleteStore(ctx context.Context, storeID, userID int64) error
+}
@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name StoreDailyTimeSlotUseCases --filename store_daily_time_slot_usecases.go
+type StoreDailyTimeSlotUseCases interface {
+	GetSlots(ctx context.Context, storeID int64) ([]*dto.StoreDailyTimeSlotDTO, error)
+	GetSlot(ctx context.Context, storeID, slotID int64) (*dto.StoreDailyTimeSlotDTO, error)
+	CreateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error)
+	UpdateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error)
+	DeleteSlot(ctx context.Context, storeID, slotID, userID int64) error
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name StoreGroupUseCases --filename store_group_usecases.go
+type StoreGroupUseCases interface {
+	GetStoreGroups(ctx context.Context, pagination entities.PaginationConfig) (*dto.StoreGroupListResponse, error)
+	GetStoreGroup(ctx context.Context, storeGroupID int64) (*dto.StoreGroupDTO, error)
+	CreateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error)
+	UpdateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error)
+	DeleteStoreGroup(ctx context.Context, storeGroupID, userID int64) error
+	AddStores(ctx context.Context, storeGroupID int64, members []entities.StoreGroupMember) (*dto.StoreGroupDTO, error)
+	RemoveStore(ctx context.Context, storeGroupID, storeID int64) error
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+//go:generate mockery --name StoreSpecificTimeSlotUseCases --filename store_specific_time_slot_usecases.go
+type StoreSpecificTimeSlotUseCases interface {
+	GetSlots(ctx context.Context, storeID int64, from, to time.Time) ([]*dto.StoreSpecificTimeSlotDTO, error)
+	GetSlot(ctx context.Context, storeID, slotID int64) (*dto.StoreSpecificTimeSlotDTO, error)
+	CreateSlots(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]*dto.StoreSpecificTimeSlotDTO, error)
+	UpdateSlot(ctx context.Context, slot entities.StoreSpecificTimeSlot) (*dto.StoreSpecificTimeSlotDTO, error)
+	DeleteSlot(ctx context.Context, storeID, slotID, userID int64) error
+}
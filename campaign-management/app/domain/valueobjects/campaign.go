@@ -1,38 +1,84 @@
-package valueobjects
-
-type (
-	CampaignID         int64
-	CampaignProductID  int64
-	CampaignStoreID    int64
-	DailyTimeSlotID    int64
-	SpecificTimeSlotID int64
-	CampaignType       string
-	CampaignStatusCode int64
-)
-
-const (
-	CampaignTypePreOrder CampaignType = "preorder" // should it be deli ?
-)
-
-func (c CampaignID) ToInt64() int64 {
-	return int64(c)
-}
-
-func (c CampaignProductID) ToInt64() int64 {
-	return int64(c)
-}
-
-func (c CampaignStoreID) ToInt64() int64 {
-	return int64(c)
-}
-
-func (c DailyTimeSlotID) ToInt64() int64 {
-	return int64(c)
-}
-
-func (c SpecificTimeSlotID) ToInt64() int64 {
-	return int64(c)
-}
-func (d CampaignType) String() string {
-	return string(d)
-}
+package valueobjects
+
+type (
+	CampaignID         int64
+	CampaignProductID  int64
+	CampaignStoreID    int64
+	DailyTimeSlotID    int64
+	SpecificTimeSlotID int64
+	ReservationID      int64
+	SlotTemplateID     int64
+	BlackoutID         int64
+	StoreID            int64
+	StoreGroupID       int64
+	StatusJobRunID     int64
+	StatusHistoryID    int64
+	CampaignType       string
+	CampaignStatusCode int64
+	OverlapMode        string
+)
+
+const (
+	CampaignTypePreOrder CampaignType = "preorder" // should it be deli ?
+)
+
+// OverlapModeWarn reports the campaigns a change overlaps and keeps the change, OverlapModeReject undoes it
+const (
+	OverlapModeWarn   OverlapMode = "warn"
+	OverlapModeReject OverlapMode = "reject"
+)
+
+// StatusChangeActorScheduler is recorded as actor for status changes made by the status job
+const StatusChangeActorScheduler = "scheduler"
+
+func (c CampaignID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c CampaignProductID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c CampaignStoreID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c DailyTimeSlotID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c SpecificTimeSlotID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c ReservationID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c SlotTemplateID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c BlackoutID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c StoreID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c StoreGroupID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c StatusJobRunID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (c StatusHistoryID) ToInt64() int64 {
+	return int64(c)
+}
+
+func (d CampaignType) String() string {
+	return string(d)
+}
@@ -1,12 +1,113 @@
-package valueobjects
-
-// CampaignStatusCode ..
-func (c CampaignStatusCode) Code() int64 {
-	return int64(c)
-}
-
-var (
-	CampaignStatusInActive  CampaignStatusCode = 1
-	CampaignStatusActive    CampaignStatusCode = 2
-	CampaignStatusScheduled CampaignStatusCode = 3
-)
+package valueobjects
+
+import (
+	"fmt"
+	"time"
+)
+
+// CampaignStatusCode ..
+func (c CampaignStatusCode) Code() int64 {
+	return int64(c)
+}
+
+var (
+	CampaignStatusInActive  CampaignStatusCode = 1
+	CampaignStatusActive    CampaignStatusCode = 2
+	CampaignStatusScheduled CampaignStatusCode = 3
+)
+
+// SystemCampaignStatuses are the statuses the status job relies on, they are always present in campaign_codes.
+// Any other status is added through the campaign codes API.
+var SystemCampaignStatuses = map[CampaignStatusCode]string{
+	CampaignStatusInActive:  "InActive",
+	CampaignStatusActive:    "Active",
+	CampaignStatusScheduled: "Scheduled",
+}
+
+// campaignStatusTransitions holds the system statuses a campaign is allowed to move to from each system status.
+// InActive is terminal, an ended campaign can not be brought back.
+var campaignStatusTransitions = map[CampaignStatusCode][]CampaignStatusCode{
+	CampaignStatusScheduled: {CampaignStatusActive, CampaignStatusInActive},
+	CampaignStatusActive:    {CampaignStatusInActive},
+	CampaignStatusInActive:  {},
+}
+
+// IsSystem ..
+func (c CampaignStatusCode) IsSystem() bool {
+	_, ok := campaignStatusTransitions[c]
+	return ok
+}
+
+// IsTerminal reports whether a campaign can not leave status c.
+// Statuses added through campaign codes, like Cancelled or Completed, are terminal.
+func (c CampaignStatusCode) IsTerminal() bool {
+	if !c.IsSystem() {
+		return true
+	}
+	return len(campaignStatusTransitions[c]) == 0
+}
+
+// CanTransitionTo reports whether a campaign in status c can be moved to the next status.
+// Keeping the same status is always allowed and any non terminal status can move to a custom status.
+func (c CampaignStatusCode) CanTransitionTo(next CampaignStatusCode) bool {
+	if c == next {
+		return true
+	}
+	if c.IsTerminal() {
+		return false
+	}
+	if !next.IsSystem() {
+		return true
+	}
+	for _, status := range campaignStatusTransitions[c] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition checks the move from c to next, next is expected to exist in campaign_codes.
+func (c CampaignStatusCode) ValidateTransition(next CampaignStatusCode) error {
+	if next <= 0 {
+		return fmt.Errorf("%w: %d", ErrCampaignStatusInvalid, next)
+	}
+	// campaigns saved before the status checks were introduced may hold no status
+	if c <= 0 {
+		return nil
+	}
+	if !c.CanTransitionTo(next) {
+		return fmt.Errorf("%w: from %d to %d", ErrCampaignStatusTransition, c, next)
+	}
+	return nil
+}
+
+// CampaignStatusTransition ..
+type CampaignStatusTransition struct {
+	From CampaignStatusCode
+	To   CampaignStatusCode
+}
+
+var (
+	// CampaignStatusPublish moves scheduled campaigns to active once ordering opens
+	CampaignStatusPublish = CampaignStatusTransition{From: CampaignStatusScheduled, To: CampaignStatusActive}
+	// CampaignStatusDeactivate moves active campaigns to inactive once ordering closes
+	CampaignStatusDeactivate = CampaignStatusTransition{From: CampaignStatusActive, To: CampaignStatusInActive}
+)
+
+// Validate ..
+func (t CampaignStatusTransition) Validate() error {
+	return t.From.ValidateTransition(t.To)
+}
+
+// CampaignStatusFromOrderDates derives the status of a campaign from its order window at the given time.
+func CampaignStatusFromOrderDates(orderStartDate, orderEndDate, now time.Time) CampaignStatusCode {
+	switch {
+	case !orderEndDate.IsZero() && now.After(orderEndDate):
+		return CampaignStatusInActive
+	case !orderStartDate.IsZero() && !now.Before(orderStartDate):
+		return CampaignStatusActive
+	default:
+		return CampaignStatusScheduled
+	}
+}
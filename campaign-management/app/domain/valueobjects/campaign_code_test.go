@@ -0,0 +1,79 @@
+package valueobjects
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCampaignStatusCode_ValidateTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     CampaignStatusCode
+		next        CampaignStatusCode
+		expectedErr error
+	}{
+		{name: "scheduled to active", current: CampaignStatusScheduled, next: CampaignStatusActive},
+		{name: "scheduled to inactive", current: CampaignStatusScheduled, next: CampaignStatusInActive},
+		{name: "active to inactive", current: CampaignStatusActive, next: CampaignStatusInActive},
+		{name: "same status", current: CampaignStatusActive, next: CampaignStatusActive},
+		{name: "unknown current status", current: CampaignStatusCode(0), next: CampaignStatusActive},
+		{name: "inactive to active", current: CampaignStatusInActive, next: CampaignStatusActive,
+			expectedErr: ErrCampaignStatusTransition},
+		{name: "inactive to scheduled", current: CampaignStatusInActive, next: CampaignStatusScheduled,
+			expectedErr: ErrCampaignStatusTransition},
+		{name: "active to scheduled", current: CampaignStatusActive, next: CampaignStatusScheduled,
+			expectedErr: ErrCampaignStatusTransition},
+		{name: "invalid next status", current: CampaignStatusActive, next: CampaignStatusCode(0),
+			expectedErr: ErrCampaignStatusInvalid},
+		{name: "scheduled to custom status", current: CampaignStatusScheduled, next: CampaignStatusCode(4)},
+		{name: "active to custom status", current: CampaignStatusActive, next: CampaignStatusCode(5)},
+		{name: "inactive to custom status", current: CampaignStatusInActive, next: CampaignStatusCode(4),
+			expectedErr: ErrCampaignStatusTransition},
+		{name: "custom status to active", current: CampaignStatusCode(4), next: CampaignStatusActive,
+			expectedErr: ErrCampaignStatusTransition},
+		{name: "custom status to other custom status", current: CampaignStatusCode(4), next: CampaignStatusCode(5),
+			expectedErr: ErrCampaignStatusTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.current.ValidateTransition(tt.next)
+			if tt.expectedErr == nil && err != nil {
+				t.Errorf("unexpected error : got - %v ; want - nil", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCampaignStatusFromOrderDates(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		orderStartDate time.Time
+		orderEndDate   time.Time
+		expectedStatus CampaignStatusCode
+	}{
+		{name: "order window in future", orderStartDate: now.AddDate(0, 0, 1), orderEndDate: now.AddDate(0, 0, 10),
+			expectedStatus: CampaignStatusScheduled},
+		{name: "order window open", orderStartDate: now.AddDate(0, 0, -1), orderEndDate: now.AddDate(0, 0, 10),
+			expectedStatus: CampaignStatusActive},
+		{name: "order window starts now", orderStartDate: now, orderEndDate: now.AddDate(0, 0, 10),
+			expectedStatus: CampaignStatusActive},
+		{name: "order window closed", orderStartDate: now.AddDate(0, 0, -10), orderEndDate: now.AddDate(0, 0, -1),
+			expectedStatus: CampaignStatusInActive},
+		{name: "no order dates", expectedStatus: CampaignStatusScheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := CampaignStatusFromOrderDates(tt.orderStartDate, tt.orderEndDate, now)
+			if status != tt.expectedStatus {
+				t.Errorf("unexpected status : got - %v ; want - %v", status, tt.expectedStatus)
+			}
+		})
+	}
+}
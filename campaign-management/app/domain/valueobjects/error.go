@@ -1,32 +1,104 @@
-package valueobjects
-
-// Error is base error type for domain
-type Error string
-
-// ErrNotFound ..
-const ErrNotFound Error = "not found"
-
-// ErrValidation ..
-const ErrValidation Error = "validation fails"
-
-// Error ..
-func (e Error) Error() string {
-	return string(e)
-}
-
-var (
-	ErrCampaignCantGet          Error = "unable to get campaign"
-	ErrCampaignCantUpdate       Error = "unable to update campaign"
-	ErrCampaignCantCreate       Error = "unable to crate campaign"
-	ErrCampaignCantExist        Error = "unable to check existence of campaign"
-	ErrCampaignCantGetList      Error = "unable to get campaign list"
-	ErrProductCantCreate        Error = "unable to create product(s)"
-	ErrProductCantUpdate        Error = "unable to update product(s)"
-	ErrStoreCantCreate          Error = "unable to create store(s)"
-	ErrStoreCantUpdate          Error = "unable to update store(s)"
-	ErrStoreCantDelete          Error = "unable to delete store(s)"
-	ErrProductCantDelete        Error = "unable to delete product"
-	ErrCampaignStatusCantUpdate Error = "unable to update campaign status"
-	ErrStoreCantGet             Error = "unable to get campaign store"
-	ErrStoreNotExists           Error = "campaign store not exists"
-)
+package valueobjects
+
+// Error is base error type for domain
+type Error string
+
+// ErrNotFound ..
+const ErrNotFound Error = "not found"
+
+// ErrValidation ..
+const ErrValidation Error = "validation fails"
+
+// Error ..
+func (e Error) Error() string {
+	return string(e)
+}
+
+var (
+	ErrCampaignCantGet            Error = "unable to get campaign"
+	ErrCampaignCantUpdate         Error = "unable to update campaign"
+	ErrCampaignCantCreate         Error = "unable to crate campaign"
+	ErrCampaignCantExist          Error = "unable to check existence of campaign"
+	ErrCampaignCantGetList        Error = "unable to get campaign list"
+	ErrCampaignCantGetSummary     Error = "unable to get campaign summary"
+	ErrIncludeDeletedForbidden    Error = "only admins can include deleted campaigns"
+	ErrProductCantCreate          Error = "unable to create product(s)"
+	ErrProductCantUpdate          Error = "unable to update product(s)"
+	ErrStoreCantCreate            Error = "unable to create store(s)"
+	ErrStoreCantUpdate            Error = "unable to update store(s)"
+	ErrStoreCantDelete            Error = "unable to delete store(s)"
+	ErrProductCantDelete          Error = "unable to delete product"
+	ErrCampaignStatusCantUpdate   Error = "unable to update campaign status"
+	ErrStoreCantGet               Error = "unable to get campaign store"
+	ErrStoreNotExists             Error = "campaign store not exists"
+	ErrCampaignStatusInvalid      Error = "invalid campaign status"
+	ErrCampaignStatusTransition   Error = "campaign status transition not allowed"
+	ErrStatusJobRunCantCreate     Error = "unable to record campaign status job run"
+	ErrLockCantAcquire            Error = "unable to acquire lock"
+	ErrStatusHistoryCantCreate    Error = "unable to record campaign status change"
+	ErrStatusHistoryCantGet       Error = "unable to get campaign status history"
+	ErrCampaignCodeCantGet        Error = "unable to get campaign status code"
+	ErrCampaignCodeCantCreate     Error = "unable to create campaign status code"
+	ErrCampaignCodeCantUpdate     Error = "unable to update campaign status code"
+	ErrCampaignCodeNotExists      Error = "campaign status code not exists"
+	ErrCampaignCodeExists         Error = "campaign status already exists"
+	ErrCampaignCodeSystem         Error = "system campaign status can not be changed"
+	ErrCampaignNotExists          Error = "campaign not exists"
+	ErrCampaignCantDelete         Error = "unable to delete campaign"
+	ErrCampaignCantRestore        Error = "unable to restore campaign"
+	ErrCampaignNotDeleted         Error = "campaign is not deleted"
+	ErrCampaignTitleExists        Error = "campaign with same title already exists"
+	ErrCampaignCursorInvalid      Error = "invalid campaign list cursor"
+	ErrCampaignOverlap            Error = "campaign sells a product at a store another campaign sells it at in overlapping order dates"
+	ErrProductNotExists           Error = "campaign product not exists"
+	ErrProductOrderInvalid        Error = "product order must list every campaign product exactly once"
+	ErrProductCantGet             Error = "unable to get campaign products"
+	ErrSortInvalid                Error = "invalid sort"
+	ErrTimeSlotInvalid            Error = "invalid time slot"
+	ErrDailyTimeSlotCantGet       Error = "unable to get store daily time slot"
+	ErrDailyTimeSlotCantCreate    Error = "unable to create store daily time slot"
+	ErrDailyTimeSlotCantUpdate    Error = "unable to update store daily time slot"
+	ErrDailyTimeSlotCantDelete    Error = "unable to delete store daily time slot"
+	ErrDailyTimeSlotNotExists     Error = "store daily time slot not exists"
+	ErrDailyTimeSlotOverlap       Error = "time slot overlaps another slot of the same day"
+	ErrSpecificTimeSlotCantGet    Error = "unable to get store specific time slot"
+	ErrSpecificTimeSlotCantCreate Error = "unable to create store specific time slot"
+	ErrSpecificTimeSlotCantUpdate Error = "unable to update store specific time slot"
+	ErrSpecificTimeSlotCantDelete Error = "unable to delete store specific time slot"
+	ErrSpecificTimeSlotNotExists  Error = "store specific time slot not exists"
+	ErrSpecificTimeSlotConflict   Error = "time slot conflicts with another slot or closure of the same date"
+	ErrCollectionSlotNotExists    Error = "collection slot not offered"
+	ErrCollectionSlotFull         Error = "collection slot has not enough quota left"
+	ErrReservationCantGet         Error = "unable to get slot reservation"
+	ErrReservationCantCreate      Error = "unable to create slot reservation"
+	ErrReservationCantUpdate      Error = "unable to update slot reservation"
+	ErrReservationNotExists       Error = "slot reservation not exists"
+	ErrReservationExpired         Error = "slot reservation hold expired"
+	ErrReservationReleased        Error = "slot reservation already released"
+	ErrSlotTemplateCantGet        Error = "unable to get slot template"
+	ErrSlotTemplateCantCreate     Error = "unable to create slot template"
+	ErrSlotTemplateCantUpdate     Error = "unable to update slot template"
+	ErrSlotTemplateCantDelete     Error = "unable to delete slot template"
+	ErrSlotTemplateNotExists      Error = "slot template not exists"
+	ErrBlackoutInvalid            Error = "invalid blackout"
+	ErrBlackoutCantGet            Error = "unable to get blackout"
+	ErrBlackoutCantCreate         Error = "unable to create blackout"
+	ErrBlackoutCantUpdate         Error = "unable to update blackout"
+	ErrBlackoutCantDelete         Error = "unable to delete blackout"
+	ErrBlackoutNotExists          Error = "blackout not exists"
+	ErrCollectionDateBlackedOut   Error = "collection date falls on a blackout day"
+	ErrRegisteredStoreCantGet     Error = "unable to get store"
+	ErrRegisteredStoreCantCreate  Error = "unable to create store"
+	ErrRegisteredStoreCantUpdate  Error = "unable to update store"
+	ErrRegisteredStoreCantDelete  Error = "unable to delete store"
+	ErrRegisteredStoreNotExists   Error = "store not exists"
+	ErrRegisteredStoreExists      Error = "store already exists"
+	ErrStoreUnknown               Error = "store is not registered or not active"
+	ErrStoreGroupCantGet          Error = "unable to get store group"
+	ErrStoreGroupCantCreate       Error = "unable to create store group"
+	ErrStoreGroupCantUpdate       Error = "unable to update store group"
+	ErrStoreGroupCantDelete       Error = "unable to delete store group"
+	ErrStoreGroupNotExists        Error = "store group not exists"
+	ErrStoreGroupExists           Error = "store group already exists"
+	ErrStoreGroupMemberNotExists  Error = "store is not a member of the store group"
+)
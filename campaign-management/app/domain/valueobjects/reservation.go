@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReservationStatus is the state of a collection slot reservation
+type ReservationStatus string
+
+const (
+	// ReservationStatusHeld takes quota until its hold expires unless confirmed
+	ReservationStatusHeld ReservationStatus = "held"
+	// ReservationStatusConfirmed takes quota until released
+	ReservationStatusConfirmed ReservationStatus = "confirmed"
+	// ReservationStatusReleased gave its quota back
+	ReservationStatusReleased ReservationStatus = "released"
+)
+
+// SlotKey points at a collection slot of a date, written as source-slotid-date e.g. daily-12-2024-02-10
+type SlotKey struct {
+	Source SlotSource
+	SlotID int64
+	Date   time.Time
+}
+
+// ParseSlotKey reads a slot key written by SlotKey.String
+func ParseSlotKey(value string) (SlotKey, error) {
+	parts := strings.SplitN(value, "-", 3)
+	if len(parts) != 3 {
+		return SlotKey{}, fmt.Errorf("%w: slot %q must be source-slotid-date", ErrTimeSlotInvalid, value)
+	}
+	source := SlotSource(parts[0])
+	if source != SlotSourceDaily && source != SlotSourceSpecific {
+		return SlotKey{}, fmt.Errorf("%w: unknown slot source %q", ErrTimeSlotInvalid, parts[0])
+	}
+	slotID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || slotID <= 0 {
+		return SlotKey{}, fmt.Errorf("%w: slot id %q must be a positive number", ErrTimeSlotInvalid, parts[1])
+	}
+	date, err := ParseDate(parts[2])
+	if err != nil {
+		return SlotKey{}, err
+	}
+	return SlotKey{Source: source, SlotID: slotID, Date: date}, nil
+}
+
+// String returns the key as source-slotid-date
+func (k SlotKey) String() string {
+	return fmt.Sprintf("%s-%d-%s", k.Source, k.SlotID, k.Date.Format(DateLayout))
+}
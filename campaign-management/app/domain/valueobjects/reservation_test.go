@@ -0,0 +1,23 @@
+package valueobjects
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSlotKey(t *testing.T) {
+	key, err := ParseSlotKey("daily-12-2024-02-10")
+	expected := SlotKey{Source: SlotSourceDaily, SlotID: 12, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+	if err != nil || key != expected {
+		t.Errorf("unexpected slot key : got - %+v, %v ; want - %+v", key, err, expected)
+	}
+	if key.String() != "daily-12-2024-02-10" {
+		t.Errorf("unexpected slot key string : got - %v ; want - daily-12-2024-02-10", key.String())
+	}
+	for _, value := range []string{"weekly-12-2024-02-10", "specific-0-2024-02-10", "specific-x-2024-02-10", "daily-12", "daily-12-2024-2-10"} {
+		if _, err := ParseSlotKey(value); !errors.Is(err, ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error for %q : got - %v ; want - %v", value, err, ErrTimeSlotInvalid)
+		}
+	}
+}
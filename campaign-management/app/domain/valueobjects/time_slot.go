@@ -0,0 +1,98 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a wall clock time of the store kept as minutes since midnight
+type TimeOfDay int
+
+// EndOfDay is the latest time a slot can end at, written as 24:00
+const EndOfDay TimeOfDay = 24 * 60
+
+var timeOfDayPattern = regexp.MustCompile(`^(\d{2}):(\d{2})(?::(\d{2}))?$`)
+
+// ParseTimeOfDay reads a time given as HH:MM or HH:MM:SS, seconds are dropped
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	match := timeOfDayPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("%w: time %q must be HH:MM", ErrTimeSlotInvalid, value)
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	seconds, _ := strconv.Atoi(match[3])
+	if minutes > 59 || seconds > 59 {
+		return 0, fmt.Errorf("%w: time %q must be HH:MM", ErrTimeSlotInvalid, value)
+	}
+	timeOfDay := TimeOfDay(hours*60 + minutes)
+	if timeOfDay > EndOfDay || (timeOfDay == EndOfDay && seconds > 0) {
+		return 0, fmt.Errorf("%w: time %q is after 24:00", ErrTimeSlotInvalid, value)
+	}
+	return timeOfDay, nil
+}
+
+// String returns the time as HH:MM
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// On returns the time t on the day of date in the location of date
+func (t TimeOfDay) On(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, date.Location()).Add(time.Duration(t) * time.Minute)
+}
+
+// SlotSource tells which table a collection slot of a date comes from
+type SlotSource string
+
+const (
+	// SlotSourceDaily is a slot of the weekly template of the store
+	SlotSourceDaily SlotSource = "daily"
+	// SlotSourceSpecific is a slot set for the particular date
+	SlotSourceSpecific SlotSource = "specific"
+)
+
+// DateLayout is the layout dates of the store calendar are exchanged in
+const DateLayout = "2006-01-02"
+
+// ParseDate reads a calendar date given as YYYY-MM-DD, the date is returned as midnight UTC
+func ParseDate(value string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q must be YYYY-MM-DD", ErrTimeSlotInvalid, value)
+	}
+	return date, nil
+}
+
+// TimeRangesOverlap reports whether the ranges [startA, endA) and [startB, endB) share any time
+func TimeRangesOverlap(startA, endA, startB, endB TimeOfDay) bool {
+	return startA < endB && startB < endA
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ParseWeekday reads an english weekday name, case insensitive
+func ParseWeekday(value string) (time.Weekday, error) {
+	weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown day of week %q", ErrTimeSlotInvalid, value)
+	}
+	return weekday, nil
+}
+
+// WeekdayName returns the lower case weekday name the slots are stored with
+func WeekdayName(weekday time.Weekday) string {
+	return strings.ToLower(weekday.String())
+}
@@ -0,0 +1,96 @@
+package valueobjects
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected TimeOfDay
+		wantErr  bool
+	}{
+		{value: "09:30", expected: 570},
+		{value: "09:30:00", expected: 570},
+		{value: "00:00", expected: 0},
+		{value: "24:00", expected: EndOfDay},
+		{value: "24:01", wantErr: true},
+		{value: "9:30", wantErr: true},
+		{value: "09:60", wantErr: true},
+		{value: "09:30abc", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			timeOfDay, err := ParseTimeOfDay(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrTimeSlotInvalid) {
+					t.Errorf("unexpected error : got - %v ; want - %v", err, ErrTimeSlotInvalid)
+				}
+				return
+			}
+			if err != nil || timeOfDay != tt.expected {
+				t.Errorf("unexpected time : got - %v, %v ; want - %v", timeOfDay, err, tt.expected)
+			}
+			if timeOfDay.String() != tt.value[:5] {
+				t.Errorf("unexpected string : got - %v ; want - %v", timeOfDay.String(), tt.value[:5])
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_On(t *testing.T) {
+	singapore, _ := time.LoadLocation("Asia/Singapore")
+	date := time.Date(2023, time.March, 1, 17, 45, 0, 0, singapore)
+	expected := time.Date(2023, time.March, 1, 9, 30, 0, 0, singapore)
+	if got := TimeOfDay(570).On(date); !got.Equal(expected) {
+		t.Errorf("unexpected time : got - %v ; want - %v", got, expected)
+	}
+}
+
+func TestTimeRangesOverlap(t *testing.T) {
+	tests := []struct {
+		name                       string
+		startA, endA, startB, endB TimeOfDay
+		expected                   bool
+	}{
+		{name: "adjacent ranges", startA: 540, endA: 600, startB: 600, endB: 660, expected: false},
+		{name: "partly overlapping ranges", startA: 540, endA: 620, startB: 600, endB: 660, expected: true},
+		{name: "range inside another", startA: 540, endA: 720, startB: 600, endB: 660, expected: true},
+		{name: "separate ranges", startA: 540, endA: 600, startB: 700, endB: 760, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimeRangesOverlap(tt.startA, tt.endA, tt.startB, tt.endB); got != tt.expected {
+				t.Errorf("unexpected overlap : got - %v ; want - %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	weekday, err := ParseWeekday("Monday")
+	if err != nil || weekday != time.Monday {
+		t.Errorf("unexpected weekday : got - %v, %v ; want - %v", weekday, err, time.Monday)
+	}
+	if WeekdayName(weekday) != "monday" {
+		t.Errorf("unexpected weekday name : got - %v ; want - monday", WeekdayName(weekday))
+	}
+	if _, err := ParseWeekday("funday"); !errors.Is(err, ErrTimeSlotInvalid) {
+		t.Errorf("unexpected error : got - %v ; want - %v", err, ErrTimeSlotInvalid)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	date, err := ParseDate("2024-02-09")
+	if err != nil || !date.Equal(time.Date(2024, time.February, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date : got - %v, %v ; want - 2024-02-09", date, err)
+	}
+	for _, value := range []string{"2024-02-30", "09/02/2024", "2024-02-09T10:00:00Z"} {
+		if _, err := ParseDate(value); !errors.Is(err, ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error for %q : got - %v ; want - %v", value, err, ErrTimeSlotInvalid)
+		}
+	}
+}
@@ -1 +1 @@
-package config
+package config
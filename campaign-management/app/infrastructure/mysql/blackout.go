@@ -0,0 +1,211 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type BlackoutService struct {
+	db *gorm.DB
+}
+
+type BlackoutEntry struct {
+	ID        int64          `gorm:"primary_key;autoIncrement;column:blackout_id"`
+	StoreID   int64          `gorm:"column:store_id;type:bigint;not null;default:0;uniqueIndex:idx_blackout_store_uid;index:idx_blackout_store_dates"`
+	StartDate time.Time      `gorm:"column:start_date;type:date;not null;index:idx_blackout_store_dates"`
+	EndDate   time.Time      `gorm:"column:end_date;type:date;not null"`
+	Reason    string         `gorm:"column:reason;type:varchar(200)"`
+	UID       *string        `gorm:"column:uid;type:varchar(255);uniqueIndex:idx_blackout_store_uid"`
+	CreatedAt time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+func NewBlackoutService(db *gorm.DB) *BlackoutService {
+	return &BlackoutService{db: db}
+}
+
+func (c *BlackoutEntry) TableName() string {
+	return "blackouts"
+}
+
+func (c *BlackoutService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&BlackoutEntry{})
+	return err
+}
+
+// GetList returns the blackouts of every store along with the ones of the store, storeID 0 leaves only the former.
+// Blackouts are returned when they share a date with from to to, a zero bound leaves that side open
+func (c *BlackoutService) GetList(ctx context.Context, storeID int64, from, to time.Time) ([]entities.Blackout, error) {
+	return c.GetListByStores(ctx, []int64{storeID}, from, to)
+}
+
+// GetListByStores returns the blackouts of every store along with the ones of any of the stores, in the same date
+// range as GetList
+func (c *BlackoutService) GetListByStores(ctx context.Context, storeIDs []int64, from, to time.Time) ([]entities.Blackout, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	db = db.Where("store_id in ?", append([]int64{0}, storeIDs...))
+	if !from.IsZero() {
+		db = db.Where("end_date >= ?", from.Format(valueobjects.DateLayout))
+	}
+	if !to.IsZero() {
+		db = db.Where("start_date <= ?", to.Format(valueobjects.DateLayout))
+	}
+	var entries []BlackoutEntry
+	err := db.Order("start_date asc, blackout_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantGet, err)
+	}
+	blackouts := []entities.Blackout{}
+	for _, entry := range entries {
+		blackouts = append(blackouts, c.ToEntity(entry))
+	}
+	return blackouts, nil
+}
+
+// GetByUIDs returns the blackouts of the store imported before under the given calendar event identifiers
+func (c *BlackoutService) GetByUIDs(ctx context.Context, storeID int64, uids []string) ([]entities.Blackout, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entries []BlackoutEntry
+	err := db.Where("store_id = ? and uid in ?", storeID, uids).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantGet, err)
+	}
+	blackouts := []entities.Blackout{}
+	for _, entry := range entries {
+		blackouts = append(blackouts, c.ToEntity(entry))
+	}
+	return blackouts, nil
+}
+
+func (c *BlackoutService) Get(ctx context.Context, blackoutID valueobjects.BlackoutID) (entities.Blackout, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry BlackoutEntry
+	err := db.Where("blackout_id = ?", blackoutID.ToInt64()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.Blackout{}, fmt.Errorf("%w: %d", valueobjects.ErrBlackoutNotExists, blackoutID)
+		}
+		return entities.Blackout{}, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *BlackoutService) CreateMultiple(ctx context.Context, blackouts []entities.Blackout) ([]entities.Blackout, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entries := []BlackoutEntry{}
+	for _, blackout := range blackouts {
+		entries = append(entries, c.ToEntry(blackout))
+	}
+	err := db.Create(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantCreate, err)
+	}
+	logger.Infof("%d blackouts created", len(entries))
+
+	created := []entities.Blackout{}
+	for _, entry := range entries {
+		created = append(created, c.ToEntity(entry))
+	}
+	return created, nil
+}
+
+// Update rewrites the blackout
+func (c *BlackoutService) Update(ctx context.Context, blackout entities.Blackout) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(blackout)
+	response := db.Model(&BlackoutEntry{}).Where("blackout_id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"store_id":   entry.StoreID,
+			"start_date": entry.StartDate,
+			"end_date":   entry.EndDate,
+			"reason":     entry.Reason,
+			"updated_by": entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantUpdate, response.Error)
+	}
+	logger.Infof("blackout with id %v updated successfully", entry.ID)
+	return nil
+}
+
+func (c *BlackoutService) Delete(ctx context.Context, blackoutID valueobjects.BlackoutID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&BlackoutEntry{}).Where("blackout_id = ?", blackoutID.ToInt64()).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrBlackoutCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrBlackoutNotExists, blackoutID)
+	}
+	logger.Infof("blackout with id %v deleted successfully", blackoutID)
+	return nil
+}
+
+// ToEntry keeps the uid of blackouts not imported empty so they stay out of the unique index
+func (c *BlackoutService) ToEntry(blackout entities.Blackout) BlackoutEntry {
+	entry := BlackoutEntry{
+		ID:        blackout.ID.ToInt64(),
+		StoreID:   blackout.StoreID,
+		StartDate: toDateColumn(blackout.StartDate),
+		EndDate:   toDateColumn(blackout.EndDate),
+		Reason:    blackout.Reason,
+		CreatedBy: blackout.CreatedBy,
+		UpdatedBy: blackout.UpdatedBy,
+	}
+	if blackout.UID != "" {
+		uid := blackout.UID
+		entry.UID = &uid
+	}
+	return entry
+}
+
+func (c *BlackoutService) ToEntity(entry BlackoutEntry) entities.Blackout {
+	blackout := entities.Blackout{
+		ID:        valueobjects.BlackoutID(entry.ID),
+		StoreID:   entry.StoreID,
+		StartDate: fromDateColumn(entry.StartDate),
+		EndDate:   fromDateColumn(entry.EndDate),
+		Reason:    entry.Reason,
+		CreatedAt: entry.CreatedAt,
+		CreatedBy: entry.CreatedBy,
+		UpdatedAt: entry.UpdatedAt,
+		UpdatedBy: entry.UpdatedBy,
+	}
+	if entry.UID != nil {
+		blackout.UID = *entry.UID
+	}
+	return blackout
+}
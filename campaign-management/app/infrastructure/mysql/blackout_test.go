@@ -0,0 +1,104 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBlackoutService_GetList(t *testing.T) {
+	from := time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("when blackouts of every store and of the store share a date with the range", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `blackouts` WHERE store_id in (?,?) AND end_date >= ? AND start_date <= ? AND `blackouts`.`deleted_at` IS NULL ORDER BY start_date asc, blackout_id asc"
+		db, mock := newMockDB(t)
+		blackoutService := NewBlackoutService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(0, 84, "2024-02-08", "2024-02-12").
+			WillReturnRows(sqlmock.NewRows([]string{"blackout_id", "store_id", "start_date", "end_date", "reason", "uid"}).
+				AddRow(1, 0, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.Local), time.Date(2024, time.February, 11, 0, 0, 0, 0, time.Local), "Lunar New Year", nil).
+				AddRow(2, 84, time.Date(2024, time.February, 12, 0, 0, 0, 0, time.Local), time.Date(2024, time.February, 12, 0, 0, 0, 0, time.Local), "Stock take", "stock-take"))
+
+		blackouts, err := blackoutService.GetList(context.TODO(), 84, from, to)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(blackouts) != 2 || !blackouts[0].EndDate.Equal(time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC)) ||
+			blackouts[0].UID != "" || blackouts[1].UID != "stock-take" {
+			t.Errorf("unexpected blackouts : got - %+v", blackouts)
+		}
+	})
+
+	t.Run("when range is left open", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `blackouts` WHERE store_id in (?,?) AND `blackouts`.`deleted_at` IS NULL ORDER BY start_date asc, blackout_id asc"
+		db, mock := newMockDB(t)
+		blackoutService := NewBlackoutService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(0, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"blackout_id", "store_id", "start_date", "end_date"}))
+
+		blackouts, err := blackoutService.GetList(context.TODO(), 0, time.Time{}, time.Time{})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(blackouts) != 0 {
+			t.Errorf("unexpected blackouts : got - %+v", blackouts)
+		}
+	})
+}
+
+func TestBlackoutService_GetListByStores(t *testing.T) {
+	t.Run("when blackouts of every store and of any of the stores share a date with the range", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `blackouts` WHERE store_id in (?,?,?) AND end_date >= ? AND start_date <= ? AND `blackouts`.`deleted_at` IS NULL ORDER BY start_date asc, blackout_id asc"
+		db, mock := newMockDB(t)
+		blackoutService := NewBlackoutService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(0, 83, 84, "2024-02-08", "2024-02-12").
+			WillReturnRows(sqlmock.NewRows([]string{"blackout_id", "store_id", "start_date", "end_date"}).
+				AddRow(2, 84, time.Date(2024, time.February, 12, 0, 0, 0, 0, time.Local), time.Date(2024, time.February, 12, 0, 0, 0, 0, time.Local)))
+
+		blackouts, err := blackoutService.GetListByStores(context.TODO(), []int64{83, 84},
+			time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(blackouts) != 1 || blackouts[0].StoreID != 84 {
+			t.Errorf("unexpected blackouts : got - %+v", blackouts)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestBlackoutService_CreateMultiple(t *testing.T) {
+	const sqlInsert = "INSERT INTO `blackouts`"
+
+	t.Run("when blackouts created leaving the uid of the one not imported empty", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		blackoutService := NewBlackoutService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs(84, sqlmock.AnyArg(), sqlmock.AnyArg(), "Lunar New Year", "cny-2024", sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0,
+				84, sqlmock.AnyArg(), sqlmock.AnyArg(), "Stock take", nil, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0).
+			WillReturnResult(sqlmock.NewResult(5, 2))
+		mock.ExpectCommit()
+
+		date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		blackouts, err := blackoutService.CreateMultiple(context.TODO(), []entities.Blackout{
+			{StoreID: 84, StartDate: date, EndDate: date, Reason: "Lunar New Year", UID: "cny-2024", CreatedBy: 7},
+			{StoreID: 84, StartDate: date, EndDate: date, Reason: "Stock take", CreatedBy: 7},
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(blackouts) != 2 || blackouts[0].ID != 5 || blackouts[1].ID != 6 || !blackouts[1].StartDate.Equal(date) {
+			t.Errorf("unexpected blackouts : got - %+v", blackouts)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
@@ -1,354 +1,781 @@
-package mysql
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-	"database/sql"
-	"fmt"
-	"time"
-
-	logger "github.com/sirupsen/logrus"
-	"gorm.io/gorm"
-)
-
-type CampaignService struct {
-	db *gorm.DB
-}
-
-type CampaignEntry struct {
-	ID                  int64          `gorm:"primary_key;autoIncrement;column:campaign_id"`
-	Title               string         `gorm:"column:title;type:varchar(1024)"`
-	OrderStartDate      sql.NullTime   `gorm:"column:order_start_date;type:datetime"`
-	OrderEndDate        sql.NullTime   `gorm:"column:order_end_date;type:datetime"`
-	CollectionStartDate sql.NullTime   `gorm:"column:collection_start_date;type:datetime"`
-	CollectionEndDate   sql.NullTime   `gorm:"column:collection_end_date;type:datetime"`
-	StatusCode          int64          `gorm:"column:status_code"`
-	CampaignType        string         `gorm:"column:campaign_type;type:varchar(1024)"`
-	ListingTitle        string         `gorm:"column:listing_title;type:varchar(1024)"`
-	ListingDesc         string         `gorm:"column:listing_description;type:text"`
-	ListingImagePath    string         `gorm:"column:listing_image_path;type:text"`
-	OnboardTitle        string         `gorm:"column:onboard_title;type:varchar(1024)"`
-	OnboardDesc         string         `gorm:"column:onboard_description;type:text"`
-	OnboardImagePath    string         `gorm:"column:onboard_image_path;type:text"`
-	LandingImagePath    string         `gorm:"column:landing_image_path;type:text"`
-	LeadTime            sql.NullInt32  `gorm:"column:lead_time;type:smallint;default:NULL"`
-	OfferID             sql.NullInt64  `gorm:"column:offer_id;default:NULL"`
-	TagID               sql.NullInt64  `gorm:"column:tag_id;default:NULL"`
-	IsCampaignPublished *bool          `gorm:"column:is_campaign_published;type:boolean;default:false"`
-	CreatedAt           sql.NullTime   `gorm:"column:created_at;type:datetime"`
-	CreatedBy           int64          `gorm:"column:created_by"`
-	UpdatedAt           sql.NullTime   `gorm:"column:updated_at;type:datetime"`
-	UpdatedBy           int64          `gorm:"column:updated_by"`
-	DeletedAt           gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
-	DeletedBy           int64          `gorm:"column:deleted_by"`
-}
-
-func NewCampaignService(db *gorm.DB) *CampaignService {
-	return &CampaignService{db: db}
-}
-
-func (c *CampaignEntry) TableName() string {
-	return "campaigns"
-}
-
-func (c *CampaignService) Migrate() error {
-	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignEntry{})
-	return err
-}
-
-func (c *CampaignService) Get(ctx context.Context, id valueobjects.CampaignID) (entities.Campaign, error) {
-	entry := CampaignEntry{}
-	err := c.db.First(&entry, id).Error
-	return c.ToEntity(entry), err
-}
-
-func (c *CampaignService) GetList(ctx context.Context, pagination entities.PaginationConfig) ([]entities.Campaign, int64, error) {
-	var entries []CampaignEntry
-	offset := (pagination.Page - 1) * pagination.Limit
-	queryBuilder := c.db.Limit(pagination.Limit).Offset(offset).Order(pagination.Sort)
-	var result *gorm.DB
-
-	if pagination.Status > int64(0) {
-		result = queryBuilder.Model(&CampaignEntry{}).Where("title like ? and status_code = ?", pagination.Name+"%", pagination.Status).Find(&entries)
-	} else {
-		result = queryBuilder.Model(&CampaignEntry{}).Where("title like ? ", pagination.Name+"%").Find(&entries)
-	}
-
-	return c.ToEntityList(entries), c.GetCampaignsCount(), result.Error
-}
-
-func (c *CampaignService) GetCampaignsCount() int64 {
-	var count int64
-	c.db.Table("campaigns").Count(&count)
-	return count
-}
-
-func (c *CampaignService) ToEntity(entry CampaignEntry) entities.Campaign {
-	var isCampaignPublished bool
-	var collectionStartDate, collectionEndDate, orderStartDate, orderEndDate time.Time
-	var leadTime int
-	var offerID, tagID int64
-	if entry.IsCampaignPublished != nil {
-		isCampaignPublished = *entry.IsCampaignPublished
-	} else {
-		isCampaignPublished = false
-	}
-	if entry.CollectionStartDate.Valid {
-		collectionStartDate = entry.CollectionStartDate.Time.UTC()
-	}
-	if entry.CollectionEndDate.Valid {
-		collectionEndDate = entry.CollectionEndDate.Time.UTC()
-	}
-	if entry.OrderStartDate.Valid {
-		orderStartDate = entry.OrderStartDate.Time.UTC()
-	}
-	if entry.OrderEndDate.Valid {
-		orderEndDate = entry.OrderEndDate.Time.UTC()
-	}
-	if entry.LeadTime.Valid {
-		leadTime = int(entry.LeadTime.Int32)
-	}
-	if entry.OfferID.Valid {
-		offerID = entry.OfferID.Int64
-	}
-	if entry.TagID.Valid {
-		tagID = entry.TagID.Int64
-	}
-	return entities.Campaign{
-		ID:                  valueobjects.CampaignID(entry.ID),
-		Title:               entry.Title,
-		CollectionStartDate: collectionStartDate,
-		CollectionEndDate:   collectionEndDate,
-		OrderStartDate:      orderStartDate,
-		OrderEndDate:        orderEndDate,
-		StatusCode:          entry.StatusCode,
-		ListingTitle:        entry.ListingTitle,
-		ListingDesc:         entry.ListingDesc,
-		ListingImagePath:    entry.ListingImagePath,
-		OnboardTitle:        entry.OnboardTitle,
-		OnboardDesc:         entry.OnboardDesc,
-		OnboardImagePath:    entry.OnboardImagePath,
-		LandingImagePath:    entry.LandingImagePath,
-		CampaignType:        valueobjects.CampaignType(entry.CampaignType),
-		LeadTime:            leadTime,
-		OfferID:             offerID,
-		TagID:               tagID,
-		IsCampaignPublished: isCampaignPublished,
-	}
-}
-
-func (c *CampaignService) ToEntry(campaignEntity entities.Campaign) CampaignEntry {
-	var collectionStartDate, collectionEndDate, orderStartDate, orderEndDate sql.NullTime
-	var leadTime sql.NullInt32
-	var offerID, tagID sql.NullInt64
-	if !campaignEntity.CollectionStartDate.IsZero() {
-		collectionStartDate = sql.NullTime{
-			Time:  campaignEntity.CollectionStartDate,
-			Valid: true,
-		}
-	}
-	if !campaignEntity.CollectionEndDate.IsZero() {
-		collectionEndDate = sql.NullTime{
-			Time:  campaignEntity.CollectionEndDate,
-			Valid: true,
-		}
-	}
-	if !campaignEntity.OrderStartDate.IsZero() {
-		orderStartDate = sql.NullTime{
-			Time:  campaignEntity.OrderStartDate,
-			Valid: true,
-		}
-	}
-	if !campaignEntity.OrderEndDate.IsZero() {
-		orderEndDate = sql.NullTime{
-			Time:  campaignEntity.OrderEndDate,
-			Valid: true,
-		}
-	}
-	if campaignEntity.LeadTime != 0 {
-		leadTime = sql.NullInt32{
-			Int32: int32(campaignEntity.LeadTime),
-			Valid: true,
-		}
-	}
-	if campaignEntity.OfferID != 0 {
-		offerID = sql.NullInt64{
-			Int64: campaignEntity.OfferID,
-			Valid: true,
-		}
-	}
-	if campaignEntity.TagID != 0 {
-		tagID = sql.NullInt64{
-			Int64: campaignEntity.TagID,
-			Valid: true,
-		}
-	}
-	return CampaignEntry{
-		ID:                  campaignEntity.ID.ToInt64(),
-		Title:               campaignEntity.Title,
-		CollectionStartDate: collectionStartDate,
-		CollectionEndDate:   collectionEndDate,
-		OrderStartDate:      orderStartDate,
-		OrderEndDate:        orderEndDate,
-		StatusCode:          campaignEntity.StatusCode,
-		ListingTitle:        campaignEntity.ListingTitle,
-		ListingDesc:         campaignEntity.ListingDesc,
-		ListingImagePath:    campaignEntity.ListingImagePath,
-		OnboardTitle:        campaignEntity.OnboardTitle,
-		OnboardDesc:         campaignEntity.OnboardDesc,
-		OnboardImagePath:    campaignEntity.OnboardImagePath,
-		LandingImagePath:    campaignEntity.LandingImagePath,
-		CampaignType:        campaignEntity.CampaignType.String(),
-		LeadTime:            leadTime,
-		OfferID:             offerID,
-		TagID:               tagID,
-		CreatedBy:           campaignEntity.CreatedBy,
-		UpdatedBy:           campaignEntity.UpdatedBy,
-		IsCampaignPublished: &campaignEntity.IsCampaignPublished,
-	}
-}
-
-func (c *CampaignService) ToEntityList(entries []CampaignEntry) []entities.Campaign {
-	var campaigns []entities.Campaign
-	for _, entry := range entries {
-		campaign := c.ToEntity(entry)
-		campaigns = append(campaigns, campaign)
-	}
-	return campaigns
-}
-
-func (c *CampaignService) Create(ctx context.Context, campaign entities.Campaign) (entities.Campaign, error) {
-	db := c.db
-	if ctxDB := DBTransaction(ctx); ctxDB != nil {
-		db = ctxDB
-	}
-
-	entry := c.ToEntry(campaign)
-	err := db.Create(&entry).Error
-	if err != nil {
-		return entities.Campaign{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantCreate, err)
-	}
-	logger.Infof("campaign created with id : %v", entry.ID)
-	return c.ToEntity(entry), nil
-}
-
-func (c *CampaignService) Exists(ctx context.Context, id valueobjects.CampaignID, title string) (bool, error) {
-	campaign := CampaignEntry{}
-	if id != 0 {
-		err := c.db.Where("campaign_id = ?", id).First(&campaign).Error
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				logger.Info("entity not found")
-				return false, nil
-			}
-			return false, fmt.Errorf("%w:  %v", valueobjects.ErrCampaignCantExist, err)
-		}
-		return true, nil
-	} else {
-		err := c.db.Where("title = ?", title).First(&campaign).Error
-		if err != nil {
-			if err == gorm.ErrRecordNotFound {
-				logger.Info("entity not found")
-				return false, nil
-			}
-			return false, err
-		}
-		return true, nil
-	}
-}
-
-func (c *CampaignService) Update(ctx context.Context, campaign entities.Campaign) error {
-	db := c.db
-	if ctxDB := DBTransaction(ctx); ctxDB != nil {
-		db = ctxDB
-	}
-
-	var exists bool
-	err := db.Model(CampaignEntry{}).Select("count(*) > 0").Where("campaign_id != ? and title = ?", campaign.ID, campaign.Title).Find(&exists).Error
-	if err != nil {
-		return fmt.Errorf("error occured while checking campaign existence with given name: %v", err)
-	}
-
-	if exists {
-		return fmt.Errorf("campaign with title '%s' already exists. please provide another title", campaign.Title)
-	}
-
-	campaignEntry := c.ToEntry(campaign)
-	err = db.Model(campaignEntry).Where("campaign_id = ?", campaignEntry.ID).Updates(map[string]interface{}{
-		"title":                 campaignEntry.Title,
-		"order_start_date":      campaignEntry.OrderStartDate,
-		"order_end_date":        campaignEntry.OrderEndDate,
-		"collection_start_date": campaignEntry.CollectionStartDate,
-		"collection_end_date":   campaignEntry.CollectionEndDate,
-		"status_code":           campaignEntry.StatusCode,
-		"campaign_type":         campaignEntry.CampaignType,
-		"listing_title":         campaignEntry.ListingTitle,
-		"listing_description":   campaignEntry.ListingDesc,
-		"listing_image_path":    campaignEntry.ListingImagePath,
-		"onboard_title":         campaignEntry.OnboardTitle,
-		"onboard_description":   campaignEntry.OnboardDesc,
-		"onboard_image_path":    campaignEntry.OnboardImagePath,
-		"landing_image_path":    campaignEntry.LandingImagePath,
-		"lead_time":             campaignEntry.LeadTime,
-		"offer_id":              campaignEntry.OfferID,
-		"tag_id":                campaignEntry.TagID,
-		"is_campaign_published": campaignEntry.IsCampaignPublished}).Error
-	if err != nil {
-		return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantUpdate, err)
-	}
-	logger.Infof("campaign with id : %v updated successfully", campaignEntry.ID)
-	return nil
-}
-
-func (c *CampaignService) UpdateStatus(ctx context.Context) error {
-	db := c.db
-	if ctxDB := DBTransaction(ctx); ctxDB != nil {
-		db = ctxDB
-	}
-	err := publishCampaigns(db)
-	if err != nil {
-		return err
-	}
-	err = deactivateCampaigns(db)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func publishCampaigns(db *gorm.DB) error {
-	logger.Info("publishing campaigns")
-	year, month, day := time.Now().Date()
-	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02 15:04:05")
-	campaign := CampaignEntry{}
-	response := db.Model(&campaign).Where("CAST(order_start_date AS DATE)  <= ? and status_code = 3", today).Update("status_code", 2)
-	if response.Error != nil {
-		return response.Error
-	}
-	if response.RowsAffected > 0 {
-		logger.Infof("published %d campaigns", response.RowsAffected)
-		return nil
-	} else {
-		logger.Info("no campaign to publish")
-		return nil
-	}
-}
-
-func deactivateCampaigns(db *gorm.DB) error {
-	logger.Info("deactivating campaigns")
-	year, month, day := time.Now().Date()
-	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02 15:04:05")
-	campaign := CampaignEntry{}
-	response := db.Model(&campaign).Where("CAST(order_end_date AS DATE) < ? and status_code = 2", today).Update("status_code", 1)
-	if response.Error != nil {
-		return response.Error
-	}
-	if response.RowsAffected > 0 {
-		logger.Infof("deactivated %d campaigns", response.RowsAffected)
-		return nil
-	} else {
-		logger.Info("no campaign to deactivate")
-		return nil
-	}
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CampaignService reads campaigns stored without a timezone, the ones created before timezones were stored, in the
+// default timezone
+type CampaignService struct {
+	db              *gorm.DB
+	defaultTimezone string
+}
+
+type CampaignEntry struct {
+	ID                  int64          `gorm:"primary_key;autoIncrement;column:campaign_id"`
+	Title               string         `gorm:"column:title;type:varchar(1024);index:idx_campaigns_search,class:FULLTEXT"`
+	OrderStartDate      sql.NullTime   `gorm:"column:order_start_date;type:datetime"`
+	OrderEndDate        sql.NullTime   `gorm:"column:order_end_date;type:datetime"`
+	CollectionStartDate sql.NullTime   `gorm:"column:collection_start_date;type:datetime"`
+	CollectionEndDate   sql.NullTime   `gorm:"column:collection_end_date;type:datetime"`
+	Timezone            string         `gorm:"column:timezone;type:varchar(64)"`
+	StatusCode          int64          `gorm:"column:status_code"`
+	CampaignType        string         `gorm:"column:campaign_type;type:varchar(1024)"`
+	ListingTitle        string         `gorm:"column:listing_title;type:varchar(1024);index:idx_campaigns_search,class:FULLTEXT"`
+	ListingDesc         string         `gorm:"column:listing_description;type:text;index:idx_campaigns_search,class:FULLTEXT"`
+	ListingImagePath    string         `gorm:"column:listing_image_path;type:text"`
+	OnboardTitle        string         `gorm:"column:onboard_title;type:varchar(1024);index:idx_campaigns_search,class:FULLTEXT"`
+	OnboardDesc         string         `gorm:"column:onboard_description;type:text;index:idx_campaigns_search,class:FULLTEXT"`
+	OnboardImagePath    string         `gorm:"column:onboard_image_path;type:text"`
+	LandingImagePath    string         `gorm:"column:landing_image_path;type:text"`
+	LeadTime            sql.NullInt32  `gorm:"column:lead_time;type:smallint;default:NULL"`
+	OfferID             sql.NullInt64  `gorm:"column:offer_id;default:NULL"`
+	TagID               sql.NullInt64  `gorm:"column:tag_id;default:NULL"`
+	IsCampaignPublished *bool          `gorm:"column:is_campaign_published;type:boolean;default:false"`
+	CreatedAt           sql.NullTime   `gorm:"column:created_at;type:datetime"`
+	CreatedBy           int64          `gorm:"column:created_by"`
+	UpdatedAt           sql.NullTime   `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy           int64          `gorm:"column:updated_by"`
+	DeletedAt           gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy           int64          `gorm:"column:deleted_by"`
+}
+
+// campaignSearchMatch matches the campaigns against a search over the columns of the idx_campaigns_search FULLTEXT index,
+// it reads as the relevance of the campaign to the search when selected
+const campaignSearchMatch = "MATCH (title, listing_title, listing_description, onboard_title, onboard_description) " +
+	"AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+func NewCampaignService(db *gorm.DB, defaultTimezone string) *CampaignService {
+	return &CampaignService{db: db, defaultTimezone: defaultTimezone}
+}
+
+func (c *CampaignEntry) TableName() string {
+	return "campaigns"
+}
+
+// Migrate also backfills the timezone of the campaigns created before timezones were stored with the default one
+func (c *CampaignService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignEntry{})
+	if err != nil {
+		return err
+	}
+	return c.backfillTimezone()
+}
+
+// backfillTimezone sets the default timezone on the campaigns stored before campaigns had one
+func (c *CampaignService) backfillTimezone() error {
+	return c.db.Model(&CampaignEntry{}).Unscoped().Where("timezone = '' OR timezone IS NULL").
+		UpdateColumn("timezone", c.defaultTimezone).Error
+}
+
+func (c *CampaignService) Get(ctx context.Context, id valueobjects.CampaignID) (entities.Campaign, error) {
+	entry := CampaignEntry{}
+	err := c.db.First(&entry, id).Error
+	return c.ToEntity(entry), err
+}
+
+// Lock reads the campaign and locks it until the transaction ends, so a status change is checked against the
+// status it replaces the way updateCampaignStatus does for the scheduled transitions
+func (c *CampaignService) Lock(ctx context.Context, id valueobjects.CampaignID) (entities.Campaign, error) {
+	db := DBTransaction(ctx)
+	if db == nil {
+		return entities.Campaign{}, fmt.Errorf("%w: campaign lock needs a transaction", valueobjects.ErrCampaignCantGet)
+	}
+	entry := CampaignEntry{}
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("campaign_id = ?", id.ToInt64()).First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entities.Campaign{}, fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotExists, id)
+	}
+	if err != nil {
+		return entities.Campaign{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+// GetList returns a page of the campaigns matching the filter ordered by the sort fields along with the total matching
+// count, campaigns sorting the same are ordered by id. A search orders the campaigns by relevance ahead of the sort fields
+func (c *CampaignService) GetList(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var count int64
+	err := db.Model(&CampaignEntry{}).Scopes(c.listScope(filter)).Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	query := db.Model(&CampaignEntry{}).Scopes(c.listScope(filter))
+	if filter.Query != "" {
+		query = query.Select("campaigns.*, "+campaignSearchMatch+" AS relevance", filter.Query).Order("relevance desc")
+	}
+	query = orderBy(query, filter.SortFields)
+	var entries []CampaignEntry
+	err = query.Order("campaign_id asc").Limit(filter.Limit).Offset(filter.Offset).Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	return c.ToEntityList(entries), count, nil
+}
+
+// GetListByCursor returns the page of the campaigns matching the filter after or before the page the cursor was
+// got from, keyed on the sort fields and the id, along with the cursors of the pages around it and the total matching
+// count. Campaigns created while paging do not shift the pages the way they shift offsets
+func (c *CampaignService) GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) ([]entities.Campaign, entities.PageCursors, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	sortFields := append(append([]entities.SortField{}, filter.SortFields...), entities.SortField{Column: "campaign_id"})
+	cursor, keys, err := decodeCampaignCursor(filter.Cursor, sortFields)
+	if err != nil {
+		return nil, entities.PageCursors{}, 0, err
+	}
+	var count int64
+	err = db.Model(&CampaignEntry{}).Scopes(c.listScope(filter)).Count(&count).Error
+	if err != nil {
+		return nil, entities.PageCursors{}, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	query := db.Model(&CampaignEntry{}).Scopes(c.listScope(filter))
+	if filter.Cursor != "" {
+		where, args := keysetCondition(sortFields, keys, cursor.Prev)
+		query = query.Where(where, args...)
+	}
+	for _, sortField := range sortFields {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: sortField.Column}, Desc: sortField.Desc != cursor.Prev})
+	}
+	// one campaign more than the page tells whether there is a page further on
+	var entries []CampaignEntry
+	err = query.Limit(filter.Limit + 1).Find(&entries).Error
+	if err != nil {
+		return nil, entities.PageCursors{}, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	more := len(entries) > filter.Limit
+	if more {
+		entries = entries[:filter.Limit]
+	}
+	if cursor.Prev {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+
+	cursors := entities.PageCursors{}
+	if len(entries) > 0 {
+		hasNext, hasPrev := more, filter.Cursor != ""
+		if cursor.Prev {
+			hasNext, hasPrev = true, more
+		}
+		if hasNext {
+			cursors.Next = encodeCampaignCursor(sortFields, entries[len(entries)-1], false)
+		}
+		if hasPrev {
+			cursors.Prev = encodeCampaignCursor(sortFields, entries[0], true)
+		}
+	}
+	return c.ToEntityList(entries), cursors, count, nil
+}
+
+// campaignSummaryColumns aggregates the campaigns in one row, the campaign stores and products are checked per campaign
+// so a campaign with many of them is still counted once
+const campaignSummaryColumns = "COUNT(*) AS total, " +
+	"COALESCE(SUM(order_start_date BETWEEN ? AND ?), 0) AS starting_soon, " +
+	"COALESCE(SUM(order_end_date BETWEEN ? AND ?), 0) AS ending_soon, " +
+	"COALESCE(SUM(NOT EXISTS (SELECT 1 FROM campaign_stores WHERE campaign_stores.campaign_id = campaigns.campaign_id " +
+	"AND campaign_stores.deleted_at IS NULL)), 0) AS without_stores, " +
+	"COALESCE(SUM(NOT EXISTS (SELECT 1 FROM campaign_products WHERE campaign_products.campaign_id = campaigns.campaign_id " +
+	"AND campaign_products.deleted_at IS NULL)), 0) AS without_products"
+
+// GetSummary aggregates the campaigns matching the filter, the campaigns starting or ending soon are the ones whose
+// order start or end date is between soonFrom and soonTo
+func (c *CampaignService) GetSummary(ctx context.Context, filter entities.CampaignListFilter, soonFrom, soonTo time.Time) (entities.CampaignSummary, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var statusCounts []struct {
+		StatusCode int64
+		Count      int64
+	}
+	err := db.Model(&CampaignEntry{}).Scopes(c.listScope(filter)).Select("status_code, COUNT(*) AS count").
+		Group("status_code").Order("status_code").Scan(&statusCounts).Error
+	if err != nil {
+		return entities.CampaignSummary{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetSummary, err)
+	}
+	var totals struct {
+		Total           int64
+		StartingSoon    int64
+		EndingSoon      int64
+		WithoutStores   int64
+		WithoutProducts int64
+	}
+	err = db.Model(&CampaignEntry{}).Scopes(c.listScope(filter)).
+		Select(campaignSummaryColumns, soonFrom.UTC(), soonTo.UTC(), soonFrom.UTC(), soonTo.UTC()).Scan(&totals).Error
+	if err != nil {
+		return entities.CampaignSummary{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetSummary, err)
+	}
+
+	summary := entities.CampaignSummary{
+		Total:           totals.Total,
+		StatusCounts:    []entities.CampaignStatusCount{},
+		StartingSoon:    totals.StartingSoon,
+		EndingSoon:      totals.EndingSoon,
+		WithoutStores:   totals.WithoutStores,
+		WithoutProducts: totals.WithoutProducts,
+	}
+	for _, statusCount := range statusCounts {
+		summary.StatusCounts = append(summary.StatusCounts, entities.CampaignStatusCount{
+			StatusCode: valueobjects.CampaignStatusCode(statusCount.StatusCode),
+			Count:      statusCount.Count,
+		})
+	}
+	return summary, nil
+}
+
+// listScope narrows the campaigns down to the ones matching the filter
+func (c *CampaignService) listScope(filter entities.CampaignListFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("title like ?", filter.Name+"%")
+		if filter.Query != "" {
+			db = db.Where(campaignSearchMatch, filter.Query)
+		}
+		if filter.Status > 0 {
+			db = db.Where("status_code = ?", filter.Status)
+		}
+		if filter.CampaignType != "" {
+			db = db.Where("campaign_type = ?", filter.CampaignType)
+		}
+		if filter.IsPublished != nil {
+			db = db.Where("is_campaign_published = ?", *filter.IsPublished)
+		}
+		if !filter.OrderFrom.IsZero() {
+			db = db.Where("order_end_date >= ?", filter.OrderFrom.UTC())
+		}
+		if !filter.OrderTo.IsZero() {
+			db = db.Where("order_start_date <= ?", filter.OrderTo.UTC())
+		}
+		if !filter.CollectionFrom.IsZero() {
+			db = db.Where("collection_end_date >= ?", filter.CollectionFrom.UTC())
+		}
+		if !filter.CollectionTo.IsZero() {
+			db = db.Where("collection_start_date <= ?", filter.CollectionTo.UTC())
+		}
+		if filter.CreatedBy > 0 {
+			db = db.Where("created_by = ?", filter.CreatedBy)
+		}
+		if filter.StoreID > 0 {
+			db = db.Where("campaign_id in (?)",
+				db.Session(&gorm.Session{NewDB: true}).Model(&CampaignStoreEntry{}).Select("campaign_id").Where("store_id = ?", filter.StoreID))
+		}
+		if filter.ProductID > 0 {
+			db = db.Where("campaign_id in (?)",
+				db.Session(&gorm.Session{NewDB: true}).Model(&CampaignProductEntry{}).Select("campaign_id").Where("product_id = ?", filter.ProductID))
+		}
+		if filter.IncludeDeleted {
+			db = db.Unscoped()
+		}
+		return db
+	}
+}
+
+// GetListByStore returns a page of the campaigns the store takes part in along with the total matching count.
+// The store is matched through a subquery on campaign_stores so a store added twice lists the campaign once
+func (c *CampaignService) GetListByStore(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) ([]entities.Campaign, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	storeCampaigns := db.Model(&CampaignStoreEntry{}).Select("campaign_id").Where("store_id = ?", storeID)
+	return c.getListIn(db, storeCampaigns, filter)
+}
+
+// GetListByProduct returns a page of the campaigns selling the product along with the total matching count,
+// the product is matched through a subquery on campaign_products the same way GetListByStore matches a store
+func (c *CampaignService) GetListByProduct(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) ([]entities.Campaign, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	productCampaigns := db.Model(&CampaignProductEntry{}).Select("campaign_id").Where("product_id = ?", productID)
+	if filter.SKUNo > 0 {
+		productCampaigns = productCampaigns.Where("SKU_no = ?", filter.SKUNo)
+	}
+	return c.getListIn(db, productCampaigns, filter.StoreCampaignFilter)
+}
+
+// getListIn returns a page of the campaigns selected by the campaignIDs subquery which match the filter, ordered the
+// way GetList orders the campaign list
+func (c *CampaignService) getListIn(db *gorm.DB, campaignIDs *gorm.DB, filter entities.StoreCampaignFilter) ([]entities.Campaign, int64, error) {
+	filterScope := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("campaign_id in (?)", campaignIDs)
+		if filter.Status > 0 {
+			db = db.Where("status_code = ?", filter.Status)
+		}
+		if filter.IsPublished != nil {
+			db = db.Where("is_campaign_published = ?", *filter.IsPublished)
+		}
+		if !filter.At.IsZero() {
+			db = db.Where("order_start_date <= ? AND collection_end_date >= ?", filter.At.UTC(), filter.At.UTC())
+		}
+		return db
+	}
+
+	var count int64
+	err := db.Model(&CampaignEntry{}).Scopes(filterScope).Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	query := db.Model(&CampaignEntry{}).Scopes(filterScope)
+	query = orderBy(query, filter.SortFields)
+	var entries []CampaignEntry
+	err = query.Order("campaign_id asc").Limit(filter.Limit).Offset(filter.Offset).Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGetList, err)
+	}
+	return c.ToEntityList(entries), count, nil
+}
+
+// overlappingCampaignsQuery pairs every product and store of the campaign with the other campaigns selling
+// the same product at the same store, keeping those whose order dates overlap the campaign's
+const overlappingCampaignsQuery = `SELECT DISTINCT other.campaign_id, product.product_id, store.store_id FROM campaigns AS campaign
+	JOIN campaign_products AS product ON product.campaign_id = campaign.campaign_id AND product.deleted_at IS NULL
+	JOIN campaign_stores AS store ON store.campaign_id = campaign.campaign_id AND store.deleted_at IS NULL
+	JOIN campaign_products AS other_product ON other_product.product_id = product.product_id
+		AND other_product.campaign_id <> campaign.campaign_id AND other_product.deleted_at IS NULL
+	JOIN campaign_stores AS other_store ON other_store.campaign_id = other_product.campaign_id
+		AND other_store.store_id = store.store_id AND other_store.deleted_at IS NULL
+	JOIN campaigns AS other ON other.campaign_id = other_product.campaign_id AND other.deleted_at IS NULL
+	WHERE campaign.campaign_id = ? AND other.order_start_date <= campaign.order_end_date
+		AND other.order_end_date >= campaign.order_start_date
+	ORDER BY other.campaign_id, product.product_id, store.store_id`
+
+// GetOverlapping returns the campaigns selling a product of the campaign at one of its stores in order dates
+// overlapping its own, once for every product and store they share
+func (c *CampaignService) GetOverlapping(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignOverlap, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	overlaps := []entities.CampaignOverlap{}
+	err := db.Raw(overlappingCampaignsQuery, campaignID.ToInt64()).Scan(&overlaps).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGet, err)
+	}
+	return overlaps, nil
+}
+
+func (c *CampaignService) GetCampaignsCount() int64 {
+	var count int64
+	c.db.Table("campaigns").Count(&count)
+	return count
+}
+
+// timezone returns the stored timezone of a campaign or the default one when it was stored without
+func (c *CampaignService) timezone(timezone string) string {
+	if timezone == "" {
+		return c.defaultTimezone
+	}
+	return timezone
+}
+
+func (c *CampaignService) ToEntity(entry CampaignEntry) entities.Campaign {
+	var isCampaignPublished bool
+	var collectionStartDate, collectionEndDate, orderStartDate, orderEndDate time.Time
+	var leadTime int
+	var offerID, tagID int64
+	if entry.IsCampaignPublished != nil {
+		isCampaignPublished = *entry.IsCampaignPublished
+	} else {
+		isCampaignPublished = false
+	}
+	if entry.CollectionStartDate.Valid {
+		collectionStartDate = entry.CollectionStartDate.Time.UTC()
+	}
+	if entry.CollectionEndDate.Valid {
+		collectionEndDate = entry.CollectionEndDate.Time.UTC()
+	}
+	if entry.OrderStartDate.Valid {
+		orderStartDate = entry.OrderStartDate.Time.UTC()
+	}
+	if entry.OrderEndDate.Valid {
+		orderEndDate = entry.OrderEndDate.Time.UTC()
+	}
+	if entry.LeadTime.Valid {
+		leadTime = int(entry.LeadTime.Int32)
+	}
+	if entry.OfferID.Valid {
+		offerID = entry.OfferID.Int64
+	}
+	if entry.TagID.Valid {
+		tagID = entry.TagID.Int64
+	}
+	var deletedAt time.Time
+	if entry.DeletedAt.Valid {
+		deletedAt = entry.DeletedAt.Time.UTC()
+	}
+	return entities.Campaign{
+		ID:                  valueobjects.CampaignID(entry.ID),
+		Title:               entry.Title,
+		CollectionStartDate: collectionStartDate,
+		CollectionEndDate:   collectionEndDate,
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderEndDate,
+		Timezone:            c.timezone(entry.Timezone),
+		StatusCode:          entry.StatusCode,
+		ListingTitle:        entry.ListingTitle,
+		ListingDesc:         entry.ListingDesc,
+		ListingImagePath:    entry.ListingImagePath,
+		OnboardTitle:        entry.OnboardTitle,
+		OnboardDesc:         entry.OnboardDesc,
+		OnboardImagePath:    entry.OnboardImagePath,
+		LandingImagePath:    entry.LandingImagePath,
+		CampaignType:        valueobjects.CampaignType(entry.CampaignType),
+		LeadTime:            leadTime,
+		OfferID:             offerID,
+		TagID:               tagID,
+		IsCampaignPublished: isCampaignPublished,
+		DeletedAt:           deletedAt,
+		DeletedBy:           entry.DeletedBy,
+	}
+}
+
+func (c *CampaignService) ToEntry(campaignEntity entities.Campaign) CampaignEntry {
+	var collectionStartDate, collectionEndDate, orderStartDate, orderEndDate sql.NullTime
+	var leadTime sql.NullInt32
+	var offerID, tagID sql.NullInt64
+	if !campaignEntity.CollectionStartDate.IsZero() {
+		collectionStartDate = sql.NullTime{
+			Time:  campaignEntity.CollectionStartDate,
+			Valid: true,
+		}
+	}
+	if !campaignEntity.CollectionEndDate.IsZero() {
+		collectionEndDate = sql.NullTime{
+			Time:  campaignEntity.CollectionEndDate,
+			Valid: true,
+		}
+	}
+	if !campaignEntity.OrderStartDate.IsZero() {
+		orderStartDate = sql.NullTime{
+			Time:  campaignEntity.OrderStartDate,
+			Valid: true,
+		}
+	}
+	if !campaignEntity.OrderEndDate.IsZero() {
+		orderEndDate = sql.NullTime{
+			Time:  campaignEntity.OrderEndDate,
+			Valid: true,
+		}
+	}
+	if campaignEntity.LeadTime != 0 {
+		leadTime = sql.NullInt32{
+			Int32: int32(campaignEntity.LeadTime),
+			Valid: true,
+		}
+	}
+	if campaignEntity.OfferID != 0 {
+		offerID = sql.NullInt64{
+			Int64: campaignEntity.OfferID,
+			Valid: true,
+		}
+	}
+	if campaignEntity.TagID != 0 {
+		tagID = sql.NullInt64{
+			Int64: campaignEntity.TagID,
+			Valid: true,
+		}
+	}
+	return CampaignEntry{
+		ID:                  campaignEntity.ID.ToInt64(),
+		Title:               campaignEntity.Title,
+		CollectionStartDate: collectionStartDate,
+		CollectionEndDate:   collectionEndDate,
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderEndDate,
+		Timezone:            campaignEntity.Timezone,
+		StatusCode:          campaignEntity.StatusCode,
+		ListingTitle:        campaignEntity.ListingTitle,
+		ListingDesc:         campaignEntity.ListingDesc,
+		ListingImagePath:    campaignEntity.ListingImagePath,
+		OnboardTitle:        campaignEntity.OnboardTitle,
+		OnboardDesc:         campaignEntity.OnboardDesc,
+		OnboardImagePath:    campaignEntity.OnboardImagePath,
+		LandingImagePath:    campaignEntity.LandingImagePath,
+		CampaignType:        campaignEntity.CampaignType.String(),
+		LeadTime:            leadTime,
+		OfferID:             offerID,
+		TagID:               tagID,
+		CreatedBy:           campaignEntity.CreatedBy,
+		UpdatedBy:           campaignEntity.UpdatedBy,
+		IsCampaignPublished: &campaignEntity.IsCampaignPublished,
+	}
+}
+
+func (c *CampaignService) ToEntityList(entries []CampaignEntry) []entities.Campaign {
+	var campaigns []entities.Campaign
+	for _, entry := range entries {
+		campaign := c.ToEntity(entry)
+		campaigns = append(campaigns, campaign)
+	}
+	return campaigns
+}
+
+func (c *CampaignService) Create(ctx context.Context, campaign entities.Campaign) (entities.Campaign, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+
+	entry := c.ToEntry(campaign)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.Campaign{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantCreate, err)
+	}
+	logger.Infof("campaign created with id : %v", entry.ID)
+	return c.ToEntity(entry), nil
+}
+
+func (c *CampaignService) Exists(ctx context.Context, id valueobjects.CampaignID, title string) (bool, error) {
+	campaign := CampaignEntry{}
+	if id != 0 {
+		err := c.db.Where("campaign_id = ?", id).First(&campaign).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				logger.Info("entity not found")
+				return false, nil
+			}
+			return false, fmt.Errorf("%w:  %v", valueobjects.ErrCampaignCantExist, err)
+		}
+		return true, nil
+	} else {
+		err := c.db.Where("title = ?", title).First(&campaign).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				logger.Info("entity not found")
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+func (c *CampaignService) Update(ctx context.Context, campaign entities.Campaign) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+
+	var exists bool
+	err := db.Model(CampaignEntry{}).Select("count(*) > 0").Where("campaign_id != ? and title = ?", campaign.ID, campaign.Title).Find(&exists).Error
+	if err != nil {
+		return fmt.Errorf("error occured while checking campaign existence with given name: %v", err)
+	}
+
+	if exists {
+		return fmt.Errorf("campaign with title '%s' already exists. please provide another title", campaign.Title)
+	}
+
+	campaignEntry := c.ToEntry(campaign)
+	err = db.Model(campaignEntry).Where("campaign_id = ?", campaignEntry.ID).Updates(map[string]interface{}{
+		"title":                 campaignEntry.Title,
+		"order_start_date":      campaignEntry.OrderStartDate,
+		"order_end_date":        campaignEntry.OrderEndDate,
+		"collection_start_date": campaignEntry.CollectionStartDate,
+		"collection_end_date":   campaignEntry.CollectionEndDate,
+		"timezone":              campaignEntry.Timezone,
+		"status_code":           campaignEntry.StatusCode,
+		"campaign_type":         campaignEntry.CampaignType,
+		"listing_title":         campaignEntry.ListingTitle,
+		"listing_description":   campaignEntry.ListingDesc,
+		"listing_image_path":    campaignEntry.ListingImagePath,
+		"onboard_title":         campaignEntry.OnboardTitle,
+		"onboard_description":   campaignEntry.OnboardDesc,
+		"onboard_image_path":    campaignEntry.OnboardImagePath,
+		"landing_image_path":    campaignEntry.LandingImagePath,
+		"lead_time":             campaignEntry.LeadTime,
+		"offer_id":              campaignEntry.OfferID,
+		"tag_id":                campaignEntry.TagID,
+		"is_campaign_published": campaignEntry.IsCampaignPublished}).Error
+	if err != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantUpdate, err)
+	}
+	logger.Infof("campaign with id : %v updated successfully", campaignEntry.ID)
+	return nil
+}
+
+// Delete soft deletes the campaign together with its stores and products.
+// The children get the same deleted_at as the campaign, so Restore only brings back what was deleted with it.
+func (c *CampaignService) Delete(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	// datetime columns keep whole seconds, the children are matched on this exact value when restoring
+	deletedAt := time.Now().Truncate(time.Second)
+	deletion := map[string]interface{}{
+		"deleted_at": deletedAt,
+		"deleted_by": userID,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		response := tx.Model(&CampaignEntry{}).Where("campaign_id = ?", campaignID.ToInt64()).Updates(deletion)
+		if response.Error != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantDelete, response.Error)
+		}
+		if response.RowsAffected < 1 {
+			return fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotExists, campaignID)
+		}
+		err := tx.Model(&CampaignStoreEntry{}).Where("campaign_id = ?", campaignID.ToInt64()).Updates(deletion).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, err)
+		}
+		err = tx.Model(&CampaignProductEntry{}).Where("campaign_id = ?", campaignID.ToInt64()).Updates(deletion).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("campaign with id : %v deleted successfully", campaignID)
+	return nil
+}
+
+// Restore brings back a deleted campaign with the stores and products deleted along with it
+func (c *CampaignService) Restore(ctx context.Context, campaignID valueobjects.CampaignID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	restoration := map[string]interface{}{
+		"deleted_at": nil,
+		"deleted_by": 0,
+		"updated_by": userID,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var entry CampaignEntry
+		err := tx.Unscoped().Where("campaign_id = ?", campaignID.ToInt64()).First(&entry).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotExists, campaignID)
+			}
+			return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantGet, err)
+		}
+		if !entry.DeletedAt.Valid {
+			return fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotDeleted, campaignID)
+		}
+
+		var exists bool
+		err = tx.Model(&CampaignEntry{}).Select("count(*) > 0").Where("campaign_id != ? and title = ?",
+			entry.ID, entry.Title).Find(&exists).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantExist, err)
+		}
+		if exists {
+			return fmt.Errorf("%w: %s", valueobjects.ErrCampaignTitleExists, entry.Title)
+		}
+
+		deletedAt := entry.DeletedAt.Time
+		err = tx.Unscoped().Model(&CampaignStoreEntry{}).Where("campaign_id = ? and deleted_at = ?",
+			entry.ID, deletedAt).Updates(restoration).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrStoreCantUpdate, err)
+		}
+		err = tx.Unscoped().Model(&CampaignProductEntry{}).Where("campaign_id = ? and deleted_at = ?",
+			entry.ID, deletedAt).Updates(restoration).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, err)
+		}
+		err = tx.Unscoped().Model(&CampaignEntry{}).Where("campaign_id = ?", entry.ID).Updates(restoration).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantRestore, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("campaign with id : %v restored successfully", campaignID)
+	return nil
+}
+
+func (c *CampaignService) PublishCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	return publishCampaigns(db, transition, now)
+}
+
+func (c *CampaignService) DeactivateCampaigns(ctx context.Context, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	return deactivateCampaigns(db, transition, now)
+}
+
+// order dates are stored as instants, so comparing them against now needs no timezone handling
+func publishCampaigns(db *gorm.DB, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	logger.Info("publishing campaigns")
+	ids, err := updateCampaignStatus(db, "order_start_date <= ?", now, transition)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		logger.Infof("published %d campaigns", len(ids))
+	} else {
+		logger.Info("no campaign to publish")
+	}
+	return ids, nil
+}
+
+func deactivateCampaigns(db *gorm.DB, transition valueobjects.CampaignStatusTransition, now time.Time) ([]valueobjects.CampaignID, error) {
+	logger.Info("deactivating campaigns")
+	ids, err := updateCampaignStatus(db, "order_end_date < ?", now, transition)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		logger.Infof("deactivated %d campaigns", len(ids))
+	} else {
+		logger.Info("no campaign to deactivate")
+	}
+	return ids, nil
+}
+
+// updateCampaignStatus locks the campaigns matching the date condition and moves them through the transition,
+// returning their ids so the change can be recorded per campaign
+func updateCampaignStatus(db *gorm.DB, dateCondition string, now time.Time, transition valueobjects.CampaignStatusTransition) ([]valueobjects.CampaignID, error) {
+	var entryIDs []int64
+	err := db.Model(&CampaignEntry{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(dateCondition+" and status_code = ?", now, transition.From.Code()).
+		Pluck("campaign_id", &entryIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignStatusCantUpdate, err)
+	}
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	err = db.Model(&CampaignEntry{}).Where("campaign_id IN ? and status_code = ?", entryIDs, transition.From.Code()).
+		Update("status_code", transition.To.Code()).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignStatusCantUpdate, err)
+	}
+	ids := make([]valueobjects.CampaignID, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		ids = append(ids, valueobjects.CampaignID(id))
+	}
+	return ids, nil
+}
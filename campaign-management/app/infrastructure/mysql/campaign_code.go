@@ -1,43 +1,168 @@
-package mysql
-
-import (
-	"time"
-
-	"gorm.io/gorm"
-)
-
-type CampaignCodeService struct {
-	db *gorm.DB
-}
-
-type CampaignCodeEntry struct {
-	StatusCode  int64     `gorm:"primary_key;autoIncrement;column:status_code"`
-	StatusValue string    `gorm:"column:status_value;type:varchar(100)"`
-	CreatedAt   time.Time `gorm:"column:created_at;type:datetime"`
-	CreatedBy   int64     `gorm:"column:created_by"`
-	UpdatedAt   time.Time `gorm:"column:updated_at;type:datetime"`
-	UpdatedBy   int64     `gorm:"column:updated_by"`
-	DeletedAt   time.Time `gorm:"column:deleted_at;type:datetime"`
-	DeletedBy   int64     `gorm:"column:deleted_by"`
-}
-
-func NewCampaignCodeService(db *gorm.DB) *CampaignCodeService {
-	return &CampaignCodeService{db: db}
-}
-
-func (c *CampaignCodeEntry) TableName() string {
-	return "campaign_codes"
-}
-
-func (c *CampaignCodeService) Migrate(defaultCampaignStatusDBEntry []map[string]interface{}) error {
-	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignCodeEntry{})
-	c.CreateCampainStatuses(defaultCampaignStatusDBEntry)
-	return err
-}
-
-func (c *CampaignCodeService) CreateCampainStatuses(defaultCampaignStatusDBEntry []map[string]interface{}) {
-	count := c.db.Model(&CampaignCodeEntry{}).Find(&CampaignCodeEntry{}).RowsAffected
-	if count == 0 {
-		c.db.Model(&CampaignCodeEntry{}).Create(defaultCampaignStatusDBEntry)
-	}
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CampaignCodeService struct {
+	db *gorm.DB
+}
+
+type CampaignCodeEntry struct {
+	StatusCode  int64          `gorm:"primary_key;autoIncrement;column:status_code"`
+	StatusValue string         `gorm:"column:status_value;type:varchar(100)"`
+	CreatedAt   time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy   int64          `gorm:"column:created_by"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy   int64          `gorm:"column:updated_by"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy   int64          `gorm:"column:deleted_by"`
+}
+
+func NewCampaignCodeService(db *gorm.DB) *CampaignCodeService {
+	return &CampaignCodeService{db: db}
+}
+
+func (c *CampaignCodeEntry) TableName() string {
+	return "campaign_codes"
+}
+
+func (c *CampaignCodeService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignCodeEntry{})
+	if err != nil {
+		return err
+	}
+	return c.CreateCampainStatuses()
+}
+
+// CreateCampainStatuses adds the system statuses missing from campaign_codes, statuses added through the API are kept as is
+func (c *CampaignCodeService) CreateCampainStatuses() error {
+	statusCodes := []valueobjects.CampaignStatusCode{}
+	for statusCode := range valueobjects.SystemCampaignStatuses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Slice(statusCodes, func(i, j int) bool { return statusCodes[i] < statusCodes[j] })
+
+	for _, statusCode := range statusCodes {
+		entry := CampaignCodeEntry{
+			StatusCode:  statusCode.Code(),
+			StatusValue: valueobjects.SystemCampaignStatuses[statusCode],
+		}
+		err := c.db.Unscoped().Where(CampaignCodeEntry{StatusCode: statusCode.Code()}).FirstOrCreate(&entry).Error
+		if err != nil {
+			return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantCreate, err)
+		}
+	}
+	return nil
+}
+
+func (c *CampaignCodeService) GetList(ctx context.Context) ([]entities.CampaignCode, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entries []CampaignCodeEntry
+	err := db.Order("status_code asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantGet, err)
+	}
+	campaignCodes := []entities.CampaignCode{}
+	for _, entry := range entries {
+		campaignCodes = append(campaignCodes, c.ToEntity(entry))
+	}
+	return campaignCodes, nil
+}
+
+func (c *CampaignCodeService) Get(ctx context.Context, statusCode valueobjects.CampaignStatusCode) (entities.CampaignCode, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry CampaignCodeEntry
+	err := db.Where("status_code = ?", statusCode.Code()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.CampaignCode{}, fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeNotExists, statusCode)
+		}
+		return entities.CampaignCode{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+// GetByValue looks the status up by name, the match follows the column collation so it is case insensitive by default
+func (c *CampaignCodeService) GetByValue(ctx context.Context, statusValue string) (entities.CampaignCode, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry CampaignCodeEntry
+	err := db.Where("status_value = ?", statusValue).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.CampaignCode{}, fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeNotExists, statusValue)
+		}
+		return entities.CampaignCode{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *CampaignCodeService) Create(ctx context.Context, campaignCode entities.CampaignCode) (entities.CampaignCode, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(campaignCode)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.CampaignCode{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantCreate, err)
+	}
+	logger.Infof("campaign status %v created with code %v", entry.StatusValue, entry.StatusCode)
+	return c.ToEntity(entry), nil
+}
+
+func (c *CampaignCodeService) Update(ctx context.Context, campaignCode entities.CampaignCode) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&CampaignCodeEntry{}).Where("status_code = ?", campaignCode.StatusCode.Code()).
+		Updates(map[string]interface{}{
+			"status_value": campaignCode.StatusValue,
+			"updated_by":   campaignCode.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrCampaignCodeCantUpdate, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeNotExists, campaignCode.StatusCode)
+	}
+	logger.Infof("campaign status with code %v updated successfully", campaignCode.StatusCode)
+	return nil
+}
+
+func (c *CampaignCodeService) ToEntry(campaignCode entities.CampaignCode) CampaignCodeEntry {
+	return CampaignCodeEntry{
+		StatusCode:  campaignCode.StatusCode.Code(),
+		StatusValue: campaignCode.StatusValue,
+		CreatedBy:   campaignCode.CreatedBy,
+		UpdatedBy:   campaignCode.UpdatedBy,
+	}
+}
+
+func (c *CampaignCodeService) ToEntity(entry CampaignCodeEntry) entities.CampaignCode {
+	return entities.CampaignCode{
+		StatusCode:  valueobjects.CampaignStatusCode(entry.StatusCode),
+		StatusValue: entry.StatusValue,
+		CreatedBy:   entry.CreatedBy,
+		UpdatedBy:   entry.UpdatedBy,
+	}
+}
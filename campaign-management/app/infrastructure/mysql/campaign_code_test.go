@@ -0,0 +1,123 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCampaignCodeService_GetByValue(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `campaign_codes` WHERE status_value = ? AND `campaign_codes`.`deleted_at` IS NULL ORDER BY `campaign_codes`.`status_code` LIMIT 1"
+
+	t.Run("when status exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs("Cancelled").
+			WillReturnRows(sqlmock.NewRows([]string{"status_code", "status_value"}).AddRow(4, "Cancelled"))
+
+		campaignCode, err := campaignCodeService.GetByValue(context.TODO(), "Cancelled")
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := entities.CampaignCode{StatusCode: 4, StatusValue: "Cancelled"}
+		if campaignCode != expected {
+			t.Errorf("unexpected campaign code : got - %+v ; want - %+v", campaignCode, expected)
+		}
+	})
+
+	t.Run("when status not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs("Unknown").
+			WillReturnRows(sqlmock.NewRows([]string{"status_code", "status_value"}))
+
+		_, err := campaignCodeService.GetByValue(context.TODO(), "Unknown")
+		if !errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeNotExists)
+		}
+	})
+
+	t.Run("when error occured while getting status", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WillReturnError(errors.New("db error"))
+
+		_, err := campaignCodeService.GetByValue(context.TODO(), "Active")
+		if !errors.Is(err, valueobjects.ErrCampaignCodeCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeCantGet)
+		}
+	})
+}
+
+func TestCampaignCodeService_Create(t *testing.T) {
+	t.Run("when status created successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `campaign_codes`").WillReturnResult(sqlmock.NewResult(4, 1))
+		mock.ExpectCommit()
+
+		campaignCode, err := campaignCodeService.Create(context.TODO(), entities.CampaignCode{StatusValue: "Cancelled", CreatedBy: 7})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if campaignCode.StatusCode != 4 || campaignCode.StatusValue != "Cancelled" {
+			t.Errorf("unexpected campaign code : got - %+v", campaignCode)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when error occured while creating status", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `campaign_codes`").WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := campaignCodeService.Create(context.TODO(), entities.CampaignCode{StatusValue: "Cancelled"})
+		if !errors.Is(err, valueobjects.ErrCampaignCodeCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeCantCreate)
+		}
+	})
+}
+
+func TestCampaignCodeService_Update(t *testing.T) {
+	const sqlUpdate = "UPDATE `campaign_codes` SET `status_value`=?,`updated_by`=?,`updated_at`=? WHERE status_code = ? AND `campaign_codes`.`deleted_at` IS NULL"
+
+	t.Run("when status updated successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs("Completed", 7, sqlmock.AnyArg(), 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := campaignCodeService.Update(context.TODO(), entities.CampaignCode{StatusCode: 4, StatusValue: "Completed", UpdatedBy: 7})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when status not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignCodeService := NewCampaignCodeService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := campaignCodeService.Update(context.TODO(), entities.CampaignCode{StatusCode: 9, StatusValue: "Completed"})
+		if !errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeNotExists)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// campaignCursor is the position of a campaign in the list sorted by Sort, it reads the page before the campaign when
+// Prev is set and the page after it otherwise
+type campaignCursor struct {
+	Sort string            `json:"s"`
+	Prev bool              `json:"p,omitempty"`
+	Keys []json.RawMessage `json:"k"`
+}
+
+// campaignSortKey reads a sortable column off a campaign and back out of a cursor, null dates read as nil
+type campaignSortKey struct {
+	value  func(entry CampaignEntry) interface{}
+	decode func(raw json.RawMessage) (interface{}, error)
+}
+
+var campaignSortKeys = map[string]campaignSortKey{
+	"campaign_id":           {value: func(entry CampaignEntry) interface{} { return entry.ID }, decode: decodeInt64Key},
+	"title":                 {value: func(entry CampaignEntry) interface{} { return entry.Title }, decode: decodeStringKey},
+	"status_code":           {value: func(entry CampaignEntry) interface{} { return entry.StatusCode }, decode: decodeInt64Key},
+	"order_start_date":      {value: func(entry CampaignEntry) interface{} { return timeKey(entry.OrderStartDate) }, decode: decodeTimeKey},
+	"order_end_date":        {value: func(entry CampaignEntry) interface{} { return timeKey(entry.OrderEndDate) }, decode: decodeTimeKey},
+	"collection_start_date": {value: func(entry CampaignEntry) interface{} { return timeKey(entry.CollectionStartDate) }, decode: decodeTimeKey},
+	"collection_end_date":   {value: func(entry CampaignEntry) interface{} { return timeKey(entry.CollectionEndDate) }, decode: decodeTimeKey},
+	"created_at":            {value: func(entry CampaignEntry) interface{} { return timeKey(entry.CreatedAt) }, decode: decodeTimeKey},
+	"updated_at":            {value: func(entry CampaignEntry) interface{} { return timeKey(entry.UpdatedAt) }, decode: decodeTimeKey},
+}
+
+// encodeCampaignCursor returns the opaque cursor of the page after the campaign, or before it when prev is set
+func encodeCampaignCursor(sortFields []entities.SortField, entry CampaignEntry, prev bool) string {
+	cursor := campaignCursor{Sort: sortSignature(sortFields), Prev: prev}
+	for _, sortField := range sortFields {
+		key, _ := json.Marshal(campaignSortKeys[sortField.Column].value(entry))
+		cursor.Keys = append(cursor.Keys, key)
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCampaignCursor reads the cursor back, a cursor got under another sort is refused as its keys would not
+// position the campaign in this one. An empty cursor is the start of the list
+func decodeCampaignCursor(value string, sortFields []entities.SortField) (campaignCursor, []interface{}, error) {
+	cursor := campaignCursor{}
+	if value == "" {
+		return cursor, nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCursorInvalid, err)
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCursorInvalid, err)
+	}
+	if cursor.Sort != sortSignature(sortFields) || len(cursor.Keys) != len(sortFields) {
+		return cursor, nil, fmt.Errorf("%w: cursor is not for sort %q", valueobjects.ErrCampaignCursorInvalid,
+			sortSignature(sortFields))
+	}
+	keys := []interface{}{}
+	for i, sortField := range sortFields {
+		key, err := campaignSortKeys[sortField.Column].decode(cursor.Keys[i])
+		if err != nil {
+			return cursor, nil, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCursorInvalid, err)
+		}
+		keys = append(keys, key)
+	}
+	return cursor, keys, nil
+}
+
+// keysetCondition keeps the campaigns sorting after the keys, or before them when prev is set. A campaign sorts after
+// the keys when it sorts the same on the first few fields and after on the next one
+func keysetCondition(sortFields []entities.SortField, keys []interface{}, prev bool) (string, []interface{}) {
+	conditions := []string{}
+	args := []interface{}{}
+	for i, sortField := range sortFields {
+		terms := []string{}
+		for j := 0; j < i; j++ {
+			terms = append(terms, sortFields[j].Column+" <=> ?")
+			args = append(args, keys[j])
+		}
+		term, termArgs := beyondKey(sortField.Column, keys[i], sortField.Desc != prev)
+		terms = append(terms, term)
+		args = append(args, termArgs...)
+		conditions = append(conditions, "("+strings.Join(terms, " AND ")+")")
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+// beyondKey keeps the values past the key in the given direction, MySQL sorts nulls before any value
+func beyondKey(column string, key interface{}, smaller bool) (string, []interface{}) {
+	switch {
+	case key == nil && smaller:
+		return "FALSE", nil
+	case key == nil:
+		return column + " IS NOT NULL", nil
+	case smaller:
+		return "(" + column + " < ? OR " + column + " IS NULL)", []interface{}{key}
+	default:
+		return column + " > ?", []interface{}{key}
+	}
+}
+
+func sortSignature(sortFields []entities.SortField) string {
+	columns := []string{}
+	for _, sortField := range sortFields {
+		if sortField.Desc {
+			columns = append(columns, "-"+sortField.Column)
+			continue
+		}
+		columns = append(columns, sortField.Column)
+	}
+	return strings.Join(columns, ",")
+}
+
+func timeKey(value sql.NullTime) interface{} {
+	if !value.Valid {
+		return nil
+	}
+	return value.Time.UTC()
+}
+
+func decodeInt64Key(raw json.RawMessage) (interface{}, error) {
+	var key int64
+	err := json.Unmarshal(raw, &key)
+	return key, err
+}
+
+func decodeStringKey(raw json.RawMessage) (interface{}, error) {
+	var key string
+	err := json.Unmarshal(raw, &key)
+	return key, err
+}
+
+func decodeTimeKey(raw json.RawMessage) (interface{}, error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+	var key time.Time
+	err := json.Unmarshal(raw, &key)
+	return key.UTC(), err
+}
@@ -1,156 +1,236 @@
-package mysql
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-	"fmt"
-	"time"
-
-	logger "github.com/sirupsen/logrus"
-	"gorm.io/gorm"
-)
-
-type CampaignProductService struct {
-	db *gorm.DB
-}
-
-type CampaignProductEntry struct {
-	ID          int64          `gorm:"primary_key;autoIncrement;column:campaign_product_id"`
-	CampaignID  int64          `gorm:"column:campaign_id"`
-	ProductID   int64          `gorm:"column:product_id"`
-	SKUNo       int64          `gorm:"column:SKU_no"`
-	SerialNo    int            `gorm:"column:serial_no;type:smallint"`
-	SequenceNo  int            `gorm:"column:sequence_no;type:smallint"`
-	ProductType string         `gorm:"column:product_type;type:varchar(20)"`
-	CreatedAt   time.Time      `gorm:"column:created_at;type:datetime"`
-	CreatedBy   int64          `gorm:"column:created_by"`
-	UpdatedAt   time.Time      `gorm:"column:updated_at;type:datetime"`
-	UpdatedBy   int64          `gorm:"column:updated_by"`
-	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
-	DeletedBy   int64          `gorm:"column:deleted_by"`
-}
-
-func NewCampaignProductService(db *gorm.DB) *CampaignProductService {
-	return &CampaignProductService{db: db}
-}
-
-func (c *CampaignProductEntry) TableName() string {
-	return "campaign_products"
-}
-
-func (c *CampaignProductService) Migrate() error {
-	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignProductEntry{})
-	return err
-}
-
-func (c *CampaignProductService) CreateMultiple(ctx context.Context, products []entities.CampaignProduct) ([]entities.CampaignProduct, error) {
-	db := c.db
-	if ctxDB := DBTransaction(ctx); ctxDB != nil {
-		db = ctxDB
-	}
-
-	productEntries := []CampaignProductEntry{}
-	for i := range products {
-		productEntries = append(productEntries, c.ToEntry(products[i]))
-	}
-	err := db.Create(&productEntries).Error
-	if err != nil {
-		return []entities.CampaignProduct{}, fmt.Errorf("%w: %v", valueobjects.ErrProductCantCreate, err)
-	}
-	logger.Infof("products added for campaign id : %v", productEntries[0].CampaignID)
-
-	var productEntities []entities.CampaignProduct
-	for _, product := range productEntries {
-		productEntities = append(productEntities, c.ToEntity(product))
-	}
-	return productEntities, nil
-}
-
-func (c *CampaignProductService) ToEntry(productEntity entities.CampaignProduct) CampaignProductEntry {
-	return CampaignProductEntry{
-		ID:          productEntity.ID.ToInt64(),
-		CampaignID:  productEntity.CampaignID.ToInt64(),
-		ProductID:   productEntity.ProductID,
-		SKUNo:       productEntity.SKUNo,
-		SerialNo:    productEntity.SerialNo,
-		SequenceNo:  productEntity.SequenceNo,
-		ProductType: productEntity.ProductType,
-		CreatedBy:   productEntity.CreatedBy,
-		UpdatedBy:   productEntity.UpdatedBy,
-	}
-}
-
-func (c *CampaignProductService) ToEntity(productEntry CampaignProductEntry) entities.CampaignProduct {
-	return entities.CampaignProduct{
-		ID:          valueobjects.CampaignProductID(productEntry.ID),
-		CampaignID:  valueobjects.CampaignID(productEntry.CampaignID),
-		ProductID:   productEntry.ProductID,
-		SKUNo:       productEntry.SKUNo,
-		SerialNo:    productEntry.SerialNo,
-		SequenceNo:  productEntry.SequenceNo,
-		ProductType: productEntry.ProductType,
-	}
-}
-
-func (c *CampaignProductService) GetByCampaignId(ctx context.Context, CampaignID valueobjects.CampaignID) ([]entities.CampaignProduct, error) {
-	var entry []CampaignProductEntry
-	err := c.db.Where("campaign_id = ?", CampaignID).Find(&entry).Error
-	return c.ToEntityList(entry), err
-}
-
-func (c *CampaignProductService) ToEntityList(entries []CampaignProductEntry) []entities.CampaignProduct {
-	var products []entities.CampaignProduct
-	for _, entry := range entries {
-		product := c.ToEntity(entry)
-		products = append(products, product)
-	}
-	return products
-}
-
-func (c *CampaignProductService) Update(ctx context.Context, campaignProduct entities.CampaignProduct) error {
-	db := c.db
-	if ctxDB := DBTransaction(ctx); ctxDB != nil {
-		db = ctxDB
-	}
-
-	campaignProductEntry := c.ToEntry(campaignProduct)
-	err := db.Model(&campaignProductEntry).Where("campaign_product_id = ? and campaign_id = ?",
-		campaignProductEntry.ID, campaignProductEntry.CampaignID).Updates(&campaignProductEntry).Error
-	if err != nil {
-		logger.Errorf("error occured : %v", err.Error())
-		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, err)
-	}
-	logger.Infof("product with id %v updated successfully", campaignProductEntry.ID)
-	return nil
-}
-
-func (c *CampaignProductService) DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error {
-	result := c.db.Where("campaign_id = ? and product_id =?", campaignID, productID).Delete(&CampaignProductEntry{})
-	if result.Error != nil {
-		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, result.Error)
-	}
-	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			logger.Info("entity not found")
-			return nil
-		}
-		return result.Error
-	}
-	return nil
-}
-
-func (c *CampaignProductService) DeleteAllByCampaignId(ctx context.Context, campaignID int64) error {
-	result := c.db.Where("campaign_id = ?", campaignID).Delete(&CampaignProductEntry{})
-	if result.Error != nil {
-		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, result.Error)
-	}
-	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			logger.Info("entity not found")
-			return nil
-		}
-		return result.Error
-	}
-	return nil
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CampaignProductService struct {
+	db *gorm.DB
+}
+
+type CampaignProductEntry struct {
+	ID          int64          `gorm:"primary_key;autoIncrement;column:campaign_product_id"`
+	CampaignID  int64          `gorm:"column:campaign_id"`
+	ProductID   int64          `gorm:"column:product_id"`
+	SKUNo       int64          `gorm:"column:SKU_no"`
+	SerialNo    int            `gorm:"column:serial_no;type:smallint"`
+	SequenceNo  int            `gorm:"column:sequence_no;type:smallint"`
+	ProductType string         `gorm:"column:product_type;type:varchar(20)"`
+	CreatedAt   time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy   int64          `gorm:"column:created_by"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy   int64          `gorm:"column:updated_by"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy   int64          `gorm:"column:deleted_by"`
+}
+
+func NewCampaignProductService(db *gorm.DB) *CampaignProductService {
+	return &CampaignProductService{db: db}
+}
+
+func (c *CampaignProductEntry) TableName() string {
+	return "campaign_products"
+}
+
+func (c *CampaignProductService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignProductEntry{})
+	return err
+}
+
+func (c *CampaignProductService) CreateMultiple(ctx context.Context, products []entities.CampaignProduct) ([]entities.CampaignProduct, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+
+	productEntries := []CampaignProductEntry{}
+	for i := range products {
+		productEntries = append(productEntries, c.ToEntry(products[i]))
+	}
+	err := db.Create(&productEntries).Error
+	if err != nil {
+		return []entities.CampaignProduct{}, fmt.Errorf("%w: %v", valueobjects.ErrProductCantCreate, err)
+	}
+	logger.Infof("products added for campaign id : %v", productEntries[0].CampaignID)
+
+	var productEntities []entities.CampaignProduct
+	for _, product := range productEntries {
+		productEntities = append(productEntities, c.ToEntity(product))
+	}
+	return productEntities, nil
+}
+
+func (c *CampaignProductService) ToEntry(productEntity entities.CampaignProduct) CampaignProductEntry {
+	return CampaignProductEntry{
+		ID:          productEntity.ID.ToInt64(),
+		CampaignID:  productEntity.CampaignID.ToInt64(),
+		ProductID:   productEntity.ProductID,
+		SKUNo:       productEntity.SKUNo,
+		SerialNo:    productEntity.SerialNo,
+		SequenceNo:  productEntity.SequenceNo,
+		ProductType: productEntity.ProductType,
+		CreatedBy:   productEntity.CreatedBy,
+		UpdatedBy:   productEntity.UpdatedBy,
+	}
+}
+
+func (c *CampaignProductService) ToEntity(productEntry CampaignProductEntry) entities.CampaignProduct {
+	return entities.CampaignProduct{
+		ID:          valueobjects.CampaignProductID(productEntry.ID),
+		CampaignID:  valueobjects.CampaignID(productEntry.CampaignID),
+		ProductID:   productEntry.ProductID,
+		SKUNo:       productEntry.SKUNo,
+		SerialNo:    productEntry.SerialNo,
+		SequenceNo:  productEntry.SequenceNo,
+		ProductType: productEntry.ProductType,
+	}
+}
+
+func (c *CampaignProductService) GetByCampaignId(ctx context.Context, CampaignID valueobjects.CampaignID) ([]entities.CampaignProduct, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry []CampaignProductEntry
+	err := db.Where("campaign_id = ?", CampaignID).Find(&entry).Error
+	return c.ToEntityList(entry), err
+}
+
+// LockByCampaignId reads the products of the campaign and locks them until the transaction ends, so the products can
+// be rewritten from what was read
+func (c *CampaignProductService) LockByCampaignId(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignProduct, error) {
+	db := DBTransaction(ctx)
+	if db == nil {
+		return nil, fmt.Errorf("%w: product lock needs a transaction", valueobjects.ErrProductCantGet)
+	}
+	var entries []CampaignProductEntry
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("campaign_id = ?", campaignID).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrProductCantGet, err)
+	}
+	return c.ToEntityList(entries), nil
+}
+
+// GetList returns a page of the campaign products matching the filter along with the total matching count
+func (c *CampaignProductService) GetList(ctx context.Context, campaignID valueobjects.CampaignID,
+	filter entities.CampaignProductFilter) ([]entities.CampaignProduct, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	filterScope := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("campaign_id = ?", campaignID.ToInt64())
+		if filter.ProductID > 0 {
+			db = db.Where("product_id = ?", filter.ProductID)
+		}
+		if filter.SKUNo > 0 {
+			db = db.Where("SKU_no = ?", filter.SKUNo)
+		}
+		if filter.ProductType != "" {
+			db = db.Where("product_type = ?", filter.ProductType)
+		}
+		return db
+	}
+
+	var count int64
+	err := db.Model(&CampaignProductEntry{}).Scopes(filterScope).Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrProductCantGet, err)
+	}
+	var entries []CampaignProductEntry
+	err = orderBy(db.Scopes(filterScope), filter.SortFields).Order("campaign_product_id asc").
+		Limit(filter.Limit).Offset(filter.Offset).Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrProductCantGet, err)
+	}
+	return c.ToEntityList(entries), count, nil
+}
+
+func (c *CampaignProductService) ToEntityList(entries []CampaignProductEntry) []entities.CampaignProduct {
+	var products []entities.CampaignProduct
+	for _, entry := range entries {
+		product := c.ToEntity(entry)
+		products = append(products, product)
+	}
+	return products
+}
+
+func (c *CampaignProductService) Update(ctx context.Context, campaignProduct entities.CampaignProduct) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+
+	campaignProductEntry := c.ToEntry(campaignProduct)
+	err := db.Model(&campaignProductEntry).Where("campaign_product_id = ? and campaign_id = ?",
+		campaignProductEntry.ID, campaignProductEntry.CampaignID).Updates(&campaignProductEntry).Error
+	if err != nil {
+		logger.Errorf("error occured : %v", err.Error())
+		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, err)
+	}
+	logger.Infof("product with id %v updated successfully", campaignProductEntry.ID)
+	return nil
+}
+
+func (c *CampaignProductService) DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error {
+	result := c.db.Where("campaign_id = ? and product_id =?", campaignID, productID).Delete(&CampaignProductEntry{})
+	if result.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, result.Error)
+	}
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			logger.Info("entity not found")
+			return nil
+		}
+		return result.Error
+	}
+	return nil
+}
+
+func (c *CampaignProductService) DeleteAllByCampaignId(ctx context.Context, campaignID int64) error {
+	result := c.db.Where("campaign_id = ?", campaignID).Delete(&CampaignProductEntry{})
+	if result.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, result.Error)
+	}
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			logger.Info("entity not found")
+			return nil
+		}
+		return result.Error
+	}
+	return nil
+}
+
+func (c *CampaignProductService) Delete(ctx context.Context, campaignID valueobjects.CampaignID, campaignProductID valueobjects.CampaignProductID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry CampaignProductEntry
+	err := db.Model(&CampaignProductEntry{}).Where("campaign_product_id = ? and campaign_id = ?",
+		campaignProductID.ToInt64(), campaignID.ToInt64()).Update("deleted_by", userID).Error
+	if err != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, err)
+	}
+
+	response := db.Where("campaign_product_id = ? and campaign_id = ?", campaignProductID.ToInt64(),
+		campaignID.ToInt64()).Delete(&entry)
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrProductCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		logger.Infof("campaign product with id %v not exists", campaignProductID.ToInt64())
+		return nil
+	}
+	logger.Infof("campaign product with id %v deleted successfully", campaignProductID.ToInt64())
+	return nil
+}
@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCampaignProductService_GetList(t *testing.T) {
+	const sqlCount = "SELECT count(*) FROM `campaign_products` WHERE campaign_id = ? AND product_type = ? AND `campaign_products`.`deleted_at` IS NULL"
+	const sqlSelect = "SELECT * FROM `campaign_products` WHERE campaign_id = ? AND product_type = ? AND `campaign_products`.`deleted_at` IS NULL ORDER BY `sequence_no` DESC,campaign_product_id asc LIMIT 2 OFFSET 2"
+	filter := entities.CampaignProductFilter{
+		PaginationConfig: entities.PaginationConfig{Limit: 2, Page: 2, Offset: 2, SortFields: []entities.SortField{{Column: "sequence_no", Desc: true}}},
+		ProductType:      "cd",
+	}
+
+	t.Run("when filtered page of products fetched successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WithArgs(1, "cd").
+			WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1, "cd").
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_product_id", "campaign_id", "product_id", "sequence_no", "product_type"}).
+				AddRow(5, 1, 501, 1, "cd"))
+
+		products, count, err := campaignProductService.GetList(context.TODO(), valueobjects.CampaignID(1), filter)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 3 || len(products) != 1 || products[0].ProductID != 501 {
+			t.Errorf("unexpected result : got - %v, %d", products, count)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when products can not be counted", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WillReturnError(errors.New("db error"))
+
+		_, _, err := campaignProductService.GetList(context.TODO(), valueobjects.CampaignID(1), filter)
+		if !errors.Is(err, valueobjects.ErrProductCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductCantGet)
+		}
+	})
+}
+
+func TestCampaignProductService_GetByCampaignId(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `campaign_products` WHERE campaign_id = ? AND `campaign_products`.`deleted_at` IS NULL"
+
+	t.Run("when products read within a transaction without locking them", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect) + "$").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_product_id", "campaign_id", "product_id"}).AddRow(5, 1, 501))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(campaignProductService.db)
+		var products []entities.CampaignProduct
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			products, err = campaignProductService.GetByCampaignId(ctx, valueobjects.CampaignID(1))
+			return err
+		})
+		if err != nil || len(products) != 1 {
+			t.Errorf("unexpected result : got - %v, %v", products, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when products read without a transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect) + "$").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_product_id", "campaign_id", "product_id"}).AddRow(5, 1, 501))
+
+		products, err := campaignProductService.GetByCampaignId(context.TODO(), valueobjects.CampaignID(1))
+		if err != nil || len(products) != 1 {
+			t.Errorf("unexpected result : got - %v, %v", products, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignProductService_LockByCampaignId(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `campaign_products` WHERE campaign_id = ? AND `campaign_products`.`deleted_at` IS NULL FOR UPDATE"
+
+	t.Run("when products locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_product_id", "campaign_id", "product_id"}).AddRow(5, 1, 501))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(campaignProductService.db)
+		var products []entities.CampaignProduct
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			products, err = campaignProductService.LockByCampaignId(ctx, valueobjects.CampaignID(1))
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(products) != 1 || products[0].ProductID != 501 {
+			t.Errorf("unexpected products : got - %v", products)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when products locked without a transaction", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+
+		_, err := campaignProductService.LockByCampaignId(context.TODO(), valueobjects.CampaignID(1))
+		if !errors.Is(err, valueobjects.ErrProductCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductCantGet)
+		}
+	})
+}
+
+func TestCampaignProductService_Delete(t *testing.T) {
+	const sqlUpdate = "UPDATE `campaign_products` SET `deleted_by`=?,`updated_at`=? WHERE (campaign_product_id = ? and campaign_id = ?) AND `campaign_products`.`deleted_at` IS NULL"
+	const sqlDelete = "UPDATE `campaign_products` SET `deleted_at`=? WHERE (campaign_product_id = ? and campaign_id = ?) AND `campaign_products`.`deleted_at` IS NULL"
+
+	t.Run("when campaign product deleted successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs(7, sqlmock.AnyArg(), 5, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDelete)).WithArgs(sqlmock.AnyArg(), 5, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := campaignProductService.Delete(context.TODO(), valueobjects.CampaignID(1), valueobjects.CampaignProductID(5), 7)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaign product delete fails", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignProductService := NewCampaignProductService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDelete)).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := campaignProductService.Delete(context.TODO(), valueobjects.CampaignID(1), valueobjects.CampaignProductID(5), 7)
+		if !errors.Is(err, valueobjects.ErrProductCantDelete) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductCantDelete)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CampaignStatusHistoryService struct {
+	db *gorm.DB
+}
+
+type CampaignStatusHistoryEntry struct {
+	ID            int64     `gorm:"primary_key;autoIncrement;column:status_history_id"`
+	CampaignID    int64     `gorm:"column:campaign_id;index"`
+	OldStatusCode int64     `gorm:"column:old_status_code"`
+	NewStatusCode int64     `gorm:"column:new_status_code"`
+	Actor         string    `gorm:"column:actor;type:varchar(100)"`
+	ChangedAt     time.Time `gorm:"column:changed_at;type:datetime"`
+}
+
+// CampaignStatusHistoryRow is a history entry joined with the status values of campaign_codes
+type CampaignStatusHistoryRow struct {
+	CampaignStatusHistoryEntry
+	OldStatusValue string `gorm:"column:old_status_value"`
+	NewStatusValue string `gorm:"column:new_status_value"`
+}
+
+func NewCampaignStatusHistoryService(db *gorm.DB) *CampaignStatusHistoryService {
+	return &CampaignStatusHistoryService{db: db}
+}
+
+func (c *CampaignStatusHistoryEntry) TableName() string {
+	return "campaign_status_history"
+}
+
+func (c *CampaignStatusHistoryService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignStatusHistoryEntry{})
+	return err
+}
+
+func (c *CampaignStatusHistoryService) CreateMultiple(ctx context.Context, history []entities.CampaignStatusHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entries := []CampaignStatusHistoryEntry{}
+	for i := range history {
+		entries = append(entries, c.ToEntry(history[i]))
+	}
+	err := db.Create(&entries).Error
+	if err != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrStatusHistoryCantCreate, err)
+	}
+	logger.Infof("recorded %d campaign status changes", len(entries))
+	return nil
+}
+
+func (c *CampaignStatusHistoryService) GetList(ctx context.Context, campaignID valueobjects.CampaignID) ([]entities.CampaignStatusHistory, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var rows []CampaignStatusHistoryRow
+	err := db.Table("campaign_status_history h").
+		Select("h.*, old_codes.status_value AS old_status_value, new_codes.status_value AS new_status_value").
+		Joins("LEFT JOIN campaign_codes old_codes ON old_codes.status_code = h.old_status_code").
+		Joins("LEFT JOIN campaign_codes new_codes ON new_codes.status_code = h.new_status_code").
+		Where("h.campaign_id = ?", campaignID.ToInt64()).
+		Order("h.changed_at asc, h.status_history_id asc").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrStatusHistoryCantGet, err)
+	}
+	history := []entities.CampaignStatusHistory{}
+	for _, row := range rows {
+		entity := c.ToEntity(row.CampaignStatusHistoryEntry)
+		entity.OldStatusValue = row.OldStatusValue
+		entity.NewStatusValue = row.NewStatusValue
+		history = append(history, entity)
+	}
+	return history, nil
+}
+
+func (c *CampaignStatusHistoryService) ToEntry(history entities.CampaignStatusHistory) CampaignStatusHistoryEntry {
+	return CampaignStatusHistoryEntry{
+		ID:            history.ID.ToInt64(),
+		CampaignID:    history.CampaignID.ToInt64(),
+		OldStatusCode: history.OldStatusCode,
+		NewStatusCode: history.NewStatusCode,
+		Actor:         history.Actor,
+		ChangedAt:     history.ChangedAt,
+	}
+}
+
+func (c *CampaignStatusHistoryService) ToEntity(entry CampaignStatusHistoryEntry) entities.CampaignStatusHistory {
+	return entities.CampaignStatusHistory{
+		ID:            valueobjects.StatusHistoryID(entry.ID),
+		CampaignID:    valueobjects.CampaignID(entry.CampaignID),
+		OldStatusCode: entry.OldStatusCode,
+		NewStatusCode: entry.NewStatusCode,
+		Actor:         entry.Actor,
+		ChangedAt:     entry.ChangedAt.UTC(),
+	}
+}
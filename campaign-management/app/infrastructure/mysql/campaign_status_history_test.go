@@ -0,0 +1,114 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCampaignStatusHistoryService_CreateMultiple(t *testing.T) {
+	changedAt := time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC)
+	history := []entities.CampaignStatusHistory{
+		{
+			CampaignID:    valueobjects.CampaignID(42),
+			OldStatusCode: 3,
+			NewStatusCode: 2,
+			Actor:         valueobjects.StatusChangeActorScheduler,
+			ChangedAt:     changedAt,
+		},
+	}
+
+	t.Run("when status changes recorded successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		statusHistoryService := NewCampaignStatusHistoryService(db)
+		const sqlInsert = "INSERT INTO `campaign_status_history` (`campaign_id`,`old_status_code`,`new_status_code`,`actor`,`changed_at`) VALUES (?,?,?,?,?)"
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).WithArgs(42, 3, 2, "scheduler", changedAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := statusHistoryService.CreateMultiple(context.TODO(), history); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when error occured while recording status changes", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		statusHistoryService := NewCampaignStatusHistoryService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `campaign_status_history`").WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := statusHistoryService.CreateMultiple(context.TODO(), history)
+		if !errors.Is(err, valueobjects.ErrStatusHistoryCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusHistoryCantCreate)
+		}
+	})
+
+	t.Run("when there is nothing to record", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		statusHistoryService := NewCampaignStatusHistoryService(db)
+		if err := statusHistoryService.CreateMultiple(context.TODO(), nil); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignStatusHistoryService_GetList(t *testing.T) {
+	changedAt := time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC)
+	const sqlSelect = "SELECT h.*, old_codes.status_value AS old_status_value, new_codes.status_value AS new_status_value " +
+		"FROM campaign_status_history h " +
+		"LEFT JOIN campaign_codes old_codes ON old_codes.status_code = h.old_status_code " +
+		"LEFT JOIN campaign_codes new_codes ON new_codes.status_code = h.new_status_code " +
+		"WHERE h.campaign_id = ? ORDER BY h.changed_at asc, h.status_history_id asc"
+
+	t.Run("when status history fetched successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		statusHistoryService := NewCampaignStatusHistoryService(db)
+		rows := sqlmock.NewRows([]string{"status_history_id", "campaign_id", "old_status_code", "new_status_code",
+			"actor", "changed_at", "old_status_value", "new_status_value"}).
+			AddRow(1, 42, 3, 2, "scheduler", changedAt, "Scheduled", "Active")
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(42).WillReturnRows(rows)
+
+		history, err := statusHistoryService.GetList(context.TODO(), valueobjects.CampaignID(42))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := entities.CampaignStatusHistory{
+			ID:             valueobjects.StatusHistoryID(1),
+			CampaignID:     valueobjects.CampaignID(42),
+			OldStatusCode:  3,
+			OldStatusValue: "Scheduled",
+			NewStatusCode:  2,
+			NewStatusValue: "Active",
+			Actor:          "scheduler",
+			ChangedAt:      changedAt,
+		}
+		if len(history) != 1 || history[0] != expected {
+			t.Errorf("unexpected value : got - %+v ; want - %+v", history, expected)
+		}
+	})
+
+	t.Run("when error occured while getting status history", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		statusHistoryService := NewCampaignStatusHistoryService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(42).WillReturnError(errors.New("db error"))
+
+		_, err := statusHistoryService.GetList(context.TODO(), valueobjects.CampaignID(42))
+		if !errors.Is(err, valueobjects.ErrStatusHistoryCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusHistoryCantGet)
+		}
+	})
+}
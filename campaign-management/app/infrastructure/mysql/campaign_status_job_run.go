@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CampaignStatusJobRunService struct {
+	db *gorm.DB
+}
+
+type CampaignStatusJobRunEntry struct {
+	ID          int64     `gorm:"primary_key;autoIncrement;column:job_run_id"`
+	Instance    string    `gorm:"column:instance;type:varchar(255)"`
+	StartedAt   time.Time `gorm:"column:started_at;type:datetime"`
+	EndedAt     time.Time `gorm:"column:ended_at;type:datetime"`
+	Published   int64     `gorm:"column:published_count"`
+	Deactivated int64     `gorm:"column:deactivated_count"`
+	Error       string    `gorm:"column:error;type:text"`
+}
+
+func NewCampaignStatusJobRunService(db *gorm.DB) *CampaignStatusJobRunService {
+	return &CampaignStatusJobRunService{db: db}
+}
+
+func (c *CampaignStatusJobRunEntry) TableName() string {
+	return "campaign_status_job_runs"
+}
+
+func (c *CampaignStatusJobRunService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CampaignStatusJobRunEntry{})
+	return err
+}
+
+func (c *CampaignStatusJobRunService) Create(ctx context.Context, run entities.CampaignStatusJobRun) (entities.CampaignStatusJobRun, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+
+	entry := c.ToEntry(run)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.CampaignStatusJobRun{}, fmt.Errorf("%w: %v", valueobjects.ErrStatusJobRunCantCreate, err)
+	}
+	logger.Infof("campaign status job run recorded with id : %v", entry.ID)
+	return c.ToEntity(entry), nil
+}
+
+func (c *CampaignStatusJobRunService) ToEntry(run entities.CampaignStatusJobRun) CampaignStatusJobRunEntry {
+	return CampaignStatusJobRunEntry{
+		ID:          run.ID.ToInt64(),
+		Instance:    run.Instance,
+		StartedAt:   run.StartedAt,
+		EndedAt:     run.EndedAt,
+		Published:   run.Published,
+		Deactivated: run.Deactivated,
+		Error:       run.Error,
+	}
+}
+
+func (c *CampaignStatusJobRunService) ToEntity(entry CampaignStatusJobRunEntry) entities.CampaignStatusJobRun {
+	return entities.CampaignStatusJobRun{
+		ID:          valueobjects.StatusJobRunID(entry.ID),
+		Instance:    entry.Instance,
+		StartedAt:   entry.StartedAt,
+		EndedAt:     entry.EndedAt,
+		Published:   entry.Published,
+		Deactivated: entry.Deactivated,
+		Error:       entry.Error,
+	}
+}
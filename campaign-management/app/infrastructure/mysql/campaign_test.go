@@ -1,338 +1,965 @@
-package mysql
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/util"
-	"context"
-	"database/sql"
-	"regexp"
-	"testing"
-	"time"
-
-	. "github.com/smartystreets/goconvey/convey"
-	"gorm.io/driver/mysql"
-
-	"github.com/DATA-DOG/go-sqlmock"
-	"gorm.io/gorm"
-)
-
-func TestCampaignService_GetCampaignsCount(t *testing.T) {
-	t.Run("when campaigns count got successfully", func(t *testing.T) {
-		db, _, _ := sqlmock.New()
-		gdb, _ := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		campaignService := NewCampaignService(gdb)
-		list, count, err := campaignService.GetList(context.TODO(), entities.PaginationConfig{Limit: 20})
-		campaignService.GetList(context.TODO(), entities.PaginationConfig{Limit: 20, Status: 2})
-		campaignService.GetCampaignsCount()
-		ShouldBeNil(err)
-		ShouldNotBeNil(count)
-		ShouldBeEmpty(list)
-	})
-}
-func TestCampaignService_ToEntity(t *testing.T) {
-	dateStr := time.Now().Format("2006-01-02 15:04:05")
-	dateTime, _ := util.ToDateTime(dateStr)
-	sqlDateTime := sql.NullTime{
-		Time:  dateTime,
-		Valid: true,
-	}
-	sqlOfferID := sql.NullInt64{
-		Int64: 123,
-		Valid: true,
-	}
-	sqlTagID := sql.NullInt64{
-		Int64: 456,
-		Valid: true,
-	}
-	sqlLeadTime := sql.NullInt32{
-		Int32: 11,
-		Valid: true,
-	}
-	t.Run("test conversion", func(t *testing.T) {
-		db, _, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		campaignService := NewCampaignService(gdb)
-		isCampaignPublished := false
-
-		entry := CampaignEntry{
-			ID:                  1,
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      sqlDateTime,
-			OrderEndDate:        sqlDateTime,
-			CollectionStartDate: sqlDateTime,
-			CollectionEndDate:   sqlDateTime,
-			OfferID:             sqlOfferID,
-			TagID:               sqlTagID,
-			LeadTime:            sqlLeadTime,
-			IsCampaignPublished: &isCampaignPublished,
-		}
-		expectedResponse := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      dateTime,
-			OrderEndDate:        dateTime,
-			CollectionStartDate: dateTime,
-			CollectionEndDate:   dateTime,
-			OfferID:             int64(123),
-			TagID:               int64(456),
-			IsCampaignPublished: isCampaignPublished,
-		}
-		response := campaignService.ToEntity(entry)
-		ShouldEqual(response, expectedResponse)
-	})
-
-	t.Run("when isCampaignPublished is nil", func(t *testing.T) {
-		db, _, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		campaignService := NewCampaignService(gdb)
-
-		entry := CampaignEntry{
-			ID:                  1,
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      sqlDateTime,
-			OrderEndDate:        sqlDateTime,
-			CollectionStartDate: sqlDateTime,
-			CollectionEndDate:   sqlDateTime,
-			OfferID:             sqlOfferID,
-			TagID:               sqlTagID,
-		}
-		expectedResponse := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      dateTime,
-			OrderEndDate:        dateTime,
-			CollectionStartDate: dateTime,
-			CollectionEndDate:   dateTime,
-			OfferID:             int64(123),
-			TagID:               int64(456),
-			IsCampaignPublished: false,
-		}
-		response := campaignService.ToEntity(entry)
-		ShouldEqual(response, expectedResponse)
-	})
-}
-
-func TestCampaignService_ToEntry(t *testing.T) {
-	dateStr := time.Now().Format("2006-01-02 15:04:05")
-	dateTime, _ := util.ToDateTime(dateStr)
-	sqlDateTime := sql.NullTime{
-		Time:  dateTime,
-		Valid: true,
-	}
-	sqlOfferID := sql.NullInt64{
-		Int64: 123,
-		Valid: true,
-	}
-	sqlTagID := sql.NullInt64{
-		Int64: 456,
-		Valid: true,
-	}
-	sqlLeadTime := sql.NullInt32{
-		Int32: 11,
-		Valid: true,
-	}
-	t.Run("test conversion", func(t *testing.T) {
-		db, _, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		campaignService := NewCampaignService(gdb)
-		isCampaignPublished := false
-
-		entity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      dateTime,
-			OrderEndDate:        dateTime,
-			CollectionStartDate: dateTime,
-			CollectionEndDate:   dateTime,
-			OfferID:             int64(123),
-			TagID:               int64(456),
-			LeadTime:            11,
-			IsCampaignPublished: isCampaignPublished,
-		}
-		expectedResponse := CampaignEntry{
-			ID:                  1,
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      sqlDateTime,
-			OrderEndDate:        sqlDateTime,
-			CollectionStartDate: sqlDateTime,
-			CollectionEndDate:   sqlDateTime,
-			OfferID:             sqlOfferID,
-			TagID:               sqlTagID,
-			LeadTime:            sqlLeadTime,
-			IsCampaignPublished: &isCampaignPublished,
-		}
-		response := campaignService.ToEntry(entity)
-		ShouldEqual(response, expectedResponse)
-	})
-}
-
-func TestCampaignService_publishCampaign(t *testing.T) {
-	t.Run("success scenario", func(t *testing.T) {
-		db, _, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		err = publishCampaigns(gdb)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected value : got - %v ; want - nil", err)
-		}
-	})
-}
-
-func TestCampaignService_deactivateCampaign(t *testing.T) {
-	t.Run("success scenario", func(t *testing.T) {
-		db, _, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		err = deactivateCampaigns(gdb)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected value : got - %v ; want - nil", err)
-		}
-	})
-}
-
-func TestCampaignService_UpdateStatus(t *testing.T) {
-	t.Run("when campaigns updated successfully", func(t *testing.T) {
-		db, mock, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		campaignService := NewCampaignService(gdb)
-
-		year, month, day := time.Now().Date()
-		today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02 15:04:05")
-
-		const sqlUpdatetoActive = `UPDATE "campaigns" SET "status_code" = 2 WHERE CAST(order_start_date AS DATE)  <= ? and status_code = 3`
-		mock.ExpectExec(regexp.QuoteMeta(sqlUpdatetoActive)).WithArgs(today).WillReturnResult(sqlmock.NewResult(0, 1))
-
-		const sqlUpdatetoInActive = `UPDATE "campaigns" SET "status_code" = 2 WHERE CAST(order_end_date AS DATE) < ? and status_code = 2`
-		mock.ExpectExec(regexp.QuoteMeta(sqlUpdatetoInActive)).WithArgs(today).WillReturnResult(sqlmock.NewResult(0, 1))
-
-		err = campaignService.UpdateStatus(context.TODO())
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-}
-
-func TestCampaignService_Update(t *testing.T) {
-	t.Run("when campaign with given id updated successfully", func(t *testing.T) {
-		db, mock, err := sqlmock.New()
-		ShouldBeNil(err)
-
-		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
-		ShouldBeNil(err)
-
-		campaignService := NewCampaignService(gdb)
-
-		dateStr := time.Now().Format("2006-01-02 15:04:05")
-		dateTime, _ := util.ToDateTime(dateStr)
-
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "test_campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      dateTime,
-			OrderEndDate:        dateTime,
-			CollectionStartDate: dateTime,
-			CollectionEndDate:   dateTime,
-			OfferID:             int64(123),
-			TagID:               int64(456),
-			UpdatedBy:           int64(12121212),
-		}
-
-		const sqlUpdate = `UPDATE "campaigns" SET "status_code" = 2 WHERE campaign_id= ?`
-		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
-
-		err = campaignService.Update(context.TODO(), campaignEntity)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/util"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/mysql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+func TestCampaignService_GetCampaignsCount(t *testing.T) {
+	t.Run("when campaigns count got successfully", func(t *testing.T) {
+		db, _, _ := sqlmock.New()
+		gdb, _ := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		campaignService := NewCampaignService(gdb, "")
+		list, count, err := campaignService.GetList(context.TODO(), entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20}})
+		campaignService.GetList(context.TODO(), entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Status: 2}})
+		campaignService.GetCampaignsCount()
+		ShouldBeNil(err)
+		ShouldNotBeNil(count)
+		ShouldBeEmpty(list)
+	})
+}
+func TestCampaignService_ToEntity(t *testing.T) {
+	dateStr := time.Now().Format("2006-01-02 15:04:05")
+	dateTime, _ := util.ToDateTime(dateStr, time.UTC)
+	sqlDateTime := sql.NullTime{
+		Time:  dateTime,
+		Valid: true,
+	}
+	sqlOfferID := sql.NullInt64{
+		Int64: 123,
+		Valid: true,
+	}
+	sqlTagID := sql.NullInt64{
+		Int64: 456,
+		Valid: true,
+	}
+	sqlLeadTime := sql.NullInt32{
+		Int32: 11,
+		Valid: true,
+	}
+	t.Run("test conversion", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+		isCampaignPublished := false
+
+		entry := CampaignEntry{
+			ID:                  1,
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      sqlDateTime,
+			OrderEndDate:        sqlDateTime,
+			CollectionStartDate: sqlDateTime,
+			CollectionEndDate:   sqlDateTime,
+			OfferID:             sqlOfferID,
+			TagID:               sqlTagID,
+			LeadTime:            sqlLeadTime,
+			IsCampaignPublished: &isCampaignPublished,
+		}
+		expectedResponse := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      dateTime,
+			OrderEndDate:        dateTime,
+			CollectionStartDate: dateTime,
+			CollectionEndDate:   dateTime,
+			OfferID:             int64(123),
+			TagID:               int64(456),
+			IsCampaignPublished: isCampaignPublished,
+		}
+		response := campaignService.ToEntity(entry)
+		ShouldEqual(response, expectedResponse)
+	})
+
+	t.Run("when isCampaignPublished is nil", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+
+		entry := CampaignEntry{
+			ID:                  1,
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      sqlDateTime,
+			OrderEndDate:        sqlDateTime,
+			CollectionStartDate: sqlDateTime,
+			CollectionEndDate:   sqlDateTime,
+			OfferID:             sqlOfferID,
+			TagID:               sqlTagID,
+		}
+		expectedResponse := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      dateTime,
+			OrderEndDate:        dateTime,
+			CollectionStartDate: dateTime,
+			CollectionEndDate:   dateTime,
+			OfferID:             int64(123),
+			TagID:               int64(456),
+			IsCampaignPublished: false,
+		}
+		response := campaignService.ToEntity(entry)
+		ShouldEqual(response, expectedResponse)
+	})
+
+	t.Run("campaign stored without timezone is in the default timezone", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		campaignService := NewCampaignService(db, "Asia/Singapore")
+		if timezone := campaignService.ToEntity(CampaignEntry{ID: 1}).Timezone; timezone != "Asia/Singapore" {
+			t.Errorf("unexpected timezone : got - %q ; want - Asia/Singapore", timezone)
+		}
+		if timezone := campaignService.ToEntity(CampaignEntry{ID: 1, Timezone: "UTC"}).Timezone; timezone != "UTC" {
+			t.Errorf("unexpected timezone : got - %q ; want - UTC", timezone)
+		}
+	})
+}
+
+func TestCampaignService_Migrate(t *testing.T) {
+	t.Run("campaigns stored without timezone are backfilled with the default timezone", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "Asia/Singapore")
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE `campaigns` SET `timezone`=? WHERE timezone = '' OR timezone IS NULL")).
+			WithArgs("Asia/Singapore").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+		if err := campaignService.backfillTimezone(); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestCampaignService_ToEntry(t *testing.T) {
+	dateStr := time.Now().Format("2006-01-02 15:04:05")
+	dateTime, _ := util.ToDateTime(dateStr, time.UTC)
+	sqlDateTime := sql.NullTime{
+		Time:  dateTime,
+		Valid: true,
+	}
+	sqlOfferID := sql.NullInt64{
+		Int64: 123,
+		Valid: true,
+	}
+	sqlTagID := sql.NullInt64{
+		Int64: 456,
+		Valid: true,
+	}
+	sqlLeadTime := sql.NullInt32{
+		Int32: 11,
+		Valid: true,
+	}
+	t.Run("test conversion", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+		isCampaignPublished := false
+
+		entity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      dateTime,
+			OrderEndDate:        dateTime,
+			CollectionStartDate: dateTime,
+			CollectionEndDate:   dateTime,
+			OfferID:             int64(123),
+			TagID:               int64(456),
+			LeadTime:            11,
+			IsCampaignPublished: isCampaignPublished,
+		}
+		expectedResponse := CampaignEntry{
+			ID:                  1,
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      sqlDateTime,
+			OrderEndDate:        sqlDateTime,
+			CollectionStartDate: sqlDateTime,
+			CollectionEndDate:   sqlDateTime,
+			OfferID:             sqlOfferID,
+			TagID:               sqlTagID,
+			LeadTime:            sqlLeadTime,
+			IsCampaignPublished: &isCampaignPublished,
+		}
+		response := campaignService.ToEntry(entity)
+		ShouldEqual(response, expectedResponse)
+	})
+}
+
+func TestCampaignService_publishCampaign(t *testing.T) {
+	t.Run("success scenario", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		_, err = publishCampaigns(gdb, valueobjects.CampaignStatusPublish, time.Now())
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected value : got - %v ; want - nil", err)
+		}
+	})
+}
+
+func TestCampaignService_deactivateCampaign(t *testing.T) {
+	t.Run("success scenario", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		_, err = deactivateCampaigns(gdb, valueobjects.CampaignStatusDeactivate, time.Now())
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected value : got - %v ; want - nil", err)
+		}
+	})
+}
+
+func TestCampaignService_PublishCampaigns(t *testing.T) {
+	t.Run("when scheduled campaigns published successfully", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db, SkipInitializeWithVersion: true}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+
+		now := time.Date(2023, time.March, 1, 4, 30, 0, 0, time.UTC)
+
+		const sqlSelectScheduled = "SELECT `campaign_id` FROM `campaigns` WHERE (order_start_date <= ? and status_code = ?) AND `campaigns`.`deleted_at` IS NULL FOR UPDATE"
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelectScheduled)).WithArgs(now, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id"}).AddRow(11).AddRow(12))
+		const sqlUpdatetoActive = "UPDATE `campaigns` SET `status_code`=?,`updated_at`=? WHERE (campaign_id IN (?,?) and status_code = ?) AND `campaigns`.`deleted_at` IS NULL"
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdatetoActive)).WithArgs(2, sqlmock.AnyArg(), 11, 12, 3).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		ids, err := campaignService.PublishCampaigns(context.TODO(), valueobjects.CampaignStatusPublish, now)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+		if !reflect.DeepEqual(ids, []valueobjects.CampaignID{11, 12}) {
+			t.Errorf("unexpected value : got - %v ; want - %v", ids, []valueobjects.CampaignID{11, 12})
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_DeactivateCampaigns(t *testing.T) {
+	t.Run("when active campaigns deactivated successfully", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db, SkipInitializeWithVersion: true}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+
+		now := time.Date(2023, time.March, 1, 4, 30, 0, 0, time.UTC)
+
+		const sqlSelectActive = "SELECT `campaign_id` FROM `campaigns` WHERE (order_end_date < ? and status_code = ?) AND `campaigns`.`deleted_at` IS NULL FOR UPDATE"
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelectActive)).WithArgs(now, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id"}).AddRow(13))
+		const sqlUpdatetoInActive = "UPDATE `campaigns` SET `status_code`=?,`updated_at`=? WHERE (campaign_id IN (?) and status_code = ?) AND `campaigns`.`deleted_at` IS NULL"
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdatetoInActive)).WithArgs(1, sqlmock.AnyArg(), 13, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ids, err := campaignService.DeactivateCampaigns(context.TODO(), valueobjects.CampaignStatusDeactivate, now)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+		if !reflect.DeepEqual(ids, []valueobjects.CampaignID{13}) {
+			t.Errorf("unexpected value : got - %v ; want - %v", ids, []valueobjects.CampaignID{13})
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_Update(t *testing.T) {
+	t.Run("when campaign with given id updated successfully", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		ShouldBeNil(err)
+
+		gdb, err := gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{})
+		ShouldBeNil(err)
+
+		campaignService := NewCampaignService(gdb, "")
+
+		dateStr := time.Now().Format("2006-01-02 15:04:05")
+		dateTime, _ := util.ToDateTime(dateStr, time.UTC)
+
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "test_campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      dateTime,
+			OrderEndDate:        dateTime,
+			CollectionStartDate: dateTime,
+			CollectionEndDate:   dateTime,
+			OfferID:             int64(123),
+			TagID:               int64(456),
+			UpdatedBy:           int64(12121212),
+		}
+
+		const sqlUpdate = `UPDATE "campaigns" SET "status_code" = 2 WHERE campaign_id= ?`
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = campaignService.Update(context.TODO(), campaignEntity)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+}
+
+func TestCampaignService_Lock(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `campaigns` WHERE campaign_id = ? AND `campaigns`.`deleted_at` IS NULL ORDER BY `campaigns`.`campaign_id` LIMIT 1 FOR UPDATE"
+
+	t.Run("when campaign locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "status_code"}).AddRow(1, 2))
+		mock.ExpectCommit()
+
+		var campaign entities.Campaign
+		err := NewTransactionService(db).RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			campaign, err = campaignService.Lock(ctx, valueobjects.CampaignID(1))
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if campaign.ID != 1 || campaign.StatusCode != 2 {
+			t.Errorf("unexpected campaign : got - %+v", campaign)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaign not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(9).WillReturnRows(sqlmock.NewRows([]string{"campaign_id"}))
+		mock.ExpectRollback()
+
+		err := NewTransactionService(db).RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			_, err := campaignService.Lock(ctx, valueobjects.CampaignID(9))
+			return err
+		})
+		if !errors.Is(err, valueobjects.ErrCampaignNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignNotExists)
+		}
+	})
+
+	t.Run("when campaign locked without a transaction", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		campaignService := NewCampaignService(db, "UTC")
+
+		_, err := campaignService.Lock(context.TODO(), valueobjects.CampaignID(1))
+		if !errors.Is(err, valueobjects.ErrCampaignCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCantGet)
+		}
+	})
+}
+
+func TestCampaignService_Delete(t *testing.T) {
+	const sqlDeleteCampaign = "UPDATE `campaigns` SET `deleted_at`=?,`deleted_by`=?,`updated_at`=? WHERE campaign_id = ? AND `campaigns`.`deleted_at` IS NULL"
+	const sqlDeleteStores = "UPDATE `campaign_stores` SET `deleted_at`=?,`deleted_by`=?,`updated_at`=? WHERE campaign_id = ? AND `campaign_stores`.`deleted_at` IS NULL"
+	const sqlDeleteProducts = "UPDATE `campaign_products` SET `deleted_at`=?,`deleted_by`=?,`updated_at`=? WHERE campaign_id = ? AND `campaign_products`.`deleted_at` IS NULL"
+
+	t.Run("when campaign deleted along with stores and products", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteCampaign)).WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteStores)).WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteProducts)).WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		if err := campaignService.Delete(context.TODO(), valueobjects.CampaignID(1), 7); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaign not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteCampaign)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := campaignService.Delete(context.TODO(), valueobjects.CampaignID(1), 7)
+		if !errors.Is(err, valueobjects.ErrCampaignNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignNotExists)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when error occured while deleting products, everything is rolled back", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteCampaign)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteStores)).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteProducts)).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := campaignService.Delete(context.TODO(), valueobjects.CampaignID(1), 7)
+		if !errors.Is(err, valueobjects.ErrProductCantDelete) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductCantDelete)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_Restore(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `campaigns` WHERE campaign_id = ? ORDER BY `campaigns`.`campaign_id` LIMIT 1"
+	const sqlTitleExists = "SELECT count(*) > 0 FROM `campaigns` WHERE (campaign_id != ? and title = ?) AND `campaigns`.`deleted_at` IS NULL"
+	const sqlRestoreStores = "UPDATE `campaign_stores` SET `deleted_at`=?,`deleted_by`=?,`updated_by`=?,`updated_at`=? WHERE campaign_id = ? and deleted_at = ?"
+	const sqlRestoreProducts = "UPDATE `campaign_products` SET `deleted_at`=?,`deleted_by`=?,`updated_by`=?,`updated_at`=? WHERE campaign_id = ? and deleted_at = ?"
+	const sqlRestoreCampaign = "UPDATE `campaigns` SET `deleted_at`=?,`deleted_by`=?,`updated_by`=?,`updated_at`=? WHERE campaign_id = ?"
+	deletedAt := time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC)
+
+	t.Run("when campaign restored along with stores and products deleted with it", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title", "deleted_at"}).AddRow(1, "campaign", deletedAt))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlTitleExists)).WithArgs(1, "campaign").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectExec(regexp.QuoteMeta(sqlRestoreStores)).WithArgs(nil, 0, 7, sqlmock.AnyArg(), 1, deletedAt).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(sqlRestoreProducts)).WithArgs(nil, 0, 7, sqlmock.AnyArg(), 1, deletedAt).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(sqlRestoreCampaign)).WithArgs(nil, 0, 7, sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if err := campaignService.Restore(context.TODO(), valueobjects.CampaignID(1), 7); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaign is not deleted", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title", "deleted_at"}).AddRow(1, "campaign", nil))
+		mock.ExpectRollback()
+
+		err := campaignService.Restore(context.TODO(), valueobjects.CampaignID(1), 7)
+		if !errors.Is(err, valueobjects.ErrCampaignNotDeleted) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignNotDeleted)
+		}
+	})
+
+	t.Run("when campaign with same title was created after the deletion", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title", "deleted_at"}).AddRow(1, "campaign", deletedAt))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlTitleExists)).WithArgs(1, "campaign").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		err := campaignService.Restore(context.TODO(), valueobjects.CampaignID(1), 7)
+		if !errors.Is(err, valueobjects.ErrCampaignTitleExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignTitleExists)
+		}
+	})
+
+	t.Run("when campaign not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id"}))
+		mock.ExpectRollback()
+
+		err := campaignService.Restore(context.TODO(), valueobjects.CampaignID(1), 7)
+		if !errors.Is(err, valueobjects.ErrCampaignNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignNotExists)
+		}
+	})
+}
+
+func TestCampaignService_GetListByStore(t *testing.T) {
+	t.Run("when campaigns of the store are filtered by status, published flag and point in time", func(t *testing.T) {
+		const sqlCount = "SELECT count(*) FROM `campaigns` WHERE campaign_id in (SELECT `campaign_id` FROM `campaign_stores` WHERE store_id = ? AND `campaign_stores`.`deleted_at` IS NULL) AND status_code = ? AND is_campaign_published = ? AND (order_start_date <= ? AND collection_end_date >= ?) AND `campaigns`.`deleted_at` IS NULL"
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE campaign_id in (SELECT `campaign_id` FROM `campaign_stores` WHERE store_id = ? AND `campaign_stores`.`deleted_at` IS NULL) AND status_code = ? AND is_campaign_published = ? AND (order_start_date <= ? AND collection_end_date >= ?) AND `campaigns`.`deleted_at` IS NULL ORDER BY `order_start_date`,campaign_id asc LIMIT 10 OFFSET 10"
+		at := time.Date(2024, time.February, 10, 4, 0, 0, 0, time.UTC)
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WithArgs(84, 2, true, at, at).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, 2, true, at, at).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title"}).AddRow(7, "Lunar New Year"))
+
+		isPublished := true
+		campaigns, count, err := campaignService.GetListByStore(context.TODO(), 84, entities.StoreCampaignFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Offset: 10, Status: 2,
+				SortFields: []entities.SortField{{Column: "order_start_date"}}},
+			IsPublished: &isPublished,
+			At:          at.In(time.FixedZone("SGT", 8*60*60)),
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 11 || len(campaigns) != 1 || campaigns[0].ID != 7 || campaigns[0].Title != "Lunar New Year" {
+			t.Errorf("unexpected campaigns : got - %+v, %d", campaigns, count)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_GetListByProduct(t *testing.T) {
+	t.Run("when campaigns of the product are narrowed down to one sku", func(t *testing.T) {
+		const sqlCount = "SELECT count(*) FROM `campaigns` WHERE campaign_id in (SELECT `campaign_id` FROM `campaign_products` WHERE product_id = ? AND SKU_no = ? AND `campaign_products`.`deleted_at` IS NULL) AND `campaigns`.`deleted_at` IS NULL"
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE campaign_id in (SELECT `campaign_id` FROM `campaign_products` WHERE product_id = ? AND SKU_no = ? AND `campaign_products`.`deleted_at` IS NULL) AND `campaigns`.`deleted_at` IS NULL ORDER BY `collection_end_date` DESC,`title`,campaign_id asc LIMIT 10"
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WithArgs(501, 9001).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(501, 9001).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title"}).AddRow(7, "Lunar New Year"))
+
+		campaigns, count, err := campaignService.GetListByProduct(context.TODO(), 501, entities.ProductCampaignFilter{
+			StoreCampaignFilter: entities.StoreCampaignFilter{
+				PaginationConfig: entities.PaginationConfig{Limit: 10,
+					SortFields: []entities.SortField{{Column: "collection_end_date", Desc: true}, {Column: "title"}}},
+			},
+			SKUNo: 9001,
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 1 || len(campaigns) != 1 || campaigns[0].ID != 7 {
+			t.Errorf("unexpected campaigns : got - %+v, %d", campaigns, count)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_GetOverlapping(t *testing.T) {
+	t.Run("when other campaigns sell a product of the campaign at its stores in overlapping dates", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta(overlappingCampaignsQuery)).WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "product_id", "store_id"}).AddRow(3, 501, 84).AddRow(9, 501, 84))
+
+		overlaps, err := campaignService.GetOverlapping(context.TODO(), valueobjects.CampaignID(7))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := []entities.CampaignOverlap{{CampaignID: 3, ProductID: 501, StoreID: 84}, {CampaignID: 9, ProductID: 501, StoreID: 84}}
+		if !reflect.DeepEqual(overlaps, expected) {
+			t.Errorf("unexpected overlaps : got - %v ; want - %v", overlaps, expected)
+		}
+	})
+
+	t.Run("when the query fails", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta(overlappingCampaignsQuery)).WithArgs(7).WillReturnError(errors.New("db error"))
+
+		_, err := campaignService.GetOverlapping(context.TODO(), valueobjects.CampaignID(7))
+		if !errors.Is(err, valueobjects.ErrCampaignCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCantGet)
+		}
+	})
+}
+
+func TestCampaignService_GetList(t *testing.T) {
+	t.Run("when campaigns are sorted by several fields", func(t *testing.T) {
+		const sqlCount = "SELECT count(*) FROM `campaigns` WHERE title like ? AND `campaigns`.`deleted_at` IS NULL"
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE title like ? AND `campaigns`.`deleted_at` IS NULL ORDER BY `order_start_date` DESC,`title`,campaign_id asc LIMIT 10"
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WithArgs("%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs("%").
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title"}).AddRow(7, "Lunar New Year"))
+
+		campaigns, _, err := campaignService.GetList(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1,
+				SortFields: []entities.SortField{{Column: "order_start_date", Desc: true}, {Column: "title"}}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(campaigns) != 1 || campaigns[0].ID != 7 {
+			t.Errorf("unexpected campaigns : got - %+v", campaigns)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaigns are searched they are ranked by relevance ahead of the sort fields", func(t *testing.T) {
+		const sqlMatch = "MATCH (title, listing_title, listing_description, onboard_title, onboard_description) " +
+			"AGAINST (? IN NATURAL LANGUAGE MODE)"
+		const sqlWhere = "WHERE title like ? AND " + sqlMatch + " AND `campaigns`.`deleted_at` IS NULL"
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `campaigns` "+sqlWhere)).WithArgs("%", "mooncake").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT campaigns.*, "+sqlMatch+" AS relevance FROM `campaigns` "+sqlWhere+
+			" ORDER BY relevance desc,`title`,campaign_id asc LIMIT 10")).WithArgs("mooncake", "%", "mooncake").
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title"}).AddRow(7, "Mid Autumn"))
+
+		campaigns, count, err := campaignService.GetList(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1,
+				SortFields: []entities.SortField{{Column: "title"}}},
+			Query: "mooncake",
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 1 || len(campaigns) != 1 || campaigns[0].ID != 7 {
+			t.Errorf("unexpected campaigns : got - %+v, count %d", campaigns, count)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when campaigns are filtered the count is filtered the same", func(t *testing.T) {
+		const sqlWhere = "WHERE title like ? AND status_code = ? AND campaign_type = ? AND is_campaign_published = ? AND " +
+			"order_end_date >= ? AND order_start_date <= ? AND created_by = ? AND " +
+			"campaign_id in (SELECT `campaign_id` FROM `campaign_stores` WHERE store_id = ? AND `campaign_stores`.`deleted_at` IS NULL) AND " +
+			"campaign_id in (SELECT `campaign_id` FROM `campaign_products` WHERE product_id = ? AND `campaign_products`.`deleted_at` IS NULL) AND " +
+			"`campaigns`.`deleted_at` IS NULL"
+		orderFrom := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		orderTo := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+		isPublished := true
+		args := []driver.Value{"Lunar%", int64(2), "preorder", true, orderFrom, orderTo, int64(5), int64(11), int64(42)}
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `campaigns` " + sqlWhere)).WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `campaigns` " + sqlWhere + " ORDER BY campaign_id asc LIMIT 10 OFFSET 10")).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "title"}).AddRow(7, "Lunar New Year"))
+
+		campaigns, count, err := campaignService.GetList(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 2, Offset: 10, Name: "Lunar", Status: 2},
+			CampaignType:     "preorder",
+			IsPublished:      &isPublished,
+			OrderFrom:        orderFrom,
+			OrderTo:          orderTo,
+			CreatedBy:        5,
+			StoreID:          11,
+			ProductID:        42,
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 12 {
+			t.Errorf("unexpected count : got - %d ; want - 12", count)
+		}
+		if len(campaigns) != 1 || campaigns[0].ID != 7 {
+			t.Errorf("unexpected campaigns : got - %+v", campaigns)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestCampaignService_GetListByCursor(t *testing.T) {
+	sortFields := []entities.SortField{{Column: "order_start_date", Desc: true}}
+	orderStartDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"campaign_id", "title", "order_start_date"}
+
+	t.Run("when the first page is got the next page has a cursor and the previous has none", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE title like ? AND `campaigns`.`deleted_at` IS NULL " +
+			"ORDER BY `order_start_date` DESC,`campaign_id` LIMIT 3"
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `campaigns`")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs("%").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(9, "Chinese New Year", orderStartDate).
+				AddRow(8, "Lunar New Year", orderStartDate).
+				AddRow(3, "Christmas", orderStartDate.AddDate(0, 0, -7)))
+
+		campaigns, cursors, count, err := campaignService.GetListByCursor(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2, SortFields: sortFields},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 5 || len(campaigns) != 2 || campaigns[1].ID != 8 {
+			t.Errorf("unexpected campaigns : got - %+v, count %d", campaigns, count)
+		}
+		if cursors.Next != encodeCampaignCursor(append(sortFields, entities.SortField{Column: "campaign_id"}),
+			CampaignEntry{ID: 8, OrderStartDate: sql.NullTime{Time: orderStartDate, Valid: true}}, false) || cursors.Prev != "" {
+			t.Errorf("unexpected cursors : got - %+v", cursors)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when the next page is got it starts after the campaign of the cursor", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE (((order_start_date < ? OR order_start_date IS NULL)) OR " +
+			"(order_start_date <=> ? AND campaign_id > ?)) AND title like ? AND `campaigns`.`deleted_at` IS NULL " +
+			"ORDER BY `order_start_date` DESC,`campaign_id` LIMIT 3"
+		cursor := encodeCampaignCursor(append(sortFields, entities.SortField{Column: "campaign_id"}),
+			CampaignEntry{ID: 8, OrderStartDate: sql.NullTime{Time: orderStartDate, Valid: true}}, false)
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `campaigns`")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(orderStartDate, orderStartDate, int64(8), "%").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "Christmas", orderStartDate.AddDate(0, 0, -7)))
+
+		campaigns, cursors, _, err := campaignService.GetListByCursor(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2, SortFields: sortFields},
+			Cursor:           cursor,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(campaigns) != 1 || campaigns[0].ID != 3 {
+			t.Errorf("unexpected campaigns : got - %+v", campaigns)
+		}
+		if cursors.Next != "" || cursors.Prev == "" {
+			t.Errorf("unexpected cursors : got - %+v", cursors)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when the previous page is got it ends before the campaign of the cursor in the list order", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `campaigns` WHERE ((order_start_date > ?) OR " +
+			"(order_start_date <=> ? AND (campaign_id < ? OR campaign_id IS NULL))) AND title like ? AND " +
+			"`campaigns`.`deleted_at` IS NULL ORDER BY `order_start_date`,`campaign_id` DESC LIMIT 3"
+		christmas := orderStartDate.AddDate(0, 0, -7)
+		cursor := encodeCampaignCursor(append(sortFields, entities.SortField{Column: "campaign_id"}),
+			CampaignEntry{ID: 3, OrderStartDate: sql.NullTime{Time: christmas, Valid: true}}, true)
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `campaigns`")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(christmas, christmas, int64(3), "%").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(8, "Lunar New Year", orderStartDate).
+				AddRow(9, "Chinese New Year", orderStartDate))
+
+		campaigns, cursors, _, err := campaignService.GetListByCursor(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2, SortFields: sortFields},
+			Cursor:           cursor,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(campaigns) != 2 || campaigns[0].ID != 9 || campaigns[1].ID != 8 {
+			t.Errorf("unexpected campaigns : got - %+v", campaigns)
+		}
+		if cursors.Next == "" || cursors.Prev != "" {
+			t.Errorf("unexpected cursors : got - %+v", cursors)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when the cursor was got under another sort it is invalid", func(t *testing.T) {
+		cursor := encodeCampaignCursor([]entities.SortField{{Column: "campaign_id"}}, CampaignEntry{ID: 8}, false)
+		db, _ := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+
+		_, _, _, err := campaignService.GetListByCursor(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2, SortFields: sortFields},
+			Cursor:           cursor,
+		})
+		if !errors.Is(err, valueobjects.ErrCampaignCursorInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCursorInvalid)
+		}
+	})
+
+	t.Run("when the cursor is not one the list returned it is invalid", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+
+		_, _, _, err := campaignService.GetListByCursor(context.TODO(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2},
+			Cursor:           "not a cursor",
+		})
+		if !errors.Is(err, valueobjects.ErrCampaignCursorInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCursorInvalid)
+		}
+	})
+}
+
+func TestCampaignService_GetSummary(t *testing.T) {
+	t.Run("when campaigns are summarised the aggregates honour the filter", func(t *testing.T) {
+		const sqlWhere = "WHERE title like ? AND campaign_type = ? AND `campaigns`.`deleted_at` IS NULL"
+		soonFrom := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+		soonTo := soonFrom.AddDate(0, 0, 7)
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT status_code, COUNT(*) AS count FROM `campaigns` "+sqlWhere+
+			" GROUP BY `status_code` ORDER BY status_code")).WithArgs("%", "preorder").
+			WillReturnRows(sqlmock.NewRows([]string{"status_code", "count"}).AddRow(1, 4).AddRow(2, 3))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) AS total, COALESCE(SUM(order_start_date BETWEEN ? AND ?), 0) AS starting_soon")).
+			WithArgs(soonFrom, soonTo, soonFrom, soonTo, "%", "preorder").
+			WillReturnRows(sqlmock.NewRows([]string{"total", "starting_soon", "ending_soon", "without_stores", "without_products"}).
+				AddRow(7, 2, 1, 3, 0))
+
+		summary, err := campaignService.GetSummary(context.TODO(), entities.CampaignListFilter{CampaignType: "preorder"}, soonFrom, soonTo)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		want := entities.CampaignSummary{
+			Total:         7,
+			StatusCounts:  []entities.CampaignStatusCount{{StatusCode: 1, Count: 4}, {StatusCode: 2, Count: 3}},
+			StartingSoon:  2,
+			EndingSoon:    1,
+			WithoutStores: 3,
+		}
+		if !reflect.DeepEqual(summary, want) {
+			t.Errorf("unexpected summary : got - %+v ; want - %+v", summary, want)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when the aggregates can not be got it is an error", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		campaignService := NewCampaignService(db, "")
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT status_code, COUNT(*) AS count FROM `campaigns`")).
+			WillReturnError(errors.New("db error"))
+
+		_, err := campaignService.GetSummary(context.TODO(), entities.CampaignListFilter{}, time.Now(), time.Now())
+		if !errors.Is(err, valueobjects.ErrCampaignCantGetSummary) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCantGetSummary)
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CollectionSlotReservationService struct {
+	db *gorm.DB
+}
+
+type CollectionSlotReservationEntry struct {
+	ID         int64        `gorm:"primary_key;autoIncrement;column:reservation_id"`
+	CampaignID int64        `gorm:"column:campaign_id;type:bigint;not null"`
+	StoreID    int64        `gorm:"column:store_id;type:bigint;not null;index:idx_reservation_store_date"`
+	SlotSource string       `gorm:"column:slot_source;type:varchar(10);not null;index:idx_reservation_slot"`
+	SlotID     int64        `gorm:"column:slot_id;type:bigint;not null;index:idx_reservation_slot"`
+	SlotDate   time.Time    `gorm:"column:slot_date;type:date;not null;index:idx_reservation_slot;index:idx_reservation_store_date"`
+	Quantity   int          `gorm:"column:quantity;type:smallint"`
+	Status     string       `gorm:"column:status;type:varchar(20)"`
+	ExpiresAt  sql.NullTime `gorm:"column:expires_at;type:datetime"`
+	Reference  string       `gorm:"column:reference;type:varchar(100)"`
+	CreatedAt  time.Time    `gorm:"column:created_at;type:datetime"`
+	CreatedBy  int64        `gorm:"column:created_by;type:bigint"`
+	UpdatedAt  time.Time    `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy  int64        `gorm:"column:updated_by;type:bigint"`
+}
+
+type reservedQuantityRow struct {
+	SlotSource string
+	SlotID     int64
+	SlotDate   time.Time
+	Quantity   int
+}
+
+func NewCollectionSlotReservationService(db *gorm.DB) *CollectionSlotReservationService {
+	return &CollectionSlotReservationService{db: db}
+}
+
+func (c *CollectionSlotReservationEntry) TableName() string {
+	return "collection_slot_reservations"
+}
+
+func (c *CollectionSlotReservationService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&CollectionSlotReservationEntry{})
+	return err
+}
+
+// LockSlot takes a row lock on the time slot, reservations of a slot wait on each other until the
+// transaction holding the lock ends so their quota check and insert can not interleave
+func (c *CollectionSlotReservationService) LockSlot(ctx context.Context, source valueobjects.SlotSource, slotID int64) (int64, error) {
+	db := DBTransaction(ctx)
+	if db == nil {
+		return 0, fmt.Errorf("%w: slot lock needs a transaction", valueobjects.ErrReservationCantCreate)
+	}
+	var storeID int64
+	var err error
+	switch source {
+	case valueobjects.SlotSourceDaily:
+		var entry StoreDailyTimeSlotEntry
+		err = db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("daily_time_slot_id = ?", slotID).First(&entry).Error
+		storeID = entry.StoreID
+	case valueobjects.SlotSourceSpecific:
+		var entry StoreSpecificTimeSlotEntry
+		err = db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("Specific_time_slot_id = ?", slotID).First(&entry).Error
+		storeID = entry.StoreID
+	default:
+		return 0, fmt.Errorf("%w: unknown slot source %q", valueobjects.ErrTimeSlotInvalid, source)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: %s slot %d", valueobjects.ErrCollectionSlotNotExists, source, slotID)
+		}
+		return 0, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantGet, err)
+	}
+	return storeID, nil
+}
+
+func (c *CollectionSlotReservationService) GetReservedQuantities(ctx context.Context, storeID int64, from, to, now time.Time) ([]entities.ReservedQuantity, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var rows []reservedQuantityRow
+	err := db.Model(&CollectionSlotReservationEntry{}).
+		Select("slot_source, slot_id, slot_date, SUM(quantity) as quantity").
+		Where("store_id = ? and slot_date >= ? and slot_date <= ?", storeID,
+			from.Format(valueobjects.DateLayout), to.Format(valueobjects.DateLayout)).
+		Where("status = ? or (status = ? and expires_at > ?)", valueobjects.ReservationStatusConfirmed,
+			valueobjects.ReservationStatusHeld, now).
+		Group("slot_source, slot_id, slot_date").Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantGet, err)
+	}
+	quantities := []entities.ReservedQuantity{}
+	for _, row := range rows {
+		quantities = append(quantities, entities.ReservedQuantity{
+			Slot: valueobjects.SlotKey{
+				Source: valueobjects.SlotSource(row.SlotSource),
+				SlotID: row.SlotID,
+				Date:   fromDateColumn(row.SlotDate),
+			},
+			Quantity: row.Quantity,
+		})
+	}
+	return quantities, nil
+}
+
+func (c *CollectionSlotReservationService) Get(ctx context.Context, reservationID valueobjects.ReservationID) (entities.CollectionSlotReservation, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry CollectionSlotReservationEntry
+	err := db.Where("reservation_id = ?", reservationID.ToInt64()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.CollectionSlotReservation{}, fmt.Errorf("%w: %d", valueobjects.ErrReservationNotExists, reservationID)
+		}
+		return entities.CollectionSlotReservation{}, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *CollectionSlotReservationService) Create(ctx context.Context, reservation entities.CollectionSlotReservation) (entities.CollectionSlotReservation, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(reservation)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.CollectionSlotReservation{}, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantCreate, err)
+	}
+	logger.Infof("reservation %v of %d created for slot %v", entry.ID, entry.Quantity, reservation.Slot)
+	return c.ToEntity(entry), nil
+}
+
+// Confirm only touches a hold not expired at now, the status always changes so the affected rows tell whether it did
+func (c *CollectionSlotReservationService) Confirm(ctx context.Context, reservationID valueobjects.ReservationID, userID int64, now time.Time) (bool, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&CollectionSlotReservationEntry{}).
+		Where("reservation_id = ? and status = ? and expires_at > ?", reservationID.ToInt64(), valueobjects.ReservationStatusHeld, now).
+		Updates(map[string]interface{}{
+			"status":     valueobjects.ReservationStatusConfirmed,
+			"expires_at": nil,
+			"updated_by": userID,
+		})
+	if response.Error != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantUpdate, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return false, nil
+	}
+	logger.Infof("reservation with id %v confirmed", reservationID)
+	return true, nil
+}
+
+// Release only touches a reservation not released yet, the status always changes so the affected rows tell whether it did
+func (c *CollectionSlotReservationService) Release(ctx context.Context, reservationID valueobjects.ReservationID, userID int64) (bool, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&CollectionSlotReservationEntry{}).
+		Where("reservation_id = ? and status <> ?", reservationID.ToInt64(), valueobjects.ReservationStatusReleased).
+		Updates(map[string]interface{}{
+			"status":     valueobjects.ReservationStatusReleased,
+			"updated_by": userID,
+		})
+	if response.Error != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrReservationCantUpdate, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return false, nil
+	}
+	logger.Infof("reservation with id %v released", reservationID)
+	return true, nil
+}
+
+func (c *CollectionSlotReservationService) ToEntry(reservation entities.CollectionSlotReservation) CollectionSlotReservationEntry {
+	entry := CollectionSlotReservationEntry{
+		ID:         reservation.ID.ToInt64(),
+		CampaignID: reservation.CampaignID.ToInt64(),
+		StoreID:    reservation.StoreID,
+		SlotSource: string(reservation.Slot.Source),
+		SlotID:     reservation.Slot.SlotID,
+		SlotDate:   toDateColumn(reservation.Slot.Date),
+		Quantity:   reservation.Quantity,
+		Status:     string(reservation.Status),
+		Reference:  reservation.Reference,
+		CreatedBy:  reservation.CreatedBy,
+		UpdatedBy:  reservation.UpdatedBy,
+	}
+	if !reservation.ExpiresAt.IsZero() {
+		entry.ExpiresAt = sql.NullTime{Time: reservation.ExpiresAt, Valid: true}
+	}
+	return entry
+}
+
+func (c *CollectionSlotReservationService) ToEntity(entry CollectionSlotReservationEntry) entities.CollectionSlotReservation {
+	reservation := entities.CollectionSlotReservation{
+		ID:         valueobjects.ReservationID(entry.ID),
+		CampaignID: valueobjects.CampaignID(entry.CampaignID),
+		StoreID:    entry.StoreID,
+		Slot: valueobjects.SlotKey{
+			Source: valueobjects.SlotSource(entry.SlotSource),
+			SlotID: entry.SlotID,
+			Date:   fromDateColumn(entry.SlotDate),
+		},
+		Quantity:  entry.Quantity,
+		Status:    valueobjects.ReservationStatus(entry.Status),
+		Reference: entry.Reference,
+		CreatedAt: entry.CreatedAt,
+		CreatedBy: entry.CreatedBy,
+		UpdatedAt: entry.UpdatedAt,
+		UpdatedBy: entry.UpdatedBy,
+	}
+	if entry.ExpiresAt.Valid {
+		reservation.ExpiresAt = entry.ExpiresAt.Time
+	}
+	return reservation
+}
@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCollectionSlotReservationService_LockSlot(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `store_daily_time_slots` WHERE daily_time_slot_id = ? AND `store_daily_time_slots`.`deleted_at` IS NULL ORDER BY `store_daily_time_slots`.`daily_time_slot_id` LIMIT 1 FOR UPDATE"
+	const sqlSelectSpecific = "SELECT * FROM `store_specific_time_slots` WHERE Specific_time_slot_id = ? AND `store_specific_time_slots`.`deleted_at` IS NULL ORDER BY `store_specific_time_slots`.`Specific_time_slot_id` LIMIT 1 FOR UPDATE"
+
+	t.Run("when slot row locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		reservationService := NewCollectionSlotReservationService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(12).
+			WillReturnRows(sqlmock.NewRows([]string{"daily_time_slot_id", "store_id"}).AddRow(12, 84))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(reservationService.db)
+		var storeID int64
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			storeID, err = reservationService.LockSlot(ctx, valueobjects.SlotSourceDaily, 12)
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if storeID != 84 {
+			t.Errorf("unexpected store id : got - %d ; want - 84", storeID)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when specific slot row locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		reservationService := NewCollectionSlotReservationService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelectSpecific)).WithArgs(21).
+			WillReturnRows(sqlmock.NewRows([]string{"Specific_time_slot_id", "store_id"}).AddRow(21, 84))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(reservationService.db)
+		var storeID int64
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			storeID, err = reservationService.LockSlot(ctx, valueobjects.SlotSourceSpecific, 21)
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if storeID != 84 {
+			t.Errorf("unexpected store id : got - %d ; want - 84", storeID)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when slot lock is released by the transaction rolling back", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		reservationService := NewCollectionSlotReservationService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(12).
+			WillReturnRows(sqlmock.NewRows([]string{"daily_time_slot_id", "store_id"}).AddRow(12, 84))
+		mock.ExpectRollback()
+
+		transactionService := NewTransactionService(reservationService.db)
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			if _, err := reservationService.LockSlot(ctx, valueobjects.SlotSourceDaily, 12); err != nil {
+				return err
+			}
+			return valueobjects.ErrCollectionSlotFull
+		})
+		if !errors.Is(err, valueobjects.ErrCollectionSlotFull) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCollectionSlotFull)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when slot is locked without a transaction", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		reservationService := NewCollectionSlotReservationService(db)
+
+		_, err := reservationService.LockSlot(context.TODO(), valueobjects.SlotSourceDaily, 12)
+		if !errors.Is(err, valueobjects.ErrReservationCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrReservationCantCreate)
+		}
+	})
+}
+
+func TestCollectionSlotReservationService_GetReservedQuantities(t *testing.T) {
+	const sqlSelect = "SELECT slot_source, slot_id, slot_date, SUM(quantity) as quantity FROM `collection_slot_reservations` WHERE (store_id = ? and slot_date >= ? and slot_date <= ?) AND (status = ? or (status = ? and expires_at > ?)) GROUP BY slot_source, slot_id, slot_date"
+
+	t.Run("when quantities of active reservations fetched successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		reservationService := NewCollectionSlotReservationService(db)
+		now := time.Date(2024, time.February, 7, 2, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, "2024-02-10", "2024-02-11", "confirmed", "held", now).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_source", "slot_id", "slot_date", "quantity"}).
+				AddRow("daily", 12, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.Local), 7))
+
+		from := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		quantities, err := reservationService.GetReservedQuantities(context.TODO(), 84, from, from.AddDate(0, 0, 1), now)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(quantities) != 1 || quantities[0].Slot.String() != "daily-12-2024-02-10" || quantities[0].Quantity != 7 {
+			t.Errorf("unexpected quantities : got - %+v", quantities)
+		}
+	})
+}
+
+func TestCollectionSlotReservationService_Confirm(t *testing.T) {
+	const sqlUpdate = "UPDATE `collection_slot_reservations` SET `expires_at`=?,`status`=?,`updated_by`=?,`updated_at`=? WHERE reservation_id = ? and status = ? and expires_at > ?"
+	now := time.Date(2024, time.February, 7, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		affected int64
+		expected bool
+	}{
+		{name: "when hold confirmed", affected: 1, expected: true},
+		{name: "when hold expired or not held", affected: 0, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			reservationService := NewCollectionSlotReservationService(db)
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs(nil, "confirmed", 7, sqlmock.AnyArg(), 9, "held", now).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			confirmed, err := reservationService.Confirm(context.TODO(), 9, 7, now)
+			if err != nil {
+				t.Errorf("unexpected error : got - %v ; want - nil", err)
+			}
+			if confirmed != tt.expected {
+				t.Errorf("unexpected result : got - %v ; want - %v", confirmed, tt.expected)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations : %v", err)
+			}
+		})
+	}
+}
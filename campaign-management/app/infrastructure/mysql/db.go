@@ -1,5 +1,38 @@
-package mysql
-
-type Tabler interface {
-	TableName() string
-}
+// Package mysql keeps the domain entities in MySQL through gorm.
+//
+// Updates do not check the affected rows, MySQL does not count a row rewritten with the values it already holds, so
+// checking that the row exists is left to the caller.
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Tabler interface {
+	TableName() string
+}
+
+// toDateColumn hands a calendar date to the driver as local midnight, the connection location, so the driver does not
+// shift it to another day
+func toDateColumn(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// fromDateColumn reads a date column back as the calendar date it holds, at midnight UTC like the entities keep dates
+func fromDateColumn(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// orderBy orders the query by the sort fields, params only lets through the sortable columns of the list
+func orderBy(db *gorm.DB, sortFields []entities.SortField) *gorm.DB {
+	for _, sortField := range sortFields {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: sortField.Column}, Desc: sortField.Desc})
+	}
+	return db
+}
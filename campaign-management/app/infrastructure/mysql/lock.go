@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"database/sql"
+	"fmt"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// LockService provides named locks across service instances using MySQL GET_LOCK
+type LockService struct {
+	pool *gorm.DB
+}
+
+// NewLockService ..
+func NewLockService(pool *gorm.DB) *LockService {
+	return &LockService{
+		pool: pool,
+	}
+}
+
+// RunWithLock will try to acquire the named lock without waiting and call fn while holding it.
+// GET_LOCK is bound to a session, so the lock is taken and released on one dedicated connection.
+func (ls *LockService) RunWithLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	sqlDB, err := ls.pool.DB()
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrLockCantAcquire, err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrLockCantAcquire, err)
+	}
+	defer conn.Close()
+
+	var acquired sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", name).Scan(&acquired)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrLockCantAcquire, err)
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		logger.Infof("lock %s is held by another session", name)
+		return false, nil
+	}
+
+	defer func() {
+		var released sql.NullInt64
+		// release with a fresh context, the caller's context may already be cancelled
+		if err := conn.QueryRowContext(context.Background(), "SELECT RELEASE_LOCK(?)", name).Scan(&released); err != nil {
+			logger.Errorf("unable to release lock %s : %v", name, err)
+		}
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLockService_RunWithLock(t *testing.T) {
+	lockName := "campaign-status-scheduler"
+
+	t.Run("when lock acquired, function is called and lock released", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		lockService := NewLockService(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, 0)")).WithArgs(lockName).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).WithArgs(lockName).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+
+		called := false
+		acquired, err := lockService.RunWithLock(context.TODO(), lockName, func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if !acquired || !called {
+			t.Errorf("unexpected value : got - acquired %v called %v ; want - true", acquired, called)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when lock held by another session, function is not called", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		lockService := NewLockService(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, 0)")).WithArgs(lockName).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+
+		called := false
+		acquired, err := lockService.RunWithLock(context.TODO(), lockName, func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if acquired || called {
+			t.Errorf("unexpected value : got - acquired %v called %v ; want - false", acquired, called)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when function fails, lock is still released", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		lockService := NewLockService(db)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, 0)")).WithArgs(lockName).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).WithArgs(lockName).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+
+		acquired, err := lockService.RunWithLock(context.TODO(), lockName, func(ctx context.Context) error {
+			return errors.New("db error")
+		})
+		if err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+		if !acquired {
+			t.Error("unexpected value : got - false ; want - true")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
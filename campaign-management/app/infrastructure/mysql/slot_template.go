@@ -0,0 +1,196 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SlotTemplateService struct {
+	db *gorm.DB
+}
+
+type SlotTemplateEntry struct {
+	ID              int64          `gorm:"primary_key;autoIncrement;column:slot_template_id"`
+	Name            string         `gorm:"column:name;type:varchar(100);not null"`
+	DaysOfWeek      string         `gorm:"column:days_of_week;type:varchar(70)"`
+	StartTime       string         `gorm:"column:start_time;type:time"`
+	EndTime         string         `gorm:"column:end_time;type:time"`
+	SlotMinutes     int            `gorm:"column:slot_minutes;type:smallint"`
+	Quota           int            `gorm:"column:quota;type:smallint"`
+	IsSlotAvailable bool           `gorm:"column:is_slot_available;type:boolean"`
+	CreatedAt       time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy       int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt       time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy       int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy       int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+func NewSlotTemplateService(db *gorm.DB) *SlotTemplateService {
+	return &SlotTemplateService{db: db}
+}
+
+func (c *SlotTemplateEntry) TableName() string {
+	return "slot_templates"
+}
+
+func (c *SlotTemplateService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&SlotTemplateEntry{})
+	return err
+}
+
+func (c *SlotTemplateService) GetList(ctx context.Context) ([]entities.SlotTemplate, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entries []SlotTemplateEntry
+	err := db.Order("name asc, slot_template_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrSlotTemplateCantGet, err)
+	}
+	templates := []entities.SlotTemplate{}
+	for _, entry := range entries {
+		templates = append(templates, c.ToEntity(entry))
+	}
+	return templates, nil
+}
+
+func (c *SlotTemplateService) Get(ctx context.Context, templateID valueobjects.SlotTemplateID) (entities.SlotTemplate, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry SlotTemplateEntry
+	err := db.Where("slot_template_id = ?", templateID.ToInt64()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.SlotTemplate{}, fmt.Errorf("%w: %d", valueobjects.ErrSlotTemplateNotExists, templateID)
+		}
+		return entities.SlotTemplate{}, fmt.Errorf("%w: %v", valueobjects.ErrSlotTemplateCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *SlotTemplateService) Create(ctx context.Context, template entities.SlotTemplate) (entities.SlotTemplate, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(template)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.SlotTemplate{}, fmt.Errorf("%w: %v", valueobjects.ErrSlotTemplateCantCreate, err)
+	}
+	logger.Infof("slot template %v created", entry.ID)
+	return c.ToEntity(entry), nil
+}
+
+// Update rewrites the template
+func (c *SlotTemplateService) Update(ctx context.Context, template entities.SlotTemplate) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(template)
+	response := db.Model(&SlotTemplateEntry{}).Where("slot_template_id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"name":              entry.Name,
+			"days_of_week":      entry.DaysOfWeek,
+			"start_time":        entry.StartTime,
+			"end_time":          entry.EndTime,
+			"slot_minutes":      entry.SlotMinutes,
+			"quota":             entry.Quota,
+			"is_slot_available": entry.IsSlotAvailable,
+			"updated_by":        entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrSlotTemplateCantUpdate, response.Error)
+	}
+	logger.Infof("slot template with id %v updated successfully", entry.ID)
+	return nil
+}
+
+func (c *SlotTemplateService) Delete(ctx context.Context, templateID valueobjects.SlotTemplateID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&SlotTemplateEntry{}).Where("slot_template_id = ?", templateID.ToInt64()).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrSlotTemplateCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrSlotTemplateNotExists, templateID)
+	}
+	logger.Infof("slot template with id %v deleted successfully", templateID)
+	return nil
+}
+
+// ToEntry keeps the weekdays as a comma separated list of their names
+func (c *SlotTemplateService) ToEntry(template entities.SlotTemplate) SlotTemplateEntry {
+	days := []string{}
+	for _, dayOfWeek := range template.DaysOfWeek {
+		days = append(days, valueobjects.WeekdayName(dayOfWeek))
+	}
+	return SlotTemplateEntry{
+		ID:              template.ID.ToInt64(),
+		Name:            template.Name,
+		DaysOfWeek:      strings.Join(days, ","),
+		StartTime:       template.StartTime.String() + ":00",
+		EndTime:         template.EndTime.String() + ":00",
+		SlotMinutes:     template.SlotMinutes,
+		Quota:           template.Quota,
+		IsSlotAvailable: template.IsSlotAvailable,
+		CreatedBy:       template.CreatedBy,
+		UpdatedBy:       template.UpdatedBy,
+	}
+}
+
+func (c *SlotTemplateService) ToEntity(entry SlotTemplateEntry) entities.SlotTemplate {
+	startTime, err := valueobjects.ParseTimeOfDay(entry.StartTime)
+	if err != nil {
+		logger.Errorf("slot template %v has invalid start time : %v", entry.ID, err)
+	}
+	endTime, err := valueobjects.ParseTimeOfDay(entry.EndTime)
+	if err != nil {
+		logger.Errorf("slot template %v has invalid end time : %v", entry.ID, err)
+	}
+	daysOfWeek := []time.Weekday{}
+	if entry.DaysOfWeek != "" {
+		for _, name := range strings.Split(entry.DaysOfWeek, ",") {
+			dayOfWeek, err := valueobjects.ParseWeekday(name)
+			if err != nil {
+				logger.Errorf("slot template %v has invalid day of week : %v", entry.ID, err)
+				continue
+			}
+			daysOfWeek = append(daysOfWeek, dayOfWeek)
+		}
+	}
+	return entities.SlotTemplate{
+		ID:              valueobjects.SlotTemplateID(entry.ID),
+		Name:            entry.Name,
+		DaysOfWeek:      daysOfWeek,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		SlotMinutes:     entry.SlotMinutes,
+		Quota:           entry.Quota,
+		IsSlotAvailable: entry.IsSlotAvailable,
+		CreatedAt:       entry.CreatedAt,
+		CreatedBy:       entry.CreatedBy,
+		UpdatedAt:       entry.UpdatedAt,
+		UpdatedBy:       entry.UpdatedBy,
+	}
+}
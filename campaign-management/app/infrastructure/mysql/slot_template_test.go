@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+func TestSlotTemplateService_Get(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `slot_templates` WHERE slot_template_id = ? AND `slot_templates`.`deleted_at` IS NULL ORDER BY `slot_templates`.`slot_template_id` LIMIT 1"
+
+	t.Run("when template fetched with its weekdays", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		slotTemplateService := NewSlotTemplateService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_template_id", "name", "days_of_week", "start_time", "end_time", "slot_minutes", "quota", "is_slot_available"}).
+				AddRow(4, "weekday", "monday,friday", "10:00:00", "20:00:00", 60, 30, true))
+
+		template, err := slotTemplateService.Get(context.TODO(), 4)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(template.DaysOfWeek) != 2 || template.DaysOfWeek[1] != time.Friday || template.StartTime != 600 || template.EndTime != 1200 {
+			t.Errorf("unexpected template : got - %+v", template)
+		}
+	})
+
+	t.Run("when template not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		slotTemplateService := NewSlotTemplateService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(4).WillReturnError(gorm.ErrRecordNotFound)
+
+		_, err := slotTemplateService.Get(context.TODO(), 4)
+		if !errors.Is(err, valueobjects.ErrSlotTemplateNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSlotTemplateNotExists)
+		}
+	})
+}
+
+func TestSlotTemplateService_Create(t *testing.T) {
+	const sqlInsert = "INSERT INTO `slot_templates`"
+
+	t.Run("when template created with weekdays as a list", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		slotTemplateService := NewSlotTemplateService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs("weekday", "monday,tuesday", "10:00:00", "20:00:00", 60, 30, true, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0).
+			WillReturnResult(sqlmock.NewResult(4, 1))
+		mock.ExpectCommit()
+
+		template, err := slotTemplateService.Create(context.TODO(), entities.SlotTemplate{Name: "weekday",
+			DaysOfWeek: []time.Weekday{time.Monday, time.Tuesday}, StartTime: 600, EndTime: 1200, SlotMinutes: 60, Quota: 30,
+			IsSlotAvailable: true, CreatedBy: 7})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if template.ID != 4 {
+			t.Errorf("unexpected template id : got - %v ; want - 4", template.ID)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
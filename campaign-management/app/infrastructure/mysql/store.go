@@ -0,0 +1,213 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type StoreService struct {
+	db *gorm.DB
+}
+
+type StoreEntry struct {
+	ID        int64          `gorm:"primary_key;autoIncrement:false;column:store_id"`
+	Name      string         `gorm:"column:name;type:varchar(200);not null"`
+	Address   string         `gorm:"column:address;type:varchar(500)"`
+	Region    string         `gorm:"column:region;type:varchar(100);index:idx_store_region"`
+	Timezone  string         `gorm:"column:timezone;type:varchar(64)"`
+	IsActive  bool           `gorm:"column:is_active;not null"`
+	CreatedAt time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+func NewStoreService(db *gorm.DB) *StoreService {
+	return &StoreService{db: db}
+}
+
+func (c *StoreEntry) TableName() string {
+	return "stores"
+}
+
+func (c *StoreService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreEntry{})
+	return err
+}
+
+// GetList returns a page of the stores matching the filter along with the total matching count
+func (c *StoreService) GetList(ctx context.Context, filter entities.StoreFilter) ([]entities.Store, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	filterScope := func(db *gorm.DB) *gorm.DB {
+		if filter.Region != "" {
+			db = db.Where("region = ?", filter.Region)
+		}
+		if filter.IsActive != nil {
+			db = db.Where("is_active = ?", *filter.IsActive)
+		}
+		return db
+	}
+
+	var count int64
+	err := db.Model(&StoreEntry{}).Scopes(filterScope).Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantGet, err)
+	}
+	var entries []StoreEntry
+	err = orderBy(db.Scopes(filterScope), filter.SortFields).Order("store_id asc").
+		Limit(filter.Limit).Offset(filter.Offset).Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantGet, err)
+	}
+	stores := []entities.Store{}
+	for _, entry := range entries {
+		stores = append(stores, c.ToEntity(entry))
+	}
+	return stores, count, nil
+}
+
+// GetByIDs returns the registered stores among the given ids, ids not registered are left out
+func (c *StoreService) GetByIDs(ctx context.Context, storeIDs []int64) ([]entities.Store, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	stores := []entities.Store{}
+	if len(storeIDs) == 0 {
+		return stores, nil
+	}
+	var entries []StoreEntry
+	err := db.Where("store_id in ?", storeIDs).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantGet, err)
+	}
+	for _, entry := range entries {
+		stores = append(stores, c.ToEntity(entry))
+	}
+	return stores, nil
+}
+
+func (c *StoreService) Get(ctx context.Context, storeID valueobjects.StoreID) (entities.Store, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry StoreEntry
+	err := db.Where("store_id = ?", storeID.ToInt64()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.Store{}, fmt.Errorf("%w: %d", valueobjects.ErrRegisteredStoreNotExists, storeID)
+		}
+		return entities.Store{}, fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+// Create registers the store under its own id, a store deleted before takes its deleted row back
+func (c *StoreService) Create(ctx context.Context, store entities.Store) (entities.Store, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(store)
+	err := db.Unscoped().Where("store_id = ?", entry.ID).
+		Assign(map[string]interface{}{
+			"name":       entry.Name,
+			"address":    entry.Address,
+			"region":     entry.Region,
+			"timezone":   entry.Timezone,
+			"is_active":  entry.IsActive,
+			"created_by": entry.CreatedBy,
+			"deleted_at": nil,
+			"deleted_by": 0,
+		}).
+		FirstOrCreate(&entry).Error
+	if err != nil {
+		return entities.Store{}, fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantCreate, err)
+	}
+	logger.Infof("store with id %v created successfully", entry.ID)
+	return c.ToEntity(entry), nil
+}
+
+// Update rewrites the store
+func (c *StoreService) Update(ctx context.Context, store entities.Store) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(store)
+	response := db.Model(&StoreEntry{}).Where("store_id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"name":       entry.Name,
+			"address":    entry.Address,
+			"region":     entry.Region,
+			"timezone":   entry.Timezone,
+			"is_active":  entry.IsActive,
+			"updated_by": entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantUpdate, response.Error)
+	}
+	logger.Infof("store with id %v updated successfully", entry.ID)
+	return nil
+}
+
+func (c *StoreService) Delete(ctx context.Context, storeID valueobjects.StoreID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&StoreEntry{}).Where("store_id = ?", storeID.ToInt64()).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrRegisteredStoreCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrRegisteredStoreNotExists, storeID)
+	}
+	logger.Infof("store with id %v deleted successfully", storeID)
+	return nil
+}
+
+func (c *StoreService) ToEntry(store entities.Store) StoreEntry {
+	return StoreEntry{
+		ID:        store.ID.ToInt64(),
+		Name:      store.Name,
+		Address:   store.Address,
+		Region:    store.Region,
+		Timezone:  store.Timezone,
+		IsActive:  store.IsActive,
+		CreatedBy: store.CreatedBy,
+		UpdatedBy: store.UpdatedBy,
+	}
+}
+
+func (c *StoreService) ToEntity(entry StoreEntry) entities.Store {
+	return entities.Store{
+		ID:        valueobjects.StoreID(entry.ID),
+		Name:      entry.Name,
+		Address:   entry.Address,
+		Region:    entry.Region,
+		Timezone:  entry.Timezone,
+		IsActive:  entry.IsActive,
+		CreatedAt: entry.CreatedAt,
+		CreatedBy: entry.CreatedBy,
+		UpdatedAt: entry.UpdatedAt,
+		UpdatedBy: entry.UpdatedBy,
+	}
+}
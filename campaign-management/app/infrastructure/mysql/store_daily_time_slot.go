@@ -1,41 +1,222 @@
-package mysql
-
-import (
-	"time"
-
-	"gorm.io/gorm"
-)
-
-type StoreDailyTimeSlotService struct {
-	db *gorm.DB
-}
-
-type StoreDailyTimeSlotEntry struct {
-	ID              int64     `gorm:"primary_key;autoIncrement;column:daily_time_slot_id"`
-	StoreID         int64     `gorm:"column:store_id;type:bigint;not null"`
-	StartTime       time.Time `gorm:"column:start_time;type:time"`
-	EndTime         time.Time `gorm:"column:end_time;type:time"`
-	Quota           int       `gorm:"column:quota;type:smallint"`
-	DayofWeek       string    `gorm:"column:day_of_week;type:varchar(20)"`
-	IsSlotAvailable bool      `gorm:"column:is_slot_available;type:boolean"`
-	UserID          int64     `gorm:"column:user_id;type:bigint"`
-	CreatedAt       time.Time `gorm:"column:created_at;type:datetime"`
-	CreatedBy       int64     `gorm:"column:created_by;type:bigint"`
-	UpdatedAt       time.Time `gorm:"column:updated_at;type:datetime"`
-	UpdatedBy       int64     `gorm:"column:updated_by;type:bigint"`
-	DeletedAt       time.Time `gorm:"column:deleted_at;type:datetime"`
-	DeletedBy       int64     `gorm:"column:deleted_by;type:bigint"`
-}
-
-func NewStoreDailyTimeSlotService(db *gorm.DB) *StoreDailyTimeSlotService {
-	return &StoreDailyTimeSlotService{db: db}
-}
-
-func (c *StoreDailyTimeSlotEntry) TableName() string {
-	return "store_daily_time_slots"
-}
-
-func (c *StoreDailyTimeSlotService) Migrate() error {
-	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreDailyTimeSlotEntry{})
-	return err
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StoreDailyTimeSlotService struct {
+	db *gorm.DB
+}
+
+type StoreDailyTimeSlotEntry struct {
+	ID              int64          `gorm:"primary_key;autoIncrement;column:daily_time_slot_id"`
+	StoreID         int64          `gorm:"column:store_id;type:bigint;not null"`
+	StartTime       string         `gorm:"column:start_time;type:time"`
+	EndTime         string         `gorm:"column:end_time;type:time"`
+	Quota           int            `gorm:"column:quota;type:smallint"`
+	DayofWeek       string         `gorm:"column:day_of_week;type:varchar(20)"`
+	IsSlotAvailable bool           `gorm:"column:is_slot_available;type:boolean"`
+	UserID          int64          `gorm:"column:user_id;type:bigint"`
+	CreatedAt       time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy       int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt       time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy       int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy       int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+func NewStoreDailyTimeSlotService(db *gorm.DB) *StoreDailyTimeSlotService {
+	return &StoreDailyTimeSlotService{db: db}
+}
+
+func (c *StoreDailyTimeSlotEntry) TableName() string {
+	return "store_daily_time_slots"
+}
+
+func (c *StoreDailyTimeSlotService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreDailyTimeSlotEntry{})
+	return err
+}
+
+func (c *StoreDailyTimeSlotService) GetList(ctx context.Context, storeID int64) ([]entities.StoreDailyTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entries []StoreDailyTimeSlotEntry
+	err := db.Where("store_id = ?", storeID).Order("start_time asc, daily_time_slot_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantGet, err)
+	}
+	slots := []entities.StoreDailyTimeSlot{}
+	for _, entry := range entries {
+		slots = append(slots, c.ToEntity(entry))
+	}
+	return slots, nil
+}
+
+// LockDay reads the slots the store has on the weekday and locks them until the transaction ends, so slots of the day
+// are checked against each other and written one writer at a time
+func (c *StoreDailyTimeSlotService) LockDay(ctx context.Context, storeID int64, dayOfWeek time.Weekday) ([]entities.StoreDailyTimeSlot, error) {
+	db := DBTransaction(ctx)
+	if db == nil {
+		return nil, fmt.Errorf("%w: day lock needs a transaction", valueobjects.ErrDailyTimeSlotCantGet)
+	}
+	var entries []StoreDailyTimeSlotEntry
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("store_id = ? AND day_of_week = ?", storeID, valueobjects.WeekdayName(dayOfWeek)).
+		Order("start_time asc, daily_time_slot_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantGet, err)
+	}
+	slots := []entities.StoreDailyTimeSlot{}
+	for _, entry := range entries {
+		slots = append(slots, c.ToEntity(entry))
+	}
+	return slots, nil
+}
+
+func (c *StoreDailyTimeSlotService) Get(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID) (entities.StoreDailyTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry StoreDailyTimeSlotEntry
+	err := db.Where("daily_time_slot_id = ? and store_id = ?", slotID.ToInt64(), storeID).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.StoreDailyTimeSlot{}, fmt.Errorf("%w: %d", valueobjects.ErrDailyTimeSlotNotExists, slotID)
+		}
+		return entities.StoreDailyTimeSlot{}, fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *StoreDailyTimeSlotService) Create(ctx context.Context, slot entities.StoreDailyTimeSlot) (entities.StoreDailyTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(slot)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.StoreDailyTimeSlot{}, fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantCreate, err)
+	}
+	logger.Infof("daily time slot %v created for store id : %v", entry.ID, entry.StoreID)
+	return c.ToEntity(entry), nil
+}
+
+func (c *StoreDailyTimeSlotService) CreateMultiple(ctx context.Context, slots []entities.StoreDailyTimeSlot) ([]entities.StoreDailyTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entries := []StoreDailyTimeSlotEntry{}
+	for _, slot := range slots {
+		entries = append(entries, c.ToEntry(slot))
+	}
+	err := db.Create(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantCreate, err)
+	}
+	logger.Infof("%d daily time slots created", len(entries))
+
+	created := []entities.StoreDailyTimeSlot{}
+	for _, entry := range entries {
+		created = append(created, c.ToEntity(entry))
+	}
+	return created, nil
+}
+
+// Update rewrites the slot
+func (c *StoreDailyTimeSlotService) Update(ctx context.Context, slot entities.StoreDailyTimeSlot) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(slot)
+	response := db.Model(&StoreDailyTimeSlotEntry{}).Where("daily_time_slot_id = ? and store_id = ?", entry.ID, entry.StoreID).
+		Updates(map[string]interface{}{
+			"day_of_week":       entry.DayofWeek,
+			"start_time":        entry.StartTime,
+			"end_time":          entry.EndTime,
+			"quota":             entry.Quota,
+			"is_slot_available": entry.IsSlotAvailable,
+			"updated_by":        entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantUpdate, response.Error)
+	}
+	logger.Infof("daily time slot with id %v updated successfully", entry.ID)
+	return nil
+}
+
+func (c *StoreDailyTimeSlotService) Delete(ctx context.Context, storeID int64, slotID valueobjects.DailyTimeSlotID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&StoreDailyTimeSlotEntry{}).Where("daily_time_slot_id = ? and store_id = ?", slotID.ToInt64(), storeID).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrDailyTimeSlotCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrDailyTimeSlotNotExists, slotID)
+	}
+	logger.Infof("daily time slot with id %v deleted successfully", slotID)
+	return nil
+}
+
+func (c *StoreDailyTimeSlotService) ToEntry(slot entities.StoreDailyTimeSlot) StoreDailyTimeSlotEntry {
+	return StoreDailyTimeSlotEntry{
+		ID:              slot.ID.ToInt64(),
+		StoreID:         slot.StoreID,
+		StartTime:       slot.StartTime.String() + ":00",
+		EndTime:         slot.EndTime.String() + ":00",
+		Quota:           slot.Quota,
+		DayofWeek:       valueobjects.WeekdayName(slot.DayOfWeek),
+		IsSlotAvailable: slot.IsSlotAvailable,
+		CreatedBy:       slot.CreatedBy,
+		UpdatedBy:       slot.UpdatedBy,
+	}
+}
+
+func (c *StoreDailyTimeSlotService) ToEntity(entry StoreDailyTimeSlotEntry) entities.StoreDailyTimeSlot {
+	startTime, err := valueobjects.ParseTimeOfDay(entry.StartTime)
+	if err != nil {
+		logger.Errorf("daily time slot %v has invalid start time : %v", entry.ID, err)
+	}
+	endTime, err := valueobjects.ParseTimeOfDay(entry.EndTime)
+	if err != nil {
+		logger.Errorf("daily time slot %v has invalid end time : %v", entry.ID, err)
+	}
+	dayOfWeek, err := valueobjects.ParseWeekday(entry.DayofWeek)
+	if err != nil {
+		logger.Errorf("daily time slot %v has invalid day of week : %v", entry.ID, err)
+	}
+	return entities.StoreDailyTimeSlot{
+		ID:              valueobjects.DailyTimeSlotID(entry.ID),
+		StoreID:         entry.StoreID,
+		DayOfWeek:       dayOfWeek,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		Quota:           entry.Quota,
+		IsSlotAvailable: entry.IsSlotAvailable,
+		CreatedAt:       entry.CreatedAt,
+		CreatedBy:       entry.CreatedBy,
+		UpdatedAt:       entry.UpdatedAt,
+		UpdatedBy:       entry.UpdatedBy,
+	}
+}
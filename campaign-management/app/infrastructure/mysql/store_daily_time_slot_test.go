@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStoreDailyTimeSlotService_GetList(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `store_daily_time_slots` WHERE store_id = ? AND `store_daily_time_slots`.`deleted_at` IS NULL ORDER BY start_time asc, daily_time_slot_id asc"
+
+	t.Run("when slots fetched successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84).
+			WillReturnRows(sqlmock.NewRows([]string{"daily_time_slot_id", "store_id", "start_time", "end_time", "quota", "day_of_week", "is_slot_available"}).
+				AddRow(1, 84, "09:00:00", "10:30:00", 10, "monday", true))
+
+		slots, err := dailyTimeSlotService.GetList(context.TODO(), 84)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := entities.StoreDailyTimeSlot{ID: 1, StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 630, Quota: 10, IsSlotAvailable: true}
+		if len(slots) != 1 || slots[0] != expected {
+			t.Errorf("unexpected slots : got - %+v ; want - %+v", slots, expected)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotService_LockDay(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `store_daily_time_slots` WHERE (store_id = ? AND day_of_week = ?) AND `store_daily_time_slots`.`deleted_at` IS NULL ORDER BY start_time asc, daily_time_slot_id asc FOR UPDATE"
+
+	t.Run("when slots of the day locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, "monday").
+			WillReturnRows(sqlmock.NewRows([]string{"daily_time_slot_id", "store_id", "start_time", "end_time", "quota", "day_of_week", "is_slot_available"}).
+				AddRow(1, 84, "09:00:00", "10:30:00", 10, "monday", true))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(dailyTimeSlotService.db)
+		var slots []entities.StoreDailyTimeSlot
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			slots, err = dailyTimeSlotService.LockDay(ctx, 84, time.Monday)
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 1 || slots[0].ID != 1 {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when slots of the day locked without a transaction", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+
+		_, err := dailyTimeSlotService.LockDay(context.TODO(), 84, time.Monday)
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotCantGet)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotService_Create(t *testing.T) {
+	const sqlInsert = "INSERT INTO `store_daily_time_slots`"
+
+	t.Run("when slot created with the times written as mysql time", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs(84, "09:00:00", "24:00:00", 10, "sunday", true, 0, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectCommit()
+
+		slot, err := dailyTimeSlotService.Create(context.TODO(), entities.StoreDailyTimeSlot{
+			StoreID: 84, DayOfWeek: time.Sunday, StartTime: 540, EndTime: valueobjects.EndOfDay, Quota: 10, IsSlotAvailable: true, CreatedBy: 7,
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if slot.ID != 5 {
+			t.Errorf("unexpected slot id : got - %v ; want - 5", slot.ID)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotService_CreateMultiple(t *testing.T) {
+	const sqlInsert = "INSERT INTO `store_daily_time_slots`"
+
+	t.Run("when slots of several stores created in one insert", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs(84, "10:00:00", "11:00:00", 30, "monday", true, 0, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0,
+				85, "10:00:00", "11:00:00", 30, "monday", true, 0, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0).
+			WillReturnResult(sqlmock.NewResult(5, 2))
+		mock.ExpectCommit()
+
+		slots, err := dailyTimeSlotService.CreateMultiple(context.TODO(), []entities.StoreDailyTimeSlot{
+			{StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660, Quota: 30, IsSlotAvailable: true, CreatedBy: 7},
+			{StoreID: 85, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660, Quota: 30, IsSlotAvailable: true, CreatedBy: 7},
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 2 || slots[0].ID != 5 || slots[1].ID != 6 || slots[1].StoreID != 85 {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotService_Delete(t *testing.T) {
+	const sqlDelete = "UPDATE `store_daily_time_slots` SET `deleted_at`=?,`deleted_by`=?,`updated_at`=? WHERE (daily_time_slot_id = ? and store_id = ?) AND `store_daily_time_slots`.`deleted_at` IS NULL"
+
+	t.Run("when slot not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		dailyTimeSlotService := NewStoreDailyTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDelete)).WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 9, 84).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := dailyTimeSlotService.Delete(context.TODO(), 84, valueobjects.DailyTimeSlotID(9), 7)
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotNotExists)
+		}
+	})
+}
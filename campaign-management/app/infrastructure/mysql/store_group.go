@@ -0,0 +1,290 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StoreGroupService struct {
+	db *gorm.DB
+}
+
+type StoreGroupEntry struct {
+	ID          int64          `gorm:"primary_key;autoIncrement;column:store_group_id"`
+	Name        string         `gorm:"column:name;type:varchar(200);not null"`
+	Description string         `gorm:"column:description;type:varchar(500)"`
+	CreatedAt   time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy   int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy   int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy   int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+// StoreGroupMemberEntry is keyed on the group and the store so a store is a member of a group at most once
+type StoreGroupMemberEntry struct {
+	StoreGroupID int64     `gorm:"primary_key;autoIncrement:false;column:store_group_id"`
+	StoreID      int64     `gorm:"primary_key;autoIncrement:false;column:store_id;index:idx_store_group_member_store"`
+	CreatedAt    time.Time `gorm:"column:created_at;type:datetime"`
+	CreatedBy    int64     `gorm:"column:created_by;type:bigint"`
+}
+
+func NewStoreGroupService(db *gorm.DB) *StoreGroupService {
+	return &StoreGroupService{db: db}
+}
+
+func (c *StoreGroupEntry) TableName() string {
+	return "store_groups"
+}
+
+func (c *StoreGroupMemberEntry) TableName() string {
+	return "store_group_members"
+}
+
+func (c *StoreGroupService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreGroupEntry{}, &StoreGroupMemberEntry{})
+	return err
+}
+
+// GetList returns a page of the store groups with their stores along with the total count
+func (c *StoreGroupService) GetList(ctx context.Context, pagination entities.PaginationConfig) ([]entities.StoreGroup, int64, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var count int64
+	err := db.Model(&StoreGroupEntry{}).Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	var entries []StoreGroupEntry
+	err = orderBy(db, pagination.SortFields).Order("store_group_id asc").
+		Limit(pagination.Limit).Offset(pagination.Offset).Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	storeGroups, err := c.withMembers(db, entries)
+	if err != nil {
+		return nil, 0, err
+	}
+	return storeGroups, count, nil
+}
+
+// GetByIDs returns the store groups among the given ids with their stores, ids not found are left out
+func (c *StoreGroupService) GetByIDs(ctx context.Context, storeGroupIDs []int64) ([]entities.StoreGroup, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	if len(storeGroupIDs) == 0 {
+		return []entities.StoreGroup{}, nil
+	}
+	var entries []StoreGroupEntry
+	err := db.Where("store_group_id in ?", storeGroupIDs).Order("store_group_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	return c.withMembers(db, entries)
+}
+
+func (c *StoreGroupService) Get(ctx context.Context, storeGroupID valueobjects.StoreGroupID) (entities.StoreGroup, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry StoreGroupEntry
+	err := db.Where("store_group_id = ?", storeGroupID.ToInt64()).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.StoreGroup{}, fmt.Errorf("%w: %d", valueobjects.ErrStoreGroupNotExists, storeGroupID)
+		}
+		return entities.StoreGroup{}, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	storeGroups, err := c.withMembers(db, []StoreGroupEntry{entry})
+	if err != nil {
+		return entities.StoreGroup{}, err
+	}
+	return storeGroups[0], nil
+}
+
+// Exists reports whether a store group other than the given one already has the name
+func (c *StoreGroupService) Exists(ctx context.Context, storeGroupID valueobjects.StoreGroupID, name string) (bool, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var count int64
+	err := db.Model(&StoreGroupEntry{}).Where("name = ? AND store_group_id <> ?", name, storeGroupID.ToInt64()).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	return count > 0, nil
+}
+
+// Create stores the group and makes its stores members of it
+func (c *StoreGroupService) Create(ctx context.Context, storeGroup entities.StoreGroup) (entities.StoreGroup, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(storeGroup)
+	err := db.Create(&entry).Error
+	if err != nil {
+		return entities.StoreGroup{}, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantCreate, err)
+	}
+	logger.Infof("store group with id %v created successfully", entry.ID)
+
+	created := c.ToEntity(entry)
+	members := []entities.StoreGroupMember{}
+	for _, storeID := range storeGroup.StoreIDs {
+		members = append(members, entities.StoreGroupMember{StoreGroupID: created.ID, StoreID: storeID, CreatedBy: storeGroup.CreatedBy})
+	}
+	if err := c.AddMembers(ctx, members); err != nil {
+		return entities.StoreGroup{}, err
+	}
+	created.StoreIDs = storeGroup.StoreIDs
+	return created, nil
+}
+
+func (c *StoreGroupService) Update(ctx context.Context, storeGroup entities.StoreGroup) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(storeGroup)
+	response := db.Model(&StoreGroupEntry{}).Where("store_group_id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"name":        entry.Name,
+			"description": entry.Description,
+			"updated_by":  entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantUpdate, response.Error)
+	}
+	logger.Infof("store group with id %v updated successfully", entry.ID)
+	return nil
+}
+
+// Delete removes the group, the members are kept as nothing reaches them through a deleted group
+func (c *StoreGroupService) Delete(ctx context.Context, storeGroupID valueobjects.StoreGroupID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&StoreGroupEntry{}).Where("store_group_id = ?", storeGroupID.ToInt64()).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrStoreGroupNotExists, storeGroupID)
+	}
+	logger.Infof("store group with id %v deleted successfully", storeGroupID)
+	return nil
+}
+
+// AddMembers makes the stores members of their groups, stores already members are left as they are
+func (c *StoreGroupService) AddMembers(ctx context.Context, members []entities.StoreGroupMember) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	entries := []StoreGroupMemberEntry{}
+	for _, member := range members {
+		entries = append(entries, StoreGroupMemberEntry{
+			StoreGroupID: member.StoreGroupID.ToInt64(),
+			StoreID:      member.StoreID,
+			CreatedBy:    member.CreatedBy,
+		})
+	}
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&entries).Error
+	if err != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantUpdate, err)
+	}
+	logger.Infof("stores added for store group id : %v", entries[0].StoreGroupID)
+	return nil
+}
+
+func (c *StoreGroupService) RemoveMember(ctx context.Context, storeGroupID valueobjects.StoreGroupID, storeID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Where("store_group_id = ? AND store_id = ?", storeGroupID.ToInt64(), storeID).
+		Delete(&StoreGroupMemberEntry{})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantUpdate, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrStoreGroupMemberNotExists, storeID)
+	}
+	logger.Infof("store with id %v removed from store group id : %v", storeID, storeGroupID)
+	return nil
+}
+
+// withMembers loads the stores of the groups with one query
+func (c *StoreGroupService) withMembers(db *gorm.DB, entries []StoreGroupEntry) ([]entities.StoreGroup, error) {
+	storeGroups := []entities.StoreGroup{}
+	if len(entries) == 0 {
+		return storeGroups, nil
+	}
+	storeGroupIDs := []int64{}
+	for _, entry := range entries {
+		storeGroupIDs = append(storeGroupIDs, entry.ID)
+	}
+	var members []StoreGroupMemberEntry
+	err := db.Where("store_group_id in ?", storeGroupIDs).Order("store_id asc").Find(&members).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrStoreGroupCantGet, err)
+	}
+	storeIDs := map[int64][]int64{}
+	for _, member := range members {
+		storeIDs[member.StoreGroupID] = append(storeIDs[member.StoreGroupID], member.StoreID)
+	}
+	for _, entry := range entries {
+		storeGroup := c.ToEntity(entry)
+		storeGroup.StoreIDs = storeIDs[entry.ID]
+		if storeGroup.StoreIDs == nil {
+			storeGroup.StoreIDs = []int64{}
+		}
+		storeGroups = append(storeGroups, storeGroup)
+	}
+	return storeGroups, nil
+}
+
+func (c *StoreGroupService) ToEntry(storeGroup entities.StoreGroup) StoreGroupEntry {
+	return StoreGroupEntry{
+		ID:          storeGroup.ID.ToInt64(),
+		Name:        storeGroup.Name,
+		Description: storeGroup.Description,
+		CreatedBy:   storeGroup.CreatedBy,
+		UpdatedBy:   storeGroup.UpdatedBy,
+	}
+}
+
+func (c *StoreGroupService) ToEntity(entry StoreGroupEntry) entities.StoreGroup {
+	return entities.StoreGroup{
+		ID:          valueobjects.StoreGroupID(entry.ID),
+		Name:        entry.Name,
+		Description: entry.Description,
+		CreatedAt:   entry.CreatedAt,
+		CreatedBy:   entry.CreatedBy,
+		UpdatedAt:   entry.UpdatedAt,
+		UpdatedBy:   entry.UpdatedBy,
+	}
+}
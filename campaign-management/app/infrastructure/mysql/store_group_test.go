@@ -0,0 +1,56 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStoreGroupService_GetByIDs(t *testing.T) {
+	t.Run("when store groups are returned with their stores", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `store_groups` WHERE store_group_id in (?,?) AND `store_groups`.`deleted_at` IS NULL ORDER BY store_group_id asc"
+		const sqlMembers = "SELECT * FROM `store_group_members` WHERE store_group_id in (?,?) ORDER BY store_id asc"
+		db, mock := newMockDB(t)
+		storeGroupService := NewStoreGroupService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(3, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"store_group_id", "name"}).AddRow(3, "All Hypermarts").AddRow(4, "Central region"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlMembers)).WithArgs(3, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"store_group_id", "store_id"}).AddRow(3, 84).AddRow(3, 85))
+
+		storeGroups, err := storeGroupService.GetByIDs(context.TODO(), []int64{3, 4})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(storeGroups) != 2 || len(storeGroups[0].StoreIDs) != 2 || storeGroups[0].StoreIDs[1] != 85 ||
+			storeGroups[1].StoreIDs == nil || len(storeGroups[1].StoreIDs) != 0 {
+			t.Errorf("unexpected store groups : got - %+v", storeGroups)
+		}
+	})
+}
+
+func TestStoreGroupService_AddMembers(t *testing.T) {
+	t.Run("when stores already members are left as they are", func(t *testing.T) {
+		const sqlInsert = "INSERT INTO `store_group_members` (`store_group_id`,`store_id`,`created_at`,`created_by`) VALUES (?,?,?,?),(?,?,?,?) ON DUPLICATE KEY UPDATE"
+		db, mock := newMockDB(t)
+		storeGroupService := NewStoreGroupService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs(3, 84, sqlmock.AnyArg(), 7, 3, 85, sqlmock.AnyArg(), 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := storeGroupService.AddMembers(context.TODO(), []entities.StoreGroupMember{
+			{StoreGroupID: 3, StoreID: 84, CreatedBy: 7},
+			{StoreGroupID: 3, StoreID: 85, CreatedBy: 7},
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
@@ -1,40 +1,224 @@
-package mysql
-
-import (
-	"time"
-
-	"gorm.io/gorm"
-)
-
-type StoreSpecificTimeSlotService struct {
-	db *gorm.DB
-}
-
-type StoreSpecificTimeSlotEntry struct {
-	ID        int64     `gorm:"primary_key;autoIncrement;column:Specific_time_slot_id"`
-	StoreID   int64     `gorm:"column:store_id;type:bigint;not null"`
-	Date      time.Time `gorm:"column:date;type:date"`
-	StartTime time.Time `gorm:"column:start_time;type:time"`
-	EndTime   time.Time `gorm:"column:end_time;type:time"`
-	Quota     int       `gorm:"column:quota;type:smallint"`
-	UserID    int64     `gorm:"column:user_id;type:bigint"`
-	CreatedAt time.Time `gorm:"column:created_at;type:datetime"`
-	CreatedBy int64     `gorm:"column:created_by;type:bigint"`
-	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime"`
-	UpdatedBy int64     `gorm:"column:updated_by;type:bigint"`
-	DeletedAt time.Time `gorm:"column:deleted_at;type:datetime"`
-	DeletedBy int64     `gorm:"column:deleted_by;type:bigint"`
-}
-
-func NewStoreSpecificTimeSlotService(db *gorm.DB) *StoreSpecificTimeSlotService {
-	return &StoreSpecificTimeSlotService{db: db}
-}
-
-func (c *StoreSpecificTimeSlotEntry) TableName() string {
-	return "store_specific_time_slots"
-}
-
-func (c *StoreSpecificTimeSlotService) Migrate() error {
-	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreSpecificTimeSlotEntry{})
-	return err
-}
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StoreSpecificTimeSlotService struct {
+	db *gorm.DB
+}
+
+type StoreSpecificTimeSlotEntry struct {
+	ID        int64          `gorm:"primary_key;autoIncrement;column:Specific_time_slot_id"`
+	StoreID   int64          `gorm:"column:store_id;type:bigint;not null"`
+	Date      time.Time      `gorm:"column:date;type:date"`
+	StartTime *string        `gorm:"column:start_time;type:time"`
+	EndTime   *string        `gorm:"column:end_time;type:time"`
+	Quota     int            `gorm:"column:quota;type:smallint"`
+	IsClosed  bool           `gorm:"column:is_closed;type:boolean;not null;default:false"`
+	UserID    int64          `gorm:"column:user_id;type:bigint"`
+	CreatedAt time.Time      `gorm:"column:created_at;type:datetime"`
+	CreatedBy int64          `gorm:"column:created_by;type:bigint"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;type:datetime"`
+	UpdatedBy int64          `gorm:"column:updated_by;type:bigint"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:datetime"`
+	DeletedBy int64          `gorm:"column:deleted_by;type:bigint"`
+}
+
+func NewStoreSpecificTimeSlotService(db *gorm.DB) *StoreSpecificTimeSlotService {
+	return &StoreSpecificTimeSlotService{db: db}
+}
+
+func (c *StoreSpecificTimeSlotEntry) TableName() string {
+	return "store_specific_time_slots"
+}
+
+func (c *StoreSpecificTimeSlotService) Migrate() error {
+	err := c.db.Set("gorm:table_options", "ENGINE=InnoDB").AutoMigrate(&StoreSpecificTimeSlotEntry{})
+	return err
+}
+
+// GetList returns the slots of the store dated between from and to both inclusive, a zero bound leaves that side open
+func (c *StoreSpecificTimeSlotService) GetList(ctx context.Context, storeID int64, from, to time.Time) ([]entities.StoreSpecificTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	db = db.Where("store_id = ?", storeID)
+	if !from.IsZero() {
+		db = db.Where("date >= ?", from.Format(valueobjects.DateLayout))
+	}
+	if !to.IsZero() {
+		db = db.Where("date <= ?", to.Format(valueobjects.DateLayout))
+	}
+	var entries []StoreSpecificTimeSlotEntry
+	err := db.Order("date asc, start_time asc, Specific_time_slot_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantGet, err)
+	}
+	slots := []entities.StoreSpecificTimeSlot{}
+	for _, entry := range entries {
+		slots = append(slots, c.ToEntity(entry))
+	}
+	return slots, nil
+}
+
+// LockDates reads the slots the store has dated between from and to both inclusive and locks them until the transaction
+// ends, so slots of the dates are checked against each other and written one writer at a time
+func (c *StoreSpecificTimeSlotService) LockDates(ctx context.Context, storeID int64, from, to time.Time) ([]entities.StoreSpecificTimeSlot, error) {
+	db := DBTransaction(ctx)
+	if db == nil {
+		return nil, fmt.Errorf("%w: dates lock needs a transaction", valueobjects.ErrSpecificTimeSlotCantGet)
+	}
+	var entries []StoreSpecificTimeSlotEntry
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("store_id = ? AND date >= ? AND date <= ?", storeID, from.Format(valueobjects.DateLayout), to.Format(valueobjects.DateLayout)).
+		Order("date asc, start_time asc, Specific_time_slot_id asc").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantGet, err)
+	}
+	slots := []entities.StoreSpecificTimeSlot{}
+	for _, entry := range entries {
+		slots = append(slots, c.ToEntity(entry))
+	}
+	return slots, nil
+}
+
+func (c *StoreSpecificTimeSlotService) Get(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID) (entities.StoreSpecificTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	var entry StoreSpecificTimeSlotEntry
+	err := db.Where("Specific_time_slot_id = ? and store_id = ?", slotID.ToInt64(), storeID).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entities.StoreSpecificTimeSlot{}, fmt.Errorf("%w: %d", valueobjects.ErrSpecificTimeSlotNotExists, slotID)
+		}
+		return entities.StoreSpecificTimeSlot{}, fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantGet, err)
+	}
+	return c.ToEntity(entry), nil
+}
+
+func (c *StoreSpecificTimeSlotService) CreateMultiple(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]entities.StoreSpecificTimeSlot, error) {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entries := []StoreSpecificTimeSlotEntry{}
+	for _, slot := range slots {
+		entries = append(entries, c.ToEntry(slot))
+	}
+	err := db.Create(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantCreate, err)
+	}
+	logger.Infof("%d specific time slots created for store id : %v", len(entries), entries[0].StoreID)
+
+	created := []entities.StoreSpecificTimeSlot{}
+	for _, entry := range entries {
+		created = append(created, c.ToEntity(entry))
+	}
+	return created, nil
+}
+
+// Update rewrites the slot
+func (c *StoreSpecificTimeSlotService) Update(ctx context.Context, slot entities.StoreSpecificTimeSlot) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	entry := c.ToEntry(slot)
+	response := db.Model(&StoreSpecificTimeSlotEntry{}).Where("Specific_time_slot_id = ? and store_id = ?", entry.ID, entry.StoreID).
+		Updates(map[string]interface{}{
+			"date":       entry.Date,
+			"start_time": entry.StartTime,
+			"end_time":   entry.EndTime,
+			"quota":      entry.Quota,
+			"is_closed":  entry.IsClosed,
+			"updated_by": entry.UpdatedBy,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantUpdate, response.Error)
+	}
+	logger.Infof("specific time slot with id %v updated successfully", entry.ID)
+	return nil
+}
+
+func (c *StoreSpecificTimeSlotService) Delete(ctx context.Context, storeID int64, slotID valueobjects.SpecificTimeSlotID, userID int64) error {
+	db := c.db
+	if ctxDB := DBTransaction(ctx); ctxDB != nil {
+		db = ctxDB
+	}
+	response := db.Model(&StoreSpecificTimeSlotEntry{}).Where("Specific_time_slot_id = ? and store_id = ?", slotID.ToInt64(), storeID).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now().UTC().Truncate(time.Second),
+			"deleted_by": userID,
+		})
+	if response.Error != nil {
+		return fmt.Errorf("%w: %v", valueobjects.ErrSpecificTimeSlotCantDelete, response.Error)
+	}
+	if response.RowsAffected < 1 {
+		return fmt.Errorf("%w: %d", valueobjects.ErrSpecificTimeSlotNotExists, slotID)
+	}
+	logger.Infof("specific time slot with id %v deleted successfully", slotID)
+	return nil
+}
+
+// ToEntry keeps the times of closed slots empty
+func (c *StoreSpecificTimeSlotService) ToEntry(slot entities.StoreSpecificTimeSlot) StoreSpecificTimeSlotEntry {
+	entry := StoreSpecificTimeSlotEntry{
+		ID:        slot.ID.ToInt64(),
+		StoreID:   slot.StoreID,
+		Date:      toDateColumn(slot.Date),
+		Quota:     slot.Quota,
+		IsClosed:  slot.IsClosed,
+		CreatedBy: slot.CreatedBy,
+		UpdatedBy: slot.UpdatedBy,
+	}
+	if !slot.IsClosed {
+		startTime := slot.StartTime.String() + ":00"
+		endTime := slot.EndTime.String() + ":00"
+		entry.StartTime = &startTime
+		entry.EndTime = &endTime
+	}
+	return entry
+}
+
+func (c *StoreSpecificTimeSlotService) ToEntity(entry StoreSpecificTimeSlotEntry) entities.StoreSpecificTimeSlot {
+	slot := entities.StoreSpecificTimeSlot{
+		ID:        valueobjects.SpecificTimeSlotID(entry.ID),
+		StoreID:   entry.StoreID,
+		Date:      fromDateColumn(entry.Date),
+		Quota:     entry.Quota,
+		IsClosed:  entry.IsClosed,
+		CreatedAt: entry.CreatedAt,
+		CreatedBy: entry.CreatedBy,
+		UpdatedAt: entry.UpdatedAt,
+		UpdatedBy: entry.UpdatedBy,
+	}
+	if entry.IsClosed {
+		return slot
+	}
+	var err error
+	if entry.StartTime != nil {
+		if slot.StartTime, err = valueobjects.ParseTimeOfDay(*entry.StartTime); err != nil {
+			logger.Errorf("specific time slot %v has invalid start time : %v", entry.ID, err)
+		}
+	}
+	if entry.EndTime != nil {
+		if slot.EndTime, err = valueobjects.ParseTimeOfDay(*entry.EndTime); err != nil {
+			logger.Errorf("specific time slot %v has invalid end time : %v", entry.ID, err)
+		}
+	}
+	return slot
+}
@@ -0,0 +1,126 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStoreSpecificTimeSlotService_GetList(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `store_specific_time_slots` WHERE store_id = ? AND date >= ? AND date <= ? AND `store_specific_time_slots`.`deleted_at` IS NULL ORDER BY date asc, start_time asc, Specific_time_slot_id asc"
+
+	t.Run("when slots and closures of the range fetched successfully", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		specificTimeSlotService := NewStoreSpecificTimeSlotService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, "2024-02-08", "2024-02-10").
+			WillReturnRows(sqlmock.NewRows([]string{"Specific_time_slot_id", "store_id", "date", "start_time", "end_time", "quota", "is_closed"}).
+				AddRow(1, 84, time.Date(2024, time.February, 8, 0, 0, 0, 0, time.Local), "08:00:00", "12:00:00", 40, false).
+				AddRow(2, 84, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.Local), nil, nil, 0, true))
+
+		from := time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC)
+		slots, err := specificTimeSlotService.GetList(context.TODO(), 84, from, from.AddDate(0, 0, 2))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := []entities.StoreSpecificTimeSlot{
+			{ID: 1, StoreID: 84, Date: from, StartTime: 480, EndTime: 720, Quota: 40},
+			{ID: 2, StoreID: 84, Date: from.AddDate(0, 0, 2), IsClosed: true},
+		}
+		if len(slots) != 2 || slots[0] != expected[0] || slots[1] != expected[1] {
+			t.Errorf("unexpected slots : got - %+v ; want - %+v", slots, expected)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotService_LockDates(t *testing.T) {
+	const sqlSelect = "SELECT * FROM `store_specific_time_slots` WHERE (store_id = ? AND date >= ? AND date <= ?) AND `store_specific_time_slots`.`deleted_at` IS NULL ORDER BY date asc, start_time asc, Specific_time_slot_id asc FOR UPDATE"
+	from := time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC)
+
+	t.Run("when slots of the dates locked within the transaction", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		specificTimeSlotService := NewStoreSpecificTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, "2024-02-08", "2024-02-09").
+			WillReturnRows(sqlmock.NewRows([]string{"Specific_time_slot_id", "store_id", "date", "start_time", "end_time", "quota", "is_closed"}).
+				AddRow(1, 84, time.Date(2024, time.February, 8, 0, 0, 0, 0, time.Local), "08:00:00", "12:00:00", 40, false))
+		mock.ExpectCommit()
+
+		transactionService := NewTransactionService(specificTimeSlotService.db)
+		var slots []entities.StoreSpecificTimeSlot
+		err := transactionService.RunWithTransaction(context.TODO(), func(ctx context.Context) error {
+			var err error
+			slots, err = specificTimeSlotService.LockDates(ctx, 84, from, from.AddDate(0, 0, 1))
+			return err
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 1 || slots[0].ID != 1 {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+
+	t.Run("when slots of the dates locked without a transaction", func(t *testing.T) {
+		db, _ := newMockDB(t)
+		specificTimeSlotService := NewStoreSpecificTimeSlotService(db)
+
+		_, err := specificTimeSlotService.LockDates(context.TODO(), 84, from, from)
+		if !errors.Is(err, valueobjects.ErrSpecificTimeSlotCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSpecificTimeSlotCantGet)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotService_CreateMultiple(t *testing.T) {
+	const sqlInsert = "INSERT INTO `store_specific_time_slots`"
+
+	t.Run("when closure created without times", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		specificTimeSlotService := NewStoreSpecificTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlInsert)).
+			WithArgs(84, sqlmock.AnyArg(), nil, nil, 0, true, 0, sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 0, nil, 0).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectCommit()
+
+		slots, err := specificTimeSlotService.CreateMultiple(context.TODO(), []entities.StoreSpecificTimeSlot{
+			{StoreID: 84, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), IsClosed: true, CreatedBy: 7},
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 1 || slots[0].ID != 5 || !slots[0].IsClosed {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotService_Delete(t *testing.T) {
+	const sqlDelete = "UPDATE `store_specific_time_slots` SET `deleted_at`=?,`deleted_by`=?,`updated_at`=? WHERE (Specific_time_slot_id = ? and store_id = ?) AND `store_specific_time_slots`.`deleted_at` IS NULL"
+
+	t.Run("when slot not exists", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		specificTimeSlotService := NewStoreSpecificTimeSlotService(db)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDelete)).WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 9, 84).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := specificTimeSlotService.Delete(context.TODO(), 84, valueobjects.SpecificTimeSlotID(9), 7)
+		if !errors.Is(err, valueobjects.ErrSpecificTimeSlotNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSpecificTimeSlotNotExists)
+		}
+	})
+}
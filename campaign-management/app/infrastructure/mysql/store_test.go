@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStoreService_GetList(t *testing.T) {
+	t.Run("when stores are filtered by region and activity", func(t *testing.T) {
+		const sqlCount = "SELECT count(*) FROM `stores` WHERE region = ? AND is_active = ? AND `stores`.`deleted_at` IS NULL"
+		const sqlSelect = "SELECT * FROM `stores` WHERE region = ? AND is_active = ? AND `stores`.`deleted_at` IS NULL ORDER BY `name`,store_id asc LIMIT 10 OFFSET 10"
+		db, mock := newMockDB(t)
+		storeService := NewStoreService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCount)).WithArgs("Central", true).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs("Central", true).
+			WillReturnRows(sqlmock.NewRows([]string{"store_id", "name", "region", "is_active"}).AddRow(84, "Orchard", "Central", true))
+
+		isActive := true
+		stores, count, err := storeService.GetList(context.TODO(), entities.StoreFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Offset: 10, SortFields: []entities.SortField{{Column: "name"}}},
+			Region:           "Central",
+			IsActive:         &isActive,
+		})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if count != 11 || len(stores) != 1 || stores[0].ID != 84 || stores[0].Name != "Orchard" || !stores[0].IsActive {
+			t.Errorf("unexpected stores : got - %+v, %d", stores, count)
+		}
+	})
+}
+
+func TestStoreService_GetByIDs(t *testing.T) {
+	t.Run("when only some of the stores are registered", func(t *testing.T) {
+		const sqlSelect = "SELECT * FROM `stores` WHERE store_id in (?,?) AND `stores`.`deleted_at` IS NULL"
+		db, mock := newMockDB(t)
+		storeService := NewStoreService(db)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSelect)).WithArgs(84, 85).
+			WillReturnRows(sqlmock.NewRows([]string{"store_id", "name", "region", "is_active"}).AddRow(84, "Orchard", "Central", false))
+
+		stores, err := storeService.GetByIDs(context.TODO(), []int64{84, 85})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(stores) != 1 || stores[0].ID != 84 || stores[0].IsActive {
+			t.Errorf("unexpected stores : got - %+v", stores)
+		}
+	})
+
+	t.Run("when no stores are asked for", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		storeService := NewStoreService(db)
+
+		stores, err := storeService.GetByIDs(context.TODO(), nil)
+		if err != nil || len(stores) != 0 {
+			t.Errorf("unexpected result : got - %+v, %v", stores, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations : %v", err)
+		}
+	})
+}
@@ -0,0 +1,329 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const IncorrectBlackoutIDErr = "incorrect blackout id value, err : %v"
+
+// MaxBlackoutCalendarBytes is the largest calendar file accepted by the import
+const MaxBlackoutCalendarBytes = 1 << 20
+
+type BlackoutController struct {
+	blackoutUseCases usecases.BlackoutUseCases
+}
+
+func NewBlackoutController(blackoutUseCases usecases.BlackoutUseCases) *BlackoutController {
+	return &BlackoutController{
+		blackoutUseCases: blackoutUseCases,
+	}
+}
+
+func (c *BlackoutController) Init(r chi.Router) {
+	r.Route("/blackouts", func(r chi.Router) {
+		r.Get("/", c.GetBlackouts)
+		r.Post("/", c.CreateBlackout)
+		r.Post("/import", c.ImportBlackouts)
+		r.Get("/{id}", c.GetBlackout)
+		r.Put("/{id}", c.UpdateBlackout)
+		r.Delete("/{id}", c.DeleteBlackout)
+	})
+}
+
+// GetBlackouts godoc
+//
+//	@Summary Get blackouts
+//	@Description API to get the blackouts of every store, along with the ones of particular store when store_id is given, optionally limited to a date range
+//	@Tags blackouts
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	query int false "Store ID"
+//	@Param	from	query string false "First date, YYYY-MM-DD"
+//	@Param	to	query string false "Last date, YYYY-MM-DD"
+//	@Success 200 {object} dto.BlackoutListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts [get]
+func (c *BlackoutController) GetBlackouts(w http.ResponseWriter, r *http.Request) {
+	storeID, err := c.storeIDParam(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	var from, to time.Time
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		dto.BadRequestJSON(w, r, "to date must not be before from date")
+		return
+	}
+
+	blackouts, err := c.blackoutUseCases.GetBlackouts(r.Context(), storeID, from, to)
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToBlackoutListResponse(blackouts))
+}
+
+// GetBlackout godoc
+//
+//	@Summary Get blackout
+//	@Description API to get particular blackout
+//	@Tags blackouts
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Blackout ID"
+//	@Success 200 {object} dto.BlackoutResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts/{id} [get]
+func (c *BlackoutController) GetBlackout(w http.ResponseWriter, r *http.Request) {
+	blackoutID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectBlackoutIDErr, err.Error()))
+		return
+	}
+	blackout, err := c.blackoutUseCases.GetBlackout(r.Context(), int64(blackoutID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToBlackoutResponse(blackout))
+}
+
+// CreateBlackout godoc
+//
+//	@Summary Add blackout
+//	@Description API to close collections on a date range, at particular store or at every store when store_id is left out.
+//	@Description Collection slots are not offered on blacked out dates and a campaign can not collect on a date blacked out for every store or for one of its stores
+//	@Tags blackouts
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	blackout body params.BlackoutForm true "Blackout details"
+//	@Success 200 {object} dto.BlackoutResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts [post]
+func (c *BlackoutController) CreateBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	request, err := c.validateBlackoutRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	blackoutEntity, err := params.ToBlackoutEntity(request, 0, int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	blackout, err := c.blackoutUseCases.CreateBlackout(ctx, blackoutEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToBlackoutResponse(blackout))
+}
+
+// UpdateBlackout godoc
+//
+//	@Summary Update blackout
+//	@Description API to update particular blackout
+//	@Tags blackouts
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Blackout ID"
+//	@Param	blackout body params.BlackoutForm true "Blackout details"
+//	@Success 200 {object} dto.BlackoutResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts/{id} [put]
+func (c *BlackoutController) UpdateBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	blackoutID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectBlackoutIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateBlackoutRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	blackoutEntity, err := params.ToBlackoutEntity(request, int64(blackoutID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	blackout, err := c.blackoutUseCases.UpdateBlackout(ctx, blackoutEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToBlackoutResponse(blackout))
+}
+
+// DeleteBlackout godoc
+//
+//	@Summary Delete blackout
+//	@Description API to delete particular blackout
+//	@Tags blackouts
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Blackout ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts/{id} [delete]
+func (c *BlackoutController) DeleteBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	blackoutID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectBlackoutIDErr, err.Error()))
+		return
+	}
+
+	err = c.blackoutUseCases.DeleteBlackout(ctx, int64(blackoutID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("blackout with id %d deleted successfully", blackoutID))
+}
+
+// ImportBlackouts godoc
+//
+//	@Summary Import blackouts from calendar
+//	@Description API to add the events of an iCalendar (.ics) file as blackouts, of particular store or of every store when store_id is left out.
+//	@Description Every event blacks out each date it touches, cancelled events are left out and recurring events are refused.
+//	@Description Events imported before are matched by their UID and updated, so importing the same calendar again adds nothing
+//	@Tags blackouts
+//	@Accept plain
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	query int false "Store ID"
+//	@Param	calendar body string true "iCalendar file content"
+//	@Success 200 {object} dto.BlackoutImportResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/blackouts/import [post]
+func (c *BlackoutController) ImportBlackouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := c.storeIDParam(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	defer r.Body.Close()
+	blackouts, err := params.ParseBlackoutCalendar(http.MaxBytesReader(w, r.Body, MaxBlackoutCalendarBytes), storeID, int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	result, err := c.blackoutUseCases.ImportBlackouts(ctx, storeID, blackouts)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToBlackoutImportResponse(result))
+}
+
+// storeIDParam returns the store_id query parameter, 0 when left out
+func (c *BlackoutController) storeIDParam(r *http.Request) (int64, error) {
+	value := r.URL.Query().Get("store_id")
+	if value == "" {
+		return 0, nil
+	}
+	storeID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if storeID < 0 {
+		return 0, errors.New("store id must not be negative")
+	}
+	return storeID, nil
+}
+
+func (c *BlackoutController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrBlackoutInvalid):
+		dto.BadRequestJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrBlackoutNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *BlackoutController) validateBlackoutRequest(r *http.Request) (params.BlackoutForm, error) {
+	var request params.BlackoutForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
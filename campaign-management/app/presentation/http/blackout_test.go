@@ -0,0 +1,127 @@
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+// newOpenBlackoutUseCases returns blackout use cases that leave every collection date open
+func newOpenBlackoutUseCases(t *testing.T) *mocks.BlackoutUseCases {
+	blackoutUseCases := mocks.NewBlackoutUseCases(t)
+	blackoutUseCases.On("CheckCollectionDates", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return blackoutUseCases
+}
+
+func newBlackoutRequest(method, target, body string, urlParams map[string]string) *http.Request {
+	req, _ := http.NewRequest(method, target, bytes.NewBufferString(body))
+	ctx := chi.NewRouteContext()
+	for key, value := range urlParams {
+		ctx.URLParams.Add(key, value)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+}
+
+func TestBlackoutController_CreateBlackout(t *testing.T) {
+	t.Run("Create Blackout request success", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/blackouts", `{"store_id": 84, "start_date": "2024-02-10", "end_date": "2024-02-11", "reason": "Lunar New Year"}`, nil)
+		w := httptest.NewRecorder()
+		mockBlackoutUsecase := mocks.NewBlackoutUseCases(t)
+		controller := NewBlackoutController(mockBlackoutUsecase)
+		mockBlackoutUsecase.On("CreateBlackout", req.Context(), entities.Blackout{StoreID: 84,
+			StartDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC),
+			Reason: "Lunar New Year", CreatedBy: 12345}).
+			Return(&dto.BlackoutDTO{ID: 2, StoreID: 84, StartDate: "2024-02-10", EndDate: "2024-02-11", Reason: "Lunar New Year"}, nil)
+
+		controller.CreateBlackout(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"blackout_id":2,"store_id":84,"start_date":"2024-02-10","end_date":"2024-02-11","reason":"Lunar New Year"}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Create Blackout request ending before it starts", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/blackouts", `{"start_date": "2024-02-10", "end_date": "2024-02-09"}`, nil)
+		w := httptest.NewRecorder()
+		mockBlackoutUsecase := mocks.NewBlackoutUseCases(t)
+		controller := NewBlackoutController(mockBlackoutUsecase)
+		mockBlackoutUsecase.On("CreateBlackout", req.Context(), mock.Anything).
+			Return(nil, fmt.Errorf("%w: end date 2024-02-09 is before start date 2024-02-10", valueobjects.ErrBlackoutInvalid))
+
+		controller.CreateBlackout(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestBlackoutController_ImportBlackouts(t *testing.T) {
+	calendar := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:cny-2024\r\nSUMMARY:Lunar New Year\r\nDTSTART;VALUE=DATE:20240210\r\n" +
+		"DTEND;VALUE=DATE:20240212\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	t.Run("Import Blackouts request success", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/blackouts/import?store_id=84", calendar, nil)
+		w := httptest.NewRecorder()
+		mockBlackoutUsecase := mocks.NewBlackoutUseCases(t)
+		controller := NewBlackoutController(mockBlackoutUsecase)
+		mockBlackoutUsecase.On("ImportBlackouts", req.Context(), int64(84), []entities.Blackout{{StoreID: 84,
+			StartDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC),
+			Reason: "Lunar New Year", UID: "cny-2024", CreatedBy: 12345}}).
+			Return(&dto.BlackoutImportResult{Created: 1, Blackouts: []*dto.BlackoutDTO{
+				{ID: 3, StoreID: 84, StartDate: "2024-02-10", EndDate: "2024-02-11", Reason: "Lunar New Year", UID: "cny-2024"},
+			}}, nil)
+
+		controller.ImportBlackouts(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"created":1,"updated":0,"blackouts":[{"blackout_id":3,"store_id":84,"start_date":"2024-02-10","end_date":"2024-02-11","reason":"Lunar New Year","uid":"cny-2024"}]}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Import Blackouts request with recurring event", func(t *testing.T) {
+		recurring := strings.Replace(calendar, "END:VEVENT", "RRULE:FREQ=YEARLY\r\nEND:VEVENT", 1)
+		req := newBlackoutRequest("POST", "/blackouts/import", recurring, nil)
+		w := httptest.NewRecorder()
+		controller := NewBlackoutController(mocks.NewBlackoutUseCases(t))
+
+		controller.ImportBlackouts(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Import Blackouts request with negative store", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/blackouts/import?store_id=-1", calendar, nil)
+		w := httptest.NewRecorder()
+		controller := NewBlackoutController(mocks.NewBlackoutUseCases(t))
+
+		controller.ImportBlackouts(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
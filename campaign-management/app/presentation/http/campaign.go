@@ -1,623 +1,1403 @@
-package http
-
-import (
-	"campaign-mgmt/app/domain/services"
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"net/http"
-	"strconv"
-	"time"
-
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/usecases"
-	"campaign-mgmt/app/usecases/dto"
-	"campaign-mgmt/app/usecases/params"
-	"campaign-mgmt/app/usecases/util"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
-)
-
-type CampaignController struct {
-	campaignUseCases        usecases.CampaignUseCases
-	campaignStoreUseCases   usecases.CampaignStoreUseCases
-	campaignProductUseCases usecases.CampaignProductUseCases
-	tx                      services.TransactionService
-	appConfig               *entities.AppCfg
-}
-
-func NewCampaignController(
-	campaignUseCases usecases.CampaignUseCases,
-	storeUseCases usecases.CampaignStoreUseCases,
-	productUsecases usecases.CampaignProductUseCases,
-	transactionService services.TransactionService,
-	appConfig *entities.AppCfg) *CampaignController {
-	return &CampaignController{
-		campaignUseCases:        campaignUseCases,
-		campaignStoreUseCases:   storeUseCases,
-		campaignProductUseCases: productUsecases,
-		tx:                      transactionService,
-		appConfig:               appConfig,
-	}
-}
-
-func (c *CampaignController) Init(r chi.Router) {
-	r.Route("/campaigns", func(r chi.Router) {
-		r.Post("/", c.CreateCampaign)
-		r.Put("/{id}", c.UpdateCampaign)
-		r.Get("/{id}", c.GetCampaign)
-		r.Get("/", c.GetCampaignList)
-		r.Put("/update-status", c.UpdateCampaignStatus)
-	})
-}
-
-// GetCampaign godoc
-//
-//	@Summary Get campaign details by id
-//	@Description API to get details of particular campaign
-//	@Tags campaign
-//	@Produce json
-//	@Param	id	path int true "Campaign ID"
-//	@Param	omit_products query boolean false "Omit Products"
-//	@Param	omit_stores query boolean false "Omit Stores"
-//	@Success 200 {object} dto.CampaignResponse
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{id} [get]
-func (c *CampaignController) GetCampaign(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "id"))
-	if campaignIDError != nil {
-		dto.BadRequestJSON(w, r, campaignIDError.Error())
-	}
-	omitProductsOptional := false
-	omitStoresOptional := false
-	if r.URL.Query().Get("omit_products") != "" {
-		omitProducts, omitProductsErr := strconv.ParseBool(r.URL.Query().Get("omit_products"))
-		if omitProductsErr != nil {
-			dto.BadRequestJSON(w, r, omitProductsErr.Error())
-		}
-		omitProductsOptional = omitProducts
-	}
-	if r.URL.Query().Get("omit_stores") != "" {
-		omitStores, omitStoresErr := strconv.ParseBool(r.URL.Query().Get("omit_stores"))
-		if omitStoresErr != nil {
-			dto.BadRequestJSON(w, r, omitStoresErr.Error())
-		}
-		omitStoresOptional = omitStores
-	}
-
-	response, CampaignDataErr := c.campaignUseCases.Get(ctx, int64(campaignID))
-	if CampaignDataErr != nil {
-		dto.InternalServerErrorJSON(w, r, "Error occured on get campaign details")
-	}
-
-	if !omitStoresOptional {
-		storeDetails, storeDetailsErr := c.getStores(ctx, int64(campaignID))
-		if storeDetailsErr != nil {
-			dto.InternalServerErrorJSON(w, r, "Error occured on get store details: "+storeDetailsErr.Error())
-		}
-		response.CampaignStores = storeDetails
-	}
-	if !omitProductsOptional {
-		productDetails, productDetailsErr := c.getProducts(ctx, int64(campaignID))
-		if productDetailsErr != nil {
-			dto.InternalServerErrorJSON(w, r, "Error occured on get product details : "+productDetailsErr.Error())
-		}
-		response.CampaignProducts = productDetails
-	}
-
-	render.JSON(w, r, dto.ToCampaignResponse(*response))
-}
-
-func (c *CampaignController) getStores(ctx context.Context, campaignID int64) ([]*dto.CampaignStores, error) {
-	storeDetails, err := c.campaignStoreUseCases.GetStores(ctx, campaignID)
-	if err != nil {
-		return nil, err
-	}
-	return storeDetails, nil
-}
-
-func (c *CampaignController) getProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error) {
-	productDetails, err := c.campaignProductUseCases.GetProducts(ctx, campaignID)
-	if err != nil {
-		return nil, err
-	}
-	return productDetails, nil
-}
-
-// CreateCampaign godoc
-//
-//	@Summary Create a campaign
-//	@Description API to create new campaign
-//	@Tags campaign
-//	@Accept json
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Param	campaign body params.CampaignCreationForm	true "Add campaign details"
-//	@Success 200 {object} dto.CampaignDTO
-//	@Failure 400 {object} dto.Response
-//	@Failure 409 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns [post]
-func (c *CampaignController) CreateCampaign(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	var exists bool
-
-	userID, err := util.GetUserID(ctx)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-	campaignRequest, err := c.validateCampaignRequest(r)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-
-	exists, err = c.campaignUseCases.Exists(ctx, 0, campaignRequest.Title)
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-	if exists {
-		dto.ConflictErrorJSON(w, r, "campaign with given name already exists")
-		return
-	}
-
-	response, err := c.create(ctx, campaignRequest, int64(userID))
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	dto.SuccessJSONResponse(w, r, response)
-}
-
-func (c *CampaignController) create(ctx context.Context, request params.CampaignCreationForm, userID int64) (*dto.CampaignDTO, error) {
-	response := &dto.CampaignDTO{}
-	var err error
-	c.tx.RunWithTransaction(
-		ctx, func(ctx context.Context) error {
-			if response, err = c.saveCampaignDetails(ctx, request, userID); err != nil {
-				return err
-			}
-			return nil
-		})
-
-	return response, err
-}
-
-func (c *CampaignController) saveCampaignDetails(ctx context.Context, request params.CampaignCreationForm, userID int64) (*dto.CampaignDTO, error) {
-	campaignEntity, err := params.ToCampaignEntity(request)
-	campaignEntity.CreatedBy = userID
-	if err != nil {
-		return nil, err
-	}
-	campaignDetails, err := c.campaignUseCases.Create(ctx, campaignEntity)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(request.Stores) != 0 {
-		storesDetails, err := c.addStores(ctx, request.Stores, campaignDetails.ID, userID)
-		if err != nil {
-			return nil, err
-		}
-		campaignDetails.CampaignStores = storesDetails
-	}
-
-	return campaignDetails, nil
-}
-
-func (c *CampaignController) addStores(ctx context.Context, storeIDs []int64, campaignID, userID int64) ([]*dto.CampaignStores, error) {
-	storeEntities := []entities.CampaignStore{}
-	for _, storeID := range storeIDs {
-		storeEntities = append(storeEntities, params.ToCampaignStoreEntity(storeID, campaignID, userID))
-	}
-	storeDetails, err := c.campaignStoreUseCases.AddStores(ctx, storeEntities)
-	if err != nil {
-		return nil, err
-	}
-	return storeDetails, nil
-}
-
-// func (c *CampaignController) addProducts(ctx context.Context, productRequest []params.CampaignProduct, campaignID, userID int64) ([]*dto.CampaignProducts, error) {
-// 	productEntities := []entities.CampaignProduct{}
-// 	for _, product := range productRequest {
-// 		productEntities = append(productEntities, params.ToCampaignProductEntity(product, campaignID, userID))
-// 	}
-// 	productDetails, err := c.campaignProductUseCases.AddProducts(ctx, productEntities)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return productDetails, nil
-// }
-
-// UpdateCampaign godoc
-//
-//	@Summary Update campaign details
-//	@Description API to update an existing campaign
-//	@Tags campaign
-//	@Accept json
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Param	id	path int true "Campaign ID"
-//	@Param	campaign body params.CampaignUpdateForm	true "campaign details"
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 409 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{id} [put]
-func (c *CampaignController) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	userID, err := util.GetUserID(ctx)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-
-	campaignID, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
-		return
-	}
-
-	campaignRequest, err := c.validateUpdateCampaignRequest(r)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-
-	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-	if !exists {
-		dto.BadRequestJSON(w, r, "campaign with given id not exists")
-		return
-	}
-
-	err = c.update(ctx, int64(campaignID), campaignRequest, int64(userID))
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-	dto.SuccessJSON(w, r, fmt.Sprintf("campaign with id %d updated successfully", campaignID))
-}
-
-func (c *CampaignController) update(ctx context.Context, campaignID int64, request params.CampaignUpdateForm, userID int64) error {
-	var err error
-	c.tx.RunWithTransaction(
-		ctx, func(ctx context.Context) error {
-			if err = c.updateCampaignDetails(ctx, campaignID, request, userID); err != nil {
-				return err
-			}
-			return nil
-		})
-
-	return err
-}
-
-func (c *CampaignController) validateCampaignRequest(r *http.Request) (params.CampaignCreationForm, error) {
-	var campaignRequest params.CampaignCreationForm
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&campaignRequest)
-	if err != nil {
-		return campaignRequest, err
-	}
-	defer r.Body.Close()
-
-	validate := validator.New()
-	err = validate.Struct(campaignRequest)
-	if err != nil {
-		return campaignRequest, err
-	}
-
-	dates := params.CampaignDates{
-		OrderStartDate:      campaignRequest.OrderStartDate,
-		OrderEndDate:        campaignRequest.OrderEndDate,
-		CollectionStartDate: campaignRequest.CollectionStartDate,
-		CollectionEndDate:   campaignRequest.CollectionEndDate,
-	}
-
-	err = c.validateCampaignDates(dates, campaignRequest.LeadTime)
-	if err != nil {
-		return campaignRequest, err
-	}
-	return campaignRequest, nil
-}
-
-func (c *CampaignController) validateUpdateCampaignRequest(r *http.Request) (params.CampaignUpdateForm, error) {
-	var campaignRequest params.CampaignUpdateForm
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&campaignRequest)
-	if err != nil {
-		return campaignRequest, err
-	}
-	defer r.Body.Close()
-
-	validate := validator.New()
-	err = validate.Struct(campaignRequest)
-	if err != nil {
-		return campaignRequest, err
-	}
-
-	dates := params.CampaignDates{
-		OrderStartDate:      campaignRequest.OrderStartDate,
-		OrderEndDate:        campaignRequest.OrderEndDate,
-		CollectionStartDate: campaignRequest.CollectionStartDate,
-		CollectionEndDate:   campaignRequest.CollectionEndDate,
-	}
-
-	err = c.validateCampaignDates(dates, campaignRequest.LeadTime)
-	if err != nil {
-		return campaignRequest, err
-	}
-	return campaignRequest, nil
-}
-
-func (c *CampaignController) validateCampaignDates(dates params.CampaignDates, leadTime int) error {
-	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
-	var err error
-	orderStartDate, err = util.ToDateTime(dates.OrderStartDate)
-	if err != nil {
-		return err
-	}
-	orderEndDate, err = util.ToDateTime(dates.OrderEndDate)
-	if err != nil {
-		return err
-	}
-	collectionStartDate, err = util.ToDateTime(dates.CollectionStartDate)
-	if err != nil {
-		return err
-	}
-	collectionEndDate, err = util.ToDateTime(dates.CollectionEndDate)
-	if err != nil {
-		return err
-	}
-	if orderStartDate.After(orderEndDate) {
-		return errors.New("invalid date : order start date should be the date before order end date")
-	}
-	if orderStartDate.After(collectionStartDate) {
-		return errors.New("invalid date : order start date should be the date before collection start date")
-	}
-	if collectionStartDate.After(collectionEndDate) {
-		return errors.New("invalid date : collection start date should be the date before collection end date")
-	}
-
-	err = c.checkStartDate(orderStartDate, collectionStartDate, leadTime)
-	if err != nil {
-		return err
-	}
-	err = c.checkEndDate(orderEndDate, collectionEndDate, leadTime)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (c *CampaignController) checkStartDate(orderStartDate, collectionStartDate time.Time, leadTime int) error {
-	if !orderStartDate.IsZero() && !collectionStartDate.IsZero() {
-		if int64(collectionStartDate.Sub(orderStartDate).Hours()/24) < int64(leadTime) {
-			return fmt.Errorf("invalid date : Collection start date should be at least %d days greater than order start date",
-				leadTime)
-		} else if int64(collectionStartDate.Sub(orderStartDate).Hours()/24) > int64(c.appConfig.ValidationParam.MaxDateDifference) {
-			return fmt.Errorf("invalid date : Collection start date should be less than %d days from order start date",
-				c.appConfig.ValidationParam.MaxDateDifference)
-		}
-	}
-	return nil
-}
-
-func (c *CampaignController) checkEndDate(orderEndDate, collectionEndDate time.Time, leadTime int) error {
-	if !orderEndDate.IsZero() && !collectionEndDate.IsZero() {
-		if int64(collectionEndDate.Sub(orderEndDate).Hours()/24) < int64(leadTime) {
-			return fmt.Errorf("invalid date : Collection end date should be at least %d days greater than order end date",
-				leadTime)
-		} else if int64(collectionEndDate.Sub(orderEndDate).Hours()/24) > int64(c.appConfig.ValidationParam.MaxDateDifference) {
-			return fmt.Errorf("invalid date : Collection end date should be less than %d days from order end date",
-				c.appConfig.ValidationParam.MaxDateDifference)
-		}
-	}
-	return nil
-}
-
-func (c *CampaignController) updateCampaignDetails(ctx context.Context, campaignID int64, request params.CampaignUpdateForm, userID int64) error {
-	campaignEntity, err := params.ToUpdateCampaignEntity(request, campaignID)
-	campaignEntity.UpdatedBy = userID
-	if err != nil {
-		return err
-	}
-	err = c.campaignUseCases.Update(ctx, campaignEntity)
-	if err != nil {
-		return err
-	}
-
-	err = c.updateStores(ctx, campaignID, request.Stores, userID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (c *CampaignController) updateStores(ctx context.Context, campaignID int64, stores []int64, userID int64) error {
-	var newStores []entities.CampaignStore
-	for _, storeID := range stores {
-		_, err := c.campaignStoreUseCases.GetByStoreID(ctx, campaignID, storeID)
-		if err != nil && errors.As(err, &valueobjects.ErrStoreNotExists) {
-			storeEntity := params.ToCampaignStoreEntity(storeID, campaignID, userID)
-			newStores = append(newStores, storeEntity)
-		} else if err != nil {
-			return err
-		}
-
-		// this is additional code for future reference.
-		// currently there are no additional fields to
-		// update, that's why there is no need to update the store entity
-		// var existingStores []entities.CampaignStore
-		// _ = storeDetails
-		// if storeDetails.ID > 0 {
-		// 	store := params.UpdateCampaignStore{
-		// 		ID:      storeDetails.ID,
-		// 		StoreID: storeDetails.StoreID,
-		// 	}
-		// 	existingStoreEntity := params.ToUpdateCampaignStoreEntity(store, campaignID, userID)
-		// 	existingStores = append(existingStores, existingStoreEntity)
-		// }
-
-	}
-
-	// this is additional code for future reference. currently there are no additional fields to
-	// update, that's why there is no need to update the store entity
-	// if len(existingStores) > 0 {
-	// 	err := c.campaignStoreUseCases.UpdateStores(ctx, existingStores)
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// }
-
-	dbStoreIDS := []int64{}
-	dbStores, err := c.campaignStoreUseCases.GetStores(ctx, campaignID)
-	if err != nil {
-		return err
-	}
-	for _, store := range dbStores {
-		dbStoreIDS = append(dbStoreIDS, store.StoreID)
-	}
-	storesToDelete := util.Difference(dbStoreIDS, stores)
-
-	if len(newStores) > 0 {
-		_, err := c.campaignStoreUseCases.AddStores(ctx, newStores)
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, id := range storesToDelete {
-		err := c.campaignStoreUseCases.DeleteByStoreID(ctx, campaignID, id, userID)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// GetCampaignList godoc
-//
-//	@Summary Get list of all campaigns
-//	@Description API to get details of all campaigns
-//	@Tags campaign
-//	@Produce json
-//	@Param	page query int false "Page Number"
-//	@Param	limit query int false "Limit"
-//	@Param	sort query string false "Sort Type [created_at asc/created_at desc]"
-//	@Param	name query string false "Campaign Name"
-//	@Param	status query string false "Campaign Status [InActive/Active/Scheduled]"
-//	@Success 200 {object} dto.CampaignListResponse
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns [get]
-func (c *CampaignController) GetCampaignList(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	pagination := c.generatePaginationFromRequest(r)
-	paginationData := params.ToPaginationEntity(pagination)
-	response, err := c.campaignUseCases.GetList(ctx, paginationData)
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-	render.JSON(w, r, response)
-}
-
-func (c *CampaignController) generatePaginationFromRequest(r *http.Request) params.Pagination {
-	// Initializing default
-	paginationConfig := c.appConfig.PaginationConfig
-	limit := paginationConfig.Limit
-	page := paginationConfig.Page
-	sort := paginationConfig.Sort
-	query := r.URL.Query()
-	name := paginationConfig.Name
-	var statusCode int64
-
-	for key, value := range query {
-		queryValue := value[len(value)-1]
-		switch key {
-		case "limit":
-			limit, _ = strconv.Atoi(queryValue)
-			break
-		case "page":
-			page, _ = strconv.Atoi(queryValue)
-			break
-		case "sort":
-			sort = queryValue
-			break
-		case "name":
-			name = queryValue
-		case "status":
-			switch queryValue {
-			case "InActive":
-				statusCode = valueobjects.CampaignStatusInActive.Code()
-				break
-			case "Active":
-				statusCode = valueobjects.CampaignStatusActive.Code()
-				break
-			case "Scheduled":
-				statusCode = valueobjects.CampaignStatusScheduled.Code()
-				break
-			}
-			break
-		}
-	}
-
-	return params.Pagination{
-		Limit:  limit,
-		Page:   page,
-		Sort:   sort,
-		Name:   name,
-		Status: statusCode,
-	}
-}
-
-// UpdateCampaignStatus godoc
-//
-//	@Summary Update status of campaign
-//	@Description API to update the status of campaign
-//	@Tags campaign
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 409 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/update-status [put]
-func (c *CampaignController) UpdateCampaignStatus(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	err := c.updateStatus(ctx)
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-	dto.SuccessJSON(w, r, "campaigns status updated successfully")
-}
-
-func (c *CampaignController) updateStatus(ctx context.Context) error {
-	var err error
-	c.tx.RunWithTransaction(
-		ctx, func(ctx context.Context) error {
-			if err = c.campaignUseCases.UpdateStatus(ctx); err != nil {
-				return err
-			}
-			return nil
-		})
-	return err
-}
+package http
+
+import (
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type CampaignController struct {
+	campaignUseCases        usecases.CampaignUseCases
+	campaignStoreUseCases   usecases.CampaignStoreUseCases
+	campaignProductUseCases usecases.CampaignProductUseCases
+	blackoutUseCases        usecases.BlackoutUseCases
+	tx                      services.TransactionService
+	appConfig               *entities.AppCfg
+}
+
+func NewCampaignController(
+	campaignUseCases usecases.CampaignUseCases,
+	storeUseCases usecases.CampaignStoreUseCases,
+	productUsecases usecases.CampaignProductUseCases,
+	blackoutUseCases usecases.BlackoutUseCases,
+	transactionService services.TransactionService,
+	appConfig *entities.AppCfg) *CampaignController {
+	return &CampaignController{
+		campaignUseCases:        campaignUseCases,
+		campaignStoreUseCases:   storeUseCases,
+		campaignProductUseCases: productUsecases,
+		blackoutUseCases:        blackoutUseCases,
+		tx:                      transactionService,
+		appConfig:               appConfig,
+	}
+}
+
+func (c *CampaignController) Init(r chi.Router) {
+	r.Route("/campaigns", func(r chi.Router) {
+		r.Post("/", c.CreateCampaign)
+		r.Put("/{id}", c.UpdateCampaign)
+		r.Get("/{id}", c.GetCampaign)
+		r.Get("/", c.GetCampaignList)
+		r.Get("/summary", c.GetCampaignSummary)
+		r.Put("/update-status", c.UpdateCampaignStatus)
+		r.Delete("/{id}", c.DeleteCampaign)
+		r.Post("/{id}/restore", c.RestoreCampaign)
+		r.Post("/{id}/clone", c.CloneCampaign)
+	})
+	r.Get("/stores/{store_id}/campaigns", c.GetStoreCampaigns)
+	r.Get("/products/{product_id}/campaigns", c.GetProductCampaigns)
+}
+
+// OverlapHeader lists the campaigns a change overlaps when the overlap mode only warns about them
+const OverlapHeader = "X-Overlapping-Campaign-Ids"
+
+func setOverlapHeader(w http.ResponseWriter, campaignIDs []int64) {
+	if len(campaignIDs) == 0 {
+		return
+	}
+	ids := []string{}
+	for _, campaignID := range campaignIDs {
+		ids = append(ids, strconv.FormatInt(campaignID, 10))
+	}
+	w.Header().Set(OverlapHeader, strings.Join(ids, ","))
+}
+
+// GetCampaign godoc
+//
+//	@Summary Get campaign details by id
+//	@Description API to get details of particular campaign
+//	@Tags campaign
+//	@Produce json
+//	@Param	id	path int true "Campaign ID"
+//	@Param	omit_products query boolean false "Omit Products"
+//	@Param	omit_stores query boolean false "Omit Stores"
+//	@Success 200 {object} dto.CampaignResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{id} [get]
+func (c *CampaignController) GetCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "id"))
+	if campaignIDError != nil {
+		dto.BadRequestJSON(w, r, campaignIDError.Error())
+	}
+	omitProductsOptional := false
+	omitStoresOptional := false
+	if r.URL.Query().Get("omit_products") != "" {
+		omitProducts, omitProductsErr := strconv.ParseBool(r.URL.Query().Get("omit_products"))
+		if omitProductsErr != nil {
+			dto.BadRequestJSON(w, r, omitProductsErr.Error())
+		}
+		omitProductsOptional = omitProducts
+	}
+	if r.URL.Query().Get("omit_stores") != "" {
+		omitStores, omitStoresErr := strconv.ParseBool(r.URL.Query().Get("omit_stores"))
+		if omitStoresErr != nil {
+			dto.BadRequestJSON(w, r, omitStoresErr.Error())
+		}
+		omitStoresOptional = omitStores
+	}
+
+	response, CampaignDataErr := c.campaignUseCases.Get(ctx, int64(campaignID))
+	if CampaignDataErr != nil {
+		dto.InternalServerErrorJSON(w, r, "Error occured on get campaign details")
+	}
+
+	if !omitStoresOptional {
+		storeDetails, storeDetailsErr := c.getStores(ctx, int64(campaignID))
+		if storeDetailsErr != nil {
+			dto.InternalServerErrorJSON(w, r, "Error occured on get store details: "+storeDetailsErr.Error())
+		}
+		response.CampaignStores = storeDetails
+	}
+	if !omitProductsOptional {
+		productDetails, productDetailsErr := c.getProducts(ctx, int64(campaignID))
+		if productDetailsErr != nil {
+			dto.InternalServerErrorJSON(w, r, "Error occured on get product details : "+productDetailsErr.Error())
+		}
+		response.CampaignProducts = productDetails
+	}
+
+	render.JSON(w, r, dto.ToCampaignResponse(*response))
+}
+
+func (c *CampaignController) getStores(ctx context.Context, campaignID int64) ([]*dto.CampaignStores, error) {
+	storeDetails, err := c.campaignStoreUseCases.GetStores(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return storeDetails, nil
+}
+
+func (c *CampaignController) getStoreIDs(ctx context.Context, campaignID int64) ([]int64, error) {
+	stores, err := c.getStores(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	storeIDs := []int64{}
+	for _, store := range stores {
+		storeIDs = append(storeIDs, store.StoreID)
+	}
+	return storeIDs, nil
+}
+
+func (c *CampaignController) getProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error) {
+	productDetails, err := c.campaignProductUseCases.GetProducts(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return productDetails, nil
+}
+
+// CreateCampaign godoc
+//
+//	@Summary Create a campaign
+//	@Description API to create new campaign, the stores given must be registered and active and the active stores of store_group_ids are added along with them.
+//	@Description Campaigns selling one of its products at one of its stores in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the campaign is not created when the overlap mode rejects
+//	@Tags campaign
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign body params.CampaignCreationForm	true "Add campaign details"
+//	@Success 200 {object} dto.CampaignDTO
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns [post]
+func (c *CampaignController) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var exists bool
+
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignRequest, err := c.validateCampaignRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err = c.campaignUseCases.Exists(ctx, 0, campaignRequest.Title)
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if exists {
+		dto.ConflictErrorJSON(w, r, "campaign with given name already exists")
+		return
+	}
+
+	response, overlaps, err := c.create(ctx, campaignRequest, int64(userID))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrStoreUnknown) || errors.Is(err, valueobjects.ErrStoreGroupNotExists) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *CampaignController) create(ctx context.Context, request params.CampaignCreationForm, userID int64) (*dto.CampaignDTO, []int64, error) {
+	response := &dto.CampaignDTO{}
+	var overlaps []int64
+	var err error
+	err = c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			if response, err = c.saveCampaignDetails(ctx, request, userID); err != nil {
+				return err
+			}
+			if overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, response.ID, nil); err != nil {
+				return err
+			}
+			return nil
+		})
+
+	return response, overlaps, err
+}
+
+func (c *CampaignController) saveCampaignDetails(ctx context.Context, request params.CampaignCreationForm, userID int64) (*dto.CampaignDTO, error) {
+	campaignEntity, err := params.ToCampaignEntity(request, c.businessTimezone(request.Timezone))
+	campaignEntity.CreatedBy = userID
+	if err != nil {
+		return nil, err
+	}
+	campaignDetails, err := c.campaignUseCases.Create(ctx, campaignEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	storeIDs, err := c.campaignStoreIDs(ctx, request.Stores, request.StoreGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(storeIDs) != 0 {
+		storesDetails, err := c.addStores(ctx, storeIDs, campaignDetails.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		campaignDetails.CampaignStores = storesDetails
+	}
+
+	if len(request.Products) != 0 {
+		productEntities := []entities.CampaignProduct{}
+		for _, product := range request.Products {
+			productEntities = append(productEntities, params.ToCampaignProductEntity(product, campaignDetails.ID, userID))
+		}
+		productDetails, err := c.campaignProductUseCases.AddProducts(ctx, productEntities)
+		if err != nil {
+			return nil, err
+		}
+		campaignDetails.CampaignProducts = productDetails
+	}
+
+	return campaignDetails, nil
+}
+
+// campaignStoreIDs returns the stores of the request with the stores of its store groups added
+func (c *CampaignController) campaignStoreIDs(ctx context.Context, storeIDs, storeGroupIDs []int64) ([]int64, error) {
+	if len(storeGroupIDs) == 0 {
+		return storeIDs, nil
+	}
+	return c.campaignStoreUseCases.ExpandStoreGroups(ctx, storeIDs, storeGroupIDs)
+}
+
+func (c *CampaignController) addStores(ctx context.Context, storeIDs []int64, campaignID, userID int64) ([]*dto.CampaignStores, error) {
+	storeEntities := []entities.CampaignStore{}
+	for _, storeID := range storeIDs {
+		storeEntities = append(storeEntities, params.ToCampaignStoreEntity(storeID, campaignID, userID))
+	}
+	storeDetails, err := c.campaignStoreUseCases.AddStores(ctx, storeEntities)
+	if err != nil {
+		return nil, err
+	}
+	return storeDetails, nil
+}
+
+// func (c *CampaignController) addProducts(ctx context.Context, productRequest []params.CampaignProduct, campaignID, userID int64) ([]*dto.CampaignProducts, error) {
+// 	productEntities := []entities.CampaignProduct{}
+// 	for _, product := range productRequest {
+// 		productEntities = append(productEntities, params.ToCampaignProductEntity(product, campaignID, userID))
+// 	}
+// 	productDetails, err := c.campaignProductUseCases.AddProducts(ctx, productEntities)
+// 	if err != nil {
+// 		return nil, err
+// 	}
+// 	return productDetails, nil
+// }
+
+// UpdateCampaign godoc
+//
+//	@Summary Update campaign details
+//	@Description API to update an existing campaign, the campaign keeps the stores given along with the active stores of store_group_ids.
+//	@Description Campaigns the update newly overlaps, selling one of its products at one of its stores in overlapping order dates, are listed in the X-Overlapping-Campaign-Ids header, or the update is undone when the overlap mode rejects. Overlaps the campaign already had are not reported
+//	@Tags campaign
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Campaign ID"
+//	@Param	campaign body params.CampaignUpdateForm	true "campaign details"
+//	@Success 200 {object} dto.Response
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{id} [put]
+func (c *CampaignController) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.BadRequestJSON(w, r, "campaign with given id not exists")
+		return
+	}
+
+	campaignRequest, err := c.validateUpdateCampaignRequest(r, int64(campaignID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	overlaps, err := c.update(ctx, int64(campaignID), campaignRequest, int64(userID))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignStatusTransition) || errors.Is(err, valueobjects.ErrCampaignStatusInvalid) ||
+			errors.Is(err, valueobjects.ErrProductNotExists) || errors.Is(err, valueobjects.ErrStoreUnknown) ||
+			errors.Is(err, valueobjects.ErrStoreGroupNotExists) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSON(w, r, fmt.Sprintf("campaign with id %d updated successfully", campaignID))
+}
+
+// DeleteCampaign godoc
+//
+//	@Summary Delete campaign
+//	@Description API to delete a campaign along with its stores and products, it can be brought back with the restore API
+//	@Tags campaign
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Campaign ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{id} [delete]
+func (c *CampaignController) DeleteCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	err = c.campaignUseCases.Delete(ctx, int64(campaignID), int64(userID))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignNotExists) {
+			dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("campaign with id %d deleted successfully", campaignID))
+}
+
+// RestoreCampaign godoc
+//
+//	@Summary Restore deleted campaign
+//	@Description API to bring back a deleted campaign along with the stores and products deleted with it
+//	@Description Campaigns selling one of its products at one of its stores in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the campaign stays deleted when the overlap mode rejects
+//	@Tags campaign
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Campaign ID"
+//	@Success 200 {object} dto.Response
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{id}/restore [post]
+func (c *CampaignController) RestoreCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	var overlaps []int64
+	err = c.tx.RunWithTransaction(ctx, func(ctx context.Context) error {
+		err := c.campaignUseCases.Restore(ctx, int64(campaignID), int64(userID))
+		if err != nil {
+			return err
+		}
+		overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, int64(campaignID), nil)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, valueobjects.ErrCampaignNotExists):
+			dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		case errors.Is(err, valueobjects.ErrCampaignNotDeleted):
+			dto.BadRequestJSON(w, r, err.Error())
+		case errors.Is(err, valueobjects.ErrCampaignTitleExists), errors.Is(err, valueobjects.ErrCampaignOverlap):
+			dto.ConflictErrorJSON(w, r, err.Error())
+		default:
+			dto.InternalServerErrorJSON(w, r, err.Error())
+		}
+		return
+	}
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSON(w, r, fmt.Sprintf("campaign with id %d restored successfully", campaignID))
+}
+
+// CloneCampaign godoc
+//
+//	@Summary Clone a campaign
+//	@Description API to create a copy of a campaign along with its stores and products, under a new title and dates.
+//	@Description Campaigns the copy overlaps are listed in the X-Overlapping-Campaign-Ids header, or the copy is not created when the overlap mode rejects
+//	@Tags campaign
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Campaign ID"
+//	@Param	campaign body params.CampaignCloneForm true "New campaign title and dates"
+//	@Success 200 {object} dto.CampaignDTO
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{id}/clone [post]
+func (c *CampaignController) CloneCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	var cloneRequest params.CampaignCloneForm
+	err = json.NewDecoder(r.Body).Decode(&cloneRequest)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	defer r.Body.Close()
+	if err = validator.New().Struct(cloneRequest); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	campaign, err := c.campaignUseCases.Get(ctx, int64(campaignID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	timezone := cloneRequest.Timezone
+	if timezone == "" {
+		timezone = c.businessTimezone(campaign.Timezone)
+	}
+	dates := params.CampaignDates{
+		OrderStartDate:      cloneRequest.OrderStartDate,
+		OrderEndDate:        cloneRequest.OrderEndDate,
+		CollectionStartDate: cloneRequest.CollectionStartDate,
+		CollectionEndDate:   cloneRequest.CollectionEndDate,
+		Timezone:            timezone,
+	}
+	dates.StoreIDs, err = c.getStoreIDs(ctx, int64(campaignID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if err = c.validateCampaignDates(ctx, dates, campaign.LeadTime); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err = c.campaignUseCases.Exists(ctx, 0, cloneRequest.Title)
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if exists {
+		dto.ConflictErrorJSON(w, r, "campaign with given name already exists")
+		return
+	}
+
+	cloneEntity, err := params.ToCloneCampaignEntity(cloneRequest, timezone)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	cloneEntity.CreatedBy = int64(userID)
+
+	var response *dto.CampaignDTO
+	var overlaps []int64
+	err = c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			response, err = c.cloneCampaignDetails(ctx, int64(campaignID), cloneEntity)
+			if err != nil {
+				return err
+			}
+			overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, response.ID, nil)
+			return err
+		})
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrStoreUnknown) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+// cloneCampaignDetails copies the campaign row, then its stores and products keeping their serial and sequence numbers
+func (c *CampaignController) cloneCampaignDetails(ctx context.Context, campaignID int64, cloneEntity entities.Campaign) (*dto.CampaignDTO, error) {
+	campaignDetails, err := c.campaignUseCases.Clone(ctx, campaignID, cloneEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	storeIDs, err := c.getStoreIDs(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if len(storeIDs) != 0 {
+		campaignDetails.CampaignStores, err = c.addStores(ctx, storeIDs, campaignDetails.ID, cloneEntity.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	products, err := c.getProducts(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if len(products) != 0 {
+		productEntities := []entities.CampaignProduct{}
+		for _, product := range products {
+			productEntities = append(productEntities, params.ToCampaignProductEntity(params.CampaignProduct{
+				ProductID:   product.ProductID,
+				SKUNo:       product.SKUNo,
+				SerialNo:    product.SerialNo,
+				SequenceNo:  product.SequenceNo,
+				ProductType: product.ProductType,
+			}, campaignDetails.ID, cloneEntity.CreatedBy))
+		}
+		campaignDetails.CampaignProducts, err = c.campaignProductUseCases.AddProducts(ctx, productEntities)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return campaignDetails, nil
+}
+
+func (c *CampaignController) update(ctx context.Context, campaignID int64, request params.CampaignUpdateForm, userID int64) ([]int64, error) {
+	var overlaps []int64
+	var err error
+	err = c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			var existing []entities.CampaignOverlap
+			if existing, err = c.campaignUseCases.GetOverlaps(ctx, campaignID); err != nil {
+				return err
+			}
+			if err = c.updateCampaignDetails(ctx, campaignID, request, userID); err != nil {
+				return err
+			}
+			if overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, campaignID, existing); err != nil {
+				return err
+			}
+			return nil
+		})
+
+	return overlaps, err
+}
+
+func (c *CampaignController) validateCampaignRequest(r *http.Request) (params.CampaignCreationForm, error) {
+	var campaignRequest params.CampaignCreationForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&campaignRequest)
+	if err != nil {
+		return campaignRequest, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(campaignRequest)
+	if err != nil {
+		return campaignRequest, err
+	}
+
+	dates := params.CampaignDates{
+		OrderStartDate:      campaignRequest.OrderStartDate,
+		OrderEndDate:        campaignRequest.OrderEndDate,
+		CollectionStartDate: campaignRequest.CollectionStartDate,
+		CollectionEndDate:   campaignRequest.CollectionEndDate,
+		Timezone:            c.businessTimezone(campaignRequest.Timezone),
+	}
+	dates.StoreIDs, err = c.campaignStoreIDs(r.Context(), campaignRequest.Stores, campaignRequest.StoreGroupIDs)
+	if err != nil {
+		return campaignRequest, err
+	}
+
+	err = c.validateCampaignDates(r.Context(), dates, campaignRequest.LeadTime)
+	if err != nil {
+		return campaignRequest, err
+	}
+	return campaignRequest, nil
+}
+
+// validateUpdateCampaignRequest checks the dates in the timezone the campaign is saved in, the request is returned
+// with that timezone so the update saves the dates it checked
+func (c *CampaignController) validateUpdateCampaignRequest(r *http.Request, campaignID int64) (params.CampaignUpdateForm, error) {
+	var campaignRequest params.CampaignUpdateForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&campaignRequest)
+	if err != nil {
+		return campaignRequest, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(campaignRequest)
+	if err != nil {
+		return campaignRequest, err
+	}
+
+	campaignRequest.Timezone, err = c.campaignTimezone(r.Context(), campaignID, campaignRequest.Timezone)
+	if err != nil {
+		return campaignRequest, err
+	}
+	dates := params.CampaignDates{
+		OrderStartDate:      campaignRequest.OrderStartDate,
+		OrderEndDate:        campaignRequest.OrderEndDate,
+		CollectionStartDate: campaignRequest.CollectionStartDate,
+		CollectionEndDate:   campaignRequest.CollectionEndDate,
+		Timezone:            campaignRequest.Timezone,
+	}
+	dates.StoreIDs, err = c.campaignStoreIDs(r.Context(), campaignRequest.Stores, campaignRequest.StoreGroupIDs)
+	if err != nil {
+		return campaignRequest, err
+	}
+	// the stores the campaign already has are checked with the requested ones, they are not all named in the request
+	if campaignRequest.CollectionStartDate != "" && campaignRequest.CollectionEndDate != "" {
+		existingIDs, err := c.getStoreIDs(r.Context(), campaignID)
+		if err != nil {
+			return campaignRequest, err
+		}
+		dates.StoreIDs = append(existingIDs, util.Difference(dates.StoreIDs, existingIDs)...)
+	}
+
+	err = c.validateCampaignDates(r.Context(), dates, campaignRequest.LeadTime)
+	if err != nil {
+		return campaignRequest, err
+	}
+	return campaignRequest, nil
+}
+
+// validateCampaignDates checks the order and collection dates against each other and the lead time, collections
+// can not run on a date blacked out for every store or for one of the stores of the campaign
+func (c *CampaignController) validateCampaignDates(ctx context.Context, dates params.CampaignDates, leadTime int) error {
+	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
+	loc, err := util.LoadLocation(dates.Timezone)
+	if err != nil {
+		return err
+	}
+	orderStartDate, err = util.ToDateTime(dates.OrderStartDate, loc)
+	if err != nil {
+		return err
+	}
+	orderEndDate, err = util.ToDateTime(dates.OrderEndDate, loc)
+	if err != nil {
+		return err
+	}
+	collectionStartDate, err = util.ToDateTime(dates.CollectionStartDate, loc)
+	if err != nil {
+		return err
+	}
+	collectionEndDate, err = util.ToDateTime(dates.CollectionEndDate, loc)
+	if err != nil {
+		return err
+	}
+	if orderStartDate.After(orderEndDate) {
+		return errors.New("invalid date : order start date should be the date before order end date")
+	}
+	if orderStartDate.After(collectionStartDate) {
+		return errors.New("invalid date : order start date should be the date before collection start date")
+	}
+	if collectionStartDate.After(collectionEndDate) {
+		return errors.New("invalid date : collection start date should be the date before collection end date")
+	}
+
+	err = c.checkStartDate(orderStartDate, collectionStartDate, leadTime)
+	if err != nil {
+		return err
+	}
+	err = c.checkEndDate(orderEndDate, collectionEndDate, leadTime)
+	if err != nil {
+		return err
+	}
+	if !collectionStartDate.IsZero() && !collectionEndDate.IsZero() {
+		return c.blackoutUseCases.CheckCollectionDates(ctx, dates.StoreIDs, collectionStartDate, collectionEndDate)
+	}
+	return nil
+}
+
+// businessTimezone returns the requested timezone or the deployment business timezone
+func (c *CampaignController) businessTimezone(timezone string) string {
+	if timezone != "" {
+		return timezone
+	}
+	return c.appConfig.TimezoneConfig.BusinessTimezone
+}
+
+func (c *CampaignController) checkStartDate(orderStartDate, collectionStartDate time.Time, leadTime int) error {
+	if !orderStartDate.IsZero() && !collectionStartDate.IsZero() {
+		if int64(collectionStartDate.Sub(orderStartDate).Hours()/24) < int64(leadTime) {
+			return fmt.Errorf("invalid date : Collection start date should be at least %d days greater than order start date",
+				leadTime)
+		} else if int64(collectionStartDate.Sub(orderStartDate).Hours()/24) > int64(c.appConfig.ValidationParam.MaxDateDifference) {
+			return fmt.Errorf("invalid date : Collection start date should be less than %d days from order start date",
+				c.appConfig.ValidationParam.MaxDateDifference)
+		}
+	}
+	return nil
+}
+
+func (c *CampaignController) checkEndDate(orderEndDate, collectionEndDate time.Time, leadTime int) error {
+	if !orderEndDate.IsZero() && !collectionEndDate.IsZero() {
+		if int64(collectionEndDate.Sub(orderEndDate).Hours()/24) < int64(leadTime) {
+			return fmt.Errorf("invalid date : Collection end date should be at least %d days greater than order end date",
+				leadTime)
+		} else if int64(collectionEndDate.Sub(orderEndDate).Hours()/24) > int64(c.appConfig.ValidationParam.MaxDateDifference) {
+			return fmt.Errorf("invalid date : Collection end date should be less than %d days from order end date",
+				c.appConfig.ValidationParam.MaxDateDifference)
+		}
+	}
+	return nil
+}
+
+// updateCampaignDetails saves the request in its timezone, the one validateUpdateCampaignRequest resolved
+func (c *CampaignController) updateCampaignDetails(ctx context.Context, campaignID int64, request params.CampaignUpdateForm, userID int64) error {
+	campaignEntity, err := params.ToUpdateCampaignEntity(request, campaignID, request.Timezone)
+	campaignEntity.UpdatedBy = userID
+	if err != nil {
+		return err
+	}
+	err = c.campaignUseCases.Update(ctx, campaignEntity)
+	if err != nil {
+		return err
+	}
+
+	storeIDs, err := c.campaignStoreIDs(ctx, request.Stores, request.StoreGroupIDs)
+	if err != nil {
+		return err
+	}
+	err = c.updateStores(ctx, campaignID, storeIDs, userID)
+	if err != nil {
+		return err
+	}
+
+	// an omitted products field keeps the products, an empty list removes them
+	if request.Products != nil {
+		err = c.updateProducts(ctx, campaignID, *request.Products, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// campaignTimezone keeps the stored timezone of a campaign unless the request changes it, a campaign stored without one
+// is in the business timezone
+func (c *CampaignController) campaignTimezone(ctx context.Context, campaignID int64, timezone string) (string, error) {
+	if timezone != "" {
+		return timezone, nil
+	}
+	campaign, err := c.campaignUseCases.Get(ctx, campaignID)
+	if err != nil {
+		return "", err
+	}
+	return c.businessTimezone(campaign.Timezone), nil
+}
+
+func (c *CampaignController) updateStores(ctx context.Context, campaignID int64, stores []int64, userID int64) error {
+	var newStores []entities.CampaignStore
+	for _, storeID := range stores {
+		_, err := c.campaignStoreUseCases.GetByStoreID(ctx, campaignID, storeID)
+		if err != nil && errors.As(err, &valueobjects.ErrStoreNotExists) {
+			storeEntity := params.ToCampaignStoreEntity(storeID, campaignID, userID)
+			newStores = append(newStores, storeEntity)
+		} else if err != nil {
+			return err
+		}
+
+		// this is additional code for future reference.
+		// currently there are no additional fields to
+		// update, that's why there is no need to update the store entity
+		// var existingStores []entities.CampaignStore
+		// _ = storeDetails
+		// if storeDetails.ID > 0 {
+		// 	store := params.UpdateCampaignStore{
+		// 		ID:      storeDetails.ID,
+		// 		StoreID: storeDetails.StoreID,
+		// 	}
+		// 	existingStoreEntity := params.ToUpdateCampaignStoreEntity(store, campaignID, userID)
+		// 	existingStores = append(existingStores, existingStoreEntity)
+		// }
+
+	}
+
+	// this is additional code for future reference. currently there are no additional fields to
+	// update, that's why there is no need to update the store entity
+	// if len(existingStores) > 0 {
+	// 	err := c.campaignStoreUseCases.UpdateStores(ctx, existingStores)
+	// 	if err != nil {
+	// 		return err
+	// 	}
+	// }
+
+	dbStoreIDS := []int64{}
+	dbStores, err := c.campaignStoreUseCases.GetStores(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	for _, store := range dbStores {
+		dbStoreIDS = append(dbStoreIDS, store.StoreID)
+	}
+	storesToDelete := util.Difference(dbStoreIDS, stores)
+
+	if len(newStores) > 0 {
+		_, err := c.campaignStoreUseCases.AddStores(ctx, newStores)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range storesToDelete {
+		err := c.campaignStoreUseCases.DeleteByStoreID(ctx, campaignID, id, userID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateProducts inserts products without campaign_product_id, updates the ones with it
+// and removes the campaign products missing from the request
+func (c *CampaignController) updateProducts(ctx context.Context, campaignID int64, products []params.UpdateCampaignProduct, userID int64) error {
+	dbProducts, err := c.campaignProductUseCases.GetProducts(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	dbProductIDs := []int64{}
+	for _, product := range dbProducts {
+		dbProductIDs = append(dbProductIDs, product.ID)
+	}
+
+	var newProducts, existingProducts []entities.CampaignProduct
+	requestProductIDs := []int64{}
+	for _, product := range products {
+		if product.ID == 0 {
+			newProducts = append(newProducts, params.ToCampaignProductEntity(params.CampaignProduct{
+				ProductID:   product.ProductID,
+				SKUNo:       product.SKUNo,
+				SerialNo:    product.SerialNo,
+				SequenceNo:  product.SequenceNo,
+				ProductType: product.ProductType,
+			}, campaignID, userID))
+			continue
+		}
+		if len(util.Difference([]int64{product.ID}, dbProductIDs)) != 0 {
+			return fmt.Errorf("%w: campaign product id %d", valueobjects.ErrProductNotExists, product.ID)
+		}
+		requestProductIDs = append(requestProductIDs, product.ID)
+		existingProducts = append(existingProducts, params.ToUpdateCampaignProductEntity(product, campaignID, userID))
+	}
+	productsToDelete := util.Difference(dbProductIDs, requestProductIDs)
+
+	if len(newProducts) > 0 {
+		_, err := c.campaignProductUseCases.AddProducts(ctx, newProducts)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(existingProducts) > 0 {
+		err := c.campaignProductUseCases.UpdateProducts(ctx, existingProducts)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range productsToDelete {
+		err := c.campaignProductUseCases.DeleteProduct(ctx, campaignID, id, userID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCampaignList godoc
+//
+//	@Summary Get list of all campaigns
+//	@Description API to get details of all campaigns
+//	@Tags campaign
+//	@Produce json
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at"
+//	@Param	name query string false "Campaign Name"
+//	@Param	status query string false "Campaign Status, any status value listed by /campaign-codes"
+//	@Param	include_deleted query bool false "List deleted campaigns too, only allowed for users of the admin group"
+//	@Param	campaign_type query string false "Campaign Type"
+//	@Param	is_published query boolean false "Published campaigns only when true, unpublished campaigns only when false"
+//	@Param	order_from query string false "Campaigns taking orders on or after this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	order_to query string false "Campaigns taking orders on or before this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	collection_from query string false "Campaigns open for collection on or after this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	collection_to query string false "Campaigns open for collection on or before this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	created_by query int false "ID of the user who created the campaigns"
+//	@Param	store_id query int false "Campaigns selling at this store"
+//	@Param	product_id query int false "Campaigns selling this product"
+//	@Param	cursor query string false "Page by cursor in place of page, empty for the first page then the next_cursor or prev_cursor of the last response"
+//	@Param	q query string false "Search the titles and descriptions, the campaigns are ranked by relevance and the matching fields highlighted. Not available with cursor"
+//	@Success 200 {object} dto.CampaignListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 403 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns [get]
+func (c *CampaignController) GetCampaignList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter, err := c.generateCampaignListFilterFromRequest(r)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrIncludeDeletedForbidden) {
+			dto.ForbiddenJSON(w, r, err.Error())
+			return
+		}
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	var response *dto.CampaignListResponse
+	if filter.Cursor != nil {
+		response, err = c.campaignUseCases.GetListByCursor(ctx, params.ToCampaignListFilterEntity(filter))
+	} else {
+		response, err = c.campaignUseCases.GetList(ctx, params.ToCampaignListFilterEntity(filter))
+	}
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignStatusInvalid) || errors.Is(err, valueobjects.ErrCampaignCursorInvalid) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, response)
+}
+
+// GetCampaignSummary godoc
+//
+//	@Summary Get summary of campaigns
+//	@Description API to get the number of campaigns in each status, starting or ending in the next 7 days and without stores
+//	@Description or products, among the campaigns matching the same filters as the campaign list
+//	@Tags campaign
+//	@Produce json
+//	@Param	name query string false "Campaign Name"
+//	@Param	status query string false "Campaign Status, any status value listed by /campaign-codes"
+//	@Param	include_deleted query bool false "Count deleted campaigns too, only allowed for users of the admin group"
+//	@Param	campaign_type query string false "Campaign Type"
+//	@Param	is_published query boolean false "Published campaigns only when true, unpublished campaigns only when false"
+//	@Param	order_from query string false "Campaigns taking orders on or after this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	order_to query string false "Campaigns taking orders on or before this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	collection_from query string false "Campaigns open for collection on or after this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	collection_to query string false "Campaigns open for collection on or before this date time [2023-12-31 12:00:00] in the business timezone"
+//	@Param	created_by query int false "ID of the user who created the campaigns"
+//	@Param	store_id query int false "Campaigns selling at this store"
+//	@Param	product_id query int false "Campaigns selling this product"
+//	@Param	q query string false "Search the titles and descriptions"
+//	@Success 200 {object} dto.CampaignSummaryResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 403 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/summary [get]
+func (c *CampaignController) GetCampaignSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter, err := c.generateCampaignListFilterFromRequest(r)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrIncludeDeletedForbidden) {
+			dto.ForbiddenJSON(w, r, err.Error())
+			return
+		}
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	response, err := c.campaignUseCases.GetSummary(ctx, params.ToCampaignListFilterEntity(filter))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, response)
+}
+
+func (c *CampaignController) generatePaginationFromRequest(r *http.Request) params.Pagination {
+	// Initializing default
+	paginationConfig := c.appConfig.PaginationConfig
+	limit := paginationConfig.Limit
+	page := paginationConfig.Page
+	sort := paginationConfig.Sort
+	query := r.URL.Query()
+	name := paginationConfig.Name
+	var statusValue string
+	var includeDeleted bool
+
+	for key, value := range query {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			limit, _ = strconv.Atoi(queryValue)
+			break
+		case "page":
+			page, _ = strconv.Atoi(queryValue)
+			break
+		case "sort":
+			sort = queryValue
+			break
+		case "name":
+			name = queryValue
+		case "status":
+			statusValue = queryValue
+			break
+		case "include_deleted":
+			includeDeleted, _ = strconv.ParseBool(queryValue)
+		}
+	}
+
+	return params.Pagination{
+		Limit:          limit,
+		Page:           page,
+		Sort:           sort,
+		Name:           name,
+		StatusValue:    statusValue,
+		IncludeDeleted: includeDeleted,
+	}
+}
+
+// GetStoreCampaigns godoc
+//
+//	@Summary Get campaigns of store
+//	@Description API to get a page of the campaigns specified store takes part in. Pass at=now to list the campaigns live at the store right now,
+//	@Description a campaign is live from its order start date until its collection end date
+//	@Tags campaign
+//	@Produce json
+//	@Param	store_id	path int true "Store ID"
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at"
+//	@Param	status query string false "Campaign Status, any status value listed by /campaign-codes"
+//	@Param	is_published query boolean false "Published campaigns only when true, unpublished campaigns only when false"
+//	@Param	at query string false "Campaigns live at this date time [2023-12-31 12:00:00] in the business timezone, or now"
+//	@Param	include_products query boolean false "Embed the products of each campaign"
+//	@Success 200 {object} dto.CampaignListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/campaigns [get]
+func (c *CampaignController) GetStoreCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	storeID, err := strconv.ParseInt(chi.URLParam(r, "store_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	filter, err := c.generateStoreCampaignFilterFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	response, err := c.campaignUseCases.GetStoreCampaigns(ctx, storeID, params.ToStoreCampaignFilterEntity(filter))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if filter.IncludeProducts {
+		for i := range response.Data.Campaigns {
+			response.Data.Campaigns[i].CampaignProducts, err = c.getProducts(ctx, response.Data.Campaigns[i].ID)
+			if err != nil {
+				dto.InternalServerErrorJSON(w, r, err.Error())
+				return
+			}
+		}
+	}
+	render.JSON(w, r, response)
+}
+
+// generateCampaignListFilterFromRequest reads the pagination of the campaign list along with its filters, the
+// order and collection bounds being date times in the business timezone
+func (c *CampaignController) generateCampaignListFilterFromRequest(r *http.Request) (params.CampaignListFilter, error) {
+	filter := params.CampaignListFilter{Pagination: c.generatePaginationFromRequest(r)}
+	if filter.IncludeDeleted && !util.IsAdmin(r.Context()) {
+		return filter, valueobjects.ErrIncludeDeletedForbidden
+	}
+	var err error
+	filter.SortFields, err = params.ToSortFields(filter.Sort, params.CampaignSortColumns)
+	if err != nil {
+		return filter, err
+	}
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "campaign_type":
+			filter.CampaignType = queryValue
+		case "is_published":
+			var isPublished bool
+			isPublished, err = strconv.ParseBool(queryValue)
+			filter.IsPublished = &isPublished
+		case "order_from":
+			filter.OrderFrom, err = c.pointInTime(queryValue)
+		case "order_to":
+			filter.OrderTo, err = c.pointInTime(queryValue)
+		case "collection_from":
+			filter.CollectionFrom, err = c.pointInTime(queryValue)
+		case "collection_to":
+			filter.CollectionTo, err = c.pointInTime(queryValue)
+		case "created_by":
+			filter.CreatedBy, err = strconv.ParseInt(queryValue, 10, 64)
+		case "store_id":
+			filter.StoreID, err = strconv.ParseInt(queryValue, 10, 64)
+		case "product_id":
+			filter.ProductID, err = strconv.ParseInt(queryValue, 10, 64)
+		case "cursor":
+			cursor := queryValue
+			filter.Cursor = &cursor
+		case "q":
+			filter.Query = strings.TrimSpace(queryValue)
+		}
+		if err != nil {
+			return filter, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if filter.Cursor != nil && filter.Limit < 1 {
+		return filter, fmt.Errorf("limit must be positive")
+	}
+	if filter.Cursor != nil && filter.Query != "" {
+		return filter, fmt.Errorf("search results are ranked by relevance and cannot be paged by cursor")
+	}
+	return filter, nil
+}
+
+func (c *CampaignController) generateStoreCampaignFilterFromRequest(r *http.Request) (params.StoreCampaignFilter, error) {
+	filter := params.StoreCampaignFilter{
+		Pagination: params.Pagination{
+			Limit: c.appConfig.PaginationConfig.Limit,
+			Page:  c.appConfig.PaginationConfig.Page,
+			Sort:  "order_start_date",
+		},
+	}
+	var err error
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			filter.Limit, err = strconv.Atoi(queryValue)
+		case "page":
+			filter.Page, err = strconv.Atoi(queryValue)
+		case "sort":
+			filter.Sort = queryValue
+		case "status":
+			filter.StatusValue = queryValue
+		case "is_published":
+			var isPublished bool
+			isPublished, err = strconv.ParseBool(queryValue)
+			filter.IsPublished = &isPublished
+		case "at":
+			filter.At, err = c.pointInTime(queryValue)
+		case "include_products":
+			filter.IncludeProducts, err = strconv.ParseBool(queryValue)
+		}
+		if err != nil {
+			return filter, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if filter.Limit < 1 || filter.Page < 1 {
+		return filter, fmt.Errorf("limit and page must be positive")
+	}
+	filter.SortFields, err = params.ToSortFields(filter.Sort, params.CampaignSortColumns)
+	return filter, err
+}
+
+// GetProductCampaigns godoc
+//
+//	@Summary Get campaigns of product
+//	@Description API to get a page of the campaigns selling specified product, narrowed down to one SKU of the product with sku_no.
+//	@Description Pass at=now to list the campaigns live right now, a campaign is live from its order start date until its collection end date
+//	@Tags campaign
+//	@Produce json
+//	@Param	product_id	path int true "Product ID"
+//	@Param	sku_no query int false "SKU Number"
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at"
+//	@Param	status query string false "Campaign Status, any status value listed by /campaign-codes"
+//	@Param	is_published query boolean false "Published campaigns only when true, unpublished campaigns only when false"
+//	@Param	at query string false "Campaigns live at this date time [2023-12-31 12:00:00] in the business timezone, or now"
+//	@Param	include_products query boolean false "Embed the products of each campaign"
+//	@Success 200 {object} dto.CampaignListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/products/{product_id}/campaigns [get]
+func (c *CampaignController) GetProductCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	productID, err := strconv.ParseInt(chi.URLParam(r, "product_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectProductIDErr, err.Error()))
+		return
+	}
+	filter, err := c.generateProductCampaignFilterFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	response, err := c.campaignUseCases.GetProductCampaigns(ctx, productID, params.ToProductCampaignFilterEntity(filter))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if filter.IncludeProducts {
+		for i := range response.Data.Campaigns {
+			response.Data.Campaigns[i].CampaignProducts, err = c.getProducts(ctx, response.Data.Campaigns[i].ID)
+			if err != nil {
+				dto.InternalServerErrorJSON(w, r, err.Error())
+				return
+			}
+		}
+	}
+	render.JSON(w, r, response)
+}
+
+// generateProductCampaignFilterFromRequest reads the filters of the campaigns of a store along with sku_no
+func (c *CampaignController) generateProductCampaignFilterFromRequest(r *http.Request) (params.ProductCampaignFilter, error) {
+	storeFilter, err := c.generateStoreCampaignFilterFromRequest(r)
+	filter := params.ProductCampaignFilter{StoreCampaignFilter: storeFilter}
+	if err != nil {
+		return filter, err
+	}
+	if skuNo := r.URL.Query().Get("sku_no"); skuNo != "" {
+		filter.SKUNo, err = strconv.ParseInt(skuNo, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("incorrect sku_no value, err : %v", err)
+		}
+	}
+	return filter, nil
+}
+
+// pointInTime reads now or a date time in the business timezone
+func (c *CampaignController) pointInTime(value string) (time.Time, error) {
+	if value == "now" {
+		return time.Now(), nil
+	}
+	loc, err := util.LoadLocation(c.businessTimezone(""))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return util.ToDateTime(value, loc)
+}
+
+// UpdateCampaignStatus godoc
+//
+//	@Summary Update status of campaign
+//	@Description API to update the status of campaign
+//	@Tags campaign
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/update-status [put]
+func (c *CampaignController) UpdateCampaignStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	err := c.updateStatus(ctx)
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	dto.SuccessJSON(w, r, "campaigns status updated successfully")
+}
+
+func (c *CampaignController) updateStatus(ctx context.Context) error {
+	var err error
+	c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			if _, err = c.campaignUseCases.UpdateStatus(ctx); err != nil {
+				return err
+			}
+			return nil
+		})
+	return err
+}
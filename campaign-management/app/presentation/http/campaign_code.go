@@ -0,0 +1,166 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type CampaignCodeController struct {
+	campaignCodeUseCases usecases.CampaignCodeUseCases
+}
+
+func NewCampaignCodeController(campaignCodeUseCases usecases.CampaignCodeUseCases) *CampaignCodeController {
+	return &CampaignCodeController{
+		campaignCodeUseCases: campaignCodeUseCases,
+	}
+}
+
+func (c *CampaignCodeController) Init(r chi.Router) {
+	r.Route("/campaign-codes", func(r chi.Router) {
+		r.Get("/", c.GetCampaignCodes)
+		r.Post("/", c.CreateCampaignCode)
+		r.Put("/{status_code}", c.UpdateCampaignCode)
+	})
+}
+
+// GetCampaignCodes godoc
+//
+//	@Summary Get list of campaign statuses
+//	@Description API to get every status a campaign can be in, system statuses are used by the status job and can not be renamed
+//	@Tags campaign codes
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Success 200 {object} dto.CampaignCodeListResponse
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaign-codes [get]
+func (c *CampaignCodeController) GetCampaignCodes(w http.ResponseWriter, r *http.Request) {
+	campaignCodes, err := c.campaignCodeUseCases.GetList(r.Context())
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToCampaignCodeListResponse(campaignCodes))
+}
+
+// CreateCampaignCode godoc
+//
+//	@Summary Add campaign status
+//	@Description API to add a new campaign status like Cancelled or Completed, the status code is generated
+//	@Tags campaign codes
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_code body params.CampaignCodeForm true "Campaign status details"
+//	@Success 200 {object} dto.CampaignCodeResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaign-codes [post]
+func (c *CampaignCodeController) CreateCampaignCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	request, err := c.validateCampaignCodeRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	campaignCode, err := c.campaignCodeUseCases.Create(ctx, params.ToCampaignCodeEntity(request, 0, int64(userID)))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignCodeExists) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToCampaignCodeResponse(campaignCode))
+}
+
+// UpdateCampaignCode godoc
+//
+//	@Summary Rename campaign status
+//	@Description API to rename a campaign status added through the API, system statuses can not be renamed
+//	@Tags campaign codes
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	status_code	path int true "Status Code"
+//	@Param	campaign_code body params.CampaignCodeForm true "Campaign status details"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaign-codes/{status_code} [put]
+func (c *CampaignCodeController) UpdateCampaignCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	statusCode, err := strconv.Atoi(chi.URLParam(r, "status_code"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect status code value, err : %v", err.Error()))
+		return
+	}
+
+	request, err := c.validateCampaignCodeRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	err = c.campaignCodeUseCases.Update(ctx, params.ToCampaignCodeEntity(request, int64(statusCode), int64(userID)))
+	if err != nil {
+		switch {
+		case errors.Is(err, valueobjects.ErrCampaignCodeSystem):
+			dto.BadRequestJSON(w, r, err.Error())
+		case errors.Is(err, valueobjects.ErrCampaignCodeNotExists):
+			dto.NotFoundJSON(w, r, err.Error())
+		case errors.Is(err, valueobjects.ErrCampaignCodeExists):
+			dto.ConflictErrorJSON(w, r, err.Error())
+		default:
+			dto.InternalServerErrorJSON(w, r, err.Error())
+		}
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("campaign status with code %d updated successfully", statusCode))
+}
+
+func (c *CampaignCodeController) validateCampaignCodeRequest(r *http.Request) (params.CampaignCodeForm, error) {
+	var request params.CampaignCodeForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestCampaignCodeController_GetCampaignCodes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/campaign-codes", nil)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("GetList", req.Context()).Return([]*dto.CampaignCodeDTO{
+			{StatusCode: 2, StatusValue: "Active", System: true},
+		}, nil)
+
+		controller.GetCampaignCodes(w, req)
+
+		expected := `{"code":200,"status":"SUCCESS","data":[{"status_code":2,"status_value":"Active","system":true}]}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+}
+
+func TestCampaignCodeController_CreateCampaignCode(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest("POST", "/campaign-codes", bytes.NewBufferString(body))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := newRequest(`{"status_value": " Cancelled "}`)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("Create", req.Context(), entities.CampaignCode{
+			StatusValue: "Cancelled", CreatedBy: 12345, UpdatedBy: 12345,
+		}).Return(&dto.CampaignCodeDTO{StatusCode: 4, StatusValue: "Cancelled"}, nil)
+
+		controller.CreateCampaignCode(w, req)
+
+		expected := `{"code":200,"status":"SUCCESS","data":{"status_code":4,"status_value":"Cancelled","system":false}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to request validation error", func(t *testing.T) {
+		req := newRequest(`{"status_value": ""}`)
+		w := httptest.NewRecorder()
+		controller := NewCampaignCodeController(mocks.NewCampaignCodeUseCases(t))
+
+		controller.CreateCampaignCode(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("failure due to status already exists", func(t *testing.T) {
+		req := newRequest(`{"status_value": "Active"}`)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("Create", req.Context(), entities.CampaignCode{
+			StatusValue: "Active", CreatedBy: 12345, UpdatedBy: 12345,
+		}).Return(nil, fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeExists, "Active"))
+
+		controller.CreateCampaignCode(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+}
+
+func TestCampaignCodeController_UpdateCampaignCode(t *testing.T) {
+	newRequest := func(statusCode, body string) *http.Request {
+		req, _ := http.NewRequest("PUT", "/campaign-codes/"+statusCode, bytes.NewBufferString(body))
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("status_code", statusCode)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := newRequest("4", `{"status_value": "Completed"}`)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("Update", req.Context(), entities.CampaignCode{
+			StatusCode: 4, StatusValue: "Completed", CreatedBy: 12345, UpdatedBy: 12345,
+		}).Return(nil)
+
+		controller.UpdateCampaignCode(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("failure due to system status", func(t *testing.T) {
+		req := newRequest("2", `{"status_value": "Live"}`)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("Update", req.Context(), entities.CampaignCode{
+			StatusCode: 2, StatusValue: "Live", CreatedBy: 12345, UpdatedBy: 12345,
+		}).Return(fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeSystem, 2))
+
+		controller.UpdateCampaignCode(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("failure due to status not exists", func(t *testing.T) {
+		req := newRequest("9", `{"status_value": "Completed"}`)
+		w := httptest.NewRecorder()
+		mockCampaignCodeUsecase := mocks.NewCampaignCodeUseCases(t)
+		controller := NewCampaignCodeController(mockCampaignCodeUsecase)
+		mockCampaignCodeUsecase.On("Update", req.Context(), entities.CampaignCode{
+			StatusCode: 9, StatusValue: "Completed", CreatedBy: 12345, UpdatedBy: 12345,
+		}).Return(fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeNotExists, 9))
+
+		controller.UpdateCampaignCode(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+}
@@ -1,167 +1,450 @@
-package http
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services"
-	"campaign-mgmt/app/domain/usecases"
-	"campaign-mgmt/app/usecases/dto"
-	"campaign-mgmt/app/usecases/params"
-	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strconv"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
-)
-
-type CampaignProductController struct {
-	campaignProductUseCases usecases.CampaignProductUseCases
-	tx                      services.TransactionService
-	appConfig               *entities.AppCfg
-}
-
-func NewCampaignProductController(
-	campaignProductUseCases usecases.CampaignProductUseCases,
-	transactionService services.TransactionService,
-	appConfig *entities.AppCfg) *CampaignProductController {
-	return &CampaignProductController{
-		campaignProductUseCases: campaignProductUseCases,
-		tx:                      transactionService,
-		appConfig:               appConfig,
-	}
-}
-func (c *CampaignProductController) Init(r chi.Router) {
-	r.Route("/campaigns/{campaign_id}/products", func(r chi.Router) {
-		r.Delete("/{id}", c.DeleteProduct)
-		r.Delete("/", c.DeleteAllProduct)
-	})
-	r.Post("/campaigns/products", c.AddProducts)
-
-}
-
-// CreateCampaignProducts godoc
-//
-//	@Summary Create a campaign products
-//	@Description API to create new campaign products
-//	@Tags campaign products
-//	@Accept json
-//	@Produce json
-//	@Param	campaign body params.CampaignProductCreationForm	true "Add campaign products details"
-//	@Success 200 {object} []dto.CampaignProducts
-//	@Failure 400 {object} dto.Response
-//	@Failure 409 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/products [post]
-func (c *CampaignProductController) AddProducts(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	campaignRequest, err := c.validateCampaignRequest(r)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-
-	response, err := c.create(ctx, campaignRequest)
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	dto.SuccessJSONResponse(w, r, response)
-}
-
-func (c *CampaignProductController) create(ctx context.Context, request params.CampaignProductCreationForm) ([]*dto.CampaignProducts, error) {
-	response := []*dto.CampaignProducts{}
-	var err error
-	c.tx.RunWithTransaction(
-		ctx, func(ctx context.Context) error {
-			if response, err = c.addProducts(ctx, request.Products, request.CampaignID, request.CreatedBy); err != nil {
-				return err
-			}
-			return nil
-		})
-	return response, err
-}
-
-func (c *CampaignProductController) validateCampaignRequest(r *http.Request) (params.CampaignProductCreationForm, error) {
-	var campaignProductRequest params.CampaignProductCreationForm
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&campaignProductRequest)
-	if err != nil {
-		return campaignProductRequest, err
-	}
-	defer r.Body.Close()
-
-	validate := validator.New()
-	err = validate.Struct(campaignProductRequest)
-	if err != nil {
-		return campaignProductRequest, err
-	}
-
-	return campaignProductRequest, nil
-}
-
-func (c *CampaignProductController) addProducts(ctx context.Context, productRequest []params.CampaignProduct, campaignID, userID int64) ([]*dto.CampaignProducts, error) {
-	productEntities := []entities.CampaignProduct{}
-	for _, product := range productRequest {
-		productEntities = append(productEntities, params.ToCampaignProductEntity(product, campaignID, userID))
-	}
-	return c.campaignProductUseCases.AddProducts(ctx, productEntities)
-}
-
-// DeleteProduct godoc
-//
-//	@Summary Delete particular campaign product by product id
-//	@Description API to delete particular product under a specified campaign
-//	@Tags campaign products
-//	@Produce json
-//	@Param	campaign_id	path int true "Campaign ID"
-//	@Param	id	path int true "Product ID"
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{campaign_id}/products/{id} [delete]
-func (c *CampaignProductController) DeleteProduct(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "campaign_id"))
-	if campaignIDError != nil {
-		dto.BadRequestJSON(w, r, "please provide correct campaign_id ")
-	}
-	productID, productIDError := strconv.Atoi(chi.URLParam(r, "id"))
-	if productIDError != nil {
-		dto.BadRequestJSON(w, r, "please provide correct id")
-	}
-	err := c.campaignProductUseCases.DeleteByCampaignId(ctx, int64(campaignID), int64(productID))
-	if err != nil {
-		dto.BadRequestJSON(w, r, "product not deleted")
-	}
-	dto.SuccessJSON(w, r, fmt.Sprintf("product with id %d deleted successfully", productID))
-}
-
-// DeleteProduct godoc
-//
-//	@Summary Delete particular campaign products
-//	@Description API to delete all products under a specified campaign
-//	@Tags campaign products
-//	@Produce json
-//	@Param	campaign_id	path int true "Campaign ID"
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{campaign_id}/products [delete]
-func (c *CampaignProductController) DeleteAllProduct(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "campaign_id"))
-	if campaignIDError != nil {
-		dto.BadRequestJSON(w, r, "please provide correct campaign_id ")
-	}
-	err := c.campaignProductUseCases.DeleteAllByCampaignId(ctx, int64(campaignID))
-	if err != nil {
-		dto.BadRequestJSON(w, r, "products not deleted")
-	}
-	dto.SuccessJSON(w, r, fmt.Sprintf("all products for campaign id %d deleted successfully", campaignID))
-}
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+const IncorrectProductIDErr = "incorrect product id value, err : %v"
+
+type CampaignProductController struct {
+	campaignUseCases        usecases.CampaignUseCases
+	campaignProductUseCases usecases.CampaignProductUseCases
+	tx                      services.TransactionService
+	appConfig               *entities.AppCfg
+}
+
+func NewCampaignProductController(
+	campaignUseCases usecases.CampaignUseCases,
+	campaignProductUseCases usecases.CampaignProductUseCases,
+	transactionService services.TransactionService,
+	appConfig *entities.AppCfg) *CampaignProductController {
+	return &CampaignProductController{
+		campaignUseCases:        campaignUseCases,
+		campaignProductUseCases: campaignProductUseCases,
+		tx:                      transactionService,
+		appConfig:               appConfig,
+	}
+}
+func (c *CampaignProductController) Init(r chi.Router) {
+	r.Route("/campaigns/{campaign_id}/products", func(r chi.Router) {
+		r.Get("/", c.GetProductList)
+		r.Put("/order", c.ReorderProducts)
+		r.Put("/{id}", c.UpdateProduct)
+		r.Delete("/{id}", c.DeleteProduct)
+		r.Delete("/", c.DeleteAllProduct)
+	})
+	r.Post("/campaigns/products", c.AddProducts)
+
+}
+
+// CreateCampaignProducts godoc
+//
+//	@Summary Create a campaign products
+//	@Description API to create new campaign products. Campaigns selling an added product at a store of the campaign in overlapping order dates
+//	@Description are listed in the X-Overlapping-Campaign-Ids header, or the products are not added when the overlap mode rejects
+//	@Tags campaign products
+//	@Accept json
+//	@Produce json
+//	@Param	campaign body params.CampaignProductCreationForm	true "Add campaign products details"
+//	@Success 200 {object} []dto.CampaignProducts
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/products [post]
+func (c *CampaignProductController) AddProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	campaignRequest, err := c.validateCampaignRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	response, overlaps, err := c.create(ctx, campaignRequest)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *CampaignProductController) create(ctx context.Context, request params.CampaignProductCreationForm) ([]*dto.CampaignProducts, []int64, error) {
+	response := []*dto.CampaignProducts{}
+	var overlaps []int64
+	var err error
+	err = c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			var existing []entities.CampaignOverlap
+			if existing, err = c.campaignUseCases.GetOverlaps(ctx, request.CampaignID); err != nil {
+				return err
+			}
+			if response, err = c.addProducts(ctx, request.Products, request.CampaignID, request.CreatedBy); err != nil {
+				return err
+			}
+			if overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, request.CampaignID, existing); err != nil {
+				return err
+			}
+			return nil
+		})
+	return response, overlaps, err
+}
+
+func (c *CampaignProductController) validateCampaignRequest(r *http.Request) (params.CampaignProductCreationForm, error) {
+	var campaignProductRequest params.CampaignProductCreationForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&campaignProductRequest)
+	if err != nil {
+		return campaignProductRequest, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(campaignProductRequest)
+	if err != nil {
+		return campaignProductRequest, err
+	}
+
+	return campaignProductRequest, nil
+}
+
+func (c *CampaignProductController) addProducts(ctx context.Context, productRequest []params.CampaignProduct, campaignID, userID int64) ([]*dto.CampaignProducts, error) {
+	productEntities := []entities.CampaignProduct{}
+	for _, product := range productRequest {
+		productEntities = append(productEntities, params.ToCampaignProductEntity(product, campaignID, userID))
+	}
+	return c.campaignProductUseCases.AddProducts(ctx, productEntities)
+}
+
+// GetProductList godoc
+//
+//	@Summary Get products of campaign
+//	@Description API to get a page of products under specified campaign
+//	@Tags campaign products
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [column asc/column desc], column is one of campaign_product_id, product_id, sku_no, serial_no, sequence_no, product_type, created_at"
+//	@Param	product_id query int false "Product ID"
+//	@Param	sku_no query int false "SKU Number"
+//	@Param	product_type query string false "Product Type [cd/ncd]"
+//	@Success 200 {object} dto.CampaignProductListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/products [get]
+func (c *CampaignProductController) GetProductList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	filter, err := c.generateProductFilterFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	response, err := c.campaignProductUseCases.GetProductList(ctx, int64(campaignID), params.ToCampaignProductFilterEntity(filter))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *CampaignProductController) generateProductFilterFromRequest(r *http.Request) (params.CampaignProductFilter, error) {
+	filter := params.CampaignProductFilter{
+		Pagination: params.Pagination{
+			Limit: c.appConfig.PaginationConfig.Limit,
+			Page:  c.appConfig.PaginationConfig.Page,
+			Sort:  "sequence_no asc",
+		},
+	}
+	var err error
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			filter.Limit, err = strconv.Atoi(queryValue)
+		case "page":
+			filter.Page, err = strconv.Atoi(queryValue)
+		case "sort":
+			filter.Sort = queryValue
+		case "product_id":
+			filter.ProductID, err = strconv.ParseInt(queryValue, 10, 64)
+		case "sku_no":
+			filter.SKUNo, err = strconv.ParseInt(queryValue, 10, 64)
+		case "product_type":
+			filter.ProductType = queryValue
+		}
+		if err != nil {
+			return filter, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if filter.Limit < 1 || filter.Page < 1 {
+		return filter, fmt.Errorf("limit and page must be positive")
+	}
+	filter.SortFields, err = params.ToSortFields(filter.Sort, params.CampaignProductSortColumns)
+	if err != nil {
+		return filter, err
+	}
+	return filter, validator.New().Struct(filter)
+}
+
+// UpdateProduct godoc
+//
+//	@Summary Update a campaign product
+//	@Description API to update product details and sequence of a product under specified campaign, fields left empty are not changed.
+//	@Description Campaigns selling a changed product at a store of the campaign in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the change is undone when the overlap mode rejects
+//	@Tags campaign products
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	id	path int true "Campaign Product ID"
+//	@Param	product body params.CampaignProductUpdateForm true "Product Details"
+//	@Success 200 {object} dto.CampaignProducts
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/products/{id} [put]
+func (c *CampaignProductController) UpdateProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	campaignProductID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect campaign product id value, err : %v", err.Error()))
+		return
+	}
+
+	request, err := c.validateUpdateProductRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	productEntity := params.ToCampaignProductUpdateEntity(request, int64(campaignProductID), int64(campaignID), int64(userID))
+	var response *dto.CampaignProducts
+	var overlaps []int64
+	err = c.tx.RunWithTransaction(ctx, func(ctx context.Context) error {
+		var existing []entities.CampaignOverlap
+		var err error
+		productChanged := productEntity.ProductID != 0 || productEntity.SKUNo != 0
+		if productChanged {
+			if existing, err = c.campaignUseCases.GetOverlaps(ctx, int64(campaignID)); err != nil {
+				return err
+			}
+		}
+		if response, err = c.campaignProductUseCases.UpdateProduct(ctx, productEntity); err != nil {
+			return err
+		}
+		if productChanged {
+			overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, int64(campaignID), existing)
+		}
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrProductNotExists) {
+			dto.NotFoundJSON(w, r, err.Error())
+			return
+		}
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	setOverlapHeader(w, overlaps)
+
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+// ReorderProducts godoc
+//
+//	@Summary Reorder campaign products
+//	@Description API to rewrite sequence_no of all products under specified campaign, the products are numbered from 1 in the given order
+//	@Tags campaign products
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	order body params.CampaignProductOrderForm true "Ordered campaign product ids"
+//	@Success 200 {object} []dto.CampaignProducts
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/products/order [put]
+func (c *CampaignProductController) ReorderProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateProductOrderRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	var response []*dto.CampaignProducts
+	err = c.tx.RunWithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		response, err = c.campaignProductUseCases.ReorderProducts(ctx, int64(campaignID), request.CampaignProductIDs, int64(userID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrProductNotExists) || errors.Is(err, valueobjects.ErrProductOrderInvalid) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *CampaignProductController) validateUpdateProductRequest(r *http.Request) (params.CampaignProductUpdateForm, error) {
+	var request params.CampaignProductUpdateForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+func (c *CampaignProductController) validateProductOrderRequest(r *http.Request) (params.CampaignProductOrderForm, error) {
+	var request params.CampaignProductOrderForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+// DeleteProduct godoc
+//
+//	@Summary Delete particular campaign product by product id
+//	@Description API to delete particular product under a specified campaign
+//	@Tags campaign products
+//	@Produce json
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	id	path int true "Product ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/products/{id} [delete]
+func (c *CampaignProductController) DeleteProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if campaignIDError != nil {
+		dto.BadRequestJSON(w, r, "please provide correct campaign_id ")
+	}
+	productID, productIDError := strconv.Atoi(chi.URLParam(r, "id"))
+	if productIDError != nil {
+		dto.BadRequestJSON(w, r, "please provide correct id")
+	}
+	err := c.campaignProductUseCases.DeleteByCampaignId(ctx, int64(campaignID), int64(productID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, "product not deleted")
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("product with id %d deleted successfully", productID))
+}
+
+// DeleteProduct godoc
+//
+//	@Summary Delete particular campaign products
+//	@Description API to delete all products under a specified campaign
+//	@Tags campaign products
+//	@Produce json
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/products [delete]
+func (c *CampaignProductController) DeleteAllProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, campaignIDError := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if campaignIDError != nil {
+		dto.BadRequestJSON(w, r, "please provide correct campaign_id ")
+	}
+	err := c.campaignProductUseCases.DeleteAllByCampaignId(ctx, int64(campaignID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, "products not deleted")
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("all products for campaign id %d deleted successfully", campaignID))
+}
@@ -1,129 +1,351 @@
-package http
-
-import (
-	"bytes"
-	"encoding/json"
-	"net/http"
-	"net/http/httptest"
-	"testing"
-
-	"github.com/stretchr/testify/assert"
-)
-
-func TestCampaignProductController_AddProducts(t *testing.T) {
-	// Setup
-	// t.Run("Add Products Executed Sucessfully", func(t *testing.T) {
-	// 	controller := NewCampaignProductController(nil, nil, nil)
-
-	// 	payload := map[string]interface{}{
-	// 		"campaign_id": 1,
-	// 		"created_by":  11,
-	// 		"products": []entities.CampaignProduct{
-	// 			{
-	// 				ProductID:   123,
-	// 				SKUNo:       1111,
-	// 				SerialNo:    1,
-	// 				SequenceNo:  1,
-	// 				ProductType: "cd",
-	// 				CampaignID:  valueobjects.CampaignID(1),
-	// 				CreatedBy:   int64(11),
-	// 			},
-	// 			{
-	// 				ProductID:   456,
-	// 				SKUNo:       2222,
-	// 				SerialNo:    2,
-	// 				SequenceNo:  2,
-	// 				ProductType: "cd",
-	// 				CampaignID:  valueobjects.CampaignID(1),
-	// 				CreatedBy:   int64(11),
-	// 			},
-	// 		},
-	// 	}
-
-	// 	payloadBytes, _ := json.Marshal(payload)
-	// 	req, _ := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
-	// 	rr := httptest.NewRecorder()
-
-	// 	// Execute
-	// 	controller.AddProducts(rr, req)
-
-	// 	// Assert
-	// 	assert.Equal(t, http.StatusOK, rr.Code)
-	// })
-
-	t.Run("Add Products Executed Failed due to error", func(t *testing.T) {
-		controller := NewCampaignProductController(nil, nil, nil)
-
-		payload := map[string]interface{}{}
-
-		payloadBytes, _ := json.Marshal(payload)
-		req, _ := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
-		rr := httptest.NewRecorder()
-
-		// Execute
-		controller.AddProducts(rr, req)
-
-		// Assert
-		assert.Equal(t, rr.Body.String(), "{\"code\":400,\"message\":\"Bad Request : Key: 'CampaignProductCreationForm.CampaignID' Error:Field validation for 'CampaignID' failed on the 'required' tag\\nKey: 'CampaignProductCreationForm.Products' Error:Field validation for 'Products' failed on the 'required' tag\\nKey: 'CampaignProductCreationForm.CreatedBy' Error:Field validation for 'CreatedBy' failed on the 'required' tag\"}\n")
-	})
-
-}
-
-// func TestAddProducts(t *testing.T) {
-// 	// Create a new instance of the controller
-// 	controller := &CampaignProductController{}
-// 	ctx := context.Background()
-// 	// Create a new request with sample data
-// 	payload := map[string]interface{}{
-// 		"campaign_id": 1,
-// 		"created_by":  11,
-// 		"products": []entities.CampaignProduct{
-// 			{
-// 				ProductID:  123,
-// 				SKUNo:      1111,
-// 				SerialNo:   1,
-// 				SequenceNo: 1,
-// 				ProductType: "cd",
-// 				CampaignID: valueobjects.CampaignID(1),
-// 				CreatedBy:  int64(11),
-// 			},
-// 			{
-// 				ProductID:  456,
-// 				SKUNo:      2222,
-// 				SerialNo:   2,
-// 				SequenceNo: 2,
-// 				ProductType: "cd",
-// 				CampaignID: valueobjects.CampaignID(1),
-// 				CreatedBy:  int64(11),
-// 			},
-// 		},
-// 	}
-
-// 	payloadBytes, _ := json.Marshal(payload)
-// 	req, err := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
-// 	if err != nil {
-// 		t.Fatal(err)
-// 	}
-
-// 	// Create a new response recorder
-// 	rr := httptest.NewRecorder()
-// 	txs := &mock1.TransactionService{}
-// 	productMock := &mocks.CampaignProductUseCases{}
-// 	controller.tx = txs
-// 	controller.campaignProductUseCases = productMock
-// 	txs.On("RunWithTransaction", ctx, mock.Anything).
-// 		Return(func(ctx context.Context, fn func(context.Context) error) error {
-// 			return fn(ctx)
-// 		})
-// 	productMock.On("AddProducts", ctx, mock.Anything).Return(nil, nil)
-
-// 	r := chi.NewRouter()
-// 	controller.Init(r)
-// 	r.ServeHTTP(rr, req)
-// 	controller.create(ctx, params.CampaignProductCreationForm{})
-
-// 	// Check that the status code is as expected
-// 	if status := rr.Code; status != http.StatusOK {
-// 		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-// 	}
-// }
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	service_mocks "campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCampaignProductController_AddProducts(t *testing.T) {
+	// Setup
+	// t.Run("Add Products Executed Sucessfully", func(t *testing.T) {
+	// 	controller := NewCampaignProductController(nil, nil, nil, nil)
+
+	// 	payload := map[string]interface{}{
+	// 		"campaign_id": 1,
+	// 		"created_by":  11,
+	// 		"products": []entities.CampaignProduct{
+	// 			{
+	// 				ProductID:   123,
+	// 				SKUNo:       1111,
+	// 				SerialNo:    1,
+	// 				SequenceNo:  1,
+	// 				ProductType: "cd",
+	// 				CampaignID:  valueobjects.CampaignID(1),
+	// 				CreatedBy:   int64(11),
+	// 			},
+	// 			{
+	// 				ProductID:   456,
+	// 				SKUNo:       2222,
+	// 				SerialNo:    2,
+	// 				SequenceNo:  2,
+	// 				ProductType: "cd",
+	// 				CampaignID:  valueobjects.CampaignID(1),
+	// 				CreatedBy:   int64(11),
+	// 			},
+	// 		},
+	// 	}
+
+	// 	payloadBytes, _ := json.Marshal(payload)
+	// 	req, _ := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
+	// 	rr := httptest.NewRecorder()
+
+	// 	// Execute
+	// 	controller.AddProducts(rr, req)
+
+	// 	// Assert
+	// 	assert.Equal(t, http.StatusOK, rr.Code)
+	// })
+
+	t.Run("Add Products Executed Failed due to error", func(t *testing.T) {
+		controller := NewCampaignProductController(nil, nil, nil, nil)
+
+		payload := map[string]interface{}{}
+
+		payloadBytes, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
+		rr := httptest.NewRecorder()
+
+		// Execute
+		controller.AddProducts(rr, req)
+
+		// Assert
+		assert.Equal(t, rr.Body.String(), "{\"code\":400,\"message\":\"Bad Request : Key: 'CampaignProductCreationForm.CampaignID' Error:Field validation for 'CampaignID' failed on the 'required' tag\\nKey: 'CampaignProductCreationForm.Products' Error:Field validation for 'Products' failed on the 'required' tag\\nKey: 'CampaignProductCreationForm.CreatedBy' Error:Field validation for 'CreatedBy' failed on the 'required' tag\"}\n")
+	})
+
+}
+
+func TestCampaignProductController_GetProductList(t *testing.T) {
+	appConfig := entities.AppCfg{
+		PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1},
+	}
+	newRequest := func(query string) *http.Request {
+		req, _ := http.NewRequest("GET", "/campaigns/1/products?"+query, nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("campaign_id", "1")
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("Get Product List request success", func(t *testing.T) {
+		req := newRequest("limit=2&page=2&sort=sequence_no%20desc&product_type=cd&sku_no=1111")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		controller := NewCampaignProductController(mockCampaignUsecase, mockCampaignProductUsecase, nil, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignProductUsecase.On("GetProductList", req.Context(), int64(1), entities.CampaignProductFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 2, Page: 2, Offset: 2, SortFields: []entities.SortField{{Column: "sequence_no", Desc: true}}},
+			SKUNo:            1111,
+			ProductType:      "cd",
+		}).Return(&dto.CampaignProductListResponse{
+			ListResponseFields: dto.ListResponseFields{Code: http.StatusOK, Status: "SUCCESS"},
+			Data: dto.CampaignProductDataList{
+				PaginationFields: dto.PaginationFields{Count: 3, Limit: 2, Offset: 2},
+				CampaignID:       1,
+				Products:         []*dto.CampaignProducts{{ID: 5, ProductID: 501, SKUNo: 1111, SequenceNo: 1, ProductType: "cd"}},
+			},
+		}, nil)
+
+		controller.GetProductList(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		expected := `{"code":200,"status":"SUCCESS","data":{"count":3,"limit":2,"offset":2,"campaign_id":1,` +
+			`"products":[{"campaign_product_id":5,"product_id":501,"sku_no":1111,"serial_no":0,"sequence_no":1,"product_type":"cd"}]}}`
+		assert.Equal(t, expected, strings.TrimSpace(w.Body.String()))
+	})
+
+	t.Run("Get Product List request with unknown sort field", func(t *testing.T) {
+		req := newRequest("sort=title")
+		w := httptest.NewRecorder()
+		controller := NewCampaignProductController(mocks.NewCampaignUseCases(t), mocks.NewCampaignProductUseCases(t), nil, &appConfig)
+
+		controller.GetProductList(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Get Product List request with unknown product type", func(t *testing.T) {
+		req := newRequest("product_type=abc")
+		w := httptest.NewRecorder()
+		controller := NewCampaignProductController(mocks.NewCampaignUseCases(t), mocks.NewCampaignProductUseCases(t), nil, &appConfig)
+
+		controller.GetProductList(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Get Product List request for campaign not exists", func(t *testing.T) {
+		req := newRequest("")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		controller := NewCampaignProductController(mockCampaignUsecase, mocks.NewCampaignProductUseCases(t), nil, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, nil)
+
+		controller.GetProductList(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCampaignProductController_UpdateProduct(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest("PUT", "/campaigns/1/products/5", bytes.NewBufferString(body))
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("campaign_id", "1")
+		ctx.URLParams.Add("id", "5")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+
+	t.Run("Update Product request success", func(t *testing.T) {
+		req := newRequest(`{"sequence_no": 3, "product_type": "ncd"}`)
+		w := httptest.NewRecorder()
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		controller := NewCampaignProductController(nil, mockCampaignProductUsecase, newRunningTransactionService(t), nil)
+		mockCampaignProductUsecase.On("UpdateProduct", req.Context(), entities.CampaignProduct{
+			ID: 5, CampaignID: 1, SequenceNo: 3, ProductType: "ncd", UpdatedBy: 12345,
+		}).Return(&dto.CampaignProducts{ID: 5, ProductID: 501, SequenceNo: 3, ProductType: "ncd"}, nil)
+
+		controller.UpdateProduct(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		expected := `{"campaign_product_id":5,"product_id":501,"sku_no":0,"serial_no":0,"sequence_no":3,"product_type":"ncd"}`
+		assert.Equal(t, expected, strings.TrimSpace(w.Body.String()))
+	})
+
+	t.Run("Update Product request changing the product to one another campaign sells at its stores", func(t *testing.T) {
+		req := newRequest(`{"product_id": 777}`)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		controller := NewCampaignProductController(mockCampaignUsecase, mockCampaignProductUsecase, newRunningTransactionService(t), nil)
+		existing := []entities.CampaignOverlap{{CampaignID: 3, ProductID: 501, StoreID: 84}}
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return(existing, nil)
+		mockCampaignProductUsecase.On("UpdateProduct", req.Context(), entities.CampaignProduct{
+			ID: 5, CampaignID: 1, ProductID: 777, UpdatedBy: 12345,
+		}).Return(&dto.CampaignProducts{ID: 5, ProductID: 777}, nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(1), existing).
+			Return(nil, fmt.Errorf("%w: campaign ids 9", valueobjects.ErrCampaignOverlap))
+
+		controller.UpdateProduct(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Update Product request for product of another campaign", func(t *testing.T) {
+		req := newRequest(`{"sequence_no": 3}`)
+		w := httptest.NewRecorder()
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		controller := NewCampaignProductController(nil, mockCampaignProductUsecase, newRunningTransactionService(t), nil)
+		mockCampaignProductUsecase.On("UpdateProduct", req.Context(), mock.Anything).
+			Return(nil, fmt.Errorf("%w: campaign product id 5", valueobjects.ErrProductNotExists))
+
+		controller.UpdateProduct(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Update Product request with invalid product type", func(t *testing.T) {
+		req := newRequest(`{"product_type": "abc"}`)
+		w := httptest.NewRecorder()
+		controller := NewCampaignProductController(nil, mocks.NewCampaignProductUseCases(t), nil, nil)
+
+		controller.UpdateProduct(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Update Product request not committed", func(t *testing.T) {
+		req := newRequest(`{"sequence_no": 3}`)
+		w := httptest.NewRecorder()
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		controller := NewCampaignProductController(nil, mocks.NewCampaignProductUseCases(t), mockTransactionService, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).Return(errors.New("commit failed"))
+
+		controller.UpdateProduct(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCampaignProductController_ReorderProducts(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest("PUT", "/campaigns/1/products/order", bytes.NewBufferString(body))
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("campaign_id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+
+	t.Run("Reorder Products request success", func(t *testing.T) {
+		req := newRequest(`{"campaign_product_ids": [7, 5, 6]}`)
+		w := httptest.NewRecorder()
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		controller := NewCampaignProductController(nil, mockCampaignProductUsecase, mockTransactionService, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignProductUsecase.On("ReorderProducts", req.Context(), int64(1), []int64{7, 5, 6}, int64(12345)).
+			Return([]*dto.CampaignProducts{{ID: 7, SequenceNo: 1}, {ID: 5, SequenceNo: 2}, {ID: 6, SequenceNo: 3}}, nil)
+
+		controller.ReorderProducts(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Reorder Products request with product of another campaign", func(t *testing.T) {
+		req := newRequest(`{"campaign_product_ids": [7, 5, 8]}`)
+		w := httptest.NewRecorder()
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		controller := NewCampaignProductController(nil, mockCampaignProductUsecase, mockTransactionService, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignProductUsecase.On("ReorderProducts", req.Context(), int64(1), []int64{7, 5, 8}, int64(12345)).
+			Return(nil, fmt.Errorf("%w: campaign product id 8", valueobjects.ErrProductNotExists))
+
+		controller.ReorderProducts(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, `{"code":400,"message":"Bad Request : campaign product not exists: campaign product id 8"}`,
+			strings.TrimSpace(w.Body.String()))
+	})
+
+	t.Run("Reorder Products request with repeated ids", func(t *testing.T) {
+		req := newRequest(`{"campaign_product_ids": [7, 7]}`)
+		w := httptest.NewRecorder()
+		controller := NewCampaignProductController(nil, mocks.NewCampaignProductUseCases(t), nil, nil)
+
+		controller.ReorderProducts(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+// func TestAddProducts(t *testing.T) {
+// 	// Create a new instance of the controller
+// 	controller := &CampaignProductController{}
+// 	ctx := context.Background()
+// 	// Create a new request with sample data
+// 	payload := map[string]interface{}{
+// 		"campaign_id": 1,
+// 		"created_by":  11,
+// 		"products": []entities.CampaignProduct{
+// 			{
+// 				ProductID:  123,
+// 				SKUNo:      1111,
+// 				SerialNo:   1,
+// 				SequenceNo: 1,
+// 				ProductType: "cd",
+// 				CampaignID: valueobjects.CampaignID(1),
+// 				CreatedBy:  int64(11),
+// 			},
+// 			{
+// 				ProductID:  456,
+// 				SKUNo:      2222,
+// 				SerialNo:   2,
+// 				SequenceNo: 2,
+// 				ProductType: "cd",
+// 				CampaignID: valueobjects.CampaignID(1),
+// 				CreatedBy:  int64(11),
+// 			},
+// 		},
+// 	}
+
+// 	payloadBytes, _ := json.Marshal(payload)
+// 	req, err := http.NewRequest("POST", "/campaigns/products", bytes.NewBuffer(payloadBytes))
+// 	if err != nil {
+// 		t.Fatal(err)
+// 	}
+
+// 	// Create a new response recorder
+// 	rr := httptest.NewRecorder()
+// 	txs := &mock1.TransactionService{}
+// 	productMock := &mocks.CampaignProductUseCases{}
+// 	controller.tx = txs
+// 	controller.campaignProductUseCases = productMock
+// 	txs.On("RunWithTransaction", ctx, mock.Anything).
+// 		Return(func(ctx context.Context, fn func(context.Context) error) error {
+// 			return fn(ctx)
+// 		})
+// 	productMock.On("AddProducts", ctx, mock.Anything).Return(nil, nil)
+
+// 	r := chi.NewRouter()
+// 	controller.Init(r)
+// 	r.ServeHTTP(rr, req)
+// 	controller.create(ctx, params.CampaignProductCreationForm{})
+
+// 	// Check that the status code is as expected
+// 	if status := rr.Code; status != http.StatusOK {
+// 		t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+// 	}
+// }
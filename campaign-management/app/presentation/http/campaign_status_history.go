@@ -0,0 +1,68 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/usecases/dto"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+type CampaignStatusHistoryController struct {
+	campaignUseCases      usecases.CampaignUseCases
+	statusHistoryUseCases usecases.CampaignStatusHistoryUseCases
+}
+
+func NewCampaignStatusHistoryController(campaignUseCases usecases.CampaignUseCases,
+	statusHistoryUseCases usecases.CampaignStatusHistoryUseCases) *CampaignStatusHistoryController {
+	return &CampaignStatusHistoryController{
+		campaignUseCases:      campaignUseCases,
+		statusHistoryUseCases: statusHistoryUseCases,
+	}
+}
+
+func (c *CampaignStatusHistoryController) Init(r chi.Router) {
+	r.Get("/campaigns/{campaign_id}/status-history", c.GetStatusHistory)
+}
+
+// GetStatusHistory godoc
+//
+//	@Summary Get status history of campaign
+//	@Description API to get every status change of particular campaign with the user id or scheduler who made it
+//	@Tags campaign
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Success 200 {object} dto.CampaignStatusHistoryResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/status-history [get]
+func (c *CampaignStatusHistoryController) GetStatusHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	history, err := c.statusHistoryUseCases.GetHistory(ctx, int64(campaignID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToCampaignStatusHistoryResponse(history))
+}
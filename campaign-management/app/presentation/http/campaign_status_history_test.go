@@ -0,0 +1,112 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestCampaignStatusHistoryController_GetStatusHistory(t *testing.T) {
+	newRequest := func(campaignID string) *http.Request {
+		req, _ := http.NewRequest("GET", "/campaigns/"+campaignID+"/status-history", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("campaign_id", campaignID)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("failure due to incorrect campaign id", func(t *testing.T) {
+		req := newRequest("abc")
+		w := httptest.NewRecorder()
+		controller := NewCampaignStatusHistoryController(mocks.NewCampaignUseCases(t), mocks.NewCampaignStatusHistoryUseCases(t))
+
+		controller.GetStatusHistory(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("failure due to campaign with given id not exists", func(t *testing.T) {
+		req := newRequest("42")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		controller := NewCampaignStatusHistoryController(mockCampaignUsecase, mocks.NewCampaignStatusHistoryUseCases(t))
+		mockCampaignUsecase.On("Exists", req.Context(), int64(42), "").Return(false, nil)
+
+		controller.GetStatusHistory(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+		expected := `{"code":404,"message":"Entity Not Found : campaign with id 42 not exists"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while getting status history", func(t *testing.T) {
+		req := newRequest("42")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockStatusHistoryUsecase := mocks.NewCampaignStatusHistoryUseCases(t)
+		controller := NewCampaignStatusHistoryController(mockCampaignUsecase, mockStatusHistoryUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(42), "").Return(true, nil)
+		mockStatusHistoryUsecase.On("GetHistory", req.Context(), int64(42)).Return(nil, errors.New("db error"))
+
+		controller.GetStatusHistory(w, req)
+
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+	})
+
+	t.Run("Success : status history fetched successfully", func(t *testing.T) {
+		req := newRequest("42")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockStatusHistoryUsecase := mocks.NewCampaignStatusHistoryUseCases(t)
+		controller := NewCampaignStatusHistoryController(mockCampaignUsecase, mockStatusHistoryUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(42), "").Return(true, nil)
+		mockStatusHistoryUsecase.On("GetHistory", req.Context(), int64(42)).Return([]*dto.CampaignStatusHistoryDTO{
+			{
+				ID:            1,
+				CampaignID:    42,
+				OldStatusCode: 3,
+				OldStatus:     "Scheduled",
+				NewStatusCode: 2,
+				NewStatus:     "Active",
+				Actor:         "scheduler",
+				ChangedAt:     "2023-03-01 01:30:00",
+			},
+			{
+				ID:            2,
+				CampaignID:    42,
+				OldStatusCode: 2,
+				OldStatus:     "Active",
+				NewStatusCode: 1,
+				NewStatus:     "InActive",
+				Actor:         "12345",
+				ChangedAt:     "2023-03-10 08:00:00",
+			},
+		}, nil)
+
+		controller.GetStatusHistory(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":[` +
+			`{"id":1,"campaign_id":42,"old_status_code":3,"old_status":"Scheduled","new_status_code":2,"new_status":"Active","actor":"scheduler","changed_at":"2023-03-01 01:30:00"},` +
+			`{"id":2,"campaign_id":42,"old_status_code":2,"old_status":"Active","new_status_code":1,"new_status":"InActive","actor":"12345","changed_at":"2023-03-10 08:00:00"}]}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+}
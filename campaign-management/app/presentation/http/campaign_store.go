@@ -1,230 +1,421 @@
-package http
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/usecases"
-	"campaign-mgmt/app/usecases/dto"
-	"campaign-mgmt/app/usecases/params"
-	"campaign-mgmt/app/usecases/util"
-	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strconv"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/go-playground/validator/v10"
-)
-
-const (
-	IncorrectCampaignIDErr = "incorrect campaign id value, err : %v"
-	CampaignNotExistsErr   = "campaign with id %d not exists"
-)
-
-type CampaignStoreController struct {
-	campaignUseCases      usecases.CampaignUseCases
-	campaignStoreUseCases usecases.CampaignStoreUseCases
-}
-
-func NewCampaignStoreController(campaignUsecases usecases.CampaignUseCases,
-	campaignStoreUseCases usecases.CampaignStoreUseCases) *CampaignStoreController {
-	return &CampaignStoreController{
-		campaignUseCases:      campaignUsecases,
-		campaignStoreUseCases: campaignStoreUseCases,
-	}
-}
-
-func (c *CampaignStoreController) Init(r chi.Router) {
-	r.Route("/campaigns/{campaign_id}/stores", func(r chi.Router) {
-		r.Delete("/", c.DeleteStores)
-		r.Delete("/{id}", c.DeleteStore)
-		r.Post("/", c.AddStores)
-	})
-}
-
-// DeleteStores godoc
-//
-//	@Summary Delete all stores under partilcular campaign
-//	@Description API to delete all stores under specified campaign
-//	@Tags campaign stores
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Param	campaign_id	path int true "Campaign ID"
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{campaign_id}/stores [delete]
-func (c *CampaignStoreController) DeleteStores(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	userID, err := util.GetUserID(ctx)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
-	if err != nil {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
-		return
-	}
-	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	if !exists {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
-		return
-	}
-
-	err = c.campaignStoreUseCases.DeleteStores(ctx, int64(campaignID), int64(userID))
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	dto.SuccessJSON(w, r, fmt.Sprintf("all campaign stores with campaign id %d deleted successfully", campaignID))
-}
-
-// DeleteStore godoc
-//
-//	@Summary Delete specified store with given store id under partilcular campaign
-//	@Description API to delete particular store under specified campaign
-//	@Tags campaign stores
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Param	campaign_id	path int true "Campaign ID"
-//	@Param	id	path int true "Campaign Store ID"
-//	@Success 200 {object} dto.Response
-//	@Failure 400 {object} dto.Response
-//	@Failure 404 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{campaign_id}/stores/{id} [delete]
-func (c *CampaignStoreController) DeleteStore(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	userID, err := util.GetUserID(ctx)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
-	if err != nil {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
-		return
-	}
-	storeID, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil {
-		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect store id value, err : %v", err.Error()))
-		return
-	}
-
-	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	if !exists {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
-		return
-	}
-
-	err = c.campaignStoreUseCases.DeleteStore(ctx, int64(campaignID), int64(storeID), int64(userID))
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	dto.SuccessJSON(w, r, fmt.Sprintf("store with id %d deleted successfully", storeID))
-}
-
-// AddStores godoc
-//
-//	@Summary add stores for specific campaign
-//	@Description API to insert new stores under given campaign id
-//	@Tags campaign stores
-//	@Accept json
-//	@Produce json
-//	@Security ApiKeyAuth
-//	@Param	campaign_id	path int true "Campaign ID"
-//	@Param	stores body params.CampaignStoresForm true "Store Details"
-//	@Success 200 {object} dto.CampaignStoresDTO
-//	@Failure 400 {object} dto.Response
-//	@Failure 409 {object} dto.Response
-//	@Failure 500 {object} dto.Response
-//	@Router	/campaigns/{campaign_id}/stores [post]
-func (c *CampaignStoreController) AddStores(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	userID, err := util.GetUserID(ctx)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
-	if err != nil {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
-		return
-	}
-
-	request, err := c.validateStoresRequest(r)
-	if err != nil {
-		dto.BadRequestJSON(w, r, err.Error())
-		return
-	}
-
-	var exists bool
-	exists, err = c.campaignUseCases.Exists(ctx, int64(campaignID), "")
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	if !exists {
-		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
-		return
-	}
-
-	stores, err := c.addStores(ctx, request, campaignID, int64(userID))
-	if err != nil {
-		dto.InternalServerErrorJSON(w, r, err.Error())
-		return
-	}
-
-	response := dto.CampaignStoresDTO{
-		CampaignID: int64(campaignID),
-		Stores:     stores,
-	}
-
-	dto.SuccessJSONResponse(w, r, response)
-}
-
-func (c *CampaignStoreController) addStores(ctx context.Context, request params.CampaignStoresForm, campaignID int, userID int64) ([]*dto.CampaignStores, error) {
-	storeEntities := []entities.CampaignStore{}
-	for _, storeID := range request.Stores {
-		storeEntities = append(storeEntities, params.ToCampaignStoreEntity(storeID, int64(campaignID), userID))
-	}
-	storeDetails, err := c.campaignStoreUseCases.AddStores(ctx, storeEntities)
-	if err != nil {
-		return nil, err
-	}
-	return storeDetails, nil
-}
-
-func (c *CampaignStoreController) validateStoresRequest(r *http.Request) (params.CampaignStoresForm, error) {
-	var request params.CampaignStoresForm
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&request)
-	if err != nil {
-		return request, err
-	}
-	defer r.Body.Close()
-
-	validate := validator.New()
-	err = validate.Struct(request)
-	if err != nil {
-		return request, err
-	}
-
-	return request, nil
-}
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	IncorrectCampaignIDErr = "incorrect campaign id value, err : %v"
+	CampaignNotExistsErr   = "campaign with id %d not exists"
+)
+
+type CampaignStoreController struct {
+	campaignUseCases      usecases.CampaignUseCases
+	campaignStoreUseCases usecases.CampaignStoreUseCases
+	blackoutUseCases      usecases.BlackoutUseCases
+	tx                    services.TransactionService
+	appConfig             *entities.AppCfg
+}
+
+func NewCampaignStoreController(campaignUsecases usecases.CampaignUseCases,
+	campaignStoreUseCases usecases.CampaignStoreUseCases, blackoutUseCases usecases.BlackoutUseCases,
+	transactionService services.TransactionService, appConfig *entities.AppCfg) *CampaignStoreController {
+	return &CampaignStoreController{
+		campaignUseCases:      campaignUsecases,
+		campaignStoreUseCases: campaignStoreUseCases,
+		blackoutUseCases:      blackoutUseCases,
+		tx:                    transactionService,
+		appConfig:             appConfig,
+	}
+}
+
+func (c *CampaignStoreController) Init(r chi.Router) {
+	r.Route("/campaigns/{campaign_id}/stores", func(r chi.Router) {
+		r.Get("/", c.GetStoreList)
+		r.Delete("/", c.DeleteStores)
+		r.Delete("/{id}", c.DeleteStore)
+		r.Post("/", c.AddStores)
+	})
+}
+
+// GetStoreList godoc
+//
+//	@Summary Get stores of campaign
+//	@Description API to get a page of stores under specified campaign
+//	@Tags campaign stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [column asc/column desc], column is one of campaign_store_id, store_id, created_at"
+//	@Param	store_id query int false "Store ID"
+//	@Success 200 {object} dto.CampaignStoreListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/stores [get]
+func (c *CampaignStoreController) GetStoreList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	filter, err := c.generateStoreFilterFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	response, err := c.campaignStoreUseCases.GetStoreList(ctx, int64(campaignID), params.ToCampaignStoreFilterEntity(filter))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *CampaignStoreController) generateStoreFilterFromRequest(r *http.Request) (params.CampaignStoreFilter, error) {
+	filter := params.CampaignStoreFilter{
+		Pagination: params.Pagination{
+			Limit: c.appConfig.PaginationConfig.Limit,
+			Page:  c.appConfig.PaginationConfig.Page,
+			Sort:  "campaign_store_id asc",
+		},
+	}
+	var err error
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			filter.Limit, err = strconv.Atoi(queryValue)
+		case "page":
+			filter.Page, err = strconv.Atoi(queryValue)
+		case "sort":
+			filter.Sort = queryValue
+		case "store_id":
+			filter.StoreID, err = strconv.ParseInt(queryValue, 10, 64)
+		}
+		if err != nil {
+			return filter, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if filter.Limit < 1 || filter.Page < 1 {
+		return filter, fmt.Errorf("limit and page must be positive")
+	}
+	filter.SortFields, err = params.ToSortFields(filter.Sort, params.CampaignStoreSortColumns)
+	return filter, err
+}
+
+// DeleteStores godoc
+//
+//	@Summary Delete all stores under partilcular campaign
+//	@Description API to delete all stores under specified campaign
+//	@Tags campaign stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/stores [delete]
+func (c *CampaignStoreController) DeleteStores(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	if !exists {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	err = c.campaignStoreUseCases.DeleteStores(ctx, int64(campaignID), int64(userID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	dto.SuccessJSON(w, r, fmt.Sprintf("all campaign stores with campaign id %d deleted successfully", campaignID))
+}
+
+// DeleteStore godoc
+//
+//	@Summary Delete specified store with given store id under partilcular campaign
+//	@Description API to delete particular store under specified campaign
+//	@Tags campaign stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	id	path int true "Campaign Store ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/stores/{id} [delete]
+func (c *CampaignStoreController) DeleteStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect store id value, err : %v", err.Error()))
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	if !exists {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	err = c.campaignStoreUseCases.DeleteStore(ctx, int64(campaignID), int64(storeID), int64(userID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	dto.SuccessJSON(w, r, fmt.Sprintf("store with id %d deleted successfully", storeID))
+}
+
+// AddStores godoc
+//
+//	@Summary add stores for specific campaign
+//	@Description API to insert new stores under given campaign id, every store must be registered and active. The active stores of store_group_ids are added too, leaving out the stores the campaign already has.
+//	@Description Campaigns selling a product of the campaign at an added store in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the stores are not added when the overlap mode rejects
+//	@Tags campaign stores
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	stores body params.CampaignStoresForm true "Store Details"
+//	@Success 200 {object} dto.CampaignStoresDTO
+//	@Header 200 {string} X-Overlapping-Campaign-Ids "Comma separated ids of the overlapping campaigns"
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/stores [post]
+func (c *CampaignStoreController) AddStores(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateStoresRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	var exists bool
+	exists, err = c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	if !exists {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	stores, overlaps, err := c.create(ctx, request, campaignID, int64(userID))
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrStoreUnknown) || errors.Is(err, valueobjects.ErrStoreGroupNotExists) ||
+			errors.Is(err, valueobjects.ErrCollectionDateBlackedOut) {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+		if errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			dto.ConflictErrorJSON(w, r, err.Error())
+			return
+		}
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+
+	response := dto.CampaignStoresDTO{
+		CampaignID: int64(campaignID),
+		Stores:     stores,
+	}
+
+	setOverlapHeader(w, overlaps)
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+// create adds the stores and checks them for blackouts and the campaign for overlaps in one transaction so rejected
+// stores are not kept
+func (c *CampaignStoreController) create(ctx context.Context, request params.CampaignStoresForm, campaignID int, userID int64) ([]*dto.CampaignStores, []int64, error) {
+	var stores []*dto.CampaignStores
+	var overlaps []int64
+	var err error
+	err = c.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			var existing []entities.CampaignOverlap
+			if existing, err = c.campaignUseCases.GetOverlaps(ctx, int64(campaignID)); err != nil {
+				return err
+			}
+			if stores, err = c.addStores(ctx, request, campaignID, userID); err != nil {
+				return err
+			}
+			if err = c.checkBlackouts(ctx, int64(campaignID), stores); err != nil {
+				return err
+			}
+			if overlaps, err = c.campaignUseCases.CheckOverlaps(ctx, int64(campaignID), existing); err != nil {
+				return err
+			}
+			return nil
+		})
+	return stores, overlaps, err
+}
+
+func (c *CampaignStoreController) addStores(ctx context.Context, request params.CampaignStoresForm, campaignID int, userID int64) ([]*dto.CampaignStores, error) {
+	storeIDs := request.Stores
+	if len(request.StoreGroupIDs) > 0 {
+		var err error
+		storeIDs, err = c.storeGroupStoreIDs(ctx, request, int64(campaignID))
+		if err != nil {
+			return nil, err
+		}
+		if len(storeIDs) == 0 {
+			return []*dto.CampaignStores{}, nil
+		}
+	}
+	storeEntities := []entities.CampaignStore{}
+	for _, storeID := range storeIDs {
+		storeEntities = append(storeEntities, params.ToCampaignStoreEntity(storeID, int64(campaignID), userID))
+	}
+	storeDetails, err := c.campaignStoreUseCases.AddStores(ctx, storeEntities)
+	if err != nil {
+		return nil, err
+	}
+	return storeDetails, nil
+}
+
+// checkBlackouts refuses added stores with a blackout in the collection window of the campaign, the window is read
+// in the timezone the campaign dates are rendered in
+func (c *CampaignStoreController) checkBlackouts(ctx context.Context, campaignID int64, stores []*dto.CampaignStores) error {
+	if len(stores) == 0 {
+		return nil
+	}
+	campaign, err := c.campaignUseCases.Get(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	loc, err := util.LoadLocation(campaign.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	collectionStartDate, err := util.ToDateTime(campaign.CollectionStartDate, loc)
+	if err != nil {
+		return err
+	}
+	collectionEndDate, err := util.ToDateTime(campaign.CollectionEndDate, loc)
+	if err != nil {
+		return err
+	}
+	if collectionStartDate.IsZero() || collectionEndDate.IsZero() {
+		return nil
+	}
+	storeIDs := []int64{}
+	for _, store := range stores {
+		storeIDs = append(storeIDs, store.StoreID)
+	}
+	return c.blackoutUseCases.CheckCollectionDates(ctx, storeIDs, collectionStartDate, collectionEndDate)
+}
+
+// storeGroupStoreIDs returns the stores of the request with the stores of its store groups added,
+// leaving out the stores the campaign already has so adding a group twice adds nothing the second time
+func (c *CampaignStoreController) storeGroupStoreIDs(ctx context.Context, request params.CampaignStoresForm, campaignID int64) ([]int64, error) {
+	storeIDs, err := c.campaignStoreUseCases.ExpandStoreGroups(ctx, request.Stores, request.StoreGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	campaignStores, err := c.campaignStoreUseCases.GetStores(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	existingIDs := []int64{}
+	for _, campaignStore := range campaignStores {
+		existingIDs = append(existingIDs, campaignStore.StoreID)
+	}
+	return util.Difference(storeIDs, existingIDs), nil
+}
+
+func (c *CampaignStoreController) validateStoresRequest(r *http.Request) (params.CampaignStoresForm, error) {
+	var request params.CampaignStoresForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
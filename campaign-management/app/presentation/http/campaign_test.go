@@ -1,2072 +1,3063 @@
-package http
-
-import (
-	"bytes"
-	"campaign-mgmt/app/domain/entities"
-	service_mocks "campaign-mgmt/app/domain/services/mocks"
-	"campaign-mgmt/app/domain/usecases/mocks"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/dto"
-	"campaign-mgmt/app/usecases/params"
-	"context"
-	"errors"
-	"fmt"
-	"net/http"
-	"net/http/httptest"
-	"strings"
-	"testing"
-	"time"
-
-	"github.com/go-chi/chi/v5"
-	. "github.com/smartystreets/goconvey/convey"
-	"github.com/stretchr/testify/mock"
-)
-
-func TestCampaignController_Init(t *testing.T) {
-	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Write([]byte(`{"code": 200,"status": "SUCCESS"}`))
-	})
-	req, _ := http.NewRequest("GET", "/", nil)
-	campaignController := NewCampaignController(nil, nil, nil, nil, nil)
-	r := chi.NewRouter()
-	campaignController.Init(r)
-	w := httptest.NewRecorder()
-	h.ServeHTTP(w, req)
-	body := string(w.Body.String())
-	expected := `{"code": 200,"status": "SUCCESS"}`
-	if body != expected {
-		t.Fatalf("expected:%s got:%s", expected, body)
-	}
-}
-
-func TestCampaignController_validateCampaignRequest(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime:       20,
-			MaxDateDifference: 28,
-		},
-	}
-
-	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-	mockTransactionService := service_mocks.NewTransactionService(t)
-	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-		mockTransactionService, &appConfig)
-
-	t.Run("Request body validation failure : error occured while decoding", func(t *testing.T) {
-		var jsonStr = []byte(`{"campaign_status_code": 1,
-		"campaign_type": "deli}`)
-
-		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateCampaignRequest(req)
-		expectedErr := "unexpected EOF"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-	t.Run("Request body validation failure : missing required params", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 15,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"offer_id": 123,
-			"tag_id":456
-		  }`)
-
-		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateCampaignRequest(req)
-		expectedErr := "Key: 'CampaignCreationForm.Title' Error:Field validation for 'Title' failed on the 'required' tag"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-
-	t.Run("Request body validation failure : invalid dates", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-01 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 20,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id":456
-		  }`)
-
-		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateCampaignRequest(req)
-		expectedErr := "invalid date : Collection start date should be at least 20 days greater than order start date"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-	t.Run("Request body validation Success", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`)
-
-		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateCampaignRequest(req)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-}
-
-func TestCampaignController_validateUpdateCampaignRequest(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime:       20,
-			MaxDateDifference: 28,
-		},
-	}
-
-	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-	mockTransactionService := service_mocks.NewTransactionService(t)
-	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-		mockTransactionService, &appConfig)
-
-	t.Run("Request body validation failure : error occured while decoding", func(t *testing.T) {
-		var jsonStr = []byte(`{"campaign_status_code": 1,
-		"campaign_type": "deli}`)
-
-		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateUpdateCampaignRequest(req)
-		expectedErr := "unexpected EOF"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-	t.Run("Request body validation failure : key validation error", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "test",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 15,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00"
-		  }`)
-
-		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateUpdateCampaignRequest(req)
-		expectedErr := "Key: 'CampaignUpdateForm.CampaignType' Error:Field validation for 'CampaignType' failed on the 'oneof' tag"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-	t.Run("Request body validation failure : invalid dates", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-01 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"title": "new campaign"
-		  }`)
-
-		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateUpdateCampaignRequest(req)
-		expectedErr := "invalid date : Collection start date should be at least 3 days greater than order start date"
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-
-	t.Run("Request body validation Success", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"offer_id": 123,
-			"tag_id":456,
-			"title": "new campaign"
-		  }`)
-
-		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		if err != nil {
-			t.Fatal(err)
-		}
-		_, err = campaignController.validateUpdateCampaignRequest(req)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-}
-
-// Get Campaign tests
-func TestCampaignController_getCampaign(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime: 20,
-		},
-	}
-
-	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-	mockTransactionService := service_mocks.NewTransactionService(t)
-	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-		mockTransactionService, &appConfig)
-
-	t.Run("Get Campaign request success", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns/1", nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		query := req.URL.Query()
-		query.Add("omit_stores", "true")
-		query.Add("omit_products", "true")
-		req.URL.RawQuery = query.Encode()
-
-		res := httptest.NewRecorder()
-		response := dto.CampaignDTO{}
-		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&response, nil)
-		campaignController.GetCampaign(res, req)
-
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-	})
-
-	t.Run("Get Campaign request success when omit_stores=false and omit_products=false", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns/1", nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		query := req.URL.Query()
-		query.Add("omit_stores", "false")
-		query.Add("omit_products", "false")
-		req.URL.RawQuery = query.Encode()
-
-		res := httptest.NewRecorder()
-		response := dto.CampaignDTO{}
-		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&response, nil)
-		var campaignStores []*dto.CampaignStores
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(campaignStores, nil)
-		var campaignProducts []*dto.CampaignProducts
-		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(1)).Return(campaignProducts, nil)
-		campaignController.GetCampaign(res, req)
-
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-	})
-
-	t.Run("Get Campaign request error when omit_stores=false and omit_products=false", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns/1", nil)
-
-		query := req.URL.Query()
-		query.Add("omit_stores", "false")
-		query.Add("omit_products", "false")
-		req.URL.RawQuery = query.Encode()
-
-		res := httptest.NewRecorder()
-		response := dto.CampaignDTO{}
-		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
-		var campaignStores []*dto.CampaignStores
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
-		var campaignProducts []*dto.CampaignProducts
-		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
-		campaignController.GetCampaign(res, req)
-
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-	})
-
-	t.Run("Get Campaign request error when omit_stores=false and omit_products=false are nil", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns/1", nil)
-
-		res := httptest.NewRecorder()
-		response := dto.CampaignDTO{}
-		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
-		var campaignStores []*dto.CampaignStores
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
-		var campaignProducts []*dto.CampaignProducts
-		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
-		campaignController.GetCampaign(res, req)
-
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-	})
-
-	t.Run("Get Campaign request error when omit_stores and omit_products are invalid inputs", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns/1", nil)
-
-		query := req.URL.Query()
-		query.Add("omit_stores", "invalid_input")
-		query.Add("omit_products", "invalid_input")
-		req.URL.RawQuery = query.Encode()
-
-		res := httptest.NewRecorder()
-		response := dto.CampaignDTO{}
-		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
-		var campaignStores []*dto.CampaignStores
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
-		var campaignProducts []*dto.CampaignProducts
-		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
-		campaignController.GetCampaign(res, req)
-
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-	})
-}
-
-func TestCampaignController_CreateCampaign(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime:       20,
-			MaxDateDifference: 28,
-		},
-	}
-
-	t.Run("failure due to invalid user id error", func(t *testing.T) {
-		var jsonStr = []byte(`{"campaign_status_code": 1,
-		"campaign_type": "deli}`)
-
-		req, _ := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		req.Header.Set("Content-Type", "application/json")
-
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		campaignController.CreateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : invalid user id"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to request validation error", func(t *testing.T) {
-		var jsonStr = []byte(`{"campaign_status_code": 1,
-		"campaign_type": "deli}`)
-
-		req, _ := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		campaignController.CreateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : unexpected EOF"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while checking campaign existence", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, errors.New("db error"))
-
-		campaignController.CreateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to campaign with given name already exists", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(true, nil)
-
-		campaignController.CreateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusConflict {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
-		}
-
-		expected := `{"code":409,"message":"Conflict Error : campaign with given name already exists"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while creating campaign", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
-
-		campaignEntity := entities.Campaign{
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			CreatedBy:           12345,
-			LeadTime:            3,
-		}
-
-		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(nil, errors.New("db error"))
-
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-
-		campaignController.CreateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while adding stores", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
-		campaignEntity := entities.Campaign{
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			CreatedBy:           12345,
-			LeadTime:            3,
-		}
-		campaignDTO := dto.CampaignDTO{
-			ID:                  int64(1),
-			Title:               "new campaign",
-			StatusCode:          1,
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      "2023-03-01 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-05 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-			OfferID:             123,
-			TagID:               456,
-			LeadTime:            3,
-		}
-		storeEntities := []entities.CampaignStore{
-			{
-				CampaignID: 1,
-				StoreID:    83,
-				CreatedBy:  12345,
-			},
-		}
-		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(&campaignDTO, nil)
-		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(nil, errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.CreateCampaign(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("Success : campaign created successfully", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
-		campaignEntity := entities.Campaign{
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			CreatedBy:           12345,
-			LeadTime:            3,
-		}
-		campaignDTO := dto.CampaignDTO{
-			ID:                  int64(1),
-			Title:               "new campaign",
-			Name:                "new campaign",
-			StatusCode:          1,
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      "2023-03-01 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-05 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-			OfferID:             123,
-			TagID:               456,
-			LeadTime:            3,
-			IsCampaignPublished: false,
-		}
-		storeEntities := []entities.CampaignStore{
-			{
-				CampaignID: 1,
-				StoreID:    83,
-				CreatedBy:  12345,
-			},
-		}
-		storesDTO := []*dto.CampaignStores{
-			{
-				ID:      1,
-				StoreID: 83,
-			},
-		}
-		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(&campaignDTO, nil)
-		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(storesDTO, nil)
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.CreateCampaign(w, req)
-		if status := w.Code; status != http.StatusOK {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-		}
-		expected := `{"id":1,"campaign_title":"new campaign","name":"new campaign","campaign_status_code":1,"campaign_type":"deli","listing_title":"test screen title","listing_description":"test description","listing_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img2.jpg","onboarding_title":"test campaign","onboarding_description":"test desc","onboard_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img3.jpg","landing_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img1.jpg","order_start_date":"2023-03-01 12:00:00","order_end_date":"2023-03-31 12:00:00","collection_start_date":"2023-03-05 12:00:00","collection_end_date":"2023-04-05 12:00:00","lead_time":3,"offer_id":123,"tag_id":456,"is_campaign_published":false,"campaign_stores":[{"campaign_store_id":1,"store_id":83}]}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-}
-
-func TestCampaignController_saveCampaignDetails(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime:       20,
-			MaxDateDifference: 28,
-		},
-	}
-	t.Run("failure due to error occured while campaign entity conversion", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		request := params.CampaignCreationForm{
-			Title:               "new campaign",
-			StatusCode:          1,
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			OrderStartDate:      "2023-03-01 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-			CollectionStartDate: "2023-13-02 12:00:00",
-			OfferID:             123,
-			TagID:               456,
-			LeadTime:            3,
-		}
-		ctx := context.WithValue(context.Background(), "userId", int64(12345))
-
-		_, err := campaignController.saveCampaignDetails(ctx, request, int64(12345))
-		expectedErr := `parsing time "2023-13-02 12:00:00": month out of range`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-}
-
-func TestCampaignController_validateCampaignDates(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime:       20,
-			MaxDateDifference: 28,
-		},
-	}
-	t.Run("failure due invalid order start date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-01",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-02 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `parsing time "2023-03-01" as "2006-01-02 15:04:05": cannot parse "" as "15"`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due invalid order end date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-31 12:00:00",
-			OrderEndDate:        "2023-03-31",
-			CollectionStartDate: "2023-03-02 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `parsing time "2023-03-31" as "2006-01-02 15:04:05": cannot parse "" as "15"`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due invalid collection start date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-02 12:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `parsing time "2023-03-02 12:00" as "2006-01-02 15:04:05": cannot parse "" as ":"`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due invalid collection end date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-02 12:00:00",
-			CollectionEndDate:   "2023-04-77 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `parsing time "2023-04-77 12:00:00": day out of range`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due order start date is after order end date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-02 12:00:00",
-			CollectionStartDate: "2023-03-02 12:00:00",
-			CollectionEndDate:   "2023-04-02 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : order start date should be the date before order end date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due order start date is after collection start date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-02 12:00:00",
-			CollectionEndDate:   "2023-04-02 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : order start date should be the date before collection start date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due collection start date is after collection end date", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-15 12:00:00",
-			CollectionEndDate:   "2023-03-05 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : collection start date should be the date before collection end date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due collection start date and order start date validation not matched", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-01 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-30 12:00:00",
-			CollectionEndDate:   "2023-04-11 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : Collection start date should be less than 28 days from order start date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due collection end date and order end date validation not matched", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-01 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-28 12:00:00",
-			CollectionEndDate:   "2023-04-30 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : Collection end date should be less than 28 days from order end date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("failure due collection end date less than lead time", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-01 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-28 12:00:00",
-			CollectionEndDate:   "2023-04-09 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 10)
-		expectedErr := `invalid date : Collection end date should be at least 10 days greater than order end date`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-	t.Run("success scenario", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		dates := params.CampaignDates{
-			OrderStartDate:      "2023-03-03 12:00:00",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			CollectionStartDate: "2023-03-15 12:00:00",
-			CollectionEndDate:   "2023-04-28 12:00:00",
-		}
-		err := campaignController.validateCampaignDates(dates, 3)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-}
-
-func TestCampaignController_UpdateCampaign(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime: 20,
-			MaxDateDifference: 28,
-		},
-	}
-
-	t.Run("failure due to incorrect user id", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type" : "deli",
-			"title": "my new campaign 113",
-			"stores":[11111, 22222, 33333, 44444]
-		  }`)
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : invalid user id"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to incorrect campaign id", func(t *testing.T) {
-		var jsonStr = []byte(`{
-			"campaign_status_code": 1,
-			"campaign_type" : "deli",
-			"title": "my new campaign 113",
-			"stores":[11111, 22222, 33333, 44444]
-		  }`)
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : incorrect campaign id value, err : strconv.Atoi: parsing \"\": invalid syntax"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to request validation error", func(t *testing.T) {
-		var jsonStr = []byte(`{"campaign_status_code": 1,
-		"campaign_type": "deli}`)
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : unexpected EOF"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while checking campaign existence", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, errors.New("db error"))
-
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to campaign with given id not exists", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, nil)
-
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusBadRequest {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
-		}
-
-		expected := `{"code":400,"message":"Bad Request : campaign with given id not exists"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while updating campaign", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83,84,85
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(errors.New("db error"))
-
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while getting store details", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
-		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
-			errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.UpdateCampaign(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while adding new stores", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-		storeEntities := []entities.CampaignStore{
-			{
-				CampaignID: 1,
-				StoreID:    83,
-				CreatedBy:  12345,
-			},
-		}
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
-		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
-			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil)
-		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(nil, errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.UpdateCampaign(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while adding getting all stores", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
-		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
-			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.UpdateCampaign(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("failure due to error occured while deleting old stores", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  83
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-		storeEntities := []entities.CampaignStore{
-			{
-				CampaignID: 1,
-				StoreID:    83,
-				CreatedBy:  12345,
-			},
-		}
-		createStoresDTO := []*dto.CampaignStores{
-			{
-				ID:      2,
-				StoreID: 83,
-			},
-		}
-		getStoresDTO := []*dto.CampaignStores{
-			{
-				ID:      1,
-				StoreID: 82,
-			},
-		}
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
-		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
-			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(getStoresDTO, nil)
-		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(createStoresDTO, nil)
-		mockCampaignStoreUsecase.On("DeleteByStoreID", req.Context(), int64(1), int64(82), int64(12345)).Return(errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.UpdateCampaign(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("Success : campaign update successfully", func(t *testing.T) {
-		campaignRequest := bytes.NewBuffer([]byte(`{
-			"campaign_status_code": 1,
-			"campaign_type": "deli",
-			"collection_end_date": "2023-04-05 12:00:00",
-			"collection_start_date": "2023-03-05 12:00:00",
-			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			"lead_time": 3,
-			"listing_description": "test description",
-			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			"listing_title": "test screen title",
-			"onboarding_description": "test desc",
-			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			"onboarding_title": "test campaign",
-			"order_end_date": "2023-03-31 12:00:00",
-			"order_start_date": "2023-03-01 12:00:00",
-			"stores": [
-			  84
-			],
-			"title": "new campaign",
-			"offer_id": 123,
-			"tag_id": 456
-		  }`))
-		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("id", "1")
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
-		campaignEntity := entities.Campaign{
-			ID:                  valueobjects.CampaignID(1),
-			Title:               "new campaign",
-			StatusCode:          int64(1),
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
-			OfferID:             123,
-			TagID:               456,
-			UpdatedBy:           12345,
-			LeadTime:            3,
-		}
-		storeEntities := []entities.CampaignStore{
-			{
-				CampaignID: 1,
-				StoreID:    84,
-				CreatedBy:  12345,
-			},
-		}
-		createStoresDTO := []*dto.CampaignStores{
-			{
-				ID:      2,
-				StoreID: 84,
-			},
-		}
-		getStoresDTO := []*dto.CampaignStores{
-			{
-				ID:      1,
-				StoreID: 83,
-			},
-		}
-		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
-		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(84)).Return(dto.CampaignStores{},
-			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
-		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(getStoresDTO, nil)
-		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(createStoresDTO, nil)
-		mockCampaignStoreUsecase.On("DeleteByStoreID", req.Context(), int64(1), int64(83), int64(12345)).Return(nil)
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-
-		campaignController.UpdateCampaign(w, req)
-
-		if status := w.Code; status != http.StatusOK {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-		}
-		expected := `{"code":200,"message":"campaign with id 1 updated successfully"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-}
-
-func TestCampaignController_updateCampaignDetails(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime: 20,
-			MaxDateDifference: 28,
-		},
-	}
-	t.Run("failure due to error occured while campaign entity conversion", func(t *testing.T) {
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		request := params.CampaignUpdateForm{
-			Title:               "new campaign",
-			StatusCode:          1,
-			CampaignType:        "deli",
-			ListingTitle:        "test screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
-			OnboardTitle:        "test campaign",
-			OnboardDesc:         "test desc",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderEndDate:        "2023-03-31 12:00:00",
-			OrderStartDate:      "2023-03-01 12:00:00",
-			CollectionEndDate:   "2023-04-05 12:00:00",
-			CollectionStartDate: "2023-13-02 12:00:00",
-			OfferID:             123,
-			TagID:               456,
-			LeadTime:            3,
-		}
-		ctx := context.WithValue(context.Background(), "userId", int64(12345))
-		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
-		expectedErr := `parsing time "2023-13-02 12:00:00": month out of range`
-		ShouldNotBeNil(err)
-		if err.Error() != expectedErr {
-			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
-		}
-	})
-}
-
-func TestCampaignController_GetCampaignList(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime: 20,
-			MaxDateDifference: 28,
-		},
-	}
-	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-	mockTransactionService := service_mocks.NewTransactionService(t)
-	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-		mockTransactionService, &appConfig)
-
-	t.Run("Get Campaign List request success for InActive", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns", nil)
-		query := req.URL.Query()
-		query.Add("limit", "10")
-		query.Add("page", "1")
-		query.Add("sort", "created_at desc")
-		query.Add("status", "InActive")
-		query.Add("name", "campaign1")
-		req.URL.RawQuery = query.Encode()
-		res := httptest.NewRecorder()
-		response := dto.CampaignListResponse{}
-		mockCampaignUsecase.On("GetList", req.Context(), entities.PaginationConfig{
-			Limit: 10, Page: 1, Sort: "created_at desc", Name: "campaign1", Status: 1}).Return(&response, nil)
-		campaignController.GetCampaignList(res, req)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-	})
-
-	t.Run("Get Campaign List request success for Active status", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns", nil)
-		query := req.URL.Query()
-		query.Add("limit", "10")
-		query.Add("page", "1")
-		query.Add("sort", "created_at desc")
-		query.Add("status", "Active")
-		query.Add("name", "campaign1")
-		req.URL.RawQuery = query.Encode()
-		res := httptest.NewRecorder()
-		response := dto.CampaignListResponse{}
-		mockCampaignUsecase.On("GetList", req.Context(), entities.PaginationConfig{
-			Limit: 10, Page: 1, Sort: "created_at desc", Name: "campaign1", Status: 2}).Return(&response, nil)
-		campaignController.GetCampaignList(res, req)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-	})
-
-	t.Run("Get Campaign List request success for Scheduled status", func(t *testing.T) {
-		req, err := http.NewRequest("GET", "/campaigns", nil)
-		query := req.URL.Query()
-		query.Add("limit", "10")
-		query.Add("page", "1")
-		query.Add("sort", "created_at desc")
-		query.Add("status", "Scheduled")
-		query.Add("name", "campaign1")
-		req.URL.RawQuery = query.Encode()
-		res := httptest.NewRecorder()
-		response := dto.CampaignListResponse{}
-		mockCampaignUsecase.On("GetList", req.Context(), entities.PaginationConfig{
-			Limit: 10, Page: 1, Sort: "created_at desc", Name: "campaign1", Status: 3}).Return(&response, nil)
-		campaignController.GetCampaignList(res, req)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Fatal(err)
-		}
-	})
-}
-
-func TestCampaignController_UpdateCampaignStatus(t *testing.T) {
-	appConfig := entities.AppCfg{
-		ValidationParam: entities.ValidationParam{
-			MaxLeadTime: 20,
-			MaxDateDifference: 28,
-		},
-	}
-
-	t.Run("failure due to error occured while updating campaign status", func(t *testing.T) {
-		req, _ := http.NewRequest("PUT", "/campaigns/update-status", nil)
-		ctx := chi.NewRouteContext()
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("UpdateStatus", req.Context()).Return(errors.New("db error"))
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-		campaignController.UpdateCampaignStatus(w, req)
-		if status := w.Code; status != http.StatusInternalServerError {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
-		}
-		expected := `{"code":500,"message":"Internal Server Error : db error"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-
-	t.Run("Success : campaign status updated successfully", func(t *testing.T) {
-		req, _ := http.NewRequest("PUT", "/campaigns/update-status", nil)
-		ctx := chi.NewRouteContext()
-		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-
-		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
-		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
-		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
-		mockTransactionService := service_mocks.NewTransactionService(t)
-		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
-			mockTransactionService, &appConfig)
-		mockCampaignUsecase.On("UpdateStatus", req.Context()).Return(nil)
-		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
-			Return(func(ctx context.Context, fn func(context.Context) error) error {
-				return fn(ctx)
-			})
-
-		campaignController.UpdateCampaignStatus(w, req)
-
-		if status := w.Code; status != http.StatusOK {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-		}
-		expected := `{"code":200,"message":"campaigns status updated successfully"}`
-		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
-			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
-		}
-	})
-}
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	service_mocks "campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCampaignController_Init(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"code": 200,"status": "SUCCESS"}`))
+	})
+	req, _ := http.NewRequest("GET", "/", nil)
+	campaignController := NewCampaignController(nil, nil, nil, nil, nil, nil)
+	r := chi.NewRouter()
+	campaignController.Init(r)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	body := string(w.Body.String())
+	expected := `{"code": 200,"status": "SUCCESS"}`
+	if body != expected {
+		t.Fatalf("expected:%s got:%s", expected, body)
+	}
+}
+
+func TestCampaignController_validateCampaignRequest(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+	}
+
+	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+	mockTransactionService := service_mocks.NewTransactionService(t)
+	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+		newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+	t.Run("Request body validation failure : error occured while decoding", func(t *testing.T) {
+		var jsonStr = []byte(`{"campaign_status_code": 1,
+		"campaign_type": "deli}`)
+
+		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateCampaignRequest(req)
+		expectedErr := "unexpected EOF"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+	t.Run("Request body validation failure : missing required params", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 15,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"offer_id": 123,
+			"tag_id":456
+		  }`)
+
+		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateCampaignRequest(req)
+		expectedErr := "Key: 'CampaignCreationForm.Title' Error:Field validation for 'Title' failed on the 'required' tag"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+
+	t.Run("Request body validation failure : invalid dates", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-01 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 20,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id":456
+		  }`)
+
+		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateCampaignRequest(req)
+		expectedErr := "invalid date : Collection start date should be at least 20 days greater than order start date"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+	t.Run("Request body validation Success", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`)
+
+		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateCampaignRequest(req)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+
+	t.Run("Request body validation failure : collections blacked out at a store of a store group", func(t *testing.T) {
+		storeUsecase := mocks.NewCampaignStoreUseCases(t)
+		blackoutUsecase := mocks.NewBlackoutUseCases(t)
+		controller := NewCampaignController(mockCampaignUsecase, storeUsecase, mockCampaignProductUsecase,
+			blackoutUsecase, mockTransactionService, &appConfig)
+		req, err := http.NewRequest("POST", "/campaigns", bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"lead_time": 3,
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [83],
+			"store_group_ids": [3],
+			"title": "new campaign"
+		  }`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		storeUsecase.On("ExpandStoreGroups", req.Context(), []int64{83}, []int64{3}).Return([]int64{83, 84}, nil)
+		blackoutUsecase.On("CheckCollectionDates", req.Context(), []int64{83, 84}, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: 2023-03-20 is blacked out at store 84 for Renovation", valueobjects.ErrCollectionDateBlackedOut))
+		_, err = controller.validateCampaignRequest(req)
+		if !errors.Is(err, valueobjects.ErrCollectionDateBlackedOut) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCollectionDateBlackedOut)
+		}
+	})
+}
+
+func TestCampaignController_validateUpdateCampaignRequest(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+	}
+
+	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+	mockTransactionService := service_mocks.NewTransactionService(t)
+	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+		newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+	mockCampaignUsecase.On("Get", mock.Anything, int64(1)).Return(&dto.CampaignDTO{ID: 1, Timezone: "UTC"}, nil).Maybe()
+	mockCampaignStoreUsecase.On("GetStores", mock.Anything, mock.Anything).Return([]*dto.CampaignStores{}, nil).Maybe()
+
+	t.Run("Request body validation failure : error occured while decoding", func(t *testing.T) {
+		var jsonStr = []byte(`{"campaign_status_code": 1,
+		"campaign_type": "deli}`)
+
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 1)
+		expectedErr := "unexpected EOF"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+	t.Run("Request body validation failure : product validation error", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"title": "new campaign",
+			"products": [{"product_id": 501, "product_type": "xx"}]
+		  }`)
+
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 1)
+		expectedErr := "Key: 'CampaignUpdateForm.Products[0].ProductType' Error:Field validation for 'ProductType' failed on the 'oneof' tag"
+		if err == nil || err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, expectedErr)
+		}
+	})
+
+	t.Run("Request body validation failure : key validation error", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "test",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 15,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00"
+		  }`)
+
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 1)
+		expectedErr := "Key: 'CampaignUpdateForm.CampaignType' Error:Field validation for 'CampaignType' failed on the 'oneof' tag"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+	t.Run("Request body validation failure : invalid dates", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-01 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"title": "new campaign"
+		  }`)
+
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 1)
+		expectedErr := "invalid date : Collection start date should be at least 3 days greater than order start date"
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+
+	t.Run("Request body validation Success", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"offer_id": 123,
+			"tag_id":456,
+			"title": "new campaign"
+		  }`)
+
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 1)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+
+	t.Run("Request without timezone is validated in the stored campaign timezone", func(t *testing.T) {
+		mockCampaignUsecase.On("Get", mock.Anything, int64(2)).Return(&dto.CampaignDTO{ID: 2, Timezone: "Asia/Singapore"}, nil)
+		req, err := http.NewRequest("PUT", "/campaigns/2", bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"title": "new campaign",
+			"lead_time": 3,
+			"order_start_date": "2023-03-01 12:00:00",
+			"order_end_date": "2023-03-31 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"collection_end_date": "2023-04-05 12:00:00"
+		  }`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		campaignRequest, err := campaignController.validateUpdateCampaignRequest(req, 2)
+		if err != nil || campaignRequest.Timezone != "Asia/Singapore" {
+			t.Errorf("unexpected result : got - %q, %v ; want - Asia/Singapore, nil", campaignRequest.Timezone, err)
+		}
+	})
+
+	t.Run("Campaign stored without timezone is validated in the business timezone", func(t *testing.T) {
+		businessConfig := appConfig
+		businessConfig.TimezoneConfig.BusinessTimezone = "Asia/Singapore"
+		campaignUsecase := mocks.NewCampaignUseCases(t)
+		controller := NewCampaignController(campaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &businessConfig)
+		campaignUsecase.On("Get", mock.Anything, int64(3)).Return(&dto.CampaignDTO{ID: 3}, nil)
+		req, err := http.NewRequest("PUT", "/campaigns/3", bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"title": "new campaign"
+		  }`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		campaignRequest, err := controller.validateUpdateCampaignRequest(req, 3)
+		if err != nil || campaignRequest.Timezone != "Asia/Singapore" {
+			t.Errorf("unexpected result : got - %q, %v ; want - Asia/Singapore, nil", campaignRequest.Timezone, err)
+		}
+	})
+
+	t.Run("Request moving the collection window onto a blackout of a store the campaign has", func(t *testing.T) {
+		storeUsecase := mocks.NewCampaignStoreUseCases(t)
+		blackoutUsecase := mocks.NewBlackoutUseCases(t)
+		controller := NewCampaignController(mockCampaignUsecase, storeUsecase, mockCampaignProductUsecase,
+			blackoutUsecase, mockTransactionService, &appConfig)
+		req, err := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"title": "new campaign",
+			"lead_time": 3,
+			"stores": [85],
+			"order_start_date": "2023-03-01 12:00:00",
+			"order_end_date": "2023-03-31 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"collection_end_date": "2023-04-05 12:00:00"
+		  }`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		storeUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{{ID: 5, StoreID: 84}}, nil)
+		blackoutUsecase.On("CheckCollectionDates", req.Context(), []int64{84, 85}, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: 2023-03-20 is blacked out at store 84 for Renovation", valueobjects.ErrCollectionDateBlackedOut))
+		_, err = controller.validateUpdateCampaignRequest(req, 1)
+		if !errors.Is(err, valueobjects.ErrCollectionDateBlackedOut) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCollectionDateBlackedOut)
+		}
+	})
+
+	t.Run("Request body validation failure : error occured while getting campaign timezone", func(t *testing.T) {
+		mockCampaignUsecase.On("Get", mock.Anything, int64(4)).Return(nil, errors.New("db error"))
+		req, err := http.NewRequest("PUT", "/campaigns/4", bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"title": "new campaign"
+		  }`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = campaignController.validateUpdateCampaignRequest(req, 4)
+		if err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+	})
+}
+
+// Get Campaign tests
+func TestCampaignController_getCampaign(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime: 20,
+		},
+	}
+
+	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+	mockTransactionService := service_mocks.NewTransactionService(t)
+	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+		newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+	t.Run("Get Campaign request success", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/1", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		query := req.URL.Query()
+		query.Add("omit_stores", "true")
+		query.Add("omit_products", "true")
+		req.URL.RawQuery = query.Encode()
+
+		res := httptest.NewRecorder()
+		response := dto.CampaignDTO{}
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&response, nil)
+		campaignController.GetCampaign(res, req)
+
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+	})
+
+	t.Run("Get Campaign request success when omit_stores=false and omit_products=false", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/1", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		query := req.URL.Query()
+		query.Add("omit_stores", "false")
+		query.Add("omit_products", "false")
+		req.URL.RawQuery = query.Encode()
+
+		res := httptest.NewRecorder()
+		response := dto.CampaignDTO{}
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&response, nil)
+		var campaignStores []*dto.CampaignStores
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(campaignStores, nil)
+		var campaignProducts []*dto.CampaignProducts
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(1)).Return(campaignProducts, nil)
+		campaignController.GetCampaign(res, req)
+
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+	})
+
+	t.Run("Get Campaign request error when omit_stores=false and omit_products=false", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/1", nil)
+
+		query := req.URL.Query()
+		query.Add("omit_stores", "false")
+		query.Add("omit_products", "false")
+		req.URL.RawQuery = query.Encode()
+
+		res := httptest.NewRecorder()
+		response := dto.CampaignDTO{}
+		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
+		var campaignStores []*dto.CampaignStores
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
+		var campaignProducts []*dto.CampaignProducts
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
+		campaignController.GetCampaign(res, req)
+
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+	})
+
+	t.Run("Get Campaign request error when omit_stores=false and omit_products=false are nil", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/1", nil)
+
+		res := httptest.NewRecorder()
+		response := dto.CampaignDTO{}
+		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
+		var campaignStores []*dto.CampaignStores
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
+		var campaignProducts []*dto.CampaignProducts
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
+		campaignController.GetCampaign(res, req)
+
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+	})
+
+	t.Run("Get Campaign request error when omit_stores and omit_products are invalid inputs", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/1", nil)
+
+		query := req.URL.Query()
+		query.Add("omit_stores", "invalid_input")
+		query.Add("omit_products", "invalid_input")
+		req.URL.RawQuery = query.Encode()
+
+		res := httptest.NewRecorder()
+		response := dto.CampaignDTO{}
+		mockCampaignUsecase.On("Get", req.Context(), int64(0)).Return(&response, nil)
+		var campaignStores []*dto.CampaignStores
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(0)).Return(campaignStores, nil)
+		var campaignProducts []*dto.CampaignProducts
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(0)).Return(campaignProducts, nil)
+		campaignController.GetCampaign(res, req)
+
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+	})
+}
+
+func TestCampaignController_CreateCampaign(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+	}
+
+	t.Run("failure due to invalid user id error", func(t *testing.T) {
+		var jsonStr = []byte(`{"campaign_status_code": 1,
+		"campaign_type": "deli}`)
+
+		req, _ := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		campaignController.CreateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : invalid user id"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to request validation error", func(t *testing.T) {
+		var jsonStr = []byte(`{"campaign_status_code": 1,
+		"campaign_type": "deli}`)
+
+		req, _ := http.NewRequest("POST", "/campaigns", bytes.NewBuffer(jsonStr))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		campaignController.CreateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : unexpected EOF"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while checking campaign existence", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, errors.New("db error"))
+
+		campaignController.CreateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to campaign with given name already exists", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(true, nil)
+
+		campaignController.CreateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+
+		expected := `{"code":409,"message":"Conflict Error : campaign with given name already exists"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while creating campaign", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
+
+		campaignEntity := entities.Campaign{
+			Title:               "new campaign",
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			CreatedBy:           12345,
+			LeadTime:            3,
+		}
+
+		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(nil, errors.New("db error"))
+
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		campaignController.CreateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while adding stores", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
+		campaignEntity := entities.Campaign{
+			Title:               "new campaign",
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			CreatedBy:           12345,
+			LeadTime:            3,
+		}
+		campaignDTO := dto.CampaignDTO{
+			ID:                  int64(1),
+			Title:               "new campaign",
+			StatusCode:          1,
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      "2023-03-01 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-05 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+			OfferID:             123,
+			TagID:               456,
+			LeadTime:            3,
+		}
+		storeEntities := []entities.CampaignStore{
+			{
+				CampaignID: 1,
+				StoreID:    83,
+				CreatedBy:  12345,
+			},
+		}
+		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(&campaignDTO, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(nil, errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		campaignController.CreateCampaign(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Success : campaign created successfully", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("POST", "/campaigns", campaignRequest)
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new campaign").Return(false, nil)
+		campaignEntity := entities.Campaign{
+			Title:               "new campaign",
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			CreatedBy:           12345,
+			LeadTime:            3,
+		}
+		campaignDTO := dto.CampaignDTO{
+			ID:                  int64(1),
+			Title:               "new campaign",
+			Name:                "new campaign",
+			StatusCode:          1,
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      "2023-03-01 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-05 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+			OfferID:             123,
+			TagID:               456,
+			LeadTime:            3,
+			IsCampaignPublished: false,
+		}
+		storeEntities := []entities.CampaignStore{
+			{
+				CampaignID: 1,
+				StoreID:    83,
+				CreatedBy:  12345,
+			},
+		}
+		storesDTO := []*dto.CampaignStores{
+			{
+				ID:      1,
+				StoreID: 83,
+			},
+		}
+		mockCampaignUsecase.On("Create", req.Context(), campaignEntity).Return(&campaignDTO, nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(1), []entities.CampaignOverlap(nil)).Return([]int64{}, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(storesDTO, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		campaignController.CreateCampaign(w, req)
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"id":1,"campaign_title":"new campaign","name":"new campaign","campaign_status_code":1,"campaign_type":"deli","listing_title":"test screen title","listing_description":"test description","listing_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img2.jpg","onboarding_title":"test campaign","onboarding_description":"test desc","onboard_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img3.jpg","landing_image_path":"https://preprod-media.nedigital.sg/fairprice/images/img1.jpg","order_start_date":"2023-03-01 12:00:00","order_end_date":"2023-03-31 12:00:00","collection_start_date":"2023-03-05 12:00:00","collection_end_date":"2023-04-05 12:00:00","timezone":"","lead_time":3,"offer_id":123,"tag_id":456,"is_campaign_published":false,"campaign_stores":[{"campaign_store_id":1,"store_id":83}]}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+}
+
+func TestCampaignController_saveCampaignDetails(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+	}
+	t.Run("failure due to error occured while campaign entity conversion", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignCreationForm{
+			Title:               "new campaign",
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			OrderStartDate:      "2023-03-01 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+			CollectionStartDate: "2023-13-02 12:00:00",
+			OfferID:             123,
+			TagID:               456,
+			LeadTime:            3,
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+
+		_, err := campaignController.saveCampaignDetails(ctx, request, int64(12345))
+		expectedErr := `parsing time "2023-13-02 12:00:00": month out of range`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("products are saved along with the campaign", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignCreationForm{
+			Title:          "new campaign",
+			OrderStartDate: "2023-03-01 12:00:00",
+			Products: []params.CampaignProduct{
+				{ProductID: 501, SerialNo: 1, SequenceNo: 1, ProductType: "cd"},
+			},
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		productDTOs := []*dto.CampaignProducts{{ID: 7, ProductID: 501, SerialNo: 1, SequenceNo: 1, ProductType: "cd"}}
+		mockCampaignUsecase.On("Create", ctx, mock.Anything).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignProductUsecase.On("AddProducts", ctx, []entities.CampaignProduct{
+			{CampaignID: 1, ProductID: 501, SerialNo: 1, SequenceNo: 1, ProductType: "cd", CreatedBy: 12345},
+		}).Return(productDTOs, nil)
+
+		campaignDetails, err := campaignController.saveCampaignDetails(ctx, request, int64(12345))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+		if len(campaignDetails.CampaignProducts) != 1 || campaignDetails.CampaignProducts[0].ID != 7 {
+			t.Errorf("unexpected campaign products : got - %v ; want - %v", campaignDetails.CampaignProducts, productDTOs)
+		}
+	})
+}
+
+func TestCampaignController_validateCampaignDates(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+	}
+	t.Run("failure due invalid order start date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-01",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-02 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `parsing time "2023-03-01" as "2006-01-02 15:04:05": cannot parse "" as "15"`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due invalid order end date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-31 12:00:00",
+			OrderEndDate:        "2023-03-31",
+			CollectionStartDate: "2023-03-02 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `parsing time "2023-03-31" as "2006-01-02 15:04:05": cannot parse "" as "15"`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due invalid collection start date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-02 12",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `parsing time "2023-03-02 12" as "2006-01-02 15:04:05": cannot parse "" as ":"`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due invalid collection end date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-02 12:00:00",
+			CollectionEndDate:   "2023-04-77 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `parsing time "2023-04-77 12:00:00": day out of range`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due order start date is after order end date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-02 12:00:00",
+			CollectionStartDate: "2023-03-02 12:00:00",
+			CollectionEndDate:   "2023-04-02 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : order start date should be the date before order end date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due order start date is after collection start date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-02 12:00:00",
+			CollectionEndDate:   "2023-04-02 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : order start date should be the date before collection start date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due collection start date is after collection end date", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-15 12:00:00",
+			CollectionEndDate:   "2023-03-05 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : collection start date should be the date before collection end date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due collection start date and order start date validation not matched", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-01 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-30 12:00:00",
+			CollectionEndDate:   "2023-04-11 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : Collection start date should be less than 28 days from order start date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due collection end date and order end date validation not matched", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-01 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-28 12:00:00",
+			CollectionEndDate:   "2023-04-30 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : Collection end date should be less than 28 days from order end date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due collection end date less than lead time", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-01 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-28 12:00:00",
+			CollectionEndDate:   "2023-04-09 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 10)
+		expectedErr := `invalid date : Collection end date should be at least 10 days greater than order end date`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due collection start date blacked out", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockBlackoutUsecase := mocks.NewBlackoutUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			mockBlackoutUsecase, mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-15 12:00:00",
+			CollectionEndDate:   "2023-04-28 12:00:00",
+			StoreIDs:            []int64{84},
+		}
+		mockBlackoutUsecase.On("CheckCollectionDates", context.TODO(), []int64{84}, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: 2023-03-15 is blacked out for Public holiday", valueobjects.ErrCollectionDateBlackedOut))
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 3)
+		if !errors.Is(err, valueobjects.ErrCollectionDateBlackedOut) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCollectionDateBlackedOut)
+		}
+	})
+	t.Run("success scenario", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		dates := params.CampaignDates{
+			OrderStartDate:      "2023-03-03 12:00:00",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			CollectionStartDate: "2023-03-15 12:00:00",
+			CollectionEndDate:   "2023-04-28 12:00:00",
+		}
+		err := campaignController.validateCampaignDates(context.TODO(), dates, 3)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+}
+
+func TestCampaignController_UpdateCampaign(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime: 20,
+			MaxDateDifference: 28,
+		},
+	}
+
+	t.Run("failure due to incorrect user id", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type" : "deli",
+			"title": "my new campaign 113",
+			"stores":[11111, 22222, 33333, 44444]
+		  }`)
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : invalid user id"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to incorrect campaign id", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"campaign_status_code": 1,
+			"campaign_type" : "deli",
+			"title": "my new campaign 113",
+			"stores":[11111, 22222, 33333, 44444]
+		  }`)
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : incorrect campaign id value, err : strconv.Atoi: parsing \"\": invalid syntax"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to request validation error", func(t *testing.T) {
+		var jsonStr = []byte(`{"campaign_status_code": 1,
+		"campaign_type": "deli}`)
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", bytes.NewBuffer(jsonStr))
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : unexpected EOF"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while checking campaign existence", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, errors.New("db error"))
+
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to campaign with given id not exists", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, nil)
+
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : campaign with given id not exists"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while updating campaign", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil).Once()
+
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(errors.New("db error"))
+
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to campaign status transition not allowed", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83,84,85
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil).Once()
+
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(
+			fmt.Errorf("%w: from 1 to 2", valueobjects.ErrCampaignStatusTransition))
+
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+
+		expected := `{"code":400,"message":"Bad Request : campaign status transition not allowed: from 1 to 2"}`
+
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while getting store details", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil).Once()
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
+			errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+		campaignController.UpdateCampaign(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while adding new stores", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+		storeEntities := []entities.CampaignStore{
+			{
+				CampaignID: 1,
+				StoreID:    83,
+				CreatedBy:  12345,
+			},
+		}
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
+			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(nil, errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+		campaignController.UpdateCampaign(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while adding getting all stores", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil).Once()
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
+			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+		campaignController.UpdateCampaign(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("failure due to error occured while deleting old stores", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  83
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+		storeEntities := []entities.CampaignStore{
+			{
+				CampaignID: 1,
+				StoreID:    83,
+				CreatedBy:  12345,
+			},
+		}
+		createStoresDTO := []*dto.CampaignStores{
+			{
+				ID:      2,
+				StoreID: 83,
+			},
+		}
+		getStoresDTO := []*dto.CampaignStores{
+			{
+				ID:      1,
+				StoreID: 82,
+			},
+		}
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(83)).Return(dto.CampaignStores{},
+			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(getStoresDTO, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(createStoresDTO, nil)
+		mockCampaignStoreUsecase.On("DeleteByStoreID", req.Context(), int64(1), int64(82), int64(12345)).Return(errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+		campaignController.UpdateCampaign(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Success : campaign update successfully", func(t *testing.T) {
+		campaignRequest := bytes.NewBuffer([]byte(`{
+			"campaign_status_code": 1,
+			"campaign_type": "deli",
+			"collection_end_date": "2023-04-05 12:00:00",
+			"collection_start_date": "2023-03-05 12:00:00",
+			"landing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			"lead_time": 3,
+			"listing_description": "test description",
+			"listing_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			"listing_title": "test screen title",
+			"onboarding_description": "test desc",
+			"onboarding_image_path": "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			"onboarding_title": "test campaign",
+			"order_end_date": "2023-03-31 12:00:00",
+			"order_start_date": "2023-03-01 12:00:00",
+			"stores": [
+			  84
+			],
+			"products": [
+			  {"campaign_product_id": 5, "product_id": 501, "serial_no": 1, "sequence_no": 2, "product_type": "cd"},
+			  {"product_id": 502, "serial_no": 2, "sequence_no": 1, "product_type": "ncd"}
+			],
+			"title": "new campaign",
+			"offer_id": 123,
+			"tag_id": 456
+		  }`))
+		req, _ := http.NewRequest("PUT", "/campaigns/1", campaignRequest)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req = req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		campaignEntity := entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "new campaign",
+			StatusCode:          int64(1),
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2023, time.March, 5, 12, 0, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2023, time.April, 5, 12, 0, 0, 0, time.UTC),
+			OfferID:             123,
+			TagID:               456,
+			UpdatedBy:           12345,
+			LeadTime:            3,
+		}
+		storeEntities := []entities.CampaignStore{
+			{
+				CampaignID: 1,
+				StoreID:    84,
+				CreatedBy:  12345,
+			},
+		}
+		createStoresDTO := []*dto.CampaignStores{
+			{
+				ID:      2,
+				StoreID: 84,
+			},
+		}
+		getStoresDTO := []*dto.CampaignStores{
+			{
+				ID:      1,
+				StoreID: 83,
+			},
+		}
+		mockCampaignUsecase.On("Update", req.Context(), campaignEntity).Return(nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(1), []entities.CampaignOverlap{}).Return([]int64{4}, nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", req.Context(), int64(1), int64(84)).Return(dto.CampaignStores{},
+			fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return(getStoresDTO, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), storeEntities).Return(createStoresDTO, nil)
+		mockCampaignStoreUsecase.On("DeleteByStoreID", req.Context(), int64(1), int64(83), int64(12345)).Return(nil)
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(1)).Return([]*dto.CampaignProducts{
+			{ID: 5, ProductID: 501},
+			{ID: 6, ProductID: 503},
+		}, nil)
+		mockCampaignProductUsecase.On("AddProducts", req.Context(), []entities.CampaignProduct{
+			{CampaignID: 1, ProductID: 502, SerialNo: 2, SequenceNo: 1, ProductType: "ncd", CreatedBy: 12345},
+		}).Return([]*dto.CampaignProducts{{ID: 7, ProductID: 502}}, nil)
+		mockCampaignProductUsecase.On("UpdateProducts", req.Context(), []entities.CampaignProduct{
+			{ID: 5, CampaignID: 1, ProductID: 501, SerialNo: 1, SequenceNo: 2, ProductType: "cd", UpdatedBy: 12345},
+		}).Return(nil)
+		mockCampaignProductUsecase.On("DeleteProduct", req.Context(), int64(1), int64(6), int64(12345)).Return(nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("GetOverlaps", req.Context(), int64(1)).Return([]entities.CampaignOverlap{}, nil)
+
+		campaignController.UpdateCampaign(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"message":"campaign with id 1 updated successfully"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+		if overlaps := w.Header().Get(OverlapHeader); overlaps != "4" {
+			t.Errorf("handler returned unexpected overlapping campaigns: got %v want 4", overlaps)
+		}
+	})
+}
+
+func TestCampaignController_updateCampaignDetails(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime: 20,
+			MaxDateDifference: 28,
+		},
+	}
+	t.Run("failure due to error occured while campaign entity conversion", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignUpdateForm{
+			Title:               "new campaign",
+			StatusCode:          1,
+			CampaignType:        "deli",
+			ListingTitle:        "test screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img2.jpg",
+			OnboardTitle:        "test campaign",
+			OnboardDesc:         "test desc",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img3.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderEndDate:        "2023-03-31 12:00:00",
+			OrderStartDate:      "2023-03-01 12:00:00",
+			CollectionEndDate:   "2023-04-05 12:00:00",
+			CollectionStartDate: "2023-13-02 12:00:00",
+			OfferID:             123,
+			TagID:               456,
+			LeadTime:            3,
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
+		expectedErr := `parsing time "2023-13-02 12:00:00": month out of range`
+		ShouldNotBeNil(err)
+		if err.Error() != expectedErr {
+			t.Errorf("unexpected error : got - %v ; want - %v", err.Error(), expectedErr)
+		}
+	})
+	t.Run("failure due to unknown campaign product id", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignUpdateForm{
+			Title:          "new campaign",
+			StatusCode:     1,
+			OrderStartDate: "2023-03-01 12:00:00",
+			Products: &[]params.UpdateCampaignProduct{
+				{ID: 9, ProductID: 501},
+			},
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		mockCampaignUsecase.On("Update", ctx, mock.Anything).Return(nil)
+		mockCampaignStoreUsecase.On("GetStores", ctx, int64(1)).Return([]*dto.CampaignStores{}, nil)
+		mockCampaignProductUsecase.On("GetProducts", ctx, int64(1)).Return([]*dto.CampaignProducts{{ID: 5, ProductID: 501}}, nil)
+		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
+		if !errors.Is(err, valueobjects.ErrProductNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductNotExists)
+		}
+	})
+	t.Run("dates are read in the request timezone", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignUpdateForm{
+			Title:          "new campaign",
+			StatusCode:     1,
+			OrderStartDate: "2023-03-01 09:30",
+			Timezone:       "Asia/Singapore",
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		mockCampaignUsecase.On("Update", ctx, mock.MatchedBy(func(campaign entities.Campaign) bool {
+			return campaign.Timezone == "Asia/Singapore" &&
+				campaign.OrderStartDate.Equal(time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC))
+		})).Return(errors.New("db error"))
+		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
+		if err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+	})
+	t.Run("omitted products are left as they are", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignUpdateForm{
+			Title:          "new campaign",
+			StatusCode:     1,
+			OrderStartDate: "2023-03-01 12:00:00",
+			Stores:         []int64{83},
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		mockCampaignUsecase.On("Update", ctx, mock.Anything).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", ctx, int64(1), int64(83)).Return(dto.CampaignStores{ID: 1, StoreID: 83}, nil)
+		mockCampaignStoreUsecase.On("GetStores", ctx, int64(1)).Return([]*dto.CampaignStores{{ID: 1, StoreID: 83}}, nil)
+		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		mockCampaignProductUsecase.AssertNotCalled(t, "GetProducts", ctx, int64(1))
+	})
+	t.Run("an empty products list removes the products", func(t *testing.T) {
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		request := params.CampaignUpdateForm{
+			Title:          "new campaign",
+			StatusCode:     1,
+			OrderStartDate: "2023-03-01 12:00:00",
+			Stores:         []int64{83},
+			Products:       &[]params.UpdateCampaignProduct{},
+		}
+		ctx := context.WithValue(context.Background(), "userId", int64(12345))
+		mockCampaignUsecase.On("Update", ctx, mock.Anything).Return(nil)
+		mockCampaignStoreUsecase.On("GetByStoreID", ctx, int64(1), int64(83)).Return(dto.CampaignStores{ID: 1, StoreID: 83}, nil)
+		mockCampaignStoreUsecase.On("GetStores", ctx, int64(1)).Return([]*dto.CampaignStores{{ID: 1, StoreID: 83}}, nil)
+		mockCampaignProductUsecase.On("GetProducts", ctx, int64(1)).Return([]*dto.CampaignProducts{{ID: 5, ProductID: 501}}, nil)
+		mockCampaignProductUsecase.On("DeleteProduct", ctx, int64(1), int64(5), int64(12345)).Return(nil)
+		err := campaignController.updateCampaignDetails(ctx, int64(1), request, int64(12345))
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+}
+
+func TestCampaignController_GetCampaignList(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime: 20,
+			MaxDateDifference: 28,
+		},
+	}
+	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+	mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+	mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+	mockTransactionService := service_mocks.NewTransactionService(t)
+	campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+		newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+	t.Run("Get Campaign List request success for InActive", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns", nil)
+		query := req.URL.Query()
+		query.Add("limit", "10")
+		query.Add("page", "1")
+		query.Add("sort", "created_at desc")
+		query.Add("status", "InActive")
+		query.Add("name", "campaign1")
+		req.URL.RawQuery = query.Encode()
+		res := httptest.NewRecorder()
+		response := dto.CampaignListResponse{}
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1, Name: "campaign1", StatusValue: "InActive",
+				SortFields: []entities.SortField{{Column: "created_at", Desc: true}}}}).Return(&response, nil)
+		campaignController.GetCampaignList(res, req)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Get Campaign List request success for Active status", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns", nil)
+		query := req.URL.Query()
+		query.Add("limit", "10")
+		query.Add("page", "1")
+		query.Add("sort", "created_at desc")
+		query.Add("status", "Active")
+		query.Add("name", "campaign1")
+		req.URL.RawQuery = query.Encode()
+		res := httptest.NewRecorder()
+		response := dto.CampaignListResponse{}
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1, Name: "campaign1", StatusValue: "Active",
+				SortFields: []entities.SortField{{Column: "created_at", Desc: true}}}}).Return(&response, nil)
+		campaignController.GetCampaignList(res, req)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Get Campaign List request success for Scheduled status", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns", nil)
+		query := req.URL.Query()
+		query.Add("limit", "10")
+		query.Add("page", "1")
+		query.Add("sort", "created_at desc")
+		query.Add("status", "Scheduled")
+		query.Add("name", "campaign1")
+		req.URL.RawQuery = query.Encode()
+		res := httptest.NewRecorder()
+		response := dto.CampaignListResponse{}
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1, Name: "campaign1", StatusValue: "Scheduled",
+				SortFields: []entities.SortField{{Column: "created_at", Desc: true}}}}).Return(&response, nil)
+		campaignController.GetCampaignList(res, req)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Get Campaign List request sorted by several fields", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?sort=-order_start_date,title", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{
+			SortFields: []entities.SortField{{Column: "order_start_date", Desc: true}, {Column: "title"}}}}).
+			Return(&dto.CampaignListResponse{}, nil)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Get Campaign List request sorted by unknown field", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?sort=title,listing_description", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Campaign List request with unknown status", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?status=Unknown", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{StatusValue: "Unknown"}}).Return(nil, fmt.Errorf("%w: %s", valueobjects.ErrCampaignStatusInvalid, "Unknown"))
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Campaign List request with filters", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&page=3&campaign_type=preorder&is_published=true"+
+			"&order_from=2023-01-01+00:00:00&collection_to=2023-02-28+23:59:59&created_by=5&store_id=11&product_id=42", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		isPublished := true
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 3, Offset: 20},
+			CampaignType:     "preorder",
+			IsPublished:      &isPublished,
+			OrderFrom:        time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			CollectionTo:     time.Date(2023, 2, 28, 23, 59, 59, 0, time.UTC),
+			CreatedBy:        5,
+			StoreID:          11,
+			ProductID:        42,
+		}).Return(&dto.CampaignListResponse{}, nil)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Get Campaign List request by cursor", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&page=3&cursor=", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetListByCursor", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 3},
+		}).Return(&dto.CampaignListResponse{Data: dto.DataList{PaginationFields: dto.PaginationFields{NextCursor: "after"}}}, nil)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		if !strings.Contains(res.Body.String(), `"next_cursor":"after"`) {
+			t.Errorf("handler returned unexpected body: got %v", res.Body.String())
+		}
+	})
+
+	t.Run("Get Campaign List request with invalid cursor", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&cursor=garbage", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetListByCursor", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10},
+			Cursor:           "garbage",
+		}).Return(nil, valueobjects.ErrCampaignCursorInvalid)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Campaign List request with search", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&page=1&q=+mooncake+", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1},
+			Query:            "mooncake",
+		}).Return(&dto.CampaignListResponse{}, nil)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Get Campaign List request with search by cursor", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&cursor=&q=mooncake", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Campaign List request including deleted campaigns without admin", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?include_deleted=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusForbidden {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusForbidden)
+		}
+	})
+
+	t.Run("Get Campaign List request including deleted campaigns as admin", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?limit=10&page=1&include_deleted=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req = req.WithContext(context.WithValue(req.Context(), "isAdmin", true))
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetList", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1, IncludeDeleted: true},
+		}).Return(&dto.CampaignListResponse{}, nil)
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Get Campaign List request with incorrect filter value", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns?store_id=abc", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignList(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCampaignController_UpdateCampaignStatus(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime: 20,
+			MaxDateDifference: 28,
+		},
+	}
+
+	t.Run("failure due to error occured while updating campaign status", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/campaigns/update-status", nil)
+		ctx := chi.NewRouteContext()
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("UpdateStatus", req.Context()).Return(nil, errors.New("db error"))
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		campaignController.UpdateCampaignStatus(w, req)
+		if status := w.Code; status != http.StatusInternalServerError {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusInternalServerError)
+		}
+		expected := `{"code":500,"message":"Internal Server Error : db error"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Success : campaign status updated successfully", func(t *testing.T) {
+		req, _ := http.NewRequest("PUT", "/campaigns/update-status", nil)
+		ctx := chi.NewRouteContext()
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+		mockCampaignUsecase.On("UpdateStatus", req.Context()).Return(&dto.CampaignStatusUpdateDTO{}, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		campaignController.UpdateCampaignStatus(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"message":"campaigns status updated successfully"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+}
+
+func TestCampaignController_GetCampaignSummary(t *testing.T) {
+	appConfig := entities.AppCfg{}
+	mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+	campaignController := NewCampaignController(mockCampaignUsecase, mocks.NewCampaignStoreUseCases(t), mocks.NewCampaignProductUseCases(t),
+		newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+
+	t.Run("Get Campaign Summary request with filters", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/summary?campaign_type=preorder&store_id=11", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		response := dto.ToCampaignSummaryResponse(entities.CampaignSummary{Total: 3, WithoutProducts: 1}, nil, 7)
+		mockCampaignUsecase.On("GetSummary", req.Context(), entities.CampaignListFilter{CampaignType: "preorder", StoreID: 11}).
+			Return(&response, nil)
+		campaignController.GetCampaignSummary(res, req)
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"total":3,"statuses":[],"soon_days":7,"starting_soon":0,` +
+			`"ending_soon":0,"without_stores":0,"without_products":1}}`
+		if a, e := strings.TrimSpace(res.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", res.Body.String(), expected)
+		}
+	})
+
+	t.Run("Get Campaign Summary request with unknown status", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/summary?status=Unknown", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		mockCampaignUsecase.On("GetSummary", req.Context(), entities.CampaignListFilter{
+			PaginationConfig: entities.PaginationConfig{StatusValue: "Unknown"}}).
+			Return(nil, fmt.Errorf("%w: %s", valueobjects.ErrCampaignStatusInvalid, "Unknown"))
+		campaignController.GetCampaignSummary(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Campaign Summary request including deleted campaigns without admin", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/summary?include_deleted=true", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignSummary(res, req)
+		if status := res.Code; status != http.StatusForbidden {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusForbidden)
+		}
+	})
+
+	t.Run("Get Campaign Summary request with incorrect filter value", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/campaigns/summary?created_by=abc", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := httptest.NewRecorder()
+		campaignController.GetCampaignSummary(res, req)
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCampaignController_DeleteCampaign(t *testing.T) {
+	newRequest := func(method, url, campaignID string) *http.Request {
+		req, _ := http.NewRequest(method, url, nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", campaignID)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+	newController := func(mockCampaignUsecase *mocks.CampaignUseCases) *CampaignController {
+		return NewCampaignController(mockCampaignUsecase, mocks.NewCampaignStoreUseCases(t),
+			mocks.NewCampaignProductUseCases(t), newOpenBlackoutUseCases(t), newRunningTransactionService(t), &entities.AppCfg{})
+	}
+
+	t.Run("Delete Campaign request success", func(t *testing.T) {
+		req := newRequest("DELETE", "/campaigns/1", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Delete", req.Context(), int64(1), int64(12345)).Return(nil)
+
+		newController(mockCampaignUsecase).DeleteCampaign(res, req)
+
+		expected := `{"code":200,"message":"campaign with id 1 deleted successfully"}`
+		if a, e := strings.TrimSpace(res.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", res.Body.String(), expected)
+		}
+	})
+
+	t.Run("Delete Campaign request for campaign not exists", func(t *testing.T) {
+		req := newRequest("DELETE", "/campaigns/1", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Delete", req.Context(), int64(1), int64(12345)).Return(
+			fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotExists, 1))
+
+		newController(mockCampaignUsecase).DeleteCampaign(res, req)
+
+		if status := res.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Restore Campaign request success", func(t *testing.T) {
+		req := newRequest("POST", "/campaigns/1/restore", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Restore", req.Context(), int64(1), int64(12345)).Return(nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(1), []entities.CampaignOverlap(nil)).Return([]int64{4}, nil)
+
+		newController(mockCampaignUsecase).RestoreCampaign(res, req)
+
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		if overlaps := res.Header().Get(OverlapHeader); overlaps != "4" {
+			t.Errorf("unexpected overlap header : got - %q ; want - %q", overlaps, "4")
+		}
+	})
+
+	t.Run("Restore Campaign request overlapping another campaign", func(t *testing.T) {
+		req := newRequest("POST", "/campaigns/1/restore", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Restore", req.Context(), int64(1), int64(12345)).Return(nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(1), []entities.CampaignOverlap(nil)).
+			Return(nil, fmt.Errorf("%w: campaign ids 4", valueobjects.ErrCampaignOverlap))
+
+		newController(mockCampaignUsecase).RestoreCampaign(res, req)
+
+		if status := res.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Restore Campaign request for campaign not deleted", func(t *testing.T) {
+		req := newRequest("POST", "/campaigns/1/restore", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Restore", req.Context(), int64(1), int64(12345)).Return(
+			fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotDeleted, 1))
+
+		newController(mockCampaignUsecase).RestoreCampaign(res, req)
+
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Restore Campaign request when title is taken", func(t *testing.T) {
+		req := newRequest("POST", "/campaigns/1/restore", "1")
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignUsecase.On("Restore", req.Context(), int64(1), int64(12345)).Return(
+			fmt.Errorf("%w: %s", valueobjects.ErrCampaignTitleExists, "campaign"))
+
+		newController(mockCampaignUsecase).RestoreCampaign(res, req)
+
+		if status := res.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+}
+
+func TestCampaignController_CloneCampaign(t *testing.T) {
+	appConfig := entities.AppCfg{
+		ValidationParam: entities.ValidationParam{
+			MaxLeadTime:       20,
+			MaxDateDifference: 28,
+		},
+		TimezoneConfig: entities.TimezoneConfig{BusinessTimezone: "Asia/Singapore"},
+	}
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest("POST", "/campaigns/1/clone", bytes.NewBufferString(body))
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("id", "1")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+		return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+	}
+	const cloneBody = `{"title": "new year 2024",
+		"order_start_date": "2024-03-01 09:00:00",
+		"order_end_date": "2024-03-10 09:00:00",
+		"collection_start_date": "2024-03-02 09:00:00",
+		"collection_end_date": "2024-03-12 09:00:00"}`
+
+	t.Run("Clone Campaign request success", func(t *testing.T) {
+		req := newRequest(cloneBody)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		mockTransactionService := service_mocks.NewTransactionService(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase, mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), mockTransactionService, &appConfig)
+
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1, LeadTime: 1, Timezone: "Asia/Singapore"}, nil)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new year 2024").Return(false, nil)
+		mockTransactionService.On("RunWithTransaction", req.Context(), mock.Anything).
+			Return(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockCampaignUsecase.On("Clone", req.Context(), int64(1), mock.MatchedBy(func(campaign entities.Campaign) bool {
+			return campaign.Title == "new year 2024" && campaign.CreatedBy == 12345 && campaign.Timezone == "Asia/Singapore" &&
+				campaign.OrderStartDate.Equal(time.Date(2024, time.March, 1, 1, 0, 0, 0, time.UTC))
+		})).Return(&dto.CampaignDTO{ID: 2, Title: "new year 2024"}, nil)
+		mockCampaignUsecase.On("CheckOverlaps", req.Context(), int64(2), []entities.CampaignOverlap(nil)).Return([]int64{}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{
+			{ID: 10, StoreID: 100},
+		}, nil)
+		mockCampaignStoreUsecase.On("AddStores", req.Context(), []entities.CampaignStore{
+			{CampaignID: 2, StoreID: 100, CreatedBy: 12345},
+		}).Return([]*dto.CampaignStores{{ID: 11, StoreID: 100}}, nil)
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(1)).Return([]*dto.CampaignProducts{
+			{ID: 20, ProductID: 200, SKUNo: 300, SerialNo: 2, SequenceNo: 5, ProductType: "cd"},
+		}, nil)
+		mockCampaignProductUsecase.On("AddProducts", req.Context(), []entities.CampaignProduct{
+			{CampaignID: 2, ProductID: 200, SKUNo: 300, SerialNo: 2, SequenceNo: 5, ProductType: "cd", CreatedBy: 12345},
+		}).Return([]*dto.CampaignProducts{{ID: 21, ProductID: 200, SKUNo: 300, SerialNo: 2, SequenceNo: 5, ProductType: "cd"}}, nil)
+
+		campaignController.CloneCampaign(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Fatalf("handler returned wrong status code: got %v want %v, body %v", status, http.StatusOK, w.Body.String())
+		}
+		if body := w.Body.String(); !strings.Contains(body, `"campaign_store_id":11`) || !strings.Contains(body, `"serial_no":2,"sequence_no":5`) {
+			t.Errorf("handler returned unexpected body: got %v", body)
+		}
+	})
+
+	t.Run("Clone Campaign request for campaign not exists", func(t *testing.T) {
+		req := newRequest(cloneBody)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mocks.NewCampaignStoreUseCases(t),
+			mocks.NewCampaignProductUseCases(t), newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(false, nil)
+
+		campaignController.CloneCampaign(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Clone Campaign request with dates breaking the lead time", func(t *testing.T) {
+		req := newRequest(cloneBody)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase,
+			mocks.NewCampaignProductUseCases(t), newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1, LeadTime: 5}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil)
+
+		campaignController.CloneCampaign(w, req)
+
+		expected := `{"code":400,"message":"Bad Request : invalid date : Collection start date should be at least 5 days greater than order start date"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Clone Campaign request with collections blacked out at a store of the campaign", func(t *testing.T) {
+		req := newRequest(cloneBody)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		mockBlackoutUsecase := mocks.NewBlackoutUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase,
+			mocks.NewCampaignProductUseCases(t), mockBlackoutUsecase, service_mocks.NewTransactionService(t), &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1, LeadTime: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{{ID: 10, StoreID: 100}}, nil)
+		mockBlackoutUsecase.On("CheckCollectionDates", req.Context(), []int64{100}, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: 2024-03-05 is blacked out at store 100 for Renovation", valueobjects.ErrCollectionDateBlackedOut))
+
+		campaignController.CloneCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Clone Campaign request with title already taken", func(t *testing.T) {
+		req := newRequest(cloneBody)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignStoreUsecase := mocks.NewCampaignStoreUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mockCampaignStoreUsecase,
+			mocks.NewCampaignProductUseCases(t), newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(1), "").Return(true, nil)
+		mockCampaignUsecase.On("Get", req.Context(), int64(1)).Return(&dto.CampaignDTO{ID: 1}, nil)
+		mockCampaignStoreUsecase.On("GetStores", req.Context(), int64(1)).Return([]*dto.CampaignStores{}, nil)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(0), "new year 2024").Return(true, nil)
+
+		campaignController.CloneCampaign(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Clone Campaign request without title", func(t *testing.T) {
+		req := newRequest(`{"order_start_date": "2024-03-01 09:00:00"}`)
+		w := httptest.NewRecorder()
+		campaignController := NewCampaignController(mocks.NewCampaignUseCases(t), mocks.NewCampaignStoreUseCases(t),
+			mocks.NewCampaignProductUseCases(t), newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+
+		campaignController.CloneCampaign(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCampaignController_GetStoreCampaigns(t *testing.T) {
+	appConfig := entities.AppCfg{
+		PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1},
+		TimezoneConfig:   entities.TimezoneConfig{BusinessTimezone: "Asia/Singapore"},
+	}
+	loc, _ := time.LoadLocation("Asia/Singapore")
+
+	t.Run("Get Store Campaigns request success with products embedded", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/stores/84/campaigns?status=Active&is_published=true&at=2024-02-10+12:00:00&include_products=true", "",
+			map[string]string{"store_id": "84"})
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCampaignProductUsecase := mocks.NewCampaignProductUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mocks.NewCampaignStoreUseCases(t), mockCampaignProductUsecase,
+			newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+		isPublished := true
+		mockCampaignUsecase.On("GetStoreCampaigns", req.Context(), int64(84), entities.StoreCampaignFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1, StatusValue: "Active",
+				SortFields: []entities.SortField{{Column: "order_start_date"}}},
+			IsPublished: &isPublished,
+			At:          time.Date(2024, time.February, 10, 12, 0, 0, 0, loc),
+		}).Return(&dto.CampaignListResponse{Data: dto.DataList{Campaigns: []dto.CampaignDTO{{ID: 7}}}}, nil)
+		mockCampaignProductUsecase.On("GetProducts", req.Context(), int64(7)).Return([]*dto.CampaignProducts{{ID: 3, ProductID: 500}}, nil)
+
+		campaignController.GetStoreCampaigns(res, req)
+
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		if !strings.Contains(res.Body.String(), `"campaign_products":[{"campaign_product_id":3,"product_id":500`) {
+			t.Errorf("handler returned unexpected body: got %v", res.Body.String())
+		}
+	})
+
+	t.Run("Get Store Campaigns request with malformed point in time", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/stores/84/campaigns?at=yesterday", "", map[string]string{"store_id": "84"})
+		res := httptest.NewRecorder()
+		campaignController := NewCampaignController(mocks.NewCampaignUseCases(t), mocks.NewCampaignStoreUseCases(t), mocks.NewCampaignProductUseCases(t),
+			newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+
+		campaignController.GetStoreCampaigns(res, req)
+
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCampaignController_GetProductCampaigns(t *testing.T) {
+	appConfig := entities.AppCfg{
+		PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1},
+		TimezoneConfig:   entities.TimezoneConfig{BusinessTimezone: "Asia/Singapore"},
+	}
+
+	t.Run("Get Product Campaigns request success for one sku", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/products/501/campaigns?sku_no=9001&is_published=false&sort=-collection_end_date,title", "",
+			map[string]string{"product_id": "501"})
+		res := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		campaignController := NewCampaignController(mockCampaignUsecase, mocks.NewCampaignStoreUseCases(t), mocks.NewCampaignProductUseCases(t),
+			newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+		isPublished := false
+		mockCampaignUsecase.On("GetProductCampaigns", req.Context(), int64(501), entities.ProductCampaignFilter{
+			StoreCampaignFilter: entities.StoreCampaignFilter{
+				PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1,
+					SortFields: []entities.SortField{{Column: "collection_end_date", Desc: true}, {Column: "title"}}},
+				IsPublished: &isPublished,
+			},
+			SKUNo: 9001,
+		}).Return(&dto.CampaignListResponse{Data: dto.DataList{Campaigns: []dto.CampaignDTO{{ID: 7}}}}, nil)
+
+		campaignController.GetProductCampaigns(res, req)
+
+		if status := res.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Get Product Campaigns request sorted by a column the campaign list can not be sorted by", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/products/501/campaigns?sort=-listing_title", "", map[string]string{"product_id": "501"})
+		res := httptest.NewRecorder()
+		campaignController := NewCampaignController(mocks.NewCampaignUseCases(t), mocks.NewCampaignStoreUseCases(t), mocks.NewCampaignProductUseCases(t),
+			newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+
+		campaignController.GetProductCampaigns(res, req)
+
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Get Product Campaigns request with malformed sku number", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/products/501/campaigns?sku_no=abc", "", map[string]string{"product_id": "501"})
+		res := httptest.NewRecorder()
+		campaignController := NewCampaignController(mocks.NewCampaignUseCases(t), mocks.NewCampaignStoreUseCases(t), mocks.NewCampaignProductUseCases(t),
+			newOpenBlackoutUseCases(t), service_mocks.NewTransactionService(t), &appConfig)
+
+		campaignController.GetProductCampaigns(res, req)
+
+		if status := res.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type CollectionSlotController struct {
+	campaignUseCases       usecases.CampaignUseCases
+	collectionSlotUseCases usecases.CollectionSlotUseCases
+}
+
+func NewCollectionSlotController(campaignUseCases usecases.CampaignUseCases,
+	collectionSlotUseCases usecases.CollectionSlotUseCases) *CollectionSlotController {
+	return &CollectionSlotController{
+		campaignUseCases:       campaignUseCases,
+		collectionSlotUseCases: collectionSlotUseCases,
+	}
+}
+
+func (c *CollectionSlotController) Init(r chi.Router) {
+	r.Get("/campaigns/{campaign_id}/stores/{store_id}/slots", c.GetAvailableSlots)
+	r.Route("/slots/{slot}/reservations", func(r chi.Router) {
+		r.Post("/", c.ReserveSlot)
+		r.Post("/{id}/confirm", c.ConfirmReservation)
+		r.Delete("/{id}", c.ReleaseReservation)
+	})
+}
+
+// GetAvailableSlots godoc
+//
+//	@Summary Get collection slots of campaign store
+//	@Description API to get the collection slots specified store offers for the campaign with the quota left on each.
+//	@Description The slots set for particular dates replace the weekly slots, slots outside the collection dates or within the lead time are left out.
+//	@Description from defaults to today and to to the collection end date, a range covers at most 92 days
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	campaign_id	path int true "Campaign ID"
+//	@Param	store_id	path int true "Store ID"
+//	@Param	from	query string false "First date, YYYY-MM-DD"
+//	@Param	to	query string false "Last date, YYYY-MM-DD"
+//	@Success 200 {object} dto.CollectionSlotAvailabilityResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/campaigns/{campaign_id}/stores/{store_id}/slots [get]
+func (c *CollectionSlotController) GetAvailableSlots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	campaignID, err := strconv.Atoi(chi.URLParam(r, "campaign_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectCampaignIDErr, err.Error()))
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	var from, to time.Time
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, int64(campaignID), "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.NotFoundJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, campaignID))
+		return
+	}
+
+	availability, err := c.collectionSlotUseCases.GetAvailableSlots(ctx, int64(campaignID), int64(storeID), from, to)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToCollectionSlotAvailabilityResponse(availability))
+}
+
+// ReserveSlot godoc
+//
+//	@Summary Reserve collection slot
+//	@Description API to take quota of a collection slot for a campaign, slot is the key returned with the campaign store slots.
+//	@Description The reservation is held until its hold expires unless confirmed, with confirm it is reserved for good right away
+//	@Tags slot reservations
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	slot	path string true "Slot key, source-slotid-date"
+//	@Param	reservation body params.CollectionSlotReservationForm true "Reservation details"
+//	@Success 200 {object} dto.CollectionSlotReservationResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slots/{slot}/reservations [post]
+func (c *CollectionSlotController) ReserveSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slot, err := valueobjects.ParseSlotKey(chi.URLParam(r, "slot"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	request, err := c.validateReservationRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	exists, err := c.campaignUseCases.Exists(ctx, request.CampaignID, "")
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	if !exists {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(CampaignNotExistsErr, request.CampaignID))
+		return
+	}
+
+	reservation, err := c.collectionSlotUseCases.ReserveSlot(ctx, params.ToCollectionSlotReservationEntity(request, slot, int64(userID)))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToCollectionSlotReservationResponse(reservation))
+}
+
+// ConfirmReservation godoc
+//
+//	@Summary Confirm collection slot reservation
+//	@Description API to keep the quota of a held reservation for good, a hold can not be confirmed once expired
+//	@Tags slot reservations
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	slot	path string true "Slot key, source-slotid-date"
+//	@Param	id	path int true "Reservation ID"
+//	@Success 200 {object} dto.CollectionSlotReservationResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slots/{slot}/reservations/{id}/confirm [post]
+func (c *CollectionSlotController) ConfirmReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slot, err := valueobjects.ParseSlotKey(chi.URLParam(r, "slot"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	reservationID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect reservation id value, err : %v", err.Error()))
+		return
+	}
+
+	reservation, err := c.collectionSlotUseCases.ConfirmReservation(ctx, slot, int64(reservationID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToCollectionSlotReservationResponse(reservation))
+}
+
+// ReleaseReservation godoc
+//
+//	@Summary Release collection slot reservation
+//	@Description API to give the quota of a held or confirmed reservation back
+//	@Tags slot reservations
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	slot	path string true "Slot key, source-slotid-date"
+//	@Param	id	path int true "Reservation ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slots/{slot}/reservations/{id} [delete]
+func (c *CollectionSlotController) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slot, err := valueobjects.ParseSlotKey(chi.URLParam(r, "slot"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	reservationID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect reservation id value, err : %v", err.Error()))
+		return
+	}
+
+	err = c.collectionSlotUseCases.ReleaseReservation(ctx, slot, int64(reservationID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("reservation with id %d released successfully", reservationID))
+}
+
+func (c *CollectionSlotController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrTimeSlotInvalid):
+		dto.BadRequestJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrStoreNotExists), errors.Is(err, valueobjects.ErrCollectionSlotNotExists),
+		errors.Is(err, valueobjects.ErrReservationNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrCollectionSlotFull), errors.Is(err, valueobjects.ErrReservationExpired),
+		errors.Is(err, valueobjects.ErrReservationReleased):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *CollectionSlotController) validateReservationRequest(r *http.Request) (params.CollectionSlotReservationForm, error) {
+	var request params.CollectionSlotReservationForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
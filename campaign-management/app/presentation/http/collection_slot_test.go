@@ -0,0 +1,250 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+)
+
+func newCollectionSlotRequest(target string) *http.Request {
+	req, _ := http.NewRequest("GET", target, nil)
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("campaign_id", "3")
+	ctx.URLParams.Add("store_id", "84")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestCollectionSlotController_GetAvailableSlots(t *testing.T) {
+	t.Run("Get Available Slots request success", func(t *testing.T) {
+		req := newCollectionSlotRequest("/campaigns/3/stores/84/slots?from=2024-02-10&to=2024-02-10")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mockCollectionSlotUsecase)
+		date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(true, nil)
+		mockCollectionSlotUsecase.On("GetAvailableSlots", req.Context(), int64(3), int64(84), date, date).
+			Return(&dto.CollectionSlotAvailability{CampaignID: 3, StoreID: 84, Timezone: "UTC", From: "2024-02-10", To: "2024-02-10",
+				Slots: []*dto.CollectionSlotDTO{{Slot: "specific-7-2024-02-10", Date: "2024-02-10", StartTime: "10:00", EndTime: "12:00",
+					StartAt: date.Add(10 * time.Hour), EndAt: date.Add(12 * time.Hour), Source: "specific", SlotID: 7, Quota: 50, RemainingQuota: 50}}}, nil)
+
+		controller.GetAvailableSlots(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"campaign_id":3,"store_id":84,"timezone":"UTC","from":"2024-02-10","to":"2024-02-10",` +
+			`"slots":[{"slot":"specific-7-2024-02-10","date":"2024-02-10","start_time":"10:00","end_time":"12:00","start_at":"2024-02-10T10:00:00Z","end_at":"2024-02-10T12:00:00Z",` +
+			`"source":"specific","slot_id":7,"quota":50,"remaining_quota":50}]}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Get Available Slots request for campaign not exists", func(t *testing.T) {
+		req := newCollectionSlotRequest("/campaigns/3/stores/84/slots")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mocks.NewCollectionSlotUseCases(t))
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(false, nil)
+
+		controller.GetAvailableSlots(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Get Available Slots request for store not in campaign", func(t *testing.T) {
+		req := newCollectionSlotRequest("/campaigns/3/stores/84/slots")
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mockCollectionSlotUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(true, nil)
+		mockCollectionSlotUsecase.On("GetAvailableSlots", req.Context(), int64(3), int64(84), time.Time{}, time.Time{}).
+			Return(nil, fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+
+		controller.GetAvailableSlots(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Get Available Slots request with incorrect date", func(t *testing.T) {
+		req := newCollectionSlotRequest("/campaigns/3/stores/84/slots?to=tomorrow")
+		w := httptest.NewRecorder()
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mocks.NewCollectionSlotUseCases(t))
+
+		controller.GetAvailableSlots(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func newReservationRequest(method, target, slot, reservationID, body string) *http.Request {
+	req, _ := http.NewRequest(method, target, strings.NewReader(body))
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("slot", slot)
+	if reservationID != "" {
+		ctx.URLParams.Add("id", reservationID)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+}
+
+func TestCollectionSlotController_ReserveSlot(t *testing.T) {
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 12, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+	expiresAt := time.Date(2024, time.February, 7, 10, 10, 0, 0, time.UTC)
+
+	t.Run("Reserve Slot request success", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/daily-12-2024-02-10/reservations", "daily-12-2024-02-10", "",
+			`{"campaign_id":3,"quantity":2,"reference":"order-1"}`)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mockCollectionSlotUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(true, nil)
+		mockCollectionSlotUsecase.On("ReserveSlot", req.Context(), entities.CollectionSlotReservation{CampaignID: 3, Slot: slotKey,
+			Quantity: 2, Status: valueobjects.ReservationStatusHeld, Reference: "order-1", CreatedBy: 12345}).
+			Return(&dto.CollectionSlotReservationDTO{ID: 9, CampaignID: 3, StoreID: 84, Slot: "daily-12-2024-02-10", Date: "2024-02-10",
+				Quantity: 2, Status: "held", ExpiresAt: &expiresAt, Reference: "order-1"}, nil)
+
+		controller.ReserveSlot(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"reservation_id":9,"campaign_id":3,"store_id":84,"slot":"daily-12-2024-02-10",` +
+			`"date":"2024-02-10","quantity":2,"status":"held","expires_at":"2024-02-07T10:10:00Z","reference":"order-1"}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Reserve Slot request for full slot", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/daily-12-2024-02-10/reservations", "daily-12-2024-02-10", "",
+			`{"campaign_id":3,"confirm":true}`)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mockCollectionSlotUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(true, nil)
+		mockCollectionSlotUsecase.On("ReserveSlot", req.Context(), entities.CollectionSlotReservation{CampaignID: 3, Slot: slotKey,
+			Quantity: 1, Status: valueobjects.ReservationStatusConfirmed, CreatedBy: 12345}).
+			Return(nil, fmt.Errorf("%w: 0 left", valueobjects.ErrCollectionSlotFull))
+
+		controller.ReserveSlot(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Reserve Slot request for slot not offered", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/daily-12-2024-02-10/reservations", "daily-12-2024-02-10", "", `{"campaign_id":3}`)
+		w := httptest.NewRecorder()
+		mockCampaignUsecase := mocks.NewCampaignUseCases(t)
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mockCampaignUsecase, mockCollectionSlotUsecase)
+		mockCampaignUsecase.On("Exists", req.Context(), int64(3), "").Return(true, nil)
+		mockCollectionSlotUsecase.On("ReserveSlot", req.Context(), mock.Anything).
+			Return(nil, fmt.Errorf("%w: daily-12-2024-02-10", valueobjects.ErrCollectionSlotNotExists))
+
+		controller.ReserveSlot(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+
+	t.Run("Reserve Slot request with invalid slot", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/weekly-12/reservations", "weekly-12", "", `{"campaign_id":3}`)
+		w := httptest.NewRecorder()
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mocks.NewCollectionSlotUseCases(t))
+
+		controller.ReserveSlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Reserve Slot request without campaign", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/daily-12-2024-02-10/reservations", "daily-12-2024-02-10", "", `{"quantity":1}`)
+		w := httptest.NewRecorder()
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mocks.NewCollectionSlotUseCases(t))
+
+		controller.ReserveSlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestCollectionSlotController_ConfirmReservation(t *testing.T) {
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 12, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("Confirm Reservation request for expired hold", func(t *testing.T) {
+		req := newReservationRequest("POST", "/slots/daily-12-2024-02-10/reservations/9/confirm", "daily-12-2024-02-10", "9", "")
+		w := httptest.NewRecorder()
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mockCollectionSlotUsecase)
+		mockCollectionSlotUsecase.On("ConfirmReservation", req.Context(), slotKey, int64(9), int64(12345)).
+			Return(nil, fmt.Errorf("%w: 9", valueobjects.ErrReservationExpired))
+
+		controller.ConfirmReservation(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+}
+
+func TestCollectionSlotController_ReleaseReservation(t *testing.T) {
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceSpecific, SlotID: 4, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("Release Reservation request success", func(t *testing.T) {
+		req := newReservationRequest("DELETE", "/slots/specific-4-2024-02-10/reservations/9", "specific-4-2024-02-10", "9", "")
+		w := httptest.NewRecorder()
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mockCollectionSlotUsecase)
+		mockCollectionSlotUsecase.On("ReleaseReservation", req.Context(), slotKey, int64(9), int64(12345)).Return(nil)
+
+		controller.ReleaseReservation(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Release Reservation request for reservation of another slot", func(t *testing.T) {
+		req := newReservationRequest("DELETE", "/slots/specific-4-2024-02-10/reservations/9", "specific-4-2024-02-10", "9", "")
+		w := httptest.NewRecorder()
+		mockCollectionSlotUsecase := mocks.NewCollectionSlotUseCases(t)
+		controller := NewCollectionSlotController(mocks.NewCampaignUseCases(t), mockCollectionSlotUsecase)
+		mockCollectionSlotUsecase.On("ReleaseReservation", req.Context(), slotKey, int64(9), int64(12345)).
+			Return(fmt.Errorf("%w: 9", valueobjects.ErrReservationNotExists))
+
+		controller.ReleaseReservation(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+}
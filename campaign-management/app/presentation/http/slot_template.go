@@ -0,0 +1,301 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const IncorrectSlotTemplateIDErr = "incorrect slot template id value, err : %v"
+
+type SlotTemplateController struct {
+	slotTemplateUseCases usecases.SlotTemplateUseCases
+}
+
+func NewSlotTemplateController(slotTemplateUseCases usecases.SlotTemplateUseCases) *SlotTemplateController {
+	return &SlotTemplateController{
+		slotTemplateUseCases: slotTemplateUseCases,
+	}
+}
+
+func (c *SlotTemplateController) Init(r chi.Router) {
+	r.Route("/slot-templates", func(r chi.Router) {
+		r.Get("/", c.GetTemplates)
+		r.Post("/", c.CreateTemplate)
+		r.Get("/{id}", c.GetTemplate)
+		r.Put("/{id}", c.UpdateTemplate)
+		r.Delete("/{id}", c.DeleteTemplate)
+		r.Post("/{id}/apply", c.ApplyTemplate)
+	})
+}
+
+// GetTemplates godoc
+//
+//	@Summary Get slot templates
+//	@Description API to get all slot templates
+//	@Tags slot templates
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Success 200 {object} dto.SlotTemplateListResponse
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates [get]
+func (c *SlotTemplateController) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := c.slotTemplateUseCases.GetTemplates(r.Context())
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToSlotTemplateListResponse(templates))
+}
+
+// GetTemplate godoc
+//
+//	@Summary Get slot template
+//	@Description API to get particular slot template
+//	@Tags slot templates
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Slot Template ID"
+//	@Success 200 {object} dto.SlotTemplateResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates/{id} [get]
+func (c *SlotTemplateController) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectSlotTemplateIDErr, err.Error()))
+		return
+	}
+	template, err := c.slotTemplateUseCases.GetTemplate(r.Context(), int64(templateID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToSlotTemplateResponse(template))
+}
+
+// CreateTemplate godoc
+//
+//	@Summary Add slot template
+//	@Description API to add a template of weekly slots, start to end is cut into slots of slot_minutes on each of the weekdays
+//	@Tags slot templates
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	template body params.SlotTemplateForm true "Slot template details"
+//	@Success 200 {object} dto.SlotTemplateResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates [post]
+func (c *SlotTemplateController) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	request, err := c.validateTemplateRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	templateEntity, err := params.ToSlotTemplateEntity(request, 0, int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	template, err := c.slotTemplateUseCases.CreateTemplate(ctx, templateEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToSlotTemplateResponse(template))
+}
+
+// UpdateTemplate godoc
+//
+//	@Summary Update slot template
+//	@Description API to update particular slot template, slots made from it before are left as they are
+//	@Tags slot templates
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Slot Template ID"
+//	@Param	template body params.SlotTemplateForm true "Slot template details"
+//	@Success 200 {object} dto.SlotTemplateResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates/{id} [put]
+func (c *SlotTemplateController) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectSlotTemplateIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateTemplateRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	templateEntity, err := params.ToSlotTemplateEntity(request, int64(templateID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	template, err := c.slotTemplateUseCases.UpdateTemplate(ctx, templateEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToSlotTemplateResponse(template))
+}
+
+// DeleteTemplate godoc
+//
+//	@Summary Delete slot template
+//	@Description API to delete particular slot template, slots made from it are left as they are
+//	@Tags slot templates
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Slot Template ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates/{id} [delete]
+func (c *SlotTemplateController) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectSlotTemplateIDErr, err.Error()))
+		return
+	}
+
+	err = c.slotTemplateUseCases.DeleteTemplate(ctx, int64(templateID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("slot template with id %d deleted successfully", templateID))
+}
+
+// ApplyTemplate godoc
+//
+//	@Summary Apply slot template to stores
+//	@Description API to create the weekly slots of the template for the given stores in one go, either the slots of every store are created or none.
+//	@Description Unknown or inactive stores and slots overlapping a slot a store already has fail the request. With dry_run the slots are returned without being created
+//	@Tags slot templates
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	id	path int true "Slot Template ID"
+//	@Param	apply body params.SlotTemplateApplyForm true "Stores to apply the template to"
+//	@Success 200 {object} dto.SlotTemplateApplyResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/slot-templates/{id}/apply [post]
+func (c *SlotTemplateController) ApplyTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	templateID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectSlotTemplateIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateApplyRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	result, err := c.slotTemplateUseCases.ApplyTemplate(ctx, int64(templateID), request.StoreIDs, request.DryRun, int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToSlotTemplateApplyResponse(result))
+}
+
+func (c *SlotTemplateController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrTimeSlotInvalid), errors.Is(err, valueobjects.ErrStoreUnknown):
+		dto.BadRequestJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrSlotTemplateNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrDailyTimeSlotOverlap):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *SlotTemplateController) validateTemplateRequest(r *http.Request) (params.SlotTemplateForm, error) {
+	var request params.SlotTemplateForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+func (c *SlotTemplateController) validateApplyRequest(r *http.Request) (params.SlotTemplateApplyForm, error) {
+	var request params.SlotTemplateApplyForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func newSlotTemplateRequest(method, body string, urlParams map[string]string) *http.Request {
+	req, _ := http.NewRequest(method, "/slot-templates", bytes.NewBufferString(body))
+	ctx := chi.NewRouteContext()
+	for key, value := range urlParams {
+		ctx.URLParams.Add(key, value)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+}
+
+func TestSlotTemplateController_CreateTemplate(t *testing.T) {
+	t.Run("Create Template request success", func(t *testing.T) {
+		req := newSlotTemplateRequest("POST", `{"name": "weekday", "days_of_week": ["monday", "friday"], "start_time": "10:00", "end_time": "20:00", "slot_minutes": 60, "quota": 30}`, nil)
+		w := httptest.NewRecorder()
+		mockSlotTemplateUsecase := mocks.NewSlotTemplateUseCases(t)
+		controller := NewSlotTemplateController(mockSlotTemplateUsecase)
+		mockSlotTemplateUsecase.On("CreateTemplate", req.Context(), entities.SlotTemplate{Name: "weekday",
+			DaysOfWeek: []time.Weekday{time.Monday, time.Friday}, StartTime: 600, EndTime: 1200, SlotMinutes: 60, Quota: 30,
+			IsSlotAvailable: true, CreatedBy: 12345}).
+			Return(&dto.SlotTemplateDTO{ID: 4, Name: "weekday", DaysOfWeek: []string{"monday", "friday"}, StartTime: "10:00", EndTime: "20:00",
+				SlotMinutes: 60, Quota: 30, IsSlotAvailable: true}, nil)
+
+		controller.CreateTemplate(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"slot_template_id":4,"name":"weekday","days_of_week":["monday","friday"],"start_time":"10:00","end_time":"20:00","slot_minutes":60,"quota":30,"is_slot_available":true}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Create Template request with unknown weekday", func(t *testing.T) {
+		req := newSlotTemplateRequest("POST", `{"name": "weekday", "days_of_week": ["someday"], "start_time": "10:00", "end_time": "20:00", "slot_minutes": 60}`, nil)
+		w := httptest.NewRecorder()
+		controller := NewSlotTemplateController(mocks.NewSlotTemplateUseCases(t))
+
+		controller.CreateTemplate(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestSlotTemplateController_ApplyTemplate(t *testing.T) {
+	t.Run("Apply Template dry run request success", func(t *testing.T) {
+		req := newSlotTemplateRequest("POST", `{"store_ids": [84], "dry_run": true}`, map[string]string{"id": "4"})
+		w := httptest.NewRecorder()
+		mockSlotTemplateUsecase := mocks.NewSlotTemplateUseCases(t)
+		controller := NewSlotTemplateController(mockSlotTemplateUsecase)
+		mockSlotTemplateUsecase.On("ApplyTemplate", req.Context(), int64(4), []int64{84}, true, int64(12345)).
+			Return(&dto.SlotTemplateApplyResult{TemplateID: 4, DryRun: true, StoreIDs: []int64{84}, Slots: []*dto.StoreDailyTimeSlotDTO{
+				{StoreID: 84, DayOfWeek: "monday", StartTime: "10:00", EndTime: "11:00", Quota: 30, IsSlotAvailable: true},
+			}}, nil)
+
+		controller.ApplyTemplate(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"slot_template_id":4,"dry_run":true,"store_ids":[84],"slots":[{"daily_time_slot_id":0,"store_id":84,"day_of_week":"monday","start_time":"10:00","end_time":"11:00","quota":30,"is_slot_available":true}]}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Apply Template request overlapping slots of a store", func(t *testing.T) {
+		req := newSlotTemplateRequest("POST", `{"store_ids": [84, 85]}`, map[string]string{"id": "4"})
+		w := httptest.NewRecorder()
+		mockSlotTemplateUsecase := mocks.NewSlotTemplateUseCases(t)
+		controller := NewSlotTemplateController(mockSlotTemplateUsecase)
+		mockSlotTemplateUsecase.On("ApplyTemplate", req.Context(), int64(4), []int64{84, 85}, false, int64(12345)).
+			Return(nil, fmt.Errorf("%w: store 85", valueobjects.ErrDailyTimeSlotOverlap))
+
+		controller.ApplyTemplate(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Apply Template request without stores", func(t *testing.T) {
+		req := newSlotTemplateRequest("POST", `{"store_ids": []}`, map[string]string{"id": "4"})
+		w := httptest.NewRecorder()
+		controller := NewSlotTemplateController(mocks.NewSlotTemplateUseCases(t))
+
+		controller.ApplyTemplate(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type StoreController struct {
+	storeUseCases usecases.StoreUseCases
+	appConfig     *entities.AppCfg
+}
+
+func NewStoreController(storeUseCases usecases.StoreUseCases, appConfig *entities.AppCfg) *StoreController {
+	return &StoreController{
+		storeUseCases: storeUseCases,
+		appConfig:     appConfig,
+	}
+}
+
+func (c *StoreController) Init(r chi.Router) {
+	r.Route("/stores", func(r chi.Router) {
+		r.Get("/", c.GetStores)
+		r.Post("/", c.CreateStore)
+		r.Get("/{store_id}", c.GetStore)
+		r.Put("/{store_id}", c.UpdateStore)
+		r.Delete("/{store_id}", c.DeleteStore)
+	})
+}
+
+// GetStores godoc
+//
+//	@Summary Get stores
+//	@Description API to get a page of the registered stores
+//	@Tags stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [column asc/column desc], column is one of store_id, name, region, created_at"
+//	@Param	region query string false "Region"
+//	@Param	is_active query boolean false "Active stores only when true, inactive stores only when false"
+//	@Success 200 {object} dto.StoreListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores [get]
+func (c *StoreController) GetStores(w http.ResponseWriter, r *http.Request) {
+	filter, err := c.generateStoreFilterFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	response, err := c.storeUseCases.GetStores(r.Context(), params.ToStoreFilterEntity(filter))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *StoreController) generateStoreFilterFromRequest(r *http.Request) (params.StoreFilter, error) {
+	filter := params.StoreFilter{
+		Pagination: params.Pagination{
+			Limit: c.appConfig.PaginationConfig.Limit,
+			Page:  c.appConfig.PaginationConfig.Page,
+			Sort:  "store_id asc",
+		},
+	}
+	var err error
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			filter.Limit, err = strconv.Atoi(queryValue)
+		case "page":
+			filter.Page, err = strconv.Atoi(queryValue)
+		case "sort":
+			filter.Sort = queryValue
+		case "region":
+			filter.Region = queryValue
+		case "is_active":
+			var isActive bool
+			isActive, err = strconv.ParseBool(queryValue)
+			filter.IsActive = &isActive
+		}
+		if err != nil {
+			return filter, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if filter.Limit < 1 || filter.Page < 1 {
+		return filter, fmt.Errorf("limit and page must be positive")
+	}
+	filter.SortFields, err = params.ToSortFields(filter.Sort, params.StoreSortColumns)
+	return filter, err
+}
+
+// GetStore godoc
+//
+//	@Summary Get store
+//	@Description API to get particular registered store
+//	@Tags stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Success 200 {object} dto.StoreResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id} [get]
+func (c *StoreController) GetStore(w http.ResponseWriter, r *http.Request) {
+	storeID, err := strconv.ParseInt(chi.URLParam(r, "store_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	store, err := c.storeUseCases.GetStore(r.Context(), storeID)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreResponse(store))
+}
+
+// CreateStore godoc
+//
+//	@Summary Register store
+//	@Description API to register a store under the store id campaigns refer to it by, only active stores can be added to campaigns
+//	@Tags stores
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store body params.StoreCreationForm true "Store details"
+//	@Success 200 {object} dto.StoreResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores [post]
+func (c *StoreController) CreateStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	var request params.StoreCreationForm
+	if err := c.decodeStoreRequest(r, &request); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	store, err := c.storeUseCases.CreateStore(ctx, params.ToStoreEntity(request, int64(userID)))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreResponse(store))
+}
+
+// UpdateStore godoc
+//
+//	@Summary Update store
+//	@Description API to update particular registered store, a store made inactive stays on the campaigns it was added to
+//	@Tags stores
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	store body params.StoreForm true "Store details"
+//	@Success 200 {object} dto.StoreResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id} [put]
+func (c *StoreController) UpdateStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.ParseInt(chi.URLParam(r, "store_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	var request params.StoreForm
+	if err := c.decodeStoreRequest(r, &request); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	store, err := c.storeUseCases.UpdateStore(ctx, params.ToUpdateStoreEntity(request, storeID, int64(userID)))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreResponse(store))
+}
+
+// DeleteStore godoc
+//
+//	@Summary Delete store
+//	@Description API to remove particular store from the registry, campaigns keep the store but can no longer add it
+//	@Tags stores
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id} [delete]
+func (c *StoreController) DeleteStore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.ParseInt(chi.URLParam(r, "store_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	err = c.storeUseCases.DeleteStore(ctx, storeID, int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("store with id %d deleted successfully", storeID))
+}
+
+func (c *StoreController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrRegisteredStoreNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrRegisteredStoreExists):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *StoreController) decodeStoreRequest(r *http.Request, request interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(request)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	return validate.Struct(request)
+}
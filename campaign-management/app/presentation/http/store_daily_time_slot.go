@@ -0,0 +1,271 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const IncorrectStoreIDErr = "incorrect store id value, err : %v"
+
+type StoreDailyTimeSlotController struct {
+	dailyTimeSlotUseCases usecases.StoreDailyTimeSlotUseCases
+}
+
+func NewStoreDailyTimeSlotController(dailyTimeSlotUseCases usecases.StoreDailyTimeSlotUseCases) *StoreDailyTimeSlotController {
+	return &StoreDailyTimeSlotController{
+		dailyTimeSlotUseCases: dailyTimeSlotUseCases,
+	}
+}
+
+func (c *StoreDailyTimeSlotController) Init(r chi.Router) {
+	r.Route("/stores/{store_id}/daily-slots", func(r chi.Router) {
+		r.Get("/", c.GetDailySlots)
+		r.Post("/", c.CreateDailySlot)
+		r.Get("/{id}", c.GetDailySlot)
+		r.Put("/{id}", c.UpdateDailySlot)
+		r.Delete("/{id}", c.DeleteDailySlot)
+	})
+}
+
+// GetDailySlots godoc
+//
+//	@Summary Get daily time slots of store
+//	@Description API to get the weekly repeating collection slots of specified store
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Success 200 {object} dto.StoreDailyTimeSlotListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/daily-slots [get]
+func (c *StoreDailyTimeSlotController) GetDailySlots(w http.ResponseWriter, r *http.Request) {
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slots, err := c.dailyTimeSlotUseCases.GetSlots(r.Context(), int64(storeID))
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToStoreDailyTimeSlotListResponse(slots))
+}
+
+// GetDailySlot godoc
+//
+//	@Summary Get daily time slot of store
+//	@Description API to get particular weekly repeating collection slot of specified store
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Daily Time Slot ID"
+//	@Success 200 {object} dto.StoreDailyTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/daily-slots/{id} [get]
+func (c *StoreDailyTimeSlotController) GetDailySlot(w http.ResponseWriter, r *http.Request) {
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect daily time slot id value, err : %v", err.Error()))
+		return
+	}
+	slot, err := c.dailyTimeSlotUseCases.GetSlot(r.Context(), int64(storeID), int64(slotID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreDailyTimeSlotResponse(slot))
+}
+
+// CreateDailySlot godoc
+//
+//	@Summary Add daily time slot to store
+//	@Description API to add a weekly repeating collection slot to specified store, slots of the same weekday can not overlap
+//	@Tags store time slots
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	slot body params.StoreDailyTimeSlotForm true "Time slot details"
+//	@Success 200 {object} dto.StoreDailyTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/daily-slots [post]
+func (c *StoreDailyTimeSlotController) CreateDailySlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateDailySlotRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slotEntity, err := params.ToStoreDailyTimeSlotEntity(request, 0, int64(storeID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	slot, err := c.dailyTimeSlotUseCases.CreateSlot(ctx, slotEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreDailyTimeSlotResponse(slot))
+}
+
+// UpdateDailySlot godoc
+//
+//	@Summary Update daily time slot of store
+//	@Description API to update particular weekly repeating collection slot of specified store, slots of the same weekday can not overlap
+//	@Tags store time slots
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Daily Time Slot ID"
+//	@Param	slot body params.StoreDailyTimeSlotForm true "Time slot details"
+//	@Success 200 {object} dto.StoreDailyTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/daily-slots/{id} [put]
+func (c *StoreDailyTimeSlotController) UpdateDailySlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect daily time slot id value, err : %v", err.Error()))
+		return
+	}
+
+	request, err := c.validateDailySlotRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slotEntity, err := params.ToStoreDailyTimeSlotEntity(request, int64(slotID), int64(storeID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	slot, err := c.dailyTimeSlotUseCases.UpdateSlot(ctx, slotEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreDailyTimeSlotResponse(slot))
+}
+
+// DeleteDailySlot godoc
+//
+//	@Summary Delete daily time slot of store
+//	@Description API to delete particular weekly repeating collection slot of specified store
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Daily Time Slot ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/daily-slots/{id} [delete]
+func (c *StoreDailyTimeSlotController) DeleteDailySlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect daily time slot id value, err : %v", err.Error()))
+		return
+	}
+
+	err = c.dailyTimeSlotUseCases.DeleteSlot(ctx, int64(storeID), int64(slotID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("daily time slot with id %d deleted successfully", slotID))
+}
+
+func (c *StoreDailyTimeSlotController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrTimeSlotInvalid), errors.Is(err, valueobjects.ErrStoreUnknown):
+		dto.BadRequestJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrDailyTimeSlotNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrDailyTimeSlotOverlap):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *StoreDailyTimeSlotController) validateDailySlotRequest(r *http.Request) (params.StoreDailyTimeSlotForm, error) {
+	var request params.StoreDailyTimeSlotForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
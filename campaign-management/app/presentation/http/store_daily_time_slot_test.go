@@ -0,0 +1,147 @@
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func newStoreDailySlotRequest(method, body string, urlParams map[string]string) *http.Request {
+	req, _ := http.NewRequest(method, "/stores/84/daily-slots", bytes.NewBufferString(body))
+	ctx := chi.NewRouteContext()
+	for key, value := range urlParams {
+		ctx.URLParams.Add(key, value)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+}
+
+func TestStoreDailyTimeSlotController_CreateDailySlot(t *testing.T) {
+	t.Run("Create Daily Slot request success", func(t *testing.T) {
+		req := newStoreDailySlotRequest("POST", `{"day_of_week": "monday", "start_time": "09:00", "end_time": "10:30", "quota": 10}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		mockDailyTimeSlotUsecase := mocks.NewStoreDailyTimeSlotUseCases(t)
+		controller := NewStoreDailyTimeSlotController(mockDailyTimeSlotUsecase)
+		mockDailyTimeSlotUsecase.On("CreateSlot", req.Context(), entities.StoreDailyTimeSlot{
+			StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 630, Quota: 10, IsSlotAvailable: true, CreatedBy: 12345,
+		}).Return(&dto.StoreDailyTimeSlotDTO{ID: 1, StoreID: 84, DayOfWeek: "monday", StartTime: "09:00", EndTime: "10:30", Quota: 10, IsSlotAvailable: true}, nil)
+
+		controller.CreateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"daily_time_slot_id":1,"store_id":84,"day_of_week":"monday","start_time":"09:00","end_time":"10:30","quota":10,"is_slot_available":true}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Create Daily Slot request with incorrect time", func(t *testing.T) {
+		req := newStoreDailySlotRequest("POST", `{"day_of_week": "monday", "start_time": "9am", "end_time": "10:30"}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		controller := NewStoreDailyTimeSlotController(mocks.NewStoreDailyTimeSlotUseCases(t))
+
+		controller.CreateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Create Daily Slot request with unknown weekday", func(t *testing.T) {
+		req := newStoreDailySlotRequest("POST", `{"day_of_week": "funday", "start_time": "09:00", "end_time": "10:30"}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		controller := NewStoreDailyTimeSlotController(mocks.NewStoreDailyTimeSlotUseCases(t))
+
+		controller.CreateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Create Daily Slot request overlapping another slot", func(t *testing.T) {
+		req := newStoreDailySlotRequest("POST", `{"day_of_week": "monday", "start_time": "09:00", "end_time": "10:30"}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		mockDailyTimeSlotUsecase := mocks.NewStoreDailyTimeSlotUseCases(t)
+		controller := NewStoreDailyTimeSlotController(mockDailyTimeSlotUsecase)
+		mockDailyTimeSlotUsecase.On("CreateSlot", req.Context(), entities.StoreDailyTimeSlot{
+			StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 630, IsSlotAvailable: true, CreatedBy: 12345,
+		}).Return(nil, fmt.Errorf("%w: monday 09:00-10:30 overlaps slot 2 10:00-11:00", valueobjects.ErrDailyTimeSlotOverlap))
+
+		controller.CreateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Create Daily Slot request for store not registered", func(t *testing.T) {
+		req := newStoreDailySlotRequest("POST", `{"day_of_week": "monday", "start_time": "09:00", "end_time": "10:30"}`,
+			map[string]string{"store_id": "99"})
+		w := httptest.NewRecorder()
+		mockDailyTimeSlotUsecase := mocks.NewStoreDailyTimeSlotUseCases(t)
+		controller := NewStoreDailyTimeSlotController(mockDailyTimeSlotUsecase)
+		mockDailyTimeSlotUsecase.On("CreateSlot", req.Context(), entities.StoreDailyTimeSlot{
+			StoreID: 99, DayOfWeek: time.Monday, StartTime: 540, EndTime: 630, IsSlotAvailable: true, CreatedBy: 12345,
+		}).Return(nil, fmt.Errorf("%w: 99", valueobjects.ErrStoreUnknown))
+
+		controller.CreateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotController_UpdateDailySlot(t *testing.T) {
+	t.Run("Update Daily Slot request for slot not exists", func(t *testing.T) {
+		req := newStoreDailySlotRequest("PUT", `{"day_of_week": "monday", "start_time": "09:00", "end_time": "10:30", "is_slot_available": false}`,
+			map[string]string{"store_id": "84", "id": "9"})
+		w := httptest.NewRecorder()
+		mockDailyTimeSlotUsecase := mocks.NewStoreDailyTimeSlotUseCases(t)
+		controller := NewStoreDailyTimeSlotController(mockDailyTimeSlotUsecase)
+		mockDailyTimeSlotUsecase.On("UpdateSlot", req.Context(), entities.StoreDailyTimeSlot{
+			ID: 9, StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 630, UpdatedBy: 12345,
+		}).Return(nil, fmt.Errorf("%w: 9", valueobjects.ErrDailyTimeSlotNotExists))
+
+		controller.UpdateDailySlot(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotController_DeleteDailySlot(t *testing.T) {
+	t.Run("Delete Daily Slot request success", func(t *testing.T) {
+		req := newStoreDailySlotRequest("DELETE", "", map[string]string{"store_id": "84", "id": "1"})
+		w := httptest.NewRecorder()
+		mockDailyTimeSlotUsecase := mocks.NewStoreDailyTimeSlotUseCases(t)
+		controller := NewStoreDailyTimeSlotController(mockDailyTimeSlotUsecase)
+		mockDailyTimeSlotUsecase.On("DeleteSlot", req.Context(), int64(84), int64(1), int64(12345)).Return(nil)
+
+		controller.DeleteDailySlot(w, req)
+
+		expected := `{"code":200,"message":"daily time slot with id 1 deleted successfully"}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+}
@@ -0,0 +1,343 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const IncorrectStoreGroupIDErr = "incorrect store group id value, err : %s"
+
+type StoreGroupController struct {
+	storeGroupUseCases usecases.StoreGroupUseCases
+	appConfig          *entities.AppCfg
+}
+
+func NewStoreGroupController(storeGroupUseCases usecases.StoreGroupUseCases, appConfig *entities.AppCfg) *StoreGroupController {
+	return &StoreGroupController{
+		storeGroupUseCases: storeGroupUseCases,
+		appConfig:          appConfig,
+	}
+}
+
+func (c *StoreGroupController) Init(r chi.Router) {
+	r.Route("/store-groups", func(r chi.Router) {
+		r.Get("/", c.GetStoreGroups)
+		r.Post("/", c.CreateStoreGroup)
+		r.Get("/{store_group_id}", c.GetStoreGroup)
+		r.Put("/{store_group_id}", c.UpdateStoreGroup)
+		r.Delete("/{store_group_id}", c.DeleteStoreGroup)
+		r.Post("/{store_group_id}/stores", c.AddStores)
+		r.Delete("/{store_group_id}/stores/{store_id}", c.RemoveStore)
+	})
+}
+
+// GetStoreGroups godoc
+//
+//	@Summary Get store groups
+//	@Description API to get a page of the store groups with their stores
+//	@Tags store groups
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	page query int false "Page Number"
+//	@Param	limit query int false "Limit"
+//	@Param	sort query string false "Sort [column asc/column desc], column is one of store_group_id, name, created_at"
+//	@Success 200 {object} dto.StoreGroupListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups [get]
+func (c *StoreGroupController) GetStoreGroups(w http.ResponseWriter, r *http.Request) {
+	pagination, err := c.generatePaginationFromRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	response, err := c.storeGroupUseCases.GetStoreGroups(r.Context(), params.ToStoreGroupPaginationEntity(pagination))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSONResponse(w, r, response)
+}
+
+func (c *StoreGroupController) generatePaginationFromRequest(r *http.Request) (params.Pagination, error) {
+	pagination := params.Pagination{
+		Limit: c.appConfig.PaginationConfig.Limit,
+		Page:  c.appConfig.PaginationConfig.Page,
+		Sort:  "name asc",
+	}
+	var err error
+	for key, value := range r.URL.Query() {
+		queryValue := value[len(value)-1]
+		switch key {
+		case "limit":
+			pagination.Limit, err = strconv.Atoi(queryValue)
+		case "page":
+			pagination.Page, err = strconv.Atoi(queryValue)
+		case "sort":
+			pagination.Sort = queryValue
+		}
+		if err != nil {
+			return pagination, fmt.Errorf("incorrect %s value, err : %v", key, err)
+		}
+	}
+	if pagination.Limit < 1 || pagination.Page < 1 {
+		return pagination, fmt.Errorf("limit and page must be positive")
+	}
+	pagination.SortFields, err = params.ToSortFields(pagination.Sort, params.StoreGroupSortColumns)
+	return pagination, err
+}
+
+// GetStoreGroup godoc
+//
+//	@Summary Get store group
+//	@Description API to get particular store group with its stores
+//	@Tags store groups
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group_id	path int true "Store Group ID"
+//	@Success 200 {object} dto.StoreGroupResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups/{store_group_id} [get]
+func (c *StoreGroupController) GetStoreGroup(w http.ResponseWriter, r *http.Request) {
+	storeGroupID, err := strconv.ParseInt(chi.URLParam(r, "store_group_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreGroupIDErr, err.Error()))
+		return
+	}
+	storeGroup, err := c.storeGroupUseCases.GetStoreGroup(r.Context(), storeGroupID)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreGroupResponse(storeGroup))
+}
+
+// CreateStoreGroup godoc
+//
+//	@Summary Create store group
+//	@Description API to create a named group of stores, pass its id as store_group_ids to add all its stores to a campaign
+//	@Tags store groups
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group body params.StoreGroupCreationForm true "Store group details"
+//	@Success 200 {object} dto.StoreGroupResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups [post]
+func (c *StoreGroupController) CreateStoreGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	var request params.StoreGroupCreationForm
+	if err := c.decodeStoreGroupRequest(r, &request); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	storeGroup, err := c.storeGroupUseCases.CreateStoreGroup(ctx, params.ToStoreGroupEntity(request, int64(userID)))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreGroupResponse(storeGroup))
+}
+
+// UpdateStoreGroup godoc
+//
+//	@Summary Update store group
+//	@Description API to rename particular store group, its stores are kept
+//	@Tags store groups
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group_id	path int true "Store Group ID"
+//	@Param	store_group body params.StoreGroupForm true "Store group details"
+//	@Success 200 {object} dto.StoreGroupResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups/{store_group_id} [put]
+func (c *StoreGroupController) UpdateStoreGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeGroupID, err := strconv.ParseInt(chi.URLParam(r, "store_group_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreGroupIDErr, err.Error()))
+		return
+	}
+
+	var request params.StoreGroupForm
+	if err := c.decodeStoreGroupRequest(r, &request); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	storeGroup, err := c.storeGroupUseCases.UpdateStoreGroup(ctx, params.ToUpdateStoreGroupEntity(request, storeGroupID, int64(userID)))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreGroupResponse(storeGroup))
+}
+
+// DeleteStoreGroup godoc
+//
+//	@Summary Delete store group
+//	@Description API to delete particular store group, campaigns keep the stores added through it
+//	@Tags store groups
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group_id	path int true "Store Group ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups/{store_group_id} [delete]
+func (c *StoreGroupController) DeleteStoreGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeGroupID, err := strconv.ParseInt(chi.URLParam(r, "store_group_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreGroupIDErr, err.Error()))
+		return
+	}
+
+	err = c.storeGroupUseCases.DeleteStoreGroup(ctx, storeGroupID, int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("store group with id %d deleted successfully", storeGroupID))
+}
+
+// AddStores godoc
+//
+//	@Summary Add stores to store group
+//	@Description API to add stores to particular store group, stores already in the group are left as they are
+//	@Tags store groups
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group_id	path int true "Store Group ID"
+//	@Param	stores body params.StoreGroupStoresForm true "Stores"
+//	@Success 200 {object} dto.StoreGroupResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups/{store_group_id}/stores [post]
+func (c *StoreGroupController) AddStores(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeGroupID, err := strconv.ParseInt(chi.URLParam(r, "store_group_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreGroupIDErr, err.Error()))
+		return
+	}
+
+	var request params.StoreGroupStoresForm
+	if err := c.decodeStoreGroupRequest(r, &request); err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	members := params.ToStoreGroupMemberEntities(request.Stores, storeGroupID, int64(userID))
+	storeGroup, err := c.storeGroupUseCases.AddStores(ctx, storeGroupID, members)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreGroupResponse(storeGroup))
+}
+
+// RemoveStore godoc
+//
+//	@Summary Remove store from store group
+//	@Description API to remove a store from particular store group
+//	@Tags store groups
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_group_id	path int true "Store Group ID"
+//	@Param	store_id	path int true "Store ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/store-groups/{store_group_id}/stores/{store_id} [delete]
+func (c *StoreGroupController) RemoveStore(w http.ResponseWriter, r *http.Request) {
+	storeGroupID, err := strconv.ParseInt(chi.URLParam(r, "store_group_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreGroupIDErr, err.Error()))
+		return
+	}
+	storeID, err := strconv.ParseInt(chi.URLParam(r, "store_id"), 10, 64)
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	err = c.storeGroupUseCases.RemoveStore(r.Context(), storeGroupID, storeID)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("store with id %d removed from store group %d successfully", storeID, storeGroupID))
+}
+
+func (c *StoreGroupController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrStoreGroupNotExists), errors.Is(err, valueobjects.ErrStoreGroupMemberNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrStoreGroupExists):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrStoreUnknown):
+		dto.BadRequestJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *StoreGroupController) decodeStoreGroupRequest(r *http.Request, request interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(request)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	return validate.Struct(request)
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStoreGroupController_CreateStoreGroup(t *testing.T) {
+	t.Run("Create Store Group request success", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/store-groups", `{"name": "All Hypermarts", "stores": [84, 85]}`, nil)
+		w := httptest.NewRecorder()
+		mockStoreGroupUsecase := mocks.NewStoreGroupUseCases(t)
+		controller := NewStoreGroupController(mockStoreGroupUsecase, &entities.AppCfg{})
+		mockStoreGroupUsecase.On("CreateStoreGroup", req.Context(), entities.StoreGroup{Name: "All Hypermarts", StoreIDs: []int64{84, 85}, CreatedBy: 12345}).
+			Return(&dto.StoreGroupDTO{ID: 3, Name: "All Hypermarts", Stores: []int64{84, 85}}, nil)
+
+		controller.CreateStoreGroup(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"store_group_id":3,"name":"All Hypermarts","description":"","stores":[84,85]}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Create Store Group request with name taken", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/store-groups", `{"name": "All Hypermarts"}`, nil)
+		w := httptest.NewRecorder()
+		mockStoreGroupUsecase := mocks.NewStoreGroupUseCases(t)
+		controller := NewStoreGroupController(mockStoreGroupUsecase, &entities.AppCfg{})
+		mockStoreGroupUsecase.On("CreateStoreGroup", req.Context(), entities.StoreGroup{Name: "All Hypermarts", CreatedBy: 12345}).
+			Return(nil, fmt.Errorf("%w: All Hypermarts", valueobjects.ErrStoreGroupExists))
+
+		controller.CreateStoreGroup(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+}
+
+func TestStoreGroupController_AddStores(t *testing.T) {
+	t.Run("Add Stores request with store not registered", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/store-groups/3/stores", `{"stores": [84]}`, map[string]string{"store_group_id": "3"})
+		w := httptest.NewRecorder()
+		mockStoreGroupUsecase := mocks.NewStoreGroupUseCases(t)
+		controller := NewStoreGroupController(mockStoreGroupUsecase, &entities.AppCfg{})
+		mockStoreGroupUsecase.On("AddStores", req.Context(), int64(3), []entities.StoreGroupMember{{StoreGroupID: 3, StoreID: 84, CreatedBy: 12345}}).
+			Return(nil, fmt.Errorf("%w: 84", valueobjects.ErrStoreUnknown))
+
+		controller.AddStores(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("Add Stores request without stores", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/store-groups/3/stores", `{"stores": []}`, map[string]string{"store_group_id": "3"})
+		w := httptest.NewRecorder()
+		controller := NewStoreGroupController(mocks.NewStoreGroupUseCases(t), &entities.AppCfg{})
+
+		controller.AddStores(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestStoreGroupController_RemoveStore(t *testing.T) {
+	t.Run("Remove Store request for store not in the group", func(t *testing.T) {
+		req := newBlackoutRequest("DELETE", "/store-groups/3/stores/84", "", map[string]string{"store_group_id": "3", "store_id": "84"})
+		w := httptest.NewRecorder()
+		mockStoreGroupUsecase := mocks.NewStoreGroupUseCases(t)
+		controller := NewStoreGroupController(mockStoreGroupUsecase, &entities.AppCfg{})
+		mockStoreGroupUsecase.On("RemoveStore", req.Context(), int64(3), int64(84)).
+			Return(fmt.Errorf("%w: 84", valueobjects.ErrStoreGroupMemberNotExists))
+
+		controller.RemoveStore(w, req)
+
+		if status := w.Code; status != http.StatusNotFound {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
+		}
+	})
+}
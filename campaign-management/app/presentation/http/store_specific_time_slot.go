@@ -0,0 +1,361 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/params"
+	"campaign-mgmt/app/usecases/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type StoreSpecificTimeSlotController struct {
+	specificTimeSlotUseCases usecases.StoreSpecificTimeSlotUseCases
+}
+
+func NewStoreSpecificTimeSlotController(specificTimeSlotUseCases usecases.StoreSpecificTimeSlotUseCases) *StoreSpecificTimeSlotController {
+	return &StoreSpecificTimeSlotController{
+		specificTimeSlotUseCases: specificTimeSlotUseCases,
+	}
+}
+
+func (c *StoreSpecificTimeSlotController) Init(r chi.Router) {
+	r.Route("/stores/{store_id}/specific-slots", func(r chi.Router) {
+		r.Get("/", c.GetSpecificSlots)
+		r.Post("/", c.CreateSpecificSlot)
+		r.Post("/bulk", c.CreateSpecificSlots)
+		r.Get("/{id}", c.GetSpecificSlot)
+		r.Put("/{id}", c.UpdateSpecificSlot)
+		r.Delete("/{id}", c.DeleteSpecificSlot)
+	})
+}
+
+// GetSpecificSlots godoc
+//
+//	@Summary Get specific date time slots of store
+//	@Description API to get the slots and closures of specified store for particular dates, optionally limited to a date range
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	from	query string false "First date, YYYY-MM-DD"
+//	@Param	to	query string false "Last date, YYYY-MM-DD"
+//	@Success 200 {object} dto.StoreSpecificTimeSlotListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots [get]
+func (c *StoreSpecificTimeSlotController) GetSpecificSlots(w http.ResponseWriter, r *http.Request) {
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	var from, to time.Time
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = valueobjects.ParseDate(value); err != nil {
+			dto.BadRequestJSON(w, r, err.Error())
+			return
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		dto.BadRequestJSON(w, r, "to date must not be before from date")
+		return
+	}
+
+	slots, err := c.specificTimeSlotUseCases.GetSlots(r.Context(), int64(storeID), from, to)
+	if err != nil {
+		dto.InternalServerErrorJSON(w, r, err.Error())
+		return
+	}
+	render.JSON(w, r, dto.ToStoreSpecificTimeSlotListResponse(slots))
+}
+
+// GetSpecificSlot godoc
+//
+//	@Summary Get specific date time slot of store
+//	@Description API to get particular slot or closure of specified store
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Specific Time Slot ID"
+//	@Success 200 {object} dto.StoreSpecificTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots/{id} [get]
+func (c *StoreSpecificTimeSlotController) GetSpecificSlot(w http.ResponseWriter, r *http.Request) {
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect specific time slot id value, err : %v", err.Error()))
+		return
+	}
+	slot, err := c.specificTimeSlotUseCases.GetSlot(r.Context(), int64(storeID), int64(slotID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreSpecificTimeSlotResponse(slot))
+}
+
+// CreateSpecificSlot godoc
+//
+//	@Summary Add specific date time slot to store
+//	@Description API to add a slot for a particular date to specified store, or with is_closed to close the store the whole date.
+//	@Description Slots of a date replace the weekly slots of that weekday, slots of the same date can not overlap and a closure can not share its date with slots.
+//	@Description The store must be registered and active
+//	@Tags store time slots
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	slot body params.StoreSpecificTimeSlotForm true "Time slot details"
+//	@Success 200 {object} dto.StoreSpecificTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots [post]
+func (c *StoreSpecificTimeSlotController) CreateSpecificSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateSpecificSlotRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slotEntity, err := params.ToStoreSpecificTimeSlotEntity(request, 0, int64(storeID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	slots, err := c.specificTimeSlotUseCases.CreateSlots(ctx, []entities.StoreSpecificTimeSlot{slotEntity})
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreSpecificTimeSlotResponse(slots[0]))
+}
+
+// CreateSpecificSlots godoc
+//
+//	@Summary Add time slots to store for a date range
+//	@Description API to add the same slots, or a closure with is_closed, to every date of a range of at most 92 days, optionally only on some weekdays.
+//	@Description Either every slot is added or none, the store must be registered and active
+//	@Tags store time slots
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	slots body params.StoreSpecificTimeSlotBulkForm true "Date range and time slot details"
+//	@Success 200 {object} dto.StoreSpecificTimeSlotListResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots/bulk [post]
+func (c *StoreSpecificTimeSlotController) CreateSpecificSlots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+
+	request, err := c.validateSpecificSlotBulkRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slotEntities, err := params.ToStoreSpecificTimeSlotEntities(request, int64(storeID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	slots, err := c.specificTimeSlotUseCases.CreateSlots(ctx, slotEntities)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreSpecificTimeSlotListResponse(slots))
+}
+
+// UpdateSpecificSlot godoc
+//
+//	@Summary Update specific date time slot of store
+//	@Description API to update particular slot or closure of specified store, slots of the same date can not overlap and a closure can not share its date with slots.
+//	@Description The store must be registered and active
+//	@Tags store time slots
+//	@Accept json
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Specific Time Slot ID"
+//	@Param	slot body params.StoreSpecificTimeSlotForm true "Time slot details"
+//	@Success 200 {object} dto.StoreSpecificTimeSlotResponse
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 409 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots/{id} [put]
+func (c *StoreSpecificTimeSlotController) UpdateSpecificSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect specific time slot id value, err : %v", err.Error()))
+		return
+	}
+
+	request, err := c.validateSpecificSlotRequest(r)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	slotEntity, err := params.ToStoreSpecificTimeSlotEntity(request, int64(slotID), int64(storeID), int64(userID))
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+
+	slot, err := c.specificTimeSlotUseCases.UpdateSlot(ctx, slotEntity)
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	render.JSON(w, r, dto.ToStoreSpecificTimeSlotResponse(slot))
+}
+
+// DeleteSpecificSlot godoc
+//
+//	@Summary Delete specific date time slot of store
+//	@Description API to delete particular slot or closure of specified store
+//	@Tags store time slots
+//	@Produce json
+//	@Security ApiKeyAuth
+//	@Param	store_id	path int true "Store ID"
+//	@Param	id	path int true "Specific Time Slot ID"
+//	@Success 200 {object} dto.Response
+//	@Failure 400 {object} dto.Response
+//	@Failure 404 {object} dto.Response
+//	@Failure 500 {object} dto.Response
+//	@Router	/stores/{store_id}/specific-slots/{id} [delete]
+func (c *StoreSpecificTimeSlotController) DeleteSpecificSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := util.GetUserID(ctx)
+	if err != nil {
+		dto.BadRequestJSON(w, r, err.Error())
+		return
+	}
+	storeID, err := strconv.Atoi(chi.URLParam(r, "store_id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf(IncorrectStoreIDErr, err.Error()))
+		return
+	}
+	slotID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		dto.BadRequestJSON(w, r, fmt.Sprintf("incorrect specific time slot id value, err : %v", err.Error()))
+		return
+	}
+
+	err = c.specificTimeSlotUseCases.DeleteSlot(ctx, int64(storeID), int64(slotID), int64(userID))
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+	dto.SuccessJSON(w, r, fmt.Sprintf("specific time slot with id %d deleted successfully", slotID))
+}
+
+func (c *StoreSpecificTimeSlotController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, valueobjects.ErrTimeSlotInvalid), errors.Is(err, valueobjects.ErrStoreUnknown):
+		dto.BadRequestJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrSpecificTimeSlotNotExists):
+		dto.NotFoundJSON(w, r, err.Error())
+	case errors.Is(err, valueobjects.ErrSpecificTimeSlotConflict):
+		dto.ConflictErrorJSON(w, r, err.Error())
+	default:
+		dto.InternalServerErrorJSON(w, r, err.Error())
+	}
+}
+
+func (c *StoreSpecificTimeSlotController) validateSpecificSlotRequest(r *http.Request) (params.StoreSpecificTimeSlotForm, error) {
+	var request params.StoreSpecificTimeSlotForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+func (c *StoreSpecificTimeSlotController) validateSpecificSlotBulkRequest(r *http.Request) (params.StoreSpecificTimeSlotBulkForm, error) {
+	var request params.StoreSpecificTimeSlotBulkForm
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&request)
+	if err != nil {
+		return request, err
+	}
+	defer r.Body.Close()
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"bytes"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func newStoreSpecificSlotRequest(method, target, body string, urlParams map[string]string) *http.Request {
+	req, _ := http.NewRequest(method, target, bytes.NewBufferString(body))
+	ctx := chi.NewRouteContext()
+	for key, value := range urlParams {
+		ctx.URLParams.Add(key, value)
+	}
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, ctx))
+	return req.WithContext(context.WithValue(req.Context(), "userId", 12345))
+}
+
+func TestStoreSpecificTimeSlotController_GetSpecificSlots(t *testing.T) {
+	t.Run("Get Specific Slots request for a date range", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("GET", "/stores/84/specific-slots?from=2024-02-10&to=2024-02-11", "",
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		mockSpecificTimeSlotUsecase := mocks.NewStoreSpecificTimeSlotUseCases(t)
+		controller := NewStoreSpecificTimeSlotController(mockSpecificTimeSlotUsecase)
+		from := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		mockSpecificTimeSlotUsecase.On("GetSlots", req.Context(), int64(84), from, from.AddDate(0, 0, 1)).
+			Return([]*dto.StoreSpecificTimeSlotDTO{{ID: 2, StoreID: 84, Date: "2024-02-10", IsClosed: true}}, nil)
+
+		controller.GetSpecificSlots(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":[{"specific_time_slot_id":2,"store_id":84,"date":"2024-02-10","quota":0,"is_closed":true}]}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Get Specific Slots request with incorrect date", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("GET", "/stores/84/specific-slots?from=10-02-2024", "",
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		controller := NewStoreSpecificTimeSlotController(mocks.NewStoreSpecificTimeSlotUseCases(t))
+
+		controller.GetSpecificSlots(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotController_CreateSpecificSlot(t *testing.T) {
+	t.Run("Create Specific Slot request on a closed date", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("POST", "/stores/84/specific-slots", `{"date": "2024-02-10", "start_time": "09:00", "end_time": "12:00", "quota": 10}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		mockSpecificTimeSlotUsecase := mocks.NewStoreSpecificTimeSlotUseCases(t)
+		controller := NewStoreSpecificTimeSlotController(mockSpecificTimeSlotUsecase)
+		mockSpecificTimeSlotUsecase.On("CreateSlots", req.Context(), []entities.StoreSpecificTimeSlot{{
+			StoreID: 84, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), StartTime: 540, EndTime: 720, Quota: 10, CreatedBy: 12345,
+		}}).Return(nil, fmt.Errorf("%w: 2024-02-10 has a closure along with other slots", valueobjects.ErrSpecificTimeSlotConflict))
+
+		controller.CreateSpecificSlot(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Create Specific Slot request without times", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("POST", "/stores/84/specific-slots", `{"date": "2024-02-10", "quota": 10}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		controller := NewStoreSpecificTimeSlotController(mocks.NewStoreSpecificTimeSlotUseCases(t))
+
+		controller.CreateSpecificSlot(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotController_CreateSpecificSlots(t *testing.T) {
+	t.Run("Create Specific Slots request closing a date range", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("POST", "/stores/84/specific-slots/bulk", `{"start_date": "2024-02-10", "end_date": "2024-02-11", "is_closed": true}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		mockSpecificTimeSlotUsecase := mocks.NewStoreSpecificTimeSlotUseCases(t)
+		controller := NewStoreSpecificTimeSlotController(mockSpecificTimeSlotUsecase)
+		date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		mockSpecificTimeSlotUsecase.On("CreateSlots", req.Context(), []entities.StoreSpecificTimeSlot{
+			{StoreID: 84, Date: date, IsClosed: true, CreatedBy: 12345},
+			{StoreID: 84, Date: date.AddDate(0, 0, 1), IsClosed: true, CreatedBy: 12345},
+		}).Return([]*dto.StoreSpecificTimeSlotDTO{
+			{ID: 3, StoreID: 84, Date: "2024-02-10", IsClosed: true},
+			{ID: 4, StoreID: 84, Date: "2024-02-11", IsClosed: true},
+		}, nil)
+
+		controller.CreateSpecificSlots(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+	})
+
+	t.Run("Create Specific Slots request without slots for open dates", func(t *testing.T) {
+		req := newStoreSpecificSlotRequest("POST", "/stores/84/specific-slots/bulk", `{"start_date": "2024-02-10", "end_date": "2024-02-11"}`,
+			map[string]string{"store_id": "84"})
+		w := httptest.NewRecorder()
+		controller := NewStoreSpecificTimeSlotController(mocks.NewStoreSpecificTimeSlotUseCases(t))
+
+		controller.CreateSpecificSlots(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
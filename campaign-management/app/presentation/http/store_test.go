@@ -0,0 +1,77 @@
+package http
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStoreController_CreateStore(t *testing.T) {
+	t.Run("Create Store request success", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/stores", `{"store_id": 84, "name": "Orchard", "region": "Central", "timezone": "Asia/Singapore"}`, nil)
+		w := httptest.NewRecorder()
+		mockStoreUsecase := mocks.NewStoreUseCases(t)
+		controller := NewStoreController(mockStoreUsecase, &entities.AppCfg{})
+		mockStoreUsecase.On("CreateStore", req.Context(), entities.Store{ID: 84, Name: "Orchard", Region: "Central",
+			Timezone: "Asia/Singapore", IsActive: true, CreatedBy: 12345}).
+			Return(&dto.StoreDTO{ID: 84, Name: "Orchard", Region: "Central", Timezone: "Asia/Singapore", IsActive: true}, nil)
+
+		controller.CreateStore(w, req)
+
+		if status := w.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+		expected := `{"code":200,"status":"SUCCESS","data":{"store_id":84,"name":"Orchard","address":"","region":"Central","timezone":"Asia/Singapore","is_active":true}}`
+		if a, e := strings.TrimSpace(w.Body.String()), strings.TrimSpace(expected); a != e {
+			t.Errorf("handler returned unexpected body: got %v want %v", w.Body.String(), expected)
+		}
+	})
+
+	t.Run("Create Store request for store already registered", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/stores", `{"store_id": 84, "name": "Orchard"}`, nil)
+		w := httptest.NewRecorder()
+		mockStoreUsecase := mocks.NewStoreUseCases(t)
+		controller := NewStoreController(mockStoreUsecase, &entities.AppCfg{})
+		mockStoreUsecase.On("CreateStore", req.Context(), entities.Store{ID: 84, Name: "Orchard", IsActive: true, CreatedBy: 12345}).
+			Return(nil, fmt.Errorf("%w: 84", valueobjects.ErrRegisteredStoreExists))
+
+		controller.CreateStore(w, req)
+
+		if status := w.Code; status != http.StatusConflict {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusConflict)
+		}
+	})
+
+	t.Run("Create Store request without name", func(t *testing.T) {
+		req := newBlackoutRequest("POST", "/stores", `{"store_id": 84}`, nil)
+		w := httptest.NewRecorder()
+		controller := NewStoreController(mocks.NewStoreUseCases(t), &entities.AppCfg{})
+
+		controller.CreateStore(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestStoreController_GetStores(t *testing.T) {
+	t.Run("Get Stores request with malformed activity filter", func(t *testing.T) {
+		req := newBlackoutRequest("GET", "/stores?is_active=maybe", "", nil)
+		w := httptest.NewRecorder()
+		controller := NewStoreController(mocks.NewStoreUseCases(t),
+			&entities.AppCfg{PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1}})
+
+		controller.GetStores(w, req)
+
+		if status := w.Code; status != http.StatusBadRequest {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusBadRequest)
+		}
+	})
+}
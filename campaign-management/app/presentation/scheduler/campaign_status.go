@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/usecases"
+	"campaign-mgmt/app/usecases/dto"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// CampaignStatusScheduler periodically publishes scheduled campaigns and deactivates expired ones.
+// Only the instance holding the named lock runs an update, so several replicas can run the scheduler.
+type CampaignStatusScheduler struct {
+	campaignUseCases usecases.CampaignUseCases
+	jobRunUseCases   usecases.CampaignStatusJobRunUseCases
+	lock             services.LockService
+	tx               services.TransactionService
+	config           entities.SchedulerConfig
+	instance         string
+	now              func() time.Time
+}
+
+func NewCampaignStatusScheduler(
+	campaignUseCases usecases.CampaignUseCases,
+	jobRunUseCases usecases.CampaignStatusJobRunUseCases,
+	lock services.LockService,
+	transactionService services.TransactionService,
+	config entities.SchedulerConfig) *CampaignStatusScheduler {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = "unknown"
+	}
+	return &CampaignStatusScheduler{
+		campaignUseCases: campaignUseCases,
+		jobRunUseCases:   jobRunUseCases,
+		lock:             lock,
+		tx:               transactionService,
+		config:           config,
+		instance:         instance,
+		now:              time.Now,
+	}
+}
+
+// Start runs the status update on every interval tick until ctx is cancelled
+func (s *CampaignStatusScheduler) Start(ctx context.Context) {
+	logger.Infof("campaign status scheduler started with interval %v", s.config.Interval)
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Run(ctx); err != nil {
+			logger.Errorf("campaign status scheduler run failed : %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			logger.Info("campaign status scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run performs a single status update when the scheduler lock is acquired and records the run
+func (s *CampaignStatusScheduler) Run(ctx context.Context) error {
+	acquired, err := s.lock.RunWithLock(ctx, s.config.LockName, s.runLocked)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		logger.Infof("campaign status update skipped, lock %s held by another instance", s.config.LockName)
+	}
+	return nil
+}
+
+func (s *CampaignStatusScheduler) runLocked(ctx context.Context) error {
+	run := entities.CampaignStatusJobRun{
+		Instance:  s.instance,
+		StartedAt: s.now(),
+	}
+
+	var result *dto.CampaignStatusUpdateDTO
+	err := s.tx.RunWithTransaction(
+		ctx, func(ctx context.Context) error {
+			var updateErr error
+			result, updateErr = s.campaignUseCases.UpdateStatus(ctx)
+			return updateErr
+		})
+
+	run.EndedAt = s.now()
+	if err != nil {
+		run.Error = err.Error()
+	} else {
+		run.Published = result.Published
+		run.Deactivated = result.Deactivated
+		logger.Infof("campaign status updated, published : %d, deactivated : %d", result.Published, result.Deactivated)
+	}
+
+	if recordErr := s.jobRunUseCases.Record(ctx, run); recordErr != nil {
+		logger.Errorf("unable to record campaign status job run : %v", recordErr)
+	}
+	return err
+}
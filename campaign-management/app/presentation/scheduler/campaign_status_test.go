@@ -0,0 +1,135 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	servicesMocks "campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/usecases/mocks"
+	"campaign-mgmt/app/usecases/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestScheduler(t *testing.T) (*CampaignStatusScheduler, *mocks.CampaignUseCases, *mocks.CampaignStatusJobRunUseCases, *servicesMocks.LockService) {
+	campaignUseCases := mocks.NewCampaignUseCases(t)
+	jobRunUseCases := mocks.NewCampaignStatusJobRunUseCases(t)
+	lockService := servicesMocks.NewLockService(t)
+	transactionService := servicesMocks.NewTransactionService(t)
+	transactionService.On("RunWithTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+
+	config := entities.SchedulerConfig{
+		Enabled:  true,
+		Interval: time.Minute,
+		LockName: "campaign-status-scheduler",
+	}
+	s := NewCampaignStatusScheduler(campaignUseCases, jobRunUseCases, lockService, transactionService, config)
+	s.instance = "instance-1"
+	now := time.Date(2023, 1, 10, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, campaignUseCases, jobRunUseCases, lockService
+}
+
+// callLockedFn runs the function handed to RunWithLock, as the lock service does once the lock is acquired
+func callLockedFn(args mock.Arguments) {
+	fn := args.Get(2).(func(ctx context.Context) error)
+	fn(args.Get(0).(context.Context))
+}
+
+func TestCampaignStatusScheduler_Run(t *testing.T) {
+	now := time.Date(2023, 1, 10, 10, 0, 0, 0, time.UTC)
+
+	t.Run("when lock acquired, status updated and run recorded", func(t *testing.T) {
+		ctx := context.Background()
+		s, campaignUseCases, jobRunUseCases, lockService := newTestScheduler(t)
+
+		lockService.On("RunWithLock", ctx, "campaign-status-scheduler", mock.Anything).
+			Run(callLockedFn).Return(true, nil)
+		campaignUseCases.On("UpdateStatus", ctx).
+			Return(&dto.CampaignStatusUpdateDTO{Published: 2, Deactivated: 1}, nil)
+		jobRunUseCases.On("Record", ctx, entities.CampaignStatusJobRun{
+			Instance:    "instance-1",
+			StartedAt:   now,
+			EndedAt:     now,
+			Published:   2,
+			Deactivated: 1,
+		}).Return(nil)
+
+		if err := s.Run(ctx); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when lock held by another instance, status not updated", func(t *testing.T) {
+		ctx := context.Background()
+		s, _, _, lockService := newTestScheduler(t)
+
+		lockService.On("RunWithLock", ctx, "campaign-status-scheduler", mock.Anything).
+			Return(false, nil)
+
+		if err := s.Run(ctx); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when status update fails, failed run recorded", func(t *testing.T) {
+		ctx := context.Background()
+		s, campaignUseCases, jobRunUseCases, lockService := newTestScheduler(t)
+
+		lockService.On("RunWithLock", ctx, "campaign-status-scheduler", mock.Anything).
+			Run(callLockedFn).Return(true, errors.New("db error"))
+		campaignUseCases.On("UpdateStatus", ctx).Return(nil, errors.New("db error"))
+		jobRunUseCases.On("Record", ctx, entities.CampaignStatusJobRun{
+			Instance:  "instance-1",
+			StartedAt: now,
+			EndedAt:   now,
+			Error:     "db error",
+		}).Return(nil)
+
+		err := s.Run(ctx)
+		if err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+	})
+	t.Run("when status update cannot be committed, failed run recorded", func(t *testing.T) {
+		ctx := context.Background()
+		s, campaignUseCases, jobRunUseCases, _ := newTestScheduler(t)
+		transactionService := servicesMocks.NewTransactionService(t)
+		transactionService.On("RunWithTransaction", ctx, mock.Anything).
+			Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				if err := fn(ctx); err != nil {
+					return err
+				}
+				return errors.New("commit failed")
+			})
+		s.tx = transactionService
+
+		campaignUseCases.On("UpdateStatus", ctx).
+			Return(&dto.CampaignStatusUpdateDTO{Published: 2, Deactivated: 1}, nil)
+		jobRunUseCases.On("Record", ctx, entities.CampaignStatusJobRun{
+			Instance:  "instance-1",
+			StartedAt: now,
+			EndedAt:   now,
+			Error:     "commit failed",
+		}).Return(nil)
+
+		if err := s.runLocked(ctx); err == nil || err.Error() != "commit failed" {
+			t.Errorf("unexpected error : got - %v ; want - commit failed", err)
+		}
+	})
+	t.Run("when lock cannot be acquired, error returned", func(t *testing.T) {
+		ctx := context.Background()
+		s, _, _, lockService := newTestScheduler(t)
+
+		lockService.On("RunWithLock", ctx, "campaign-status-scheduler", mock.Anything).
+			Return(false, errors.New("connection refused"))
+
+		if err := s.Run(ctx); err == nil {
+			t.Error("unexpected error : got - nil ; want - connection refused")
+		}
+	})
+}
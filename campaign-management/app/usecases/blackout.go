@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+// MaxBlackoutDays is the longest date range a single blackout may cover
+const MaxBlackoutDays = 366
+
+type BlackoutUseCase struct {
+	blackoutRepo       services.Blackouts
+	transactionService services.TransactionService
+}
+
+func NewBlackoutUseCase(blackoutRepo services.Blackouts, transactionService services.TransactionService) *BlackoutUseCase {
+	return &BlackoutUseCase{
+		blackoutRepo:       blackoutRepo,
+		transactionService: transactionService,
+	}
+}
+
+func (c *BlackoutUseCase) GetBlackouts(ctx context.Context, storeID int64, from, to time.Time) ([]*dto.BlackoutDTO, error) {
+	blackouts, err := c.blackoutRepo.GetList(ctx, storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.BlackoutDTO{}
+	for _, blackout := range blackouts {
+		response = append(response, dto.ToBlackoutDTO(blackout))
+	}
+	return response, nil
+}
+
+func (c *BlackoutUseCase) GetBlackout(ctx context.Context, blackoutID int64) (*dto.BlackoutDTO, error) {
+	blackout, err := c.blackoutRepo.Get(ctx, valueobjects.BlackoutID(blackoutID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToBlackoutDTO(blackout), nil
+}
+
+func (c *BlackoutUseCase) CreateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error) {
+	if err := validateBlackout(blackout); err != nil {
+		return nil, err
+	}
+	created, err := c.blackoutRepo.CreateMultiple(ctx, []entities.Blackout{blackout})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToBlackoutDTO(created[0]), nil
+}
+
+func (c *BlackoutUseCase) UpdateBlackout(ctx context.Context, blackout entities.Blackout) (*dto.BlackoutDTO, error) {
+	existing, err := c.blackoutRepo.Get(ctx, blackout.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBlackout(blackout); err != nil {
+		return nil, err
+	}
+	if err := c.blackoutRepo.Update(ctx, blackout); err != nil {
+		return nil, err
+	}
+	blackout.UID = existing.UID
+	return dto.ToBlackoutDTO(blackout), nil
+}
+
+func (c *BlackoutUseCase) DeleteBlackout(ctx context.Context, blackoutID, userID int64) error {
+	return c.blackoutRepo.Delete(ctx, valueobjects.BlackoutID(blackoutID), userID)
+}
+
+// ImportBlackouts adds the blackouts read from a calendar of the store in one transaction, events imported
+// before are matched by their UID and updated so importing the same calendar again adds nothing
+func (c *BlackoutUseCase) ImportBlackouts(ctx context.Context, storeID int64, blackouts []entities.Blackout) (*dto.BlackoutImportResult, error) {
+	uids := []string{}
+	seen := map[string]bool{}
+	for _, blackout := range blackouts {
+		if err := validateBlackout(blackout); err != nil {
+			return nil, fmt.Errorf("%w: event %s", err, blackout.UID)
+		}
+		if seen[blackout.UID] {
+			return nil, fmt.Errorf("%w: event %s appears more than once", valueobjects.ErrBlackoutInvalid, blackout.UID)
+		}
+		seen[blackout.UID] = true
+		uids = append(uids, blackout.UID)
+	}
+
+	result := &dto.BlackoutImportResult{Blackouts: []*dto.BlackoutDTO{}}
+	imported := []entities.Blackout{}
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		existing, err := c.blackoutRepo.GetByUIDs(ctx, storeID, uids)
+		if err != nil {
+			return err
+		}
+		existingByUID := map[string]entities.Blackout{}
+		for _, blackout := range existing {
+			existingByUID[blackout.UID] = blackout
+		}
+		added := []entities.Blackout{}
+		for _, blackout := range blackouts {
+			previous, ok := existingByUID[blackout.UID]
+			if !ok {
+				added = append(added, blackout)
+				continue
+			}
+			blackout.ID = previous.ID
+			blackout.UpdatedBy = blackout.CreatedBy
+			blackout.CreatedBy = previous.CreatedBy
+			if err := c.blackoutRepo.Update(ctx, blackout); err != nil {
+				return err
+			}
+			imported = append(imported, blackout)
+			result.Updated++
+		}
+		if len(added) == 0 {
+			return nil
+		}
+		created, err := c.blackoutRepo.CreateMultiple(ctx, added)
+		if err != nil {
+			return err
+		}
+		imported = append(imported, created...)
+		result.Created = len(created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, blackout := range imported {
+		result.Blackouts = append(result.Blackouts, dto.ToBlackoutDTO(blackout))
+	}
+	return result, nil
+}
+
+// CheckCollectionDates refuses a collection window sharing a date with a blackout of every store or of one of the
+// stores collecting in it, the dates are taken in the location they are given in
+func (c *BlackoutUseCase) CheckCollectionDates(ctx context.Context, storeIDs []int64, collectionStartDate, collectionEndDate time.Time) error {
+	startDate, endDate := civilDate(collectionStartDate), civilDate(collectionEndDate)
+	blackouts, err := c.blackoutRepo.GetListByStores(ctx, storeIDs, startDate, endDate)
+	if err != nil {
+		return err
+	}
+	for _, blackout := range blackouts {
+		date := startDate
+		if blackout.StartDate.After(date) {
+			date = blackout.StartDate
+		}
+		if date.After(endDate) || !blackout.Covers(date) {
+			continue
+		}
+		if blackout.StoreID != 0 {
+			return fmt.Errorf("%w: %s is blacked out at store %d for %s", valueobjects.ErrCollectionDateBlackedOut,
+				date.Format(valueobjects.DateLayout), blackout.StoreID, blackout.Reason)
+		}
+		return fmt.Errorf("%w: %s is blacked out for %s", valueobjects.ErrCollectionDateBlackedOut,
+			date.Format(valueobjects.DateLayout), blackout.Reason)
+	}
+	return nil
+}
+
+func validateBlackout(blackout entities.Blackout) error {
+	if blackout.EndDate.Before(blackout.StartDate) {
+		return fmt.Errorf("%w: end date %s is before start date %s", valueobjects.ErrBlackoutInvalid,
+			blackout.EndDate.Format(valueobjects.DateLayout), blackout.StartDate.Format(valueobjects.DateLayout))
+	}
+	if days := int(blackout.EndDate.Sub(blackout.StartDate).Hours()/24) + 1; days > MaxBlackoutDays {
+		return fmt.Errorf("%w: range of %d days is longer than %d days", valueobjects.ErrBlackoutInvalid, days, MaxBlackoutDays)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlackoutUseCase_CreateBlackout(t *testing.T) {
+	date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		blackout    entities.Blackout
+		expectedErr error
+	}{
+		{name: "when blackout ends before it starts",
+			blackout: entities.Blackout{StartDate: date, EndDate: date.AddDate(0, 0, -1)}, expectedErr: valueobjects.ErrBlackoutInvalid},
+		{name: "when blackout is longer than allowed",
+			blackout: entities.Blackout{StartDate: date, EndDate: date.AddDate(0, 0, MaxBlackoutDays)}, expectedErr: valueobjects.ErrBlackoutInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blackoutUseCase := NewBlackoutUseCase(mocks.NewBlackouts(t), mocks.NewTransactionService(t))
+			_, err := blackoutUseCase.CreateBlackout(context.Background(), tt.blackout)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestBlackoutUseCase_ImportBlackouts(t *testing.T) {
+	date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+	runInTransaction := func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}
+
+	t.Run("when events imported before are updated and the others created", func(t *testing.T) {
+		ctx := context.Background()
+		mockBlackoutService := mocks.NewBlackouts(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		blackoutUseCase := NewBlackoutUseCase(mockBlackoutService, mockTransactionService)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockBlackoutService.On("GetByUIDs", ctx, int64(84), []string{"cny-2024", "stock-take"}).Return([]entities.Blackout{
+			{ID: 3, StoreID: 84, StartDate: date, EndDate: date, Reason: "Lunar New Year", UID: "cny-2024", CreatedBy: 5},
+		}, nil)
+		mockBlackoutService.On("Update", ctx, entities.Blackout{ID: 3, StoreID: 84, StartDate: date, EndDate: date.AddDate(0, 0, 1),
+			Reason: "Lunar New Year", UID: "cny-2024", CreatedBy: 5, UpdatedBy: 7}).Return(nil)
+		mockBlackoutService.On("CreateMultiple", ctx, []entities.Blackout{
+			{StoreID: 84, StartDate: date.AddDate(0, 0, 5), EndDate: date.AddDate(0, 0, 5), Reason: "Stock take", UID: "stock-take", CreatedBy: 7},
+		}).Return(func(ctx context.Context, blackouts []entities.Blackout) []entities.Blackout {
+			blackouts[0].ID = 4
+			return blackouts
+		}, nil)
+
+		result, err := blackoutUseCase.ImportBlackouts(ctx, 84, []entities.Blackout{
+			{StoreID: 84, StartDate: date, EndDate: date.AddDate(0, 0, 1), Reason: "Lunar New Year", UID: "cny-2024", CreatedBy: 7},
+			{StoreID: 84, StartDate: date.AddDate(0, 0, 5), EndDate: date.AddDate(0, 0, 5), Reason: "Stock take", UID: "stock-take", CreatedBy: 7},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if result.Created != 1 || result.Updated != 1 || len(result.Blackouts) != 2 || result.Blackouts[0].ID != 3 ||
+			result.Blackouts[0].EndDate != "2024-02-11" || result.Blackouts[1].ID != 4 {
+			t.Errorf("unexpected result : got - %+v", result)
+		}
+	})
+
+	t.Run("when calendar has an event more than once", func(t *testing.T) {
+		blackoutUseCase := NewBlackoutUseCase(mocks.NewBlackouts(t), mocks.NewTransactionService(t))
+		_, err := blackoutUseCase.ImportBlackouts(context.Background(), 84, []entities.Blackout{
+			{StoreID: 84, StartDate: date, EndDate: date, UID: "cny-2024"},
+			{StoreID: 84, StartDate: date, EndDate: date, UID: "cny-2024"},
+		})
+		if !errors.Is(err, valueobjects.ErrBlackoutInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrBlackoutInvalid)
+		}
+	})
+}
+
+func TestBlackoutUseCase_CheckCollectionDates(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Singapore")
+	blackouts := []entities.Blackout{
+		{ID: 1, StartDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC),
+			Reason: "Lunar New Year"},
+	}
+	storeBlackouts := []entities.Blackout{
+		{ID: 2, StoreID: 84, StartDate: time.Date(2024, time.February, 15, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 16, 0, 0, 0, 0, time.UTC),
+			Reason: "Renovation"},
+	}
+	tests := []struct {
+		name        string
+		blackouts   []entities.Blackout
+		start       time.Time
+		end         time.Time
+		expectedErr string
+	}{
+		{name: "when collections start on a blacked out date", blackouts: blackouts, start: time.Date(2024, time.February, 11, 23, 30, 0, 0, loc),
+			end: time.Date(2024, time.February, 20, 12, 0, 0, 0, loc), expectedErr: "2024-02-11 is blacked out for Lunar New Year"},
+		{name: "when collections end on a blacked out date", blackouts: blackouts, start: time.Date(2024, time.February, 1, 12, 0, 0, 0, loc),
+			end: time.Date(2024, time.February, 10, 12, 0, 0, 0, loc), expectedErr: "2024-02-10 is blacked out for Lunar New Year"},
+		{name: "when blacked out dates fall within the collections", blackouts: blackouts, start: time.Date(2024, time.February, 1, 12, 0, 0, 0, loc),
+			end: time.Date(2024, time.February, 20, 12, 0, 0, 0, loc), expectedErr: "2024-02-10 is blacked out for Lunar New Year"},
+		{name: "when a store of the campaign is blacked out within the collections", blackouts: storeBlackouts,
+			start: time.Date(2024, time.February, 1, 12, 0, 0, 0, loc), end: time.Date(2024, time.February, 20, 12, 0, 0, 0, loc),
+			expectedErr: "2024-02-15 is blacked out at store 84 for Renovation"},
+		{name: "when no blackout shares a date with the collections", blackouts: []entities.Blackout{},
+			start: time.Date(2024, time.February, 1, 12, 0, 0, 0, loc), end: time.Date(2024, time.February, 20, 12, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockBlackoutService := mocks.NewBlackouts(t)
+			blackoutUseCase := NewBlackoutUseCase(mockBlackoutService, mocks.NewTransactionService(t))
+			mockBlackoutService.On("GetListByStores", ctx, []int64{83, 84}, civilDate(tt.start), civilDate(tt.end)).Return(tt.blackouts, nil)
+
+			err := blackoutUseCase.CheckCollectionDates(ctx, []int64{83, 84}, tt.start, tt.end)
+			if tt.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error : got - %v ; want - nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, valueobjects.ErrCollectionDateBlackedOut) || !strings.HasSuffix(err.Error(), tt.expectedErr) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
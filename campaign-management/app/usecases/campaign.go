@@ -1,61 +1,315 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/dto"
-	"context"
-)
-
-type CampaignUseCase struct {
-	campaignRepo services.Campaigns
-}
-
-func NewCampaignUseCase(campaignRepo services.Campaigns) *CampaignUseCase {
-	return &CampaignUseCase{
-		campaignRepo: campaignRepo,
-	}
-}
-
-func (c *CampaignUseCase) Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error) {
-	data, err := c.campaignRepo.Get(ctx, valueobjects.CampaignID(campaignID))
-	if err != nil {
-		return nil, err
-	}
-	response := dto.ToCampaignDTO(data)
-	return &response, nil
-}
-
-func (c *CampaignUseCase) Create(ctx context.Context, campaignDetails entities.Campaign) (*dto.CampaignDTO, error) {
-	// add logic for offer id genration
-	// campaignDetails.OfferID = offerID
-	campaign, err := c.campaignRepo.Create(ctx, campaignDetails)
-	if err != nil {
-		return nil, err
-	}
-	response := dto.ToCampaignDTO(campaign)
-	return &response, nil
-}
-
-func (c *CampaignUseCase) Exists(ctx context.Context, campaignID int64, title string) (bool, error) {
-	return c.campaignRepo.Exists(ctx, valueobjects.CampaignID(campaignID), title)
-}
-
-func (c *CampaignUseCase) Update(ctx context.Context, campaignDetails entities.Campaign) error {
-	return c.campaignRepo.Update(ctx, campaignDetails)
-}
-
-func (c *CampaignUseCase) GetList(ctx context.Context, pagination entities.PaginationConfig) (*dto.CampaignListResponse, error) {
-	data, count, err := c.campaignRepo.GetList(ctx, pagination)
-	if err != nil {
-		return nil, err
-	}
-	campaignList := dto.ToCampaignDataList(data, count, pagination)
-	response := dto.ToCampaignListResponse(campaignList)
-	return &response, nil
-}
-
-func (c *CampaignUseCase) UpdateStatus(ctx context.Context) error {
-	return c.campaignRepo.UpdateStatus(ctx)
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/util"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type CampaignUseCase struct {
+	campaignRepo      services.Campaigns
+	statusHistoryRepo services.CampaignStatusHistory
+	campaignCodeRepo  services.CampaignCodes
+	overlapConfig     entities.OverlapConfig
+}
+
+func NewCampaignUseCase(campaignRepo services.Campaigns, statusHistoryRepo services.CampaignStatusHistory,
+	campaignCodeRepo services.CampaignCodes, overlapConfig entities.OverlapConfig) *CampaignUseCase {
+	return &CampaignUseCase{
+		campaignRepo:      campaignRepo,
+		statusHistoryRepo: statusHistoryRepo,
+		campaignCodeRepo:  campaignCodeRepo,
+		overlapConfig:     overlapConfig,
+	}
+}
+
+func (c *CampaignUseCase) Get(ctx context.Context, campaignID int64) (*dto.CampaignDTO, error) {
+	data, err := c.campaignRepo.Get(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCampaignDTO(data)
+	return &response, nil
+}
+
+func (c *CampaignUseCase) Create(ctx context.Context, campaignDetails entities.Campaign) (*dto.CampaignDTO, error) {
+	// add logic for offer id genration
+	// campaignDetails.OfferID = offerID
+	campaignDetails.StatusCode = valueobjects.CampaignStatusFromOrderDates(campaignDetails.OrderStartDate,
+		campaignDetails.OrderEndDate, time.Now()).Code()
+	campaign, err := c.campaignRepo.Create(ctx, campaignDetails)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCampaignDTO(campaign)
+	return &response, nil
+}
+
+// Clone creates a copy of the campaign with the title, dates and timezone of cloneDetails.
+// The copy starts unpublished and gets its status from its own order dates.
+func (c *CampaignUseCase) Clone(ctx context.Context, campaignID int64, cloneDetails entities.Campaign) (*dto.CampaignDTO, error) {
+	campaign, err := c.campaignRepo.Get(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	campaign.ID = 0
+	campaign.Title = cloneDetails.Title
+	campaign.OrderStartDate = cloneDetails.OrderStartDate
+	campaign.OrderEndDate = cloneDetails.OrderEndDate
+	campaign.CollectionStartDate = cloneDetails.CollectionStartDate
+	campaign.CollectionEndDate = cloneDetails.CollectionEndDate
+	campaign.Timezone = cloneDetails.Timezone
+	campaign.IsCampaignPublished = false
+	campaign.CreatedBy = cloneDetails.CreatedBy
+	campaign.UpdatedBy = 0
+	return c.Create(ctx, campaign)
+}
+
+func (c *CampaignUseCase) Exists(ctx context.Context, campaignID int64, title string) (bool, error) {
+	return c.campaignRepo.Exists(ctx, valueobjects.CampaignID(campaignID), title)
+}
+
+// Update runs in the transaction of the caller, the campaign stays locked from reading its status to recording
+// the change so two updates cannot both move it from the same status
+func (c *CampaignUseCase) Update(ctx context.Context, campaignDetails entities.Campaign) error {
+	campaign, err := c.campaignRepo.Lock(ctx, campaignDetails.ID)
+	if err != nil {
+		return err
+	}
+	currentStatus := valueobjects.CampaignStatusCode(campaign.StatusCode)
+	nextStatus := valueobjects.CampaignStatusCode(campaignDetails.StatusCode)
+	err = currentStatus.ValidateTransition(nextStatus)
+	if err != nil {
+		return err
+	}
+	if currentStatus != nextStatus {
+		if err = c.validateStatusCode(ctx, nextStatus); err != nil {
+			return err
+		}
+	}
+	err = c.campaignRepo.Update(ctx, campaignDetails)
+	if err != nil {
+		return err
+	}
+	if campaign.StatusCode == campaignDetails.StatusCode {
+		return nil
+	}
+	return c.statusHistoryRepo.CreateMultiple(ctx, []entities.CampaignStatusHistory{{
+		CampaignID:    campaignDetails.ID,
+		OldStatusCode: campaign.StatusCode,
+		NewStatusCode: campaignDetails.StatusCode,
+		Actor:         strconv.FormatInt(campaignDetails.UpdatedBy, 10),
+		ChangedAt:     time.Now(),
+	}})
+}
+
+// validateStatusCode checks the status exists in campaign codes
+func (c *CampaignUseCase) validateStatusCode(ctx context.Context, statusCode valueobjects.CampaignStatusCode) error {
+	_, err := c.campaignCodeRepo.Get(ctx, statusCode)
+	if errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+		return fmt.Errorf("%w: %d", valueobjects.ErrCampaignStatusInvalid, statusCode)
+	}
+	return err
+}
+
+func (c *CampaignUseCase) GetList(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error) {
+	status, err := c.filterStatus(ctx, filter.StatusValue)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	data, count, err := c.campaignRepo.GetList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	campaignList := dto.ToCampaignDataList(data, count, filter.PaginationConfig)
+	if filter.Query != "" {
+		campaignList.Campaigns = dto.WithHighlights(campaignList.Campaigns, util.SearchTerms(filter.Query))
+	}
+	response := dto.ToCampaignListResponse(campaignList)
+	return &response, nil
+}
+
+// GetListByCursor returns the page of the campaigns the cursor points to along with the cursors of the pages around it
+func (c *CampaignUseCase) GetListByCursor(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignListResponse, error) {
+	status, err := c.filterStatus(ctx, filter.StatusValue)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	data, cursors, count, err := c.campaignRepo.GetListByCursor(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	campaignList := dto.ToCampaignDataList(data, count, filter.PaginationConfig)
+	campaignList.NextCursor = cursors.Next
+	campaignList.PrevCursor = cursors.Prev
+	response := dto.ToCampaignListResponse(campaignList)
+	return &response, nil
+}
+
+// summarySoonDays is how many days ahead the summary counts the campaigns starting and ending soon
+const summarySoonDays = 7
+
+// GetSummary aggregates the campaigns matching the filter for the dashboard, with a count for every campaign status
+func (c *CampaignUseCase) GetSummary(ctx context.Context, filter entities.CampaignListFilter) (*dto.CampaignSummaryResponse, error) {
+	status, err := c.filterStatus(ctx, filter.StatusValue)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	now := time.Now()
+	summary, err := c.campaignRepo.GetSummary(ctx, filter, now, now.AddDate(0, 0, summarySoonDays))
+	if err != nil {
+		return nil, err
+	}
+	campaignCodes, err := c.campaignCodeRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCampaignSummaryResponse(summary, campaignCodes, summarySoonDays)
+	return &response, nil
+}
+
+// GetStoreCampaigns returns a page of the campaigns the store takes part in
+func (c *CampaignUseCase) GetStoreCampaigns(ctx context.Context, storeID int64, filter entities.StoreCampaignFilter) (*dto.CampaignListResponse, error) {
+	status, err := c.filterStatus(ctx, filter.StatusValue)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	data, count, err := c.campaignRepo.GetListByStore(ctx, storeID, filter)
+	if err != nil {
+		return nil, err
+	}
+	campaignList := dto.ToCampaignDataList(data, count, filter.PaginationConfig)
+	response := dto.ToCampaignListResponse(campaignList)
+	return &response, nil
+}
+
+// GetProductCampaigns returns a page of the campaigns selling the product
+func (c *CampaignUseCase) GetProductCampaigns(ctx context.Context, productID int64, filter entities.ProductCampaignFilter) (*dto.CampaignListResponse, error) {
+	status, err := c.filterStatus(ctx, filter.StatusValue)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = status
+	data, count, err := c.campaignRepo.GetListByProduct(ctx, productID, filter)
+	if err != nil {
+		return nil, err
+	}
+	campaignList := dto.ToCampaignDataList(data, count, filter.PaginationConfig)
+	response := dto.ToCampaignListResponse(campaignList)
+	return &response, nil
+}
+
+// filterStatus returns the status code of a status value to filter by, zero when there is no status to filter by
+func (c *CampaignUseCase) filterStatus(ctx context.Context, statusValue string) (int64, error) {
+	if statusValue == "" {
+		return 0, nil
+	}
+	campaignCode, err := c.campaignCodeRepo.GetByValue(ctx, statusValue)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+			return 0, fmt.Errorf("%w: %s", valueobjects.ErrCampaignStatusInvalid, statusValue)
+		}
+		return 0, err
+	}
+	return campaignCode.StatusCode.Code(), nil
+}
+
+// GetOverlaps returns what the campaign overlaps before a change, for CheckOverlaps to leave out after it
+func (c *CampaignUseCase) GetOverlaps(ctx context.Context, campaignID int64) ([]entities.CampaignOverlap, error) {
+	return c.campaignRepo.GetOverlapping(ctx, valueobjects.CampaignID(campaignID))
+}
+
+// CheckOverlaps returns the campaigns selling a product of the campaign at one of its stores in overlapping order
+// dates, leaving out the overlaps the campaign already had before the change. A new campaign or a restored one has
+// no existing overlaps. When the overlap mode rejects, finding any is an ErrCampaignOverlap listing them
+func (c *CampaignUseCase) CheckOverlaps(ctx context.Context, campaignID int64, existing []entities.CampaignOverlap) ([]int64, error) {
+	overlaps, err := c.campaignRepo.GetOverlapping(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	existed := make(map[entities.CampaignOverlap]bool, len(existing))
+	for _, overlap := range existing {
+		existed[overlap] = true
+	}
+	campaignIDs := []int64{}
+	seen := map[int64]bool{}
+	for _, overlap := range overlaps {
+		if existed[overlap] || seen[overlap.CampaignID] {
+			continue
+		}
+		seen[overlap.CampaignID] = true
+		campaignIDs = append(campaignIDs, overlap.CampaignID)
+	}
+	if len(campaignIDs) > 0 && c.overlapConfig.Mode == valueobjects.OverlapModeReject {
+		ids := []string{}
+		for _, id := range campaignIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		return nil, fmt.Errorf("%w: campaign ids %s", valueobjects.ErrCampaignOverlap, strings.Join(ids, ", "))
+	}
+	return campaignIDs, nil
+}
+
+func (c *CampaignUseCase) Delete(ctx context.Context, campaignID, userID int64) error {
+	return c.campaignRepo.Delete(ctx, valueobjects.CampaignID(campaignID), userID)
+}
+
+func (c *CampaignUseCase) Restore(ctx context.Context, campaignID, userID int64) error {
+	return c.campaignRepo.Restore(ctx, valueobjects.CampaignID(campaignID), userID)
+}
+
+func (c *CampaignUseCase) UpdateStatus(ctx context.Context) (*dto.CampaignStatusUpdateDTO, error) {
+	if err := valueobjects.CampaignStatusPublish.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	published, err := c.campaignRepo.PublishCampaigns(ctx, valueobjects.CampaignStatusPublish, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := valueobjects.CampaignStatusDeactivate.Validate(); err != nil {
+		return nil, err
+	}
+	deactivated, err := c.campaignRepo.DeactivateCampaigns(ctx, valueobjects.CampaignStatusDeactivate, now)
+	if err != nil {
+		return nil, err
+	}
+
+	history := toStatusHistory(published, valueobjects.CampaignStatusPublish, now)
+	history = append(history, toStatusHistory(deactivated, valueobjects.CampaignStatusDeactivate, now)...)
+	if len(history) > 0 {
+		if err := c.statusHistoryRepo.CreateMultiple(ctx, history); err != nil {
+			return nil, err
+		}
+	}
+	return &dto.CampaignStatusUpdateDTO{
+		Published:   int64(len(published)),
+		Deactivated: int64(len(deactivated)),
+	}, nil
+}
+
+func toStatusHistory(campaignIDs []valueobjects.CampaignID, transition valueobjects.CampaignStatusTransition, changedAt time.Time) []entities.CampaignStatusHistory {
+	history := []entities.CampaignStatusHistory{}
+	for _, campaignID := range campaignIDs {
+		history = append(history, entities.CampaignStatusHistory{
+			CampaignID:    campaignID,
+			OldStatusCode: transition.From.Code(),
+			NewStatusCode: transition.To.Code(),
+			Actor:         valueobjects.StatusChangeActorScheduler,
+			ChangedAt:     changedAt,
+		})
+	}
+	return history
+}
@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type CampaignCodeUseCase struct {
+	campaignCodeRepo services.CampaignCodes
+}
+
+func NewCampaignCodeUseCase(campaignCodeRepo services.CampaignCodes) *CampaignCodeUseCase {
+	return &CampaignCodeUseCase{
+		campaignCodeRepo: campaignCodeRepo,
+	}
+}
+
+func (c *CampaignCodeUseCase) GetList(ctx context.Context) ([]*dto.CampaignCodeDTO, error) {
+	campaignCodes, err := c.campaignCodeRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.CampaignCodeDTO{}
+	for _, campaignCode := range campaignCodes {
+		response = append(response, dto.ToCampaignCodeDTO(campaignCode))
+	}
+	return response, nil
+}
+
+func (c *CampaignCodeUseCase) Create(ctx context.Context, campaignCode entities.CampaignCode) (*dto.CampaignCodeDTO, error) {
+	if err := c.validateStatusValue(ctx, campaignCode); err != nil {
+		return nil, err
+	}
+	// status codes of new statuses are always generated, the system codes are reserved
+	campaignCode.StatusCode = 0
+	created, err := c.campaignCodeRepo.Create(ctx, campaignCode)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToCampaignCodeDTO(created), nil
+}
+
+func (c *CampaignCodeUseCase) Update(ctx context.Context, campaignCode entities.CampaignCode) error {
+	if campaignCode.StatusCode.IsSystem() {
+		return fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeSystem, campaignCode.StatusCode)
+	}
+	if _, err := c.campaignCodeRepo.Get(ctx, campaignCode.StatusCode); err != nil {
+		return err
+	}
+	if err := c.validateStatusValue(ctx, campaignCode); err != nil {
+		return err
+	}
+	return c.campaignCodeRepo.Update(ctx, campaignCode)
+}
+
+// validateStatusValue makes sure no other status already uses the name
+func (c *CampaignCodeUseCase) validateStatusValue(ctx context.Context, campaignCode entities.CampaignCode) error {
+	existing, err := c.campaignCodeRepo.GetByValue(ctx, campaignCode.StatusValue)
+	if err != nil {
+		if errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+			return nil
+		}
+		return err
+	}
+	if existing.StatusCode != campaignCode.StatusCode {
+		return fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeExists, campaignCode.StatusValue)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCampaignCodeUseCase_GetList(t *testing.T) {
+	t.Run("when campaign codes fetched successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignCodeUseCase := NewCampaignCodeUseCase(campaignCodeService)
+
+		campaignCodeService.On("GetList", ctx).Return([]entities.CampaignCode{
+			{StatusCode: valueobjects.CampaignStatusActive, StatusValue: "Active"},
+			{StatusCode: valueobjects.CampaignStatusCode(4), StatusValue: "Cancelled"},
+		}, nil)
+		response, err := campaignCodeUseCase.GetList(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response) != 2 || !response[0].System || response[1].System || response[1].StatusValue != "Cancelled" {
+			t.Errorf("unexpected response : got - %+v, %+v", response[0], response[1])
+		}
+	})
+}
+
+func TestCampaignCodeUseCase_Create(t *testing.T) {
+	t.Run("when campaign code created successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignCodeUseCase := NewCampaignCodeUseCase(campaignCodeService)
+
+		campaignCodeService.On("GetByValue", ctx, "Cancelled").Return(entities.CampaignCode{},
+			fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeNotExists, "Cancelled"))
+		campaignCodeService.On("Create", ctx, entities.CampaignCode{StatusValue: "Cancelled", CreatedBy: 7}).Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(4), StatusValue: "Cancelled"}, nil)
+		response, err := campaignCodeUseCase.Create(ctx, entities.CampaignCode{StatusValue: "Cancelled", CreatedBy: 7})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.StatusCode != 4 || response.System {
+			t.Errorf("unexpected response : got - %+v", response)
+		}
+	})
+	t.Run("when campaign status already exists", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignCodeUseCase := NewCampaignCodeUseCase(campaignCodeService)
+
+		campaignCodeService.On("GetByValue", ctx, "Active").Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusActive, StatusValue: "Active"}, nil)
+		_, err := campaignCodeUseCase.Create(ctx, entities.CampaignCode{StatusValue: "Active"})
+		if !errors.Is(err, valueobjects.ErrCampaignCodeExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeExists)
+		}
+	})
+}
+
+func TestCampaignCodeUseCase_Update(t *testing.T) {
+	t.Run("when campaign code updated successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignCodeUseCase := NewCampaignCodeUseCase(campaignCodeService)
+		campaignCode := entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(4), StatusValue: "Completed", UpdatedBy: 7}
+
+		campaignCodeService.On("Get", ctx, valueobjects.CampaignStatusCode(4)).Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(4), StatusValue: "Cancelled"}, nil)
+		campaignCodeService.On("GetByValue", ctx, "Completed").Return(entities.CampaignCode{},
+			fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeNotExists, "Completed"))
+		campaignCodeService.On("Update", ctx, campaignCode).Return(nil)
+		if err := campaignCodeUseCase.Update(ctx, campaignCode); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when system campaign status is renamed", func(t *testing.T) {
+		campaignCodeUseCase := NewCampaignCodeUseCase(mocks.NewCampaignCodes(t))
+		err := campaignCodeUseCase.Update(context.Background(),
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusActive, StatusValue: "Live"})
+		if !errors.Is(err, valueobjects.ErrCampaignCodeSystem) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeSystem)
+		}
+	})
+	t.Run("when campaign code not exists", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignCodeUseCase := NewCampaignCodeUseCase(campaignCodeService)
+
+		campaignCodeService.On("Get", ctx, valueobjects.CampaignStatusCode(9)).Return(entities.CampaignCode{},
+			fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeNotExists, 9))
+		err := campaignCodeUseCase.Update(ctx, entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(9), StatusValue: "Completed"})
+		if !errors.Is(err, valueobjects.ErrCampaignCodeNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCodeNotExists)
+		}
+	})
+}
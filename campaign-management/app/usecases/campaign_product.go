@@ -1,70 +1,171 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services"
-	"campaign-mgmt/app/usecases/dto"
-)
-
-type CampaignProductUseCase struct {
-	campaignProductRepo services.CampaignProducts
-}
-
-func NewCampaignProductUseCase(campaignProductRepo services.CampaignProducts) *CampaignProductUseCase {
-	return &CampaignProductUseCase{
-		campaignProductRepo: campaignProductRepo,
-	}
-}
-
-func (c *CampaignProductUseCase) AddProducts(ctx context.Context, products []entities.CampaignProduct) ([]*dto.CampaignProducts, error) {
-	response, err := c.campaignProductRepo.CreateMultiple(ctx, products)
-	if err != nil {
-		return nil, err
-	}
-	var campaignProducts []*dto.CampaignProducts
-	for _, product := range response {
-		campaignProducts = append(campaignProducts, dto.ToCampaignProductDTO(product))
-	}
-	return campaignProducts, nil
-}
-
-func (c *CampaignProductUseCase) GetProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error) {
-	campaignProductsEntities, err := c.campaignProductRepo.GetByCampaignId(ctx, valueobjects.CampaignID(campaignID))
-	if err != nil {
-		return nil, err
-	}
-	var campaignProducts []*dto.CampaignProducts
-	for _, product := range campaignProductsEntities {
-		campaignProducts = append(campaignProducts, dto.ToCampaignProductDTO(product))
-	}
-	return campaignProducts, nil
-}
-
-func (c *CampaignProductUseCase) UpdateProducts(ctx context.Context, campaignProductDetails []entities.CampaignProduct) error {
-	for _, product := range campaignProductDetails {
-		err := c.campaignProductRepo.Update(ctx, product)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (c *CampaignProductUseCase) DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error {
-	err := c.campaignProductRepo.DeleteByCampaignId(ctx, campaignID, productID)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (c *CampaignProductUseCase) DeleteAllByCampaignId(ctx context.Context, campaignID int64) error {
-	err := c.campaignProductRepo.DeleteAllByCampaignId(ctx, campaignID)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"fmt"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type CampaignProductUseCase struct {
+	campaignProductRepo services.CampaignProducts
+}
+
+func NewCampaignProductUseCase(campaignProductRepo services.CampaignProducts) *CampaignProductUseCase {
+	return &CampaignProductUseCase{
+		campaignProductRepo: campaignProductRepo,
+	}
+}
+
+func (c *CampaignProductUseCase) AddProducts(ctx context.Context, products []entities.CampaignProduct) ([]*dto.CampaignProducts, error) {
+	response, err := c.campaignProductRepo.CreateMultiple(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+	var campaignProducts []*dto.CampaignProducts
+	for _, product := range response {
+		campaignProducts = append(campaignProducts, dto.ToCampaignProductDTO(product))
+	}
+	return campaignProducts, nil
+}
+
+func (c *CampaignProductUseCase) GetProducts(ctx context.Context, campaignID int64) ([]*dto.CampaignProducts, error) {
+	campaignProductsEntities, err := c.campaignProductRepo.GetByCampaignId(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	var campaignProducts []*dto.CampaignProducts
+	for _, product := range campaignProductsEntities {
+		campaignProducts = append(campaignProducts, dto.ToCampaignProductDTO(product))
+	}
+	return campaignProducts, nil
+}
+
+func (c *CampaignProductUseCase) GetProductList(ctx context.Context, campaignID int64, filter entities.CampaignProductFilter) (*dto.CampaignProductListResponse, error) {
+	products, count, err := c.campaignProductRepo.GetList(ctx, valueobjects.CampaignID(campaignID), filter)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCampaignProductListResponse(campaignID, products, count, filter.PaginationConfig)
+	return &response, nil
+}
+
+func (c *CampaignProductUseCase) UpdateProducts(ctx context.Context, campaignProductDetails []entities.CampaignProduct) error {
+	for _, product := range campaignProductDetails {
+		err := c.campaignProductRepo.Update(ctx, product)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateProduct updates a single campaign product, the product has to belong to the campaign. It runs in a
+// transaction as the campaign products stay locked until the product is written
+func (c *CampaignProductUseCase) UpdateProduct(ctx context.Context, product entities.CampaignProduct) (*dto.CampaignProducts, error) {
+	products, err := c.campaignProductRepo.LockByCampaignId(ctx, product.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	var existing *entities.CampaignProduct
+	for i := range products {
+		if products[i].ID == product.ID {
+			existing = &products[i]
+			break
+		}
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("%w: campaign product id %d", valueobjects.ErrProductNotExists, product.ID)
+	}
+
+	err = c.campaignProductRepo.Update(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	// zero values are not written by the repo, so the response keeps the stored ones
+	if product.ProductID != 0 {
+		existing.ProductID = product.ProductID
+	}
+	if product.SKUNo != 0 {
+		existing.SKUNo = product.SKUNo
+	}
+	if product.SerialNo != 0 {
+		existing.SerialNo = product.SerialNo
+	}
+	if product.SequenceNo != 0 {
+		existing.SequenceNo = product.SequenceNo
+	}
+	if product.ProductType != "" {
+		existing.ProductType = product.ProductType
+	}
+	return dto.ToCampaignProductDTO(*existing), nil
+}
+
+// ReorderProducts rewrites sequence_no of the campaign products following the given order,
+// the list has to contain every product of the campaign exactly once. It runs in a transaction as the campaign products
+// stay locked until they are renumbered
+func (c *CampaignProductUseCase) ReorderProducts(ctx context.Context, campaignID int64, campaignProductIDs []int64, userID int64) ([]*dto.CampaignProducts, error) {
+	products, err := c.campaignProductRepo.LockByCampaignId(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	productsByID := make(map[int64]entities.CampaignProduct, len(products))
+	for _, product := range products {
+		productsByID[product.ID.ToInt64()] = product
+	}
+
+	seen := make(map[int64]bool, len(campaignProductIDs))
+	for _, id := range campaignProductIDs {
+		if _, ok := productsByID[id]; !ok {
+			return nil, fmt.Errorf("%w: campaign product id %d", valueobjects.ErrProductNotExists, id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("%w: campaign product id %d repeated", valueobjects.ErrProductOrderInvalid, id)
+		}
+		seen[id] = true
+	}
+	if len(seen) != len(productsByID) {
+		return nil, fmt.Errorf("%w: got %d of %d products", valueobjects.ErrProductOrderInvalid, len(seen), len(productsByID))
+	}
+
+	var campaignProducts []*dto.CampaignProducts
+	for i, id := range campaignProductIDs {
+		product := productsByID[id]
+		product.SequenceNo = i + 1
+		err := c.campaignProductRepo.Update(ctx, entities.CampaignProduct{
+			ID:         product.ID,
+			CampaignID: product.CampaignID,
+			SequenceNo: product.SequenceNo,
+			UpdatedBy:  userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		campaignProducts = append(campaignProducts, dto.ToCampaignProductDTO(product))
+	}
+	return campaignProducts, nil
+}
+
+func (c *CampaignProductUseCase) DeleteByCampaignId(ctx context.Context, campaignID int64, productID int64) error {
+	err := c.campaignProductRepo.DeleteByCampaignId(ctx, campaignID, productID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CampaignProductUseCase) DeleteAllByCampaignId(ctx context.Context, campaignID int64) error {
+	err := c.campaignProductRepo.DeleteAllByCampaignId(ctx, campaignID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CampaignProductUseCase) DeleteProduct(ctx context.Context, campaignID, campaignProductID, userID int64) error {
+	return c.campaignProductRepo.Delete(ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignProductID(campaignProductID), userID)
+}
@@ -1,271 +1,416 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services/mocks"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/dto"
-	"context"
-	"errors"
-	"fmt"
-	"testing"
-
-	. "github.com/smartystreets/goconvey/convey"
-)
-
-func TestCampaignProductUseCase_AddProducts(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	productEntities := []entities.CampaignProduct{
-		{
-			ProductID:   123,
-			SKUNo:       1111,
-			SerialNo:    1,
-			SequenceNo:  1,
-			ProductType: "cd",
-			CampaignID:  valueobjects.CampaignID(campaignID),
-			CreatedBy:   int64(userID),
-		},
-		{
-			ProductID:   456,
-			SKUNo:       2222,
-			SerialNo:    2,
-			SequenceNo:  2,
-			ProductType: "cd",
-			CampaignID:  valueobjects.CampaignID(campaignID),
-			CreatedBy:   int64(userID),
-		},
-	}
-	t.Run("when products for particular campaign added successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-
-		productsDTO := []dto.CampaignProducts{
-			{
-				ID:          1,
-				ProductID:   456,
-				SKUNo:       2222,
-				SerialNo:    2,
-				SequenceNo:  2,
-				ProductType: "cd",
-			},
-			{
-				ID:          2,
-				ProductID:   456,
-				SKUNo:       2222,
-				SerialNo:    2,
-				SequenceNo:  2,
-				ProductType: "cd",
-			},
-		}
-		mockCampaignProductService.On("CreateMultiple", ctx, productEntities).Return([]entities.CampaignProduct{
-			{
-				ID:          1,
-				ProductID:   456,
-				SKUNo:       2222,
-				SerialNo:    2,
-				SequenceNo:  2,
-				ProductType: "cd",
-				CampaignID:  valueobjects.CampaignID(campaignID),
-				CreatedBy:   int64(userID),
-			},
-			{
-				ID:          2,
-				ProductID:   456,
-				SKUNo:       2222,
-				SerialNo:    2,
-				SequenceNo:  2,
-				ProductType: "cd",
-				CampaignID:  valueobjects.CampaignID(campaignID),
-				CreatedBy:   int64(userID),
-			},
-		}, nil)
-
-		response, err := productUseCase.AddProducts(ctx, productEntities)
-		ShouldEqual(response, productsDTO)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while saving product details in db", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("CreateMultiple", ctx, productEntities).Return(
-			[]entities.CampaignProduct{}, fmt.Errorf("%w: %v", valueobjects.ErrProductCantCreate, errors.New("db error")))
-
-		_, err := productUseCase.AddProducts(ctx, productEntities)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrProductCantCreate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignProductUseCase_UpdateProducts(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	productEntities := []entities.CampaignProduct{
-		{
-			ID:          valueobjects.CampaignProductID(111),
-			ProductID:   123,
-			SKUNo:       1111,
-			SerialNo:    1,
-			SequenceNo:  1,
-			ProductType: "ncd",
-			CampaignID:  valueobjects.CampaignID(campaignID),
-			CreatedBy:   int64(userID),
-		},
-		{
-			ID:          valueobjects.CampaignProductID(222),
-			ProductID:   456,
-			SKUNo:       2222,
-			SerialNo:    2,
-			SequenceNo:  2,
-			ProductType: "ncd",
-			CampaignID:  valueobjects.CampaignID(campaignID),
-			CreatedBy:   int64(userID),
-		},
-	}
-	t.Run("when products for particular campaign updated successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		for _, product := range productEntities {
-			mockCampaignProductService.On("Update", ctx, product).Return(nil)
-		}
-
-		err := productUseCase.UpdateProducts(ctx, productEntities)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while updating product details in db", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("Update", ctx, productEntities[0]).Return(
-			fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, errors.New("db error")))
-
-		err := productUseCase.UpdateProducts(ctx, productEntities)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrProductCantUpdate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignProductUseCase_DeleteProductByCampaignId(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	productEntity := entities.CampaignProduct{
-		ID:          valueobjects.CampaignProductID(1000),
-		ProductID:   100,
-		SKUNo:       1111,
-		SerialNo:    1,
-		SequenceNo:  1,
-		ProductType: "ncd",
-		CampaignID:  valueobjects.CampaignID(campaignID),
-		CreatedBy:   int64(userID),
-	}
-
-	t.Run("when product for particular campaign deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("DeleteByCampaignId", ctx, int64(productEntity.CampaignID), productEntity.ProductID).Return(nil)
-		err := productUseCase.DeleteByCampaignId(ctx, int64(productEntity.CampaignID), productEntity.ProductID)
-		ShouldBeNil(err)
-	})
-	t.Run("when product for particular campaign deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("DeleteByCampaignId", ctx, int64(productEntity.CampaignID), productEntity.ProductID).Return(errors.New("record Not Found"))
-		err := productUseCase.DeleteByCampaignId(ctx, int64(productEntity.CampaignID), productEntity.ProductID)
-		ShouldNotBeNil(err)
-	})
-}
-
-func TestCampaignProductUseCase_GetProducts(t *testing.T) {
-	t.Run("When campaign product exist, it returns product data", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductsService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductsService)
-		campaignID := 101
-		userID := 987654321
-		response := []entities.CampaignProduct{
-			{
-				ID:          valueobjects.CampaignProductID(111),
-				ProductID:   123,
-				SKUNo:       1111,
-				SerialNo:    1,
-				SequenceNo:  1,
-				ProductType: "cd",
-				CampaignID:  valueobjects.CampaignID(campaignID),
-				CreatedBy:   int64(userID),
-			},
-			{
-				ID:          valueobjects.CampaignProductID(222),
-				ProductID:   456,
-				SKUNo:       2222,
-				SerialNo:    2,
-				SequenceNo:  2,
-				ProductType: "cd",
-				CampaignID:  valueobjects.CampaignID(campaignID),
-				CreatedBy:   int64(userID),
-			},
-		}
-		mockCampaignProductsService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
-			response,
-			nil,
-		)
-		actualValue, err := productUseCase.GetProducts(ctx, int64(campaignID))
-		ShouldEqual(actualValue, response)
-		ShouldBeNil(err)
-	})
-	t.Run("When campaign details not exist, it returns error", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductsService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductsService)
-		campaignID := 101
-		response := []entities.CampaignProduct{}
-		mockCampaignProductsService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
-			response,
-			errors.New("record Not Found"),
-		)
-		actualValue, err := productUseCase.GetProducts(ctx, int64(campaignID))
-		ShouldEqual(actualValue, response)
-		ShouldNotBeNil(err)
-	})
-}
-func TestCampaignProductUseCase_DeleteAllProductByCampaignId(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	productEntity := entities.CampaignProduct{
-		ID:          valueobjects.CampaignProductID(1000),
-		ProductID:   100,
-		SKUNo:       1111,
-		SerialNo:    1,
-		SequenceNo:  1,
-		ProductType: "ncd",
-		CampaignID:  valueobjects.CampaignID(campaignID),
-		CreatedBy:   int64(userID),
-	}
-
-	t.Run("when all products for particular campaign deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("DeleteAllByCampaignId", ctx, int64(productEntity.CampaignID)).Return(nil)
-		err := productUseCase.DeleteAllByCampaignId(ctx, int64(productEntity.CampaignID))
-		ShouldBeNil(err)
-	})
-	t.Run("when all products for particular campaign deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignProductService := mocks.NewCampaignProducts(t)
-		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
-		mockCampaignProductService.On("DeleteAllByCampaignId", ctx, int64(productEntity.CampaignID)).Return(errors.New("record Not Found"))
-		err := productUseCase.DeleteAllByCampaignId(ctx, int64(productEntity.CampaignID))
-		ShouldNotBeNil(err)
-	})
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCampaignProductUseCase_AddProducts(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	productEntities := []entities.CampaignProduct{
+		{
+			ProductID:   123,
+			SKUNo:       1111,
+			SerialNo:    1,
+			SequenceNo:  1,
+			ProductType: "cd",
+			CampaignID:  valueobjects.CampaignID(campaignID),
+			CreatedBy:   int64(userID),
+		},
+		{
+			ProductID:   456,
+			SKUNo:       2222,
+			SerialNo:    2,
+			SequenceNo:  2,
+			ProductType: "cd",
+			CampaignID:  valueobjects.CampaignID(campaignID),
+			CreatedBy:   int64(userID),
+		},
+	}
+	t.Run("when products for particular campaign added successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+
+		productsDTO := []dto.CampaignProducts{
+			{
+				ID:          1,
+				ProductID:   456,
+				SKUNo:       2222,
+				SerialNo:    2,
+				SequenceNo:  2,
+				ProductType: "cd",
+			},
+			{
+				ID:          2,
+				ProductID:   456,
+				SKUNo:       2222,
+				SerialNo:    2,
+				SequenceNo:  2,
+				ProductType: "cd",
+			},
+		}
+		mockCampaignProductService.On("CreateMultiple", ctx, productEntities).Return([]entities.CampaignProduct{
+			{
+				ID:          1,
+				ProductID:   456,
+				SKUNo:       2222,
+				SerialNo:    2,
+				SequenceNo:  2,
+				ProductType: "cd",
+				CampaignID:  valueobjects.CampaignID(campaignID),
+				CreatedBy:   int64(userID),
+			},
+			{
+				ID:          2,
+				ProductID:   456,
+				SKUNo:       2222,
+				SerialNo:    2,
+				SequenceNo:  2,
+				ProductType: "cd",
+				CampaignID:  valueobjects.CampaignID(campaignID),
+				CreatedBy:   int64(userID),
+			},
+		}, nil)
+
+		response, err := productUseCase.AddProducts(ctx, productEntities)
+		ShouldEqual(response, productsDTO)
+		ShouldBeNil(err)
+	})
+	t.Run("when error occured while saving product details in db", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("CreateMultiple", ctx, productEntities).Return(
+			[]entities.CampaignProduct{}, fmt.Errorf("%w: %v", valueobjects.ErrProductCantCreate, errors.New("db error")))
+
+		_, err := productUseCase.AddProducts(ctx, productEntities)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrProductCantCreate) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignProductUseCase_UpdateProducts(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	productEntities := []entities.CampaignProduct{
+		{
+			ID:          valueobjects.CampaignProductID(111),
+			ProductID:   123,
+			SKUNo:       1111,
+			SerialNo:    1,
+			SequenceNo:  1,
+			ProductType: "ncd",
+			CampaignID:  valueobjects.CampaignID(campaignID),
+			CreatedBy:   int64(userID),
+		},
+		{
+			ID:          valueobjects.CampaignProductID(222),
+			ProductID:   456,
+			SKUNo:       2222,
+			SerialNo:    2,
+			SequenceNo:  2,
+			ProductType: "ncd",
+			CampaignID:  valueobjects.CampaignID(campaignID),
+			CreatedBy:   int64(userID),
+		},
+	}
+	t.Run("when products for particular campaign updated successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		for _, product := range productEntities {
+			mockCampaignProductService.On("Update", ctx, product).Return(nil)
+		}
+
+		err := productUseCase.UpdateProducts(ctx, productEntities)
+		ShouldBeNil(err)
+	})
+	t.Run("when error occured while updating product details in db", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("Update", ctx, productEntities[0]).Return(
+			fmt.Errorf("%w: %v", valueobjects.ErrProductCantUpdate, errors.New("db error")))
+
+		err := productUseCase.UpdateProducts(ctx, productEntities)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrProductCantUpdate) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignProductUseCase_GetProductList(t *testing.T) {
+	filter := entities.CampaignProductFilter{
+		PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1, SortFields: []entities.SortField{{Column: "sequence_no"}}},
+		ProductType:      "cd",
+	}
+
+	t.Run("when page of products fetched successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("GetList", ctx, valueobjects.CampaignID(1), filter).
+			Return([]entities.CampaignProduct{{ID: 5, CampaignID: 1, ProductID: 501, ProductType: "cd"}}, int64(1), nil)
+		response, err := productUseCase.GetProductList(ctx, 1, filter)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		data := response.Data
+		if data.Count != 1 || data.Limit != 20 || data.Offset != 0 || len(data.Products) != 1 || data.Products[0].ProductID != 501 {
+			t.Errorf("unexpected response : got - %+v", data)
+		}
+	})
+	t.Run("when campaign has no matching products", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("GetList", ctx, valueobjects.CampaignID(1), filter).Return(nil, int64(0), nil)
+		response, err := productUseCase.GetProductList(ctx, 1, filter)
+		if err != nil || response.Data.Products == nil {
+			t.Errorf("unexpected response : got - %+v, %v ; want empty product list", response, err)
+		}
+	})
+}
+
+func TestCampaignProductUseCase_UpdateProduct(t *testing.T) {
+	storedProducts := []entities.CampaignProduct{
+		{ID: 5, CampaignID: 1, ProductID: 501, SKUNo: 1111, SerialNo: 1, SequenceNo: 1, ProductType: "cd"},
+	}
+
+	t.Run("when campaign product updated successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		product := entities.CampaignProduct{ID: 5, CampaignID: 1, SequenceNo: 3, ProductType: "ncd", UpdatedBy: 7}
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		mockCampaignProductService.On("Update", ctx, product).Return(nil)
+		response, err := productUseCase.UpdateProduct(ctx, product)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := &dto.CampaignProducts{ID: 5, ProductID: 501, SKUNo: 1111, SerialNo: 1, SequenceNo: 3, ProductType: "ncd"}
+		if *response != *expected {
+			t.Errorf("unexpected response : got - %v ; want - %v", response, expected)
+		}
+	})
+	t.Run("when campaign product belongs to another campaign", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		_, err := productUseCase.UpdateProduct(ctx, entities.CampaignProduct{ID: 6, CampaignID: 1, SequenceNo: 3})
+		if !errors.Is(err, valueobjects.ErrProductNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductNotExists)
+		}
+	})
+}
+
+func TestCampaignProductUseCase_ReorderProducts(t *testing.T) {
+	storedProducts := []entities.CampaignProduct{
+		{ID: 5, CampaignID: 1, ProductID: 501, SequenceNo: 1},
+		{ID: 6, CampaignID: 1, ProductID: 502, SequenceNo: 2},
+		{ID: 7, CampaignID: 1, ProductID: 503, SequenceNo: 3},
+	}
+
+	t.Run("when products reordered successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		mockCampaignProductService.On("Update", ctx, entities.CampaignProduct{ID: 7, CampaignID: 1, SequenceNo: 1, UpdatedBy: 9}).Return(nil)
+		mockCampaignProductService.On("Update", ctx, entities.CampaignProduct{ID: 5, CampaignID: 1, SequenceNo: 2, UpdatedBy: 9}).Return(nil)
+		mockCampaignProductService.On("Update", ctx, entities.CampaignProduct{ID: 6, CampaignID: 1, SequenceNo: 3, UpdatedBy: 9}).Return(nil)
+		response, err := productUseCase.ReorderProducts(ctx, 1, []int64{7, 5, 6}, 9)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response) != 3 || response[0].ProductID != 503 || response[0].SequenceNo != 1 || response[2].SequenceNo != 3 {
+			t.Errorf("unexpected response : got - %v", response)
+		}
+	})
+	t.Run("when product does not belong to the campaign", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		_, err := productUseCase.ReorderProducts(ctx, 1, []int64{7, 5, 8}, 9)
+		if !errors.Is(err, valueobjects.ErrProductNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductNotExists)
+		}
+	})
+	t.Run("when some campaign products are missing from the order", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		_, err := productUseCase.ReorderProducts(ctx, 1, []int64{7, 5}, 9)
+		if !errors.Is(err, valueobjects.ErrProductOrderInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductOrderInvalid)
+		}
+	})
+	t.Run("when a product is repeated in the order", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("LockByCampaignId", ctx, valueobjects.CampaignID(1)).Return(storedProducts, nil)
+		_, err := productUseCase.ReorderProducts(ctx, 1, []int64{7, 5, 5}, 9)
+		if !errors.Is(err, valueobjects.ErrProductOrderInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductOrderInvalid)
+		}
+	})
+}
+
+func TestCampaignProductUseCase_DeleteProductByCampaignId(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	productEntity := entities.CampaignProduct{
+		ID:          valueobjects.CampaignProductID(1000),
+		ProductID:   100,
+		SKUNo:       1111,
+		SerialNo:    1,
+		SequenceNo:  1,
+		ProductType: "ncd",
+		CampaignID:  valueobjects.CampaignID(campaignID),
+		CreatedBy:   int64(userID),
+	}
+
+	t.Run("when product for particular campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("DeleteByCampaignId", ctx, int64(productEntity.CampaignID), productEntity.ProductID).Return(nil)
+		err := productUseCase.DeleteByCampaignId(ctx, int64(productEntity.CampaignID), productEntity.ProductID)
+		ShouldBeNil(err)
+	})
+	t.Run("when product for particular campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("DeleteByCampaignId", ctx, int64(productEntity.CampaignID), productEntity.ProductID).Return(errors.New("record Not Found"))
+		err := productUseCase.DeleteByCampaignId(ctx, int64(productEntity.CampaignID), productEntity.ProductID)
+		ShouldNotBeNil(err)
+	})
+}
+
+func TestCampaignProductUseCase_DeleteProduct(t *testing.T) {
+	t.Run("when campaign product deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("Delete", ctx, valueobjects.CampaignID(1), valueobjects.CampaignProductID(5), int64(7)).Return(nil)
+		err := productUseCase.DeleteProduct(ctx, 1, 5, 7)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when campaign product delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("Delete", ctx, valueobjects.CampaignID(1), valueobjects.CampaignProductID(5), int64(7)).
+			Return(fmt.Errorf("%w: db error", valueobjects.ErrProductCantDelete))
+		err := productUseCase.DeleteProduct(ctx, 1, 5, 7)
+		if !errors.Is(err, valueobjects.ErrProductCantDelete) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrProductCantDelete)
+		}
+	})
+}
+
+func TestCampaignProductUseCase_GetProducts(t *testing.T) {
+	t.Run("When campaign product exist, it returns product data", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductsService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductsService)
+		campaignID := 101
+		userID := 987654321
+		response := []entities.CampaignProduct{
+			{
+				ID:          valueobjects.CampaignProductID(111),
+				ProductID:   123,
+				SKUNo:       1111,
+				SerialNo:    1,
+				SequenceNo:  1,
+				ProductType: "cd",
+				CampaignID:  valueobjects.CampaignID(campaignID),
+				CreatedBy:   int64(userID),
+			},
+			{
+				ID:          valueobjects.CampaignProductID(222),
+				ProductID:   456,
+				SKUNo:       2222,
+				SerialNo:    2,
+				SequenceNo:  2,
+				ProductType: "cd",
+				CampaignID:  valueobjects.CampaignID(campaignID),
+				CreatedBy:   int64(userID),
+			},
+		}
+		mockCampaignProductsService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
+			response,
+			nil,
+		)
+		actualValue, err := productUseCase.GetProducts(ctx, int64(campaignID))
+		ShouldEqual(actualValue, response)
+		ShouldBeNil(err)
+	})
+	t.Run("When campaign details not exist, it returns error", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductsService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductsService)
+		campaignID := 101
+		response := []entities.CampaignProduct{}
+		mockCampaignProductsService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
+			response,
+			errors.New("record Not Found"),
+		)
+		actualValue, err := productUseCase.GetProducts(ctx, int64(campaignID))
+		ShouldEqual(actualValue, response)
+		ShouldNotBeNil(err)
+	})
+}
+func TestCampaignProductUseCase_DeleteAllProductByCampaignId(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	productEntity := entities.CampaignProduct{
+		ID:          valueobjects.CampaignProductID(1000),
+		ProductID:   100,
+		SKUNo:       1111,
+		SerialNo:    1,
+		SequenceNo:  1,
+		ProductType: "ncd",
+		CampaignID:  valueobjects.CampaignID(campaignID),
+		CreatedBy:   int64(userID),
+	}
+
+	t.Run("when all products for particular campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("DeleteAllByCampaignId", ctx, int64(productEntity.CampaignID)).Return(nil)
+		err := productUseCase.DeleteAllByCampaignId(ctx, int64(productEntity.CampaignID))
+		ShouldBeNil(err)
+	})
+	t.Run("when all products for particular campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignProductService := mocks.NewCampaignProducts(t)
+		productUseCase := NewCampaignProductUseCase(mockCampaignProductService)
+		mockCampaignProductService.On("DeleteAllByCampaignId", ctx, int64(productEntity.CampaignID)).Return(errors.New("record Not Found"))
+		err := productUseCase.DeleteAllByCampaignId(ctx, int64(productEntity.CampaignID))
+		ShouldNotBeNil(err)
+	})
+}
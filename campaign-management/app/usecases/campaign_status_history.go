@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type CampaignStatusHistoryUseCase struct {
+	statusHistoryRepo services.CampaignStatusHistory
+}
+
+func NewCampaignStatusHistoryUseCase(statusHistoryRepo services.CampaignStatusHistory) *CampaignStatusHistoryUseCase {
+	return &CampaignStatusHistoryUseCase{
+		statusHistoryRepo: statusHistoryRepo,
+	}
+}
+
+func (c *CampaignStatusHistoryUseCase) GetHistory(ctx context.Context, campaignID int64) ([]*dto.CampaignStatusHistoryDTO, error) {
+	history, err := c.statusHistoryRepo.GetList(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.CampaignStatusHistoryDTO{}
+	for _, entry := range history {
+		response = append(response, dto.ToCampaignStatusHistoryDTO(entry))
+	}
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCampaignStatusHistoryUseCase_GetHistory(t *testing.T) {
+	t.Run("when status history fetched successfully", func(t *testing.T) {
+		ctx := context.Background()
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		statusHistoryUseCase := NewCampaignStatusHistoryUseCase(statusHistoryService)
+
+		statusHistoryService.On("GetList", ctx, valueobjects.CampaignID(42)).Return([]entities.CampaignStatusHistory{
+			{
+				ID:             valueobjects.StatusHistoryID(1),
+				CampaignID:     valueobjects.CampaignID(42),
+				OldStatusCode:  valueobjects.CampaignStatusScheduled.Code(),
+				OldStatusValue: "Scheduled",
+				NewStatusCode:  valueobjects.CampaignStatusActive.Code(),
+				NewStatusValue: "Active",
+				Actor:          valueobjects.StatusChangeActorScheduler,
+				ChangedAt:      time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC),
+			},
+		}, nil)
+		response, err := statusHistoryUseCase.GetHistory(ctx, 42)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response) != 1 {
+			t.Fatalf("unexpected length : got - %d ; want - 1", len(response))
+		}
+		if response[0].NewStatus != "Active" || response[0].Actor != "scheduler" || response[0].ChangedAt != "2023-03-01 01:30:00" {
+			t.Errorf("unexpected value : got - %+v", response[0])
+		}
+	})
+	t.Run("when error occured while getting status history", func(t *testing.T) {
+		ctx := context.Background()
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		statusHistoryUseCase := NewCampaignStatusHistoryUseCase(statusHistoryService)
+
+		statusHistoryService.On("GetList", ctx, valueobjects.CampaignID(42)).Return(nil,
+			fmt.Errorf("%w: %v", valueobjects.ErrStatusHistoryCantGet, errors.New("db error")))
+		_, err := statusHistoryUseCase.GetHistory(ctx, 42)
+		if !errors.Is(err, valueobjects.ErrStatusHistoryCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusHistoryCantGet)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+)
+
+type CampaignStatusJobRunUseCase struct {
+	jobRunRepo services.CampaignStatusJobRuns
+}
+
+func NewCampaignStatusJobRunUseCase(jobRunRepo services.CampaignStatusJobRuns) *CampaignStatusJobRunUseCase {
+	return &CampaignStatusJobRunUseCase{
+		jobRunRepo: jobRunRepo,
+	}
+}
+
+func (c *CampaignStatusJobRunUseCase) Record(ctx context.Context, run entities.CampaignStatusJobRun) error {
+	_, err := c.jobRunRepo.Create(ctx, run)
+	return err
+}
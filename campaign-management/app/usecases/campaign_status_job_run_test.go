@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCampaignStatusJobRunUseCase_Record(t *testing.T) {
+	run := entities.CampaignStatusJobRun{
+		Instance:    "instance-1",
+		StartedAt:   time.Date(2023, 1, 10, 10, 0, 0, 0, time.UTC),
+		EndedAt:     time.Date(2023, 1, 10, 10, 0, 1, 0, time.UTC),
+		Published:   2,
+		Deactivated: 1,
+	}
+
+	t.Run("when job run recorded successfully", func(t *testing.T) {
+		ctx := context.Background()
+		jobRunService := mocks.NewCampaignStatusJobRuns(t)
+		jobRunUseCase := NewCampaignStatusJobRunUseCase(jobRunService)
+
+		created := run
+		created.ID = valueobjects.StatusJobRunID(1)
+		jobRunService.On("Create", ctx, run).Return(created, nil)
+		if err := jobRunUseCase.Record(ctx, run); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when error occured while recording job run", func(t *testing.T) {
+		ctx := context.Background()
+		jobRunService := mocks.NewCampaignStatusJobRuns(t)
+		jobRunUseCase := NewCampaignStatusJobRunUseCase(jobRunService)
+
+		jobRunService.On("Create", ctx, run).Return(entities.CampaignStatusJobRun{},
+			fmt.Errorf("%w: %v", valueobjects.ErrStatusJobRunCantCreate, errors.New("db error")))
+		err := jobRunUseCase.Record(ctx, run)
+		if !errors.Is(err, valueobjects.ErrStatusJobRunCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusJobRunCantCreate)
+		}
+	})
+}
@@ -1,75 +1,177 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/valueobjects"
-	"context"
-
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services"
-	"campaign-mgmt/app/usecases/dto"
-)
-
-type CampaignStoreUseCase struct {
-	campaignStoreRepo services.CampaignStores
-}
-
-func NewCampaignStoreUseCase(campaignStoreRepo services.CampaignStores) *CampaignStoreUseCase {
-	return &CampaignStoreUseCase{
-		campaignStoreRepo: campaignStoreRepo,
-	}
-}
-
-func (c *CampaignStoreUseCase) AddStores(ctx context.Context, stores []entities.CampaignStore) ([]*dto.CampaignStores, error) {
-	response, err := c.campaignStoreRepo.CreateMultiple(ctx, stores)
-	if err != nil {
-		return nil, err
-	}
-	var campaignStores []*dto.CampaignStores
-	for _, store := range response {
-		campaignStores = append(campaignStores, dto.ToCampaignStoreDTO(store))
-	}
-	return campaignStores, nil
-}
-
-func (c *CampaignStoreUseCase) GetStores(ctx context.Context, campaignID int64) ([]*dto.CampaignStores, error) {
-	campaignStoresEntities, err := c.campaignStoreRepo.GetByCampaignId(ctx, valueobjects.CampaignID(campaignID))
-	if err != nil {
-		return nil, err
-	}
-	var campaignStores []*dto.CampaignStores
-	for _, store := range campaignStoresEntities {
-		campaignStores = append(campaignStores, dto.ToCampaignStoreDTO(store))
-	}
-	return campaignStores, nil
-}
-
-func (c *CampaignStoreUseCase) UpdateStores(ctx context.Context, campaignStoreDetails []entities.CampaignStore) error {
-	for _, store := range campaignStoreDetails {
-		err := c.campaignStoreRepo.Update(ctx, store)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (c *CampaignStoreUseCase) DeleteStores(ctx context.Context, campaignID, userID int64) error {
-	return c.campaignStoreRepo.DeleteByCampaignID(ctx, valueobjects.CampaignID(campaignID), userID)
-}
-
-func (c *CampaignStoreUseCase) DeleteStore(ctx context.Context, campaignID, storeID, userID int64) error {
-	return c.campaignStoreRepo.Delete(ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID), userID)
-}
-
-func (c *CampaignStoreUseCase) GetByStoreID(ctx context.Context, campaignID int64, StoreID int64) (dto.CampaignStores, error) {
-	data, err := c.campaignStoreRepo.GetByStoreID(ctx, valueobjects.CampaignID(campaignID), StoreID)
-	if err != nil {
-		return dto.CampaignStores{}, err
-	}
-	response := dto.ToCampaignStoreDTO(data)
-	return *response, nil
-}
-
-func (c *CampaignStoreUseCase) DeleteByStoreID(ctx context.Context, campaignID, storeID, userID int64) error {
-	return c.campaignStoreRepo.DeleteByStoreID(ctx, valueobjects.CampaignID(campaignID), storeID, userID)
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type CampaignStoreUseCase struct {
+	campaignStoreRepo services.CampaignStores
+	storeRepo         services.Stores
+	storeGroupRepo    services.StoreGroups
+}
+
+func NewCampaignStoreUseCase(campaignStoreRepo services.CampaignStores, storeRepo services.Stores,
+	storeGroupRepo services.StoreGroups) *CampaignStoreUseCase {
+	return &CampaignStoreUseCase{
+		campaignStoreRepo: campaignStoreRepo,
+		storeRepo:         storeRepo,
+		storeGroupRepo:    storeGroupRepo,
+	}
+}
+
+// ExpandStoreGroups returns the stores followed by the active stores of the groups, each store once.
+// Group members no longer active are left out so a closed store does not hold up the whole group
+func (c *CampaignStoreUseCase) ExpandStoreGroups(ctx context.Context, storeIDs, storeGroupIDs []int64) ([]int64, error) {
+	storeGroups, err := c.storeGroupRepo.GetByIDs(ctx, storeGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	found := map[int64]bool{}
+	memberIDs := []int64{}
+	for _, storeGroup := range storeGroups {
+		found[storeGroup.ID.ToInt64()] = true
+		memberIDs = append(memberIDs, storeGroup.StoreIDs...)
+	}
+	missing := []string{}
+	for _, storeGroupID := range storeGroupIDs {
+		if !found[storeGroupID] {
+			missing = append(missing, strconv.FormatInt(storeGroupID, 10))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: %s", valueobjects.ErrStoreGroupNotExists, strings.Join(missing, ", "))
+	}
+	registered, err := registeredStoresByID(ctx, c.storeRepo, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := []int64{}
+	seen := map[int64]bool{}
+	for _, storeID := range storeIDs {
+		if !seen[storeID] {
+			seen[storeID] = true
+			expanded = append(expanded, storeID)
+		}
+	}
+	for _, storeID := range memberIDs {
+		if !seen[storeID] && registered[storeID].IsActive {
+			seen[storeID] = true
+			expanded = append(expanded, storeID)
+		}
+	}
+	return expanded, nil
+}
+
+// AddStores adds the stores to the campaign, every store must be registered and active
+func (c *CampaignStoreUseCase) AddStores(ctx context.Context, stores []entities.CampaignStore) ([]*dto.CampaignStores, error) {
+	registered, err := c.activeStores(ctx, stores)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.campaignStoreRepo.CreateMultiple(ctx, stores)
+	if err != nil {
+		return nil, err
+	}
+	var campaignStores []*dto.CampaignStores
+	for _, store := range response {
+		campaignStores = append(campaignStores, dto.ToRegisteredCampaignStoreDTO(store, registered))
+	}
+	return campaignStores, nil
+}
+
+func (c *CampaignStoreUseCase) GetStores(ctx context.Context, campaignID int64) ([]*dto.CampaignStores, error) {
+	campaignStoresEntities, err := c.campaignStoreRepo.GetByCampaignId(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	registered, err := c.registeredStores(ctx, campaignStoresEntities)
+	if err != nil {
+		return nil, err
+	}
+	var campaignStores []*dto.CampaignStores
+	for _, store := range campaignStoresEntities {
+		campaignStores = append(campaignStores, dto.ToRegisteredCampaignStoreDTO(store, registered))
+	}
+	return campaignStores, nil
+}
+
+func (c *CampaignStoreUseCase) GetStoreList(ctx context.Context, campaignID int64, filter entities.CampaignStoreFilter) (*dto.CampaignStoreListResponse, error) {
+	stores, count, err := c.campaignStoreRepo.GetList(ctx, valueobjects.CampaignID(campaignID), filter)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := c.registeredStores(ctx, stores)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCampaignStoreListResponse(campaignID, stores, registered, count, filter.PaginationConfig)
+	return &response, nil
+}
+
+// UpdateStores points the campaign stores at other stores, every store must be registered and active
+func (c *CampaignStoreUseCase) UpdateStores(ctx context.Context, campaignStoreDetails []entities.CampaignStore) error {
+	if _, err := c.activeStores(ctx, campaignStoreDetails); err != nil {
+		return err
+	}
+	for _, store := range campaignStoreDetails {
+		err := c.campaignStoreRepo.Update(ctx, store)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CampaignStoreUseCase) DeleteStores(ctx context.Context, campaignID, userID int64) error {
+	return c.campaignStoreRepo.DeleteByCampaignID(ctx, valueobjects.CampaignID(campaignID), userID)
+}
+
+func (c *CampaignStoreUseCase) DeleteStore(ctx context.Context, campaignID, storeID, userID int64) error {
+	return c.campaignStoreRepo.Delete(ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID), userID)
+}
+
+func (c *CampaignStoreUseCase) GetByStoreID(ctx context.Context, campaignID int64, StoreID int64) (dto.CampaignStores, error) {
+	data, err := c.campaignStoreRepo.GetByStoreID(ctx, valueobjects.CampaignID(campaignID), StoreID)
+	if err != nil {
+		return dto.CampaignStores{}, err
+	}
+	registered, err := c.registeredStores(ctx, []entities.CampaignStore{data})
+	if err != nil {
+		return dto.CampaignStores{}, err
+	}
+	response := dto.ToRegisteredCampaignStoreDTO(data, registered)
+	return *response, nil
+}
+
+func (c *CampaignStoreUseCase) DeleteByStoreID(ctx context.Context, campaignID, storeID, userID int64) error {
+	return c.campaignStoreRepo.DeleteByStoreID(ctx, valueobjects.CampaignID(campaignID), storeID, userID)
+}
+
+// registeredStores returns the registry details of the campaign stores by store id, stores not registered are left out
+func (c *CampaignStoreUseCase) registeredStores(ctx context.Context, campaignStores []entities.CampaignStore) (map[int64]entities.Store, error) {
+	return registeredStoresByID(ctx, c.storeRepo, campaignStoreIDs(campaignStores))
+}
+
+// activeStores refuses campaign stores whose store is not registered or not active, naming every such store
+func (c *CampaignStoreUseCase) activeStores(ctx context.Context, campaignStores []entities.CampaignStore) (map[int64]entities.Store, error) {
+	return activeStoresByID(ctx, c.storeRepo, campaignStoreIDs(campaignStores))
+}
+
+func campaignStoreIDs(campaignStores []entities.CampaignStore) []int64 {
+	storeIDs := []int64{}
+	for _, campaignStore := range campaignStores {
+		storeIDs = append(storeIDs, campaignStore.StoreID)
+	}
+	return storeIDs
+}
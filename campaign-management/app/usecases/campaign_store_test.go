@@ -1,302 +1,437 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services/mocks"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/dto"
-	"context"
-	"errors"
-	"fmt"
-	"testing"
-
-	. "github.com/smartystreets/goconvey/convey"
-)
-
-func TestCampaignStoreUseCase_AddStores(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	storeEntities := []entities.CampaignStore{
-		{
-			StoreID:    1234,
-			CampaignID: valueobjects.CampaignID(campaignID),
-			CreatedBy:  int64(userID),
-		},
-		{
-			StoreID:    5678,
-			CampaignID: valueobjects.CampaignID(campaignID),
-			CreatedBy:  int64(userID),
-		},
-	}
-	t.Run("when stores for particular campaign added successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		storesDTO := []dto.CampaignStores{
-			{
-				ID:      1,
-				StoreID: 1234,
-			},
-			{
-				ID:      2,
-				StoreID: 5678,
-			},
-		}
-		mockCampaignStoreService.On("CreateMultiple", ctx, storeEntities).Return([]entities.CampaignStore{
-			{
-				ID:         1,
-				StoreID:    1234,
-				CampaignID: valueobjects.CampaignID(campaignID),
-				CreatedBy:  int64(userID),
-			},
-			{
-				ID:         2,
-				StoreID:    5678,
-				CampaignID: valueobjects.CampaignID(campaignID),
-				CreatedBy:  int64(userID),
-			},
-		}, nil)
-
-		response, err := storeUseCase.AddStores(ctx, storeEntities)
-		ShouldEqual(response, storesDTO)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while saving store details in db", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		mockCampaignStoreService.On("CreateMultiple", ctx, storeEntities).Return(
-			[]entities.CampaignStore{}, fmt.Errorf("%w: %v", valueobjects.ErrStoreCantCreate, errors.New("db error")))
-
-		_, err := storeUseCase.AddStores(ctx, storeEntities)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantCreate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignStoreUseCase_UpdateStores(t *testing.T) {
-	campaignID := 101
-	userID := 987654321
-	storeEntities := []entities.CampaignStore{
-		{
-			ID:         valueobjects.CampaignStoreID(1),
-			StoreID:    1234,
-			CampaignID: valueobjects.CampaignID(campaignID),
-			CreatedBy:  int64(userID),
-		},
-		{
-			ID:         valueobjects.CampaignStoreID(2),
-			StoreID:    5678,
-			CampaignID: valueobjects.CampaignID(campaignID),
-			CreatedBy:  int64(userID),
-		},
-	}
-	t.Run("when stores for particular campaign updated successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		for _, store := range storeEntities {
-			mockCampaignStoreService.On("Update", ctx, store).Return(nil)
-		}
-
-		err := storeUseCase.UpdateStores(ctx, storeEntities)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while updating store details in db", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		mockCampaignStoreService.On("Update", ctx, storeEntities[0]).Return(
-			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantUpdate, errors.New("db error")))
-
-		err := storeUseCase.UpdateStores(ctx, storeEntities)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantUpdate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignStoreUseCase_GetStores(t *testing.T) {
-	t.Run("When campaign Store exist, it returns Store data", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		campaignID := 101
-		userID := 987654321
-		response := []entities.CampaignStore{
-			{
-				ID:         valueobjects.CampaignStoreID(1),
-				StoreID:    1234,
-				CampaignID: valueobjects.CampaignID(campaignID),
-				CreatedBy:  int64(userID),
-			},
-			{
-				ID:         valueobjects.CampaignStoreID(2),
-				StoreID:    5678,
-				CampaignID: valueobjects.CampaignID(campaignID),
-				CreatedBy:  int64(userID),
-			},
-		}
-		mockCampaignStoreService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
-			response,
-			nil,
-		)
-		actualValue, err := storeUseCase.GetStores(ctx, int64(campaignID))
-		ShouldEqual(actualValue, response)
-		ShouldBeNil(err)
-	})
-	t.Run("When campaign store details not exist, it returns error", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		campaignID := 101
-		response := []entities.CampaignStore{}
-		mockCampaignStoreService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
-			response,
-			errors.New("record Not Found"),
-		)
-		actualValue, err := storeUseCase.GetStores(ctx, int64(campaignID))
-		ShouldEqual(actualValue, response)
-		ShouldNotBeNil(err)
-	})
-}
-
-func TestCampaignStoreUseCase_DeleteStores(t *testing.T) {
-	campaignID := int64(101)
-	userID := int64(232323)
-	t.Run("when all the stores for particular campaign deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("DeleteByCampaignID", ctx, valueobjects.CampaignID(campaignID), userID).Return(nil)
-
-		err := storeUseCase.DeleteStores(ctx, campaignID, userID)
-		ShouldBeNil(err)
-	})
-
-	t.Run("error occured while deleting stores entries from db", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("DeleteByCampaignID", ctx, valueobjects.CampaignID(campaignID), userID).Return(
-			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
-		err := storeUseCase.DeleteStores(ctx, campaignID, userID)
-
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignStoreUseCase_DeleteStore(t *testing.T) {
-	campaignID := int64(101)
-	storeID := int64(2121)
-	userID := int64(232323)
-	t.Run("when store with given id deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("Delete", ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID),
-			userID).Return(nil)
-
-		err := storeUseCase.DeleteStore(ctx, campaignID, storeID, userID)
-		ShouldBeNil(err)
-	})
-
-	t.Run("error occured while deleting particular store entry from db", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("Delete", ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID),
-			userID).Return(fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
-		err := storeUseCase.DeleteStore(ctx, campaignID, storeID, userID)
-
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignStoreUseCase_GetByStoreID(t *testing.T) {
-	campaignID := valueobjects.CampaignID(123)
-	userID := 987654321
-	t.Run("when store with given campaign and store id fetched successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		storeDTO := dto.CampaignStores{
-			ID:      1,
-			StoreID: 1234,
-		}
-		mockCampaignStoreService.On("GetByStoreID", ctx, campaignID, int64(1234)).Return(entities.CampaignStore{
-			ID:         1,
-			StoreID:    1234,
-			CampaignID: valueobjects.CampaignID(campaignID),
-			CreatedBy:  int64(userID),
-		}, nil)
-
-		response, err := storeUseCase.GetByStoreID(ctx, int64(campaignID), int64(1234))
-		ShouldEqual(response, storeDTO)
-		ShouldBeNil(err)
-	})
-
-	t.Run("error occured while getting store details", func(t *testing.T) {
-		ctx := context.Background()
-		mockCampaignStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService)
-		mockCampaignStoreService.On("GetByStoreID", ctx, campaignID, int64(1234)).Return(entities.CampaignStore{},
-			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantGet, errors.New("db error")))
-		_, err := storeUseCase.GetByStoreID(ctx, int64(campaignID), int64(1234))
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantGet) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignStoreUseCase_DeleteByStoreID(t *testing.T) {
-	campaignID := int64(101)
-	storeID := int64(2121)
-	userID := int64(232323)
-	t.Run("when store with given store id deleted successfully", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("DeleteByStoreID", ctx, valueobjects.CampaignID(campaignID), storeID,
-			userID).Return(nil)
-
-		err := storeUseCase.DeleteByStoreID(ctx, campaignID, storeID, userID)
-		ShouldBeNil(err)
-	})
-
-	t.Run("error occured while deleting store entry from db", func(t *testing.T) {
-		ctx := context.Background()
-		mockStoreService := mocks.NewCampaignStores(t)
-		storeUseCase := NewCampaignStoreUseCase(mockStoreService)
-
-		mockStoreService.On("DeleteByStoreID", ctx, valueobjects.CampaignID(campaignID), storeID,
-			userID).Return(fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
-		err := storeUseCase.DeleteByStoreID(ctx, campaignID, storeID, userID)
-
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
-			t.Error("invalid error type")
-		}
-	})
-}
\ No newline at end of file
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+// newOpenStoreRegistry returns a store registry where every store is registered and active
+func newOpenStoreRegistry(t *testing.T) *mocks.Stores {
+	storeRegistry := mocks.NewStores(t)
+	storeRegistry.On("GetByIDs", mock.Anything, mock.Anything).Return(func(ctx context.Context, storeIDs []int64) []entities.Store {
+		stores := []entities.Store{}
+		for _, storeID := range storeIDs {
+			stores = append(stores, entities.Store{ID: valueobjects.StoreID(storeID), IsActive: true})
+		}
+		return stores
+	}, nil).Maybe()
+	return storeRegistry
+}
+
+func TestCampaignStoreUseCase_AddStores(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	storeEntities := []entities.CampaignStore{
+		{
+			StoreID:    1234,
+			CampaignID: valueobjects.CampaignID(campaignID),
+			CreatedBy:  int64(userID),
+		},
+		{
+			StoreID:    5678,
+			CampaignID: valueobjects.CampaignID(campaignID),
+			CreatedBy:  int64(userID),
+		},
+	}
+	t.Run("when stores for particular campaign added successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		storesDTO := []dto.CampaignStores{
+			{
+				ID:      1,
+				StoreID: 1234,
+			},
+			{
+				ID:      2,
+				StoreID: 5678,
+			},
+		}
+		mockCampaignStoreService.On("CreateMultiple", ctx, storeEntities).Return([]entities.CampaignStore{
+			{
+				ID:         1,
+				StoreID:    1234,
+				CampaignID: valueobjects.CampaignID(campaignID),
+				CreatedBy:  int64(userID),
+			},
+			{
+				ID:         2,
+				StoreID:    5678,
+				CampaignID: valueobjects.CampaignID(campaignID),
+				CreatedBy:  int64(userID),
+			},
+		}, nil)
+
+		response, err := storeUseCase.AddStores(ctx, storeEntities)
+		ShouldEqual(response, storesDTO)
+		ShouldBeNil(err)
+	})
+	t.Run("when error occured while saving store details in db", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		mockCampaignStoreService.On("CreateMultiple", ctx, storeEntities).Return(
+			[]entities.CampaignStore{}, fmt.Errorf("%w: %v", valueobjects.ErrStoreCantCreate, errors.New("db error")))
+
+		_, err := storeUseCase.AddStores(ctx, storeEntities)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantCreate) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_AddStores_Registry(t *testing.T) {
+	storeEntities := []entities.CampaignStore{
+		{StoreID: 1234, CampaignID: 101, CreatedBy: 7},
+		{StoreID: 5678, CampaignID: 101, CreatedBy: 7},
+		{StoreID: 9012, CampaignID: 101, CreatedBy: 7},
+	}
+
+	t.Run("when stores are added along with their name and region", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockStoreRegistry := mocks.NewStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, mockStoreRegistry, mocks.NewStoreGroups(t))
+		mockStoreRegistry.On("GetByIDs", ctx, []int64{1234, 5678}).Return([]entities.Store{
+			{ID: 1234, Name: "Orchard", Region: "central", IsActive: true},
+			{ID: 5678, Name: "Tampines", Region: "east", IsActive: true},
+		}, nil)
+		mockCampaignStoreService.On("CreateMultiple", ctx, storeEntities[:2]).Return([]entities.CampaignStore{
+			{ID: 1, StoreID: 1234, CampaignID: 101},
+			{ID: 2, StoreID: 5678, CampaignID: 101},
+		}, nil)
+
+		response, err := storeUseCase.AddStores(ctx, storeEntities[:2])
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response) != 2 || response[0].StoreName != "Orchard" || response[1].Region != "east" {
+			t.Errorf("unexpected stores : got - %+v", response)
+		}
+	})
+
+	t.Run("when stores are not registered or not active", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreRegistry := mocks.NewStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mocks.NewCampaignStores(t), mockStoreRegistry, mocks.NewStoreGroups(t))
+		mockStoreRegistry.On("GetByIDs", ctx, []int64{1234, 5678, 9012}).Return([]entities.Store{
+			{ID: 1234, Name: "Orchard", IsActive: true},
+			{ID: 5678, Name: "Tampines"},
+		}, nil)
+
+		_, err := storeUseCase.AddStores(ctx, storeEntities)
+		if !errors.Is(err, valueobjects.ErrStoreUnknown) {
+			t.Fatalf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreUnknown)
+		}
+		if expected := "store is not registered or not active: 5678, 9012"; err.Error() != expected {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, expected)
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_UpdateStores(t *testing.T) {
+	campaignID := 101
+	userID := 987654321
+	storeEntities := []entities.CampaignStore{
+		{
+			ID:         valueobjects.CampaignStoreID(1),
+			StoreID:    1234,
+			CampaignID: valueobjects.CampaignID(campaignID),
+			CreatedBy:  int64(userID),
+		},
+		{
+			ID:         valueobjects.CampaignStoreID(2),
+			StoreID:    5678,
+			CampaignID: valueobjects.CampaignID(campaignID),
+			CreatedBy:  int64(userID),
+		},
+	}
+	t.Run("when stores for particular campaign updated successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		for _, store := range storeEntities {
+			mockCampaignStoreService.On("Update", ctx, store).Return(nil)
+		}
+
+		err := storeUseCase.UpdateStores(ctx, storeEntities)
+		ShouldBeNil(err)
+	})
+	t.Run("when error occured while updating store details in db", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		mockCampaignStoreService.On("Update", ctx, storeEntities[0]).Return(
+			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantUpdate, errors.New("db error")))
+
+		err := storeUseCase.UpdateStores(ctx, storeEntities)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantUpdate) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_GetStores(t *testing.T) {
+	t.Run("When campaign Store exist, it returns Store data", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		campaignID := 101
+		userID := 987654321
+		response := []entities.CampaignStore{
+			{
+				ID:         valueobjects.CampaignStoreID(1),
+				StoreID:    1234,
+				CampaignID: valueobjects.CampaignID(campaignID),
+				CreatedBy:  int64(userID),
+			},
+			{
+				ID:         valueobjects.CampaignStoreID(2),
+				StoreID:    5678,
+				CampaignID: valueobjects.CampaignID(campaignID),
+				CreatedBy:  int64(userID),
+			},
+		}
+		mockCampaignStoreService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
+			response,
+			nil,
+		)
+		actualValue, err := storeUseCase.GetStores(ctx, int64(campaignID))
+		ShouldEqual(actualValue, response)
+		ShouldBeNil(err)
+	})
+	t.Run("When campaign store details not exist, it returns error", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		campaignID := 101
+		response := []entities.CampaignStore{}
+		mockCampaignStoreService.On("GetByCampaignId", ctx, valueobjects.CampaignID(campaignID)).Return(
+			response,
+			errors.New("record Not Found"),
+		)
+		actualValue, err := storeUseCase.GetStores(ctx, int64(campaignID))
+		ShouldEqual(actualValue, response)
+		ShouldNotBeNil(err)
+	})
+}
+
+func TestCampaignStoreUseCase_GetStoreList(t *testing.T) {
+	filter := entities.CampaignStoreFilter{
+		PaginationConfig: entities.PaginationConfig{Limit: 2, Page: 2, Offset: 2, SortFields: []entities.SortField{{Column: "store_id"}}},
+	}
+
+	t.Run("when page of stores fetched successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		mockCampaignStoreService.On("GetList", ctx, valueobjects.CampaignID(1), filter).
+			Return([]entities.CampaignStore{{ID: 3, CampaignID: 1, StoreID: 84}}, int64(3), nil)
+		response, err := storeUseCase.GetStoreList(ctx, 1, filter)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		data := response.Data
+		if data.Count != 3 || data.Limit != 2 || data.Offset != 2 || data.CampaignID != 1 ||
+			len(data.Stores) != 1 || data.Stores[0].StoreID != 84 {
+			t.Errorf("unexpected response : got - %+v", data)
+		}
+	})
+	t.Run("when stores can not be fetched", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		mockCampaignStoreService.On("GetList", ctx, valueobjects.CampaignID(1), filter).
+			Return(nil, int64(0), fmt.Errorf("%w: db error", valueobjects.ErrStoreCantGet))
+		_, err := storeUseCase.GetStoreList(ctx, 1, filter)
+		if !errors.Is(err, valueobjects.ErrStoreCantGet) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreCantGet)
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_DeleteStores(t *testing.T) {
+	campaignID := int64(101)
+	userID := int64(232323)
+	t.Run("when all the stores for particular campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("DeleteByCampaignID", ctx, valueobjects.CampaignID(campaignID), userID).Return(nil)
+
+		err := storeUseCase.DeleteStores(ctx, campaignID, userID)
+		ShouldBeNil(err)
+	})
+
+	t.Run("error occured while deleting stores entries from db", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("DeleteByCampaignID", ctx, valueobjects.CampaignID(campaignID), userID).Return(
+			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
+		err := storeUseCase.DeleteStores(ctx, campaignID, userID)
+
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_DeleteStore(t *testing.T) {
+	campaignID := int64(101)
+	storeID := int64(2121)
+	userID := int64(232323)
+	t.Run("when store with given id deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("Delete", ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID),
+			userID).Return(nil)
+
+		err := storeUseCase.DeleteStore(ctx, campaignID, storeID, userID)
+		ShouldBeNil(err)
+	})
+
+	t.Run("error occured while deleting particular store entry from db", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("Delete", ctx, valueobjects.CampaignID(campaignID), valueobjects.CampaignStoreID(storeID),
+			userID).Return(fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
+		err := storeUseCase.DeleteStore(ctx, campaignID, storeID, userID)
+
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_GetByStoreID(t *testing.T) {
+	campaignID := valueobjects.CampaignID(123)
+	userID := 987654321
+	t.Run("when store with given campaign and store id fetched successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		storeDTO := dto.CampaignStores{
+			ID:      1,
+			StoreID: 1234,
+		}
+		mockCampaignStoreService.On("GetByStoreID", ctx, campaignID, int64(1234)).Return(entities.CampaignStore{
+			ID:         1,
+			StoreID:    1234,
+			CampaignID: valueobjects.CampaignID(campaignID),
+			CreatedBy:  int64(userID),
+		}, nil)
+
+		response, err := storeUseCase.GetByStoreID(ctx, int64(campaignID), int64(1234))
+		ShouldEqual(response, storeDTO)
+		ShouldBeNil(err)
+	})
+
+	t.Run("error occured while getting store details", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockCampaignStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+		mockCampaignStoreService.On("GetByStoreID", ctx, campaignID, int64(1234)).Return(entities.CampaignStore{},
+			fmt.Errorf("%w: %v", valueobjects.ErrStoreCantGet, errors.New("db error")))
+		_, err := storeUseCase.GetByStoreID(ctx, int64(campaignID), int64(1234))
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantGet) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignStoreUseCase_DeleteByStoreID(t *testing.T) {
+	campaignID := int64(101)
+	storeID := int64(2121)
+	userID := int64(232323)
+	t.Run("when store with given store id deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("DeleteByStoreID", ctx, valueobjects.CampaignID(campaignID), storeID,
+			userID).Return(nil)
+
+		err := storeUseCase.DeleteByStoreID(ctx, campaignID, storeID, userID)
+		ShouldBeNil(err)
+	})
+
+	t.Run("error occured while deleting store entry from db", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewCampaignStores(t)
+		storeUseCase := NewCampaignStoreUseCase(mockStoreService, newOpenStoreRegistry(t), mocks.NewStoreGroups(t))
+
+		mockStoreService.On("DeleteByStoreID", ctx, valueobjects.CampaignID(campaignID), storeID,
+			userID).Return(fmt.Errorf("%w: %v", valueobjects.ErrStoreCantDelete, errors.New("db error")))
+		err := storeUseCase.DeleteByStoreID(ctx, campaignID, storeID, userID)
+
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrStoreCantDelete) {
+			t.Error("invalid error type")
+		}
+	})
+}
+func TestCampaignStoreUseCase_ExpandStoreGroups(t *testing.T) {
+	t.Run("when active stores of the groups are added after the stores, each store once", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreRegistry := mocks.NewStores(t)
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		storeUseCase := NewCampaignStoreUseCase(mocks.NewCampaignStores(t), mockStoreRegistry, mockStoreGroupService)
+		mockStoreGroupService.On("GetByIDs", ctx, []int64{3, 4}).Return([]entities.StoreGroup{
+			{ID: 3, Name: "All Hypermarts", StoreIDs: []int64{1234, 5678, 9012}},
+			{ID: 4, Name: "Central region", StoreIDs: []int64{1234, 3456}},
+		}, nil)
+		mockStoreRegistry.On("GetByIDs", ctx, []int64{1234, 5678, 9012, 1234, 3456}).Return([]entities.Store{
+			{ID: 1234, IsActive: true}, {ID: 5678, IsActive: true}, {ID: 9012}, {ID: 3456, IsActive: true},
+		}, nil)
+
+		storeIDs, err := storeUseCase.ExpandStoreGroups(ctx, []int64{5678, 7890}, []int64{3, 4})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if expected := []int64{5678, 7890, 1234, 3456}; fmt.Sprint(storeIDs) != fmt.Sprint(expected) {
+			t.Errorf("unexpected stores : got - %v ; want - %v", storeIDs, expected)
+		}
+	})
+
+	t.Run("when store groups do not exist", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		storeUseCase := NewCampaignStoreUseCase(mocks.NewCampaignStores(t), mocks.NewStores(t), mockStoreGroupService)
+		mockStoreGroupService.On("GetByIDs", ctx, []int64{3, 4, 5}).Return([]entities.StoreGroup{{ID: 4}}, nil)
+
+		_, err := storeUseCase.ExpandStoreGroups(ctx, nil, []int64{3, 4, 5})
+		if !errors.Is(err, valueobjects.ErrStoreGroupNotExists) {
+			t.Fatalf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreGroupNotExists)
+		}
+		if expected := "store group not exists: 3, 5"; err.Error() != expected {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, expected)
+		}
+	})
+}
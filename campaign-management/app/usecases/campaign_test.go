@@ -1,440 +1,931 @@
-package usecases
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/services/mocks"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/dto"
-	"campaign-mgmt/app/usecases/util"
-	"context"
-	"errors"
-	"fmt"
-	"testing"
-	"time"
-
-	. "github.com/smartystreets/goconvey/convey"
-)
-
-func TestCampaignUseCase_ExistsOtherWay(t *testing.T) {
-	campaignService := mocks.NewCampaigns(t)
-	campaignUseCase := NewCampaignUseCase(campaignService)
-
-	Convey("Given a campaign has(exists) use case", t, func() {
-		ctx := context.Background()
-
-		Convey("When campaign exists, it returns true", func() {
-			campaignID := valueobjects.CampaignID(1)
-			campaignTitle := ""
-			campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
-				true,
-				nil,
-			)
-			actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
-			ShouldEqual(actualValue, true)
-			ShouldBeNil(err)
-		})
-
-		Convey("When campaign does not exist, it returns false", func() {
-			campaignID := valueobjects.CampaignID(2)
-			campaignTitle := ""
-			campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
-				false,
-				errors.New("something happened"),
-			)
-			actualValue, err := campaignUseCase.Exists(ctx, int64(2), campaignTitle)
-			ShouldEqual(actualValue, false)
-			ShouldNotBeNil(err)
-		})
-
-	})
-}
-
-func TestCampaignUseCase_Exists(t *testing.T) {
-	t.Run("When campaign exists, it returns true", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignID := valueobjects.CampaignID(1)
-		campaignTitle := ""
-		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
-			true,
-			nil,
-		)
-		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
-		ShouldEqual(actualValue, true)
-		ShouldBeNil(err)
-	})
-
-	t.Run("When campaign does not exist, it returns false", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignID := valueobjects.CampaignID(1)
-		campaignTitle := ""
-		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
-			true,
-			nil,
-		)
-		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
-		ShouldEqual(actualValue, true)
-		ShouldBeNil(err)
-	})
-
-	t.Run("When some error occured", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignID := valueobjects.CampaignID(1)
-		campaignTitle := ""
-		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
-			true,
-			valueobjects.ErrCampaignCantExist, errors.New("db error"),
-		)
-		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
-		ShouldEqual(actualValue, true)
-		ShouldBeNil(err)
-	})
-}
-
-func TestCampaignUseCase_ExistsOtherWay1(t *testing.T) {
-	campaignUseCase := NewCampaignUseCase(nil)
-	ctx := context.Background()
-	tests := []struct {
-		name          string
-		prepare       func()
-		expectedErr   error
-		campaignID    int64
-		campaignTitle string
-		expectedValue bool
-	}{
-		{
-			name: "when the campaign exist",
-			prepare: func() {
-				campaignService := mocks.NewCampaigns(t)
-				campaignUseCase = NewCampaignUseCase(campaignService)
-				campaignService.On("Exists", ctx, valueobjects.CampaignID(1), "").Return(
-					true,
-					nil,
-				)
-			},
-			expectedErr:   nil,
-			expectedValue: true,
-			campaignID:    1,
-			campaignTitle: "",
-		},
-
-		{
-			name: "when the campaign no exist",
-			prepare: func() {
-				campaignService := mocks.NewCampaigns(t)
-				campaignUseCase = NewCampaignUseCase(campaignService)
-				campaignService.On("Exists", ctx, valueobjects.CampaignID(2), "").Return(
-					false,
-					errors.New("something happenend"),
-				)
-			},
-			expectedErr:   errors.New("something happenend"),
-			expectedValue: false,
-			campaignID:    2,
-			campaignTitle: "",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-
-			actualValue, actualErr := campaignUseCase.Exists(ctx, tt.campaignID, tt.campaignTitle)
-			ShouldEqual(actualValue, tt.expectedValue)
-			ShouldEqual(actualErr, tt.expectedErr)
-		})
-	}
-}
-
-func TestCampaignUseCase_Get(t *testing.T) {
-	t.Run("When campaign details exist, it returns campaign Details", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignID := valueobjects.CampaignID(1)
-		response := entities.Campaign{
-			ID:                  campaignID,
-			Title:               "Test Campaign 1",
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			CreatedBy:           1,
-			OrderStartDate:      time.Date(2000, 1, 3, 12, 30, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2000, 1, 31, 12, 30, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2000, 1, 5, 12, 30, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2000, 2, 2, 12, 30, 0, 0, time.UTC),
-			LeadTime:            10,
-			OfferID:             1234,
-		}
-		campaignService.On("Get", ctx, campaignID).Return(
-			response,
-			nil,
-		)
-		actualValue, err := campaignUseCase.Get(ctx, int64(1))
-		ShouldEqual(actualValue, response)
-		ShouldBeNil(err)
-	})
-	t.Run("When campaign details not exist, it returns error", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignID := valueobjects.CampaignID(1000)
-		response := entities.Campaign{}
-		campaignService.On("Get", ctx, campaignID).Return(
-			response,
-			errors.New("record Not Found"),
-		)
-		actualValue, err := campaignUseCase.Get(ctx, int64(1000))
-		ShouldEqual(actualValue, response)
-		ShouldNotBeNil(err)
-	})
-}
-
-func TestCampaignUseCase_GetList(t *testing.T) {
-	t.Run("When campaign details exist, it returns campaigns list", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignDetails1 := entities.Campaign{
-			ID:                  1,
-			StatusCode:          int64(1),
-			Title:               "Test Campaign 1",
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			CreatedBy:           int64(12),
-			OrderStartDate:      time.Date(2000, 1, 3, 12, 30, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2000, 1, 31, 12, 30, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2000, 1, 5, 12, 30, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2000, 2, 2, 12, 30, 0, 0, time.UTC),
-			LeadTime:            10,
-			OfferID:             1234,
-		}
-		campaignDetails2 := entities.Campaign{
-			ID:                  2,
-			StatusCode:          int64(1),
-			Title:               "Test Campaign 2",
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title 2",
-			ListingDesc:         "test description ",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			CreatedBy:           int64(12),
-			OrderStartDate:      time.Date(2001, 1, 3, 12, 30, 0, 0, time.UTC),
-			OrderEndDate:        time.Date(2001, 1, 31, 12, 30, 0, 0, time.UTC),
-			CollectionStartDate: time.Date(2001, 1, 5, 12, 30, 0, 0, time.UTC),
-			CollectionEndDate:   time.Date(2001, 2, 2, 12, 30, 0, 0, time.UTC),
-			LeadTime:            10,
-			OfferID:             12345,
-		}
-		campaignEntities := []entities.Campaign{
-			campaignDetails1,
-			campaignDetails2,
-		}
-		responseDTO := dto.CampaignListResponse{
-			ListResponseFields: dto.ListResponseFields{Code: 200, Status: "SUCCESS"},
-			Data:               dto.ToCampaignDataList(campaignEntities, 2, entities.PaginationConfig{Limit: 20, Page: 1}),
-		}
-		campaignService.On("GetList", ctx, entities.PaginationConfig{Limit: 20, Page: 1}).Return(
-			campaignEntities,
-			int64(2),
-			nil,
-		)
-		actualValue, err := campaignUseCase.GetList(ctx, entities.PaginationConfig{Limit: 20, Page: 1})
-		ShouldEqual(actualValue, responseDTO)
-		ShouldBeNil(err)
-	})
-
-	t.Run("When campaign details does not exist, it returns error", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		var response []entities.Campaign
-		campaignService.On("GetList", ctx, entities.PaginationConfig{Limit: 20, Page: 1}).Return(
-			response, int64(0),
-			errors.New("records not found"),
-		)
-		actualValue, err := campaignUseCase.GetList(ctx, entities.PaginationConfig{Limit: 20, Page: 1})
-		ShouldEqual(actualValue, response)
-		ShouldNotBeNil(err)
-	})
-}
-
-func TestCampaignUseCase_Create(t *testing.T) {
-	dateStr := time.Now().Format("2006-01-02 15:04:05")
-	dateTime, _ := util.ToDateTime(dateStr)
-	campaignEntity := entities.Campaign{
-		Title:               "test_campaign",
-		StatusCode:          int64(1),
-		CampaignType:        "deli",
-		ListingTitle:        "listing screen title",
-		ListingDesc:         "test description",
-		ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		OnboardTitle:        "New Year:2023",
-		OnboardDesc:         "this is sample campaign 1",
-		OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		OrderStartDate:      dateTime,
-		OrderEndDate:        dateTime,
-		CollectionStartDate: dateTime,
-		CollectionEndDate:   dateTime,
-		OfferID:             int64(123),
-		TagID:               int64(456),
-		CreatedBy:           int64(12121212),
-		LeadTime:            10,
-	}
-	t.Run("when campaign creation is successful", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignDetails := dto.CampaignDTO{
-			ID:                  1,
-			Title:               "test_campaign",
-			Name:                "test_campaign",
-			StatusCode:          1,
-			CampaignType:        "deli",
-			ListingTitle:        "listing screen title",
-			ListingDesc:         "test description",
-			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OnboardTitle:        "New Year:2023",
-			OnboardDesc:         "this is sample campaign 1",
-			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-			OrderStartDate:      dateStr,
-			OrderEndDate:        dateStr,
-			CollectionStartDate: dateStr,
-			CollectionEndDate:   dateStr,
-			LeadTime:            10,
-			OfferID:             int64(123),
-			TagID:               int64(456),
-		}
-		campaignService.On("Create", ctx, campaignEntity).Return(
-			entities.Campaign{
-				ID:                  valueobjects.CampaignID(1),
-				Title:               "test_campaign",
-				StatusCode:          int64(1),
-				CampaignType:        "deli",
-				ListingTitle:        "listing screen title",
-				ListingDesc:         "test description",
-				ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-				OnboardTitle:        "New Year:2023",
-				OnboardDesc:         "this is sample campaign 1",
-				OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-				LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-				OrderStartDate:      dateTime,
-				OrderEndDate:        dateTime,
-				CollectionStartDate: dateTime,
-				CollectionEndDate:   dateTime,
-				OfferID:             int64(123),
-				TagID:               int64(456),
-			}, nil)
-		response, err := campaignUseCase.Create(ctx, campaignEntity)
-		ShouldEqual(response, campaignDetails)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while campaign creation", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignService.On("Create", ctx, campaignEntity).Return(
-			entities.Campaign{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantCreate, errors.New("db error")))
-		_, err := campaignUseCase.Create(ctx, campaignEntity)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrCampaignCantCreate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignUseCase_Update(t *testing.T) {
-	dateStr := time.Now().Format("2006-01-02 15:04:05")
-	dateTime, _ := util.ToDateTime(dateStr)
-	campaignEntity := entities.Campaign{
-		ID:                  valueobjects.CampaignID(1),
-		Title:               "test_campaign",
-		StatusCode:          int64(1),
-		CampaignType:        "deli",
-		ListingTitle:        "listing screen title",
-		ListingDesc:         "test description",
-		ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		OnboardTitle:        "New Year:2023",
-		OnboardDesc:         "this is sample campaign 1",
-		OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
-		OrderStartDate:      dateTime,
-		OrderEndDate:        dateTime,
-		CollectionStartDate: dateTime,
-		CollectionEndDate:   dateTime,
-		OfferID:             int64(123),
-		TagID:               int64(456),
-		UpdatedBy:           int64(12121212),
-	}
-	t.Run("when campaign update is successful", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-
-		campaignService.On("Update", ctx, campaignEntity).Return(nil)
-		err := campaignUseCase.Update(ctx, campaignEntity)
-		ShouldBeNil(err)
-	})
-	t.Run("when error occured while updating campaign details", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignService.On("Update", ctx, campaignEntity).Return(fmt.Errorf("%w: %v",
-			valueobjects.ErrCampaignCantUpdate, errors.New("db error")))
-		err := campaignUseCase.Update(ctx, campaignEntity)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrCampaignCantUpdate) {
-			t.Error("invalid error type")
-		}
-	})
-}
-
-func TestCampaignUseCase_UpdateStatus(t *testing.T) {
-	t.Run("when campaign updated successfully", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-
-		campaignService.On("UpdateStatus", ctx).Return(nil)
-		err := campaignUseCase.UpdateStatus(ctx)
-		ShouldBeNil(err)
-		if err != nil {
-			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
-		}
-	})
-	t.Run("when error occured while updating campaign  status", func(t *testing.T) {
-		ctx := context.Background()
-		campaignService := mocks.NewCampaigns(t)
-		campaignUseCase := NewCampaignUseCase(campaignService)
-		campaignService.On("UpdateStatus", ctx).Return(fmt.Errorf("%w: %v", valueobjects.ErrCampaignStatusCantUpdate, errors.New("db error")))
-		err := campaignUseCase.UpdateStatus(ctx)
-		ShouldNotBeNil(err)
-		ShouldEqual(err.Error(), "db error")
-		if !errors.As(err, &valueobjects.ErrCampaignStatusCantUpdate) {
-			t.Error("invalid error type")
-		}
-	})
-}
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/util"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCampaignUseCase_ExistsOtherWay(t *testing.T) {
+	campaignService := mocks.NewCampaigns(t)
+	campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+
+	Convey("Given a campaign has(exists) use case", t, func() {
+		ctx := context.Background()
+
+		Convey("When campaign exists, it returns true", func() {
+			campaignID := valueobjects.CampaignID(1)
+			campaignTitle := ""
+			campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
+				true,
+				nil,
+			)
+			actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
+			ShouldEqual(actualValue, true)
+			ShouldBeNil(err)
+		})
+
+		Convey("When campaign does not exist, it returns false", func() {
+			campaignID := valueobjects.CampaignID(2)
+			campaignTitle := ""
+			campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
+				false,
+				errors.New("something happened"),
+			)
+			actualValue, err := campaignUseCase.Exists(ctx, int64(2), campaignTitle)
+			ShouldEqual(actualValue, false)
+			ShouldNotBeNil(err)
+		})
+
+	})
+}
+
+func TestCampaignUseCase_Exists(t *testing.T) {
+	t.Run("When campaign exists, it returns true", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignID := valueobjects.CampaignID(1)
+		campaignTitle := ""
+		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
+			true,
+			nil,
+		)
+		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
+		ShouldEqual(actualValue, true)
+		ShouldBeNil(err)
+	})
+
+	t.Run("When campaign does not exist, it returns false", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignID := valueobjects.CampaignID(1)
+		campaignTitle := ""
+		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
+			true,
+			nil,
+		)
+		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
+		ShouldEqual(actualValue, true)
+		ShouldBeNil(err)
+	})
+
+	t.Run("When some error occured", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignID := valueobjects.CampaignID(1)
+		campaignTitle := ""
+		campaignService.On("Exists", ctx, campaignID, campaignTitle).Return(
+			true,
+			valueobjects.ErrCampaignCantExist, errors.New("db error"),
+		)
+		actualValue, err := campaignUseCase.Exists(ctx, int64(1), campaignTitle)
+		ShouldEqual(actualValue, true)
+		ShouldBeNil(err)
+	})
+}
+
+func TestCampaignUseCase_ExistsOtherWay1(t *testing.T) {
+	campaignUseCase := NewCampaignUseCase(nil, nil, nil, entities.OverlapConfig{})
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		prepare       func()
+		expectedErr   error
+		campaignID    int64
+		campaignTitle string
+		expectedValue bool
+	}{
+		{
+			name: "when the campaign exist",
+			prepare: func() {
+				campaignService := mocks.NewCampaigns(t)
+				campaignUseCase = NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+				campaignService.On("Exists", ctx, valueobjects.CampaignID(1), "").Return(
+					true,
+					nil,
+				)
+			},
+			expectedErr:   nil,
+			expectedValue: true,
+			campaignID:    1,
+			campaignTitle: "",
+		},
+
+		{
+			name: "when the campaign no exist",
+			prepare: func() {
+				campaignService := mocks.NewCampaigns(t)
+				campaignUseCase = NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+				campaignService.On("Exists", ctx, valueobjects.CampaignID(2), "").Return(
+					false,
+					errors.New("something happenend"),
+				)
+			},
+			expectedErr:   errors.New("something happenend"),
+			expectedValue: false,
+			campaignID:    2,
+			campaignTitle: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+
+			actualValue, actualErr := campaignUseCase.Exists(ctx, tt.campaignID, tt.campaignTitle)
+			ShouldEqual(actualValue, tt.expectedValue)
+			ShouldEqual(actualErr, tt.expectedErr)
+		})
+	}
+}
+
+func TestCampaignUseCase_Get(t *testing.T) {
+	t.Run("When campaign details exist, it returns campaign Details", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignID := valueobjects.CampaignID(1)
+		response := entities.Campaign{
+			ID:                  campaignID,
+			Title:               "Test Campaign 1",
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			CreatedBy:           1,
+			OrderStartDate:      time.Date(2000, 1, 3, 12, 30, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2000, 1, 31, 12, 30, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2000, 1, 5, 12, 30, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2000, 2, 2, 12, 30, 0, 0, time.UTC),
+			LeadTime:            10,
+			OfferID:             1234,
+		}
+		campaignService.On("Get", ctx, campaignID).Return(
+			response,
+			nil,
+		)
+		actualValue, err := campaignUseCase.Get(ctx, int64(1))
+		ShouldEqual(actualValue, response)
+		ShouldBeNil(err)
+	})
+	t.Run("When campaign details not exist, it returns error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignID := valueobjects.CampaignID(1000)
+		response := entities.Campaign{}
+		campaignService.On("Get", ctx, campaignID).Return(
+			response,
+			errors.New("record Not Found"),
+		)
+		actualValue, err := campaignUseCase.Get(ctx, int64(1000))
+		ShouldEqual(actualValue, response)
+		ShouldNotBeNil(err)
+	})
+}
+
+func TestCampaignUseCase_GetList(t *testing.T) {
+	t.Run("When campaign details exist, it returns campaigns list", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignDetails1 := entities.Campaign{
+			ID:                  1,
+			StatusCode:          int64(1),
+			Title:               "Test Campaign 1",
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			CreatedBy:           int64(12),
+			OrderStartDate:      time.Date(2000, 1, 3, 12, 30, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2000, 1, 31, 12, 30, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2000, 1, 5, 12, 30, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2000, 2, 2, 12, 30, 0, 0, time.UTC),
+			LeadTime:            10,
+			OfferID:             1234,
+		}
+		campaignDetails2 := entities.Campaign{
+			ID:                  2,
+			StatusCode:          int64(1),
+			Title:               "Test Campaign 2",
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title 2",
+			ListingDesc:         "test description ",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			CreatedBy:           int64(12),
+			OrderStartDate:      time.Date(2001, 1, 3, 12, 30, 0, 0, time.UTC),
+			OrderEndDate:        time.Date(2001, 1, 31, 12, 30, 0, 0, time.UTC),
+			CollectionStartDate: time.Date(2001, 1, 5, 12, 30, 0, 0, time.UTC),
+			CollectionEndDate:   time.Date(2001, 2, 2, 12, 30, 0, 0, time.UTC),
+			LeadTime:            10,
+			OfferID:             12345,
+		}
+		campaignEntities := []entities.Campaign{
+			campaignDetails1,
+			campaignDetails2,
+		}
+		responseDTO := dto.CampaignListResponse{
+			ListResponseFields: dto.ListResponseFields{Code: 200, Status: "SUCCESS"},
+			Data:               dto.ToCampaignDataList(campaignEntities, 2, entities.PaginationConfig{Limit: 20, Page: 1}),
+		}
+		campaignService.On("GetList", ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1}}).Return(
+			campaignEntities,
+			int64(2),
+			nil,
+		)
+		actualValue, err := campaignUseCase.GetList(ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1}})
+		ShouldEqual(actualValue, responseDTO)
+		ShouldBeNil(err)
+	})
+
+	t.Run("When campaign details does not exist, it returns error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		var response []entities.Campaign
+		campaignService.On("GetList", ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1}}).Return(
+			response, int64(0),
+			errors.New("records not found"),
+		)
+		actualValue, err := campaignUseCase.GetList(ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1}})
+		ShouldEqual(actualValue, response)
+		ShouldNotBeNil(err)
+	})
+	t.Run("When status name is given, it filters by the status code", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		campaignCodeService.On("GetByValue", ctx, "Cancelled").Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(4), StatusValue: "Cancelled"}, nil)
+		campaignService.On("GetList", ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1, Status: 4, StatusValue: "Cancelled"}}).Return(
+			[]entities.Campaign{}, int64(0), nil)
+		_, err := campaignUseCase.GetList(ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1, StatusValue: "Cancelled"}})
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+
+	t.Run("When status name is unknown, it returns error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(mocks.NewCampaigns(t), mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		campaignCodeService.On("GetByValue", ctx, "Unknown").Return(entities.CampaignCode{},
+			fmt.Errorf("%w: %s", valueobjects.ErrCampaignCodeNotExists, "Unknown"))
+		_, err := campaignUseCase.GetList(ctx, entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 20, Page: 1, StatusValue: "Unknown"}})
+		if !errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignStatusInvalid)
+		}
+	})
+}
+
+func TestCampaignUseCase_Create(t *testing.T) {
+	dateStr := time.Now().Format("2006-01-02 15:04:05")
+	dateTime, _ := util.ToDateTime(dateStr, time.UTC)
+	campaignEntity := entities.Campaign{
+		Title:               "test_campaign",
+		StatusCode:          int64(1),
+		CampaignType:        "deli",
+		ListingTitle:        "listing screen title",
+		ListingDesc:         "test description",
+		ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		OnboardTitle:        "New Year:2023",
+		OnboardDesc:         "this is sample campaign 1",
+		OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		OrderStartDate:      dateTime,
+		OrderEndDate:        dateTime,
+		CollectionStartDate: dateTime,
+		CollectionEndDate:   dateTime,
+		OfferID:             int64(123),
+		TagID:               int64(456),
+		CreatedBy:           int64(12121212),
+		LeadTime:            10,
+	}
+	t.Run("when campaign creation is successful", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignDetails := dto.CampaignDTO{
+			ID:                  1,
+			Title:               "test_campaign",
+			Name:                "test_campaign",
+			StatusCode:          1,
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			ListingDesc:         "test description",
+			ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OnboardTitle:        "New Year:2023",
+			OnboardDesc:         "this is sample campaign 1",
+			OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+			OrderStartDate:      dateStr,
+			OrderEndDate:        dateStr,
+			CollectionStartDate: dateStr,
+			CollectionEndDate:   dateStr,
+			LeadTime:            10,
+			OfferID:             int64(123),
+			TagID:               int64(456),
+		}
+		campaignService.On("Create", ctx, campaignEntity).Return(
+			entities.Campaign{
+				ID:                  valueobjects.CampaignID(1),
+				Title:               "test_campaign",
+				StatusCode:          int64(1),
+				CampaignType:        "deli",
+				ListingTitle:        "listing screen title",
+				ListingDesc:         "test description",
+				ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+				OnboardTitle:        "New Year:2023",
+				OnboardDesc:         "this is sample campaign 1",
+				OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+				LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+				OrderStartDate:      dateTime,
+				OrderEndDate:        dateTime,
+				CollectionStartDate: dateTime,
+				CollectionEndDate:   dateTime,
+				OfferID:             int64(123),
+				TagID:               int64(456),
+			}, nil)
+		response, err := campaignUseCase.Create(ctx, campaignEntity)
+		ShouldEqual(response, campaignDetails)
+		ShouldBeNil(err)
+	})
+	t.Run("when error occured while campaign creation", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Create", ctx, campaignEntity).Return(
+			entities.Campaign{}, fmt.Errorf("%w: %v", valueobjects.ErrCampaignCantCreate, errors.New("db error")))
+		_, err := campaignUseCase.Create(ctx, campaignEntity)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrCampaignCantCreate) {
+			t.Error("invalid error type")
+		}
+	})
+}
+
+func TestCampaignUseCase_Update(t *testing.T) {
+	dateStr := time.Now().Format("2006-01-02 15:04:05")
+	dateTime, _ := util.ToDateTime(dateStr, time.UTC)
+	campaignEntity := entities.Campaign{
+		ID:                  valueobjects.CampaignID(1),
+		Title:               "test_campaign",
+		StatusCode:          int64(1),
+		CampaignType:        "deli",
+		ListingTitle:        "listing screen title",
+		ListingDesc:         "test description",
+		ListingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		OnboardTitle:        "New Year:2023",
+		OnboardDesc:         "this is sample campaign 1",
+		OnboardImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		LandingImagePath:    "https://preprod-media.nedigital.sg/fairprice/images/img1.jpg",
+		OrderStartDate:      dateTime,
+		OrderEndDate:        dateTime,
+		CollectionStartDate: dateTime,
+		CollectionEndDate:   dateTime,
+		OfferID:             int64(123),
+		TagID:               int64(456),
+		UpdatedBy:           int64(12121212),
+	}
+	t.Run("when campaign update is successful", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, statusHistoryService, campaignCodeService, entities.OverlapConfig{})
+
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: int64(2)}, nil)
+		campaignCodeService.On("Get", ctx, valueobjects.CampaignStatusInActive).Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusInActive, StatusValue: "InActive"}, nil)
+		campaignService.On("Update", ctx, campaignEntity).Return(nil)
+		statusHistoryService.On("CreateMultiple", ctx, mock.MatchedBy(func(history []entities.CampaignStatusHistory) bool {
+			return len(history) == 1 && history[0].CampaignID == valueobjects.CampaignID(1) &&
+				history[0].OldStatusCode == 2 && history[0].NewStatusCode == 1 && history[0].Actor == "12121212"
+		})).Return(nil)
+		err := campaignUseCase.Update(ctx, campaignEntity)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+	t.Run("when campaign update keeps the status, no status change is recorded", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: int64(1)}, nil)
+		campaignService.On("Update", ctx, campaignEntity).Return(nil)
+		if err := campaignUseCase.Update(ctx, campaignEntity); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+	})
+	t.Run("when error occured while recording status change", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, statusHistoryService, campaignCodeService, entities.OverlapConfig{})
+
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: int64(2)}, nil)
+		campaignCodeService.On("Get", ctx, valueobjects.CampaignStatusInActive).Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusInActive, StatusValue: "InActive"}, nil)
+		campaignService.On("Update", ctx, campaignEntity).Return(nil)
+		statusHistoryService.On("CreateMultiple", ctx, mock.Anything).Return(
+			fmt.Errorf("%w: %v", valueobjects.ErrStatusHistoryCantCreate, errors.New("db error")))
+		err := campaignUseCase.Update(ctx, campaignEntity)
+		if !errors.Is(err, valueobjects.ErrStatusHistoryCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusHistoryCantCreate)
+		}
+	})
+	t.Run("when error occured while updating campaign details", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: int64(1)}, nil)
+		campaignService.On("Update", ctx, campaignEntity).Return(fmt.Errorf("%w: %v",
+			valueobjects.ErrCampaignCantUpdate, errors.New("db error")))
+		err := campaignUseCase.Update(ctx, campaignEntity)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrCampaignCantUpdate) {
+			t.Error("invalid error type")
+		}
+	})
+	t.Run("when error occured while getting current campaign details", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(entities.Campaign{}, errors.New("db error"))
+		err := campaignUseCase.Update(ctx, campaignEntity)
+		if err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+	})
+	t.Run("when inactive campaign is moved back to active", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		activeCampaign := campaignEntity
+		activeCampaign.StatusCode = valueobjects.CampaignStatusActive.Code()
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: valueobjects.CampaignStatusInActive.Code()}, nil)
+		err := campaignUseCase.Update(ctx, activeCampaign)
+		if !errors.Is(err, valueobjects.ErrCampaignStatusTransition) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignStatusTransition)
+		}
+	})
+	t.Run("when campaign is moved to a status missing from campaign codes", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		cancelledCampaign := campaignEntity
+		cancelledCampaign.StatusCode = 9
+		campaignService.On("Lock", ctx, valueobjects.CampaignID(1)).Return(
+			entities.Campaign{ID: valueobjects.CampaignID(1), StatusCode: valueobjects.CampaignStatusActive.Code()}, nil)
+		campaignCodeService.On("Get", ctx, valueobjects.CampaignStatusCode(9)).Return(entities.CampaignCode{},
+			fmt.Errorf("%w: %d", valueobjects.ErrCampaignCodeNotExists, 9))
+		err := campaignUseCase.Update(ctx, cancelledCampaign)
+		if !errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignStatusInvalid)
+		}
+	})
+}
+
+func TestCampaignUseCase_UpdateStatus(t *testing.T) {
+	t.Run("when campaign updated successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, statusHistoryService, mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+
+		campaignService.On("PublishCampaigns", ctx, valueobjects.CampaignStatusPublish, mock.AnythingOfType("time.Time")).
+			Return([]valueobjects.CampaignID{11, 12}, nil)
+		campaignService.On("DeactivateCampaigns", ctx, valueobjects.CampaignStatusDeactivate, mock.AnythingOfType("time.Time")).
+			Return([]valueobjects.CampaignID{13}, nil)
+		statusHistoryService.On("CreateMultiple", ctx, mock.MatchedBy(func(history []entities.CampaignStatusHistory) bool {
+			return len(history) == 3 &&
+				history[0].CampaignID == 11 && history[0].NewStatusCode == valueobjects.CampaignStatusActive.Code() &&
+				history[2].CampaignID == 13 && history[2].NewStatusCode == valueobjects.CampaignStatusInActive.Code() &&
+				history[2].Actor == valueobjects.StatusChangeActorScheduler
+		})).Return(nil)
+		result, err := campaignUseCase.UpdateStatus(ctx)
+		ShouldBeNil(err)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err.Error())
+		}
+		if result.Published != 2 || result.Deactivated != 1 {
+			t.Errorf("unexpected value : got - %+v ; want - published 2 deactivated 1", result)
+		}
+	})
+	t.Run("when error occured while updating campaign  status", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("PublishCampaigns", ctx, valueobjects.CampaignStatusPublish, mock.AnythingOfType("time.Time")).Return(nil,
+			fmt.Errorf("%w: %v", valueobjects.ErrCampaignStatusCantUpdate, errors.New("db error")))
+		_, err := campaignUseCase.UpdateStatus(ctx)
+		ShouldNotBeNil(err)
+		ShouldEqual(err.Error(), "db error")
+		if !errors.As(err, &valueobjects.ErrCampaignStatusCantUpdate) {
+			t.Error("invalid error type")
+		}
+	})
+	t.Run("when error occured while deactivating campaigns", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("PublishCampaigns", ctx, valueobjects.CampaignStatusPublish, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		campaignService.On("DeactivateCampaigns", ctx, valueobjects.CampaignStatusDeactivate, mock.AnythingOfType("time.Time")).Return(nil,
+			fmt.Errorf("%w: %v", valueobjects.ErrCampaignStatusCantUpdate, errors.New("db error")))
+		_, err := campaignUseCase.UpdateStatus(ctx)
+		if !errors.Is(err, valueobjects.ErrCampaignStatusCantUpdate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignStatusCantUpdate)
+		}
+	})
+	t.Run("when error occured while recording status changes", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		statusHistoryService := mocks.NewCampaignStatusHistory(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, statusHistoryService, mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("PublishCampaigns", ctx, valueobjects.CampaignStatusPublish, mock.AnythingOfType("time.Time")).
+			Return([]valueobjects.CampaignID{11}, nil)
+		campaignService.On("DeactivateCampaigns", ctx, valueobjects.CampaignStatusDeactivate, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		statusHistoryService.On("CreateMultiple", ctx, mock.Anything).Return(
+			fmt.Errorf("%w: %v", valueobjects.ErrStatusHistoryCantCreate, errors.New("db error")))
+		_, err := campaignUseCase.UpdateStatus(ctx)
+		if !errors.Is(err, valueobjects.ErrStatusHistoryCantCreate) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStatusHistoryCantCreate)
+		}
+	})
+}
+
+func TestCampaignUseCase_Delete(t *testing.T) {
+	t.Run("when campaign deleted successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Delete", ctx, valueobjects.CampaignID(1), int64(7)).Return(nil)
+		if err := campaignUseCase.Delete(ctx, 1, 7); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+	t.Run("when campaign not exists", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Delete", ctx, valueobjects.CampaignID(1), int64(7)).Return(
+			fmt.Errorf("%w: %d", valueobjects.ErrCampaignNotExists, 1))
+		if err := campaignUseCase.Delete(ctx, 1, 7); !errors.Is(err, valueobjects.ErrCampaignNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignNotExists)
+		}
+	})
+}
+
+func TestCampaignUseCase_Restore(t *testing.T) {
+	t.Run("when campaign restored successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Restore", ctx, valueobjects.CampaignID(1), int64(7)).Return(nil)
+		if err := campaignUseCase.Restore(ctx, 1, 7); err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+	})
+}
+
+func TestCampaignUseCase_Clone(t *testing.T) {
+	orderStartDate := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	cloneDetails := entities.Campaign{
+		Title:               "cloned campaign",
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderStartDate.Add(72 * time.Hour),
+		CollectionStartDate: orderStartDate.Add(24 * time.Hour),
+		CollectionEndDate:   orderStartDate.Add(96 * time.Hour),
+		Timezone:            "Asia/Singapore",
+		CreatedBy:           7,
+	}
+	t.Run("when campaign cloned successfully", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Get", ctx, valueobjects.CampaignID(1)).Return(entities.Campaign{
+			ID:                  valueobjects.CampaignID(1),
+			Title:               "campaign",
+			StatusCode:          valueobjects.CampaignStatusInActive.Code(),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			LeadTime:            1,
+			OfferID:             123,
+			IsCampaignPublished: true,
+			CreatedBy:           3,
+			UpdatedBy:           4,
+		}, nil)
+		expected := entities.Campaign{
+			Title:               "cloned campaign",
+			StatusCode:          valueobjects.CampaignStatusScheduled.Code(),
+			CampaignType:        "deli",
+			ListingTitle:        "listing screen title",
+			LeadTime:            1,
+			OfferID:             123,
+			OrderStartDate:      cloneDetails.OrderStartDate,
+			OrderEndDate:        cloneDetails.OrderEndDate,
+			CollectionStartDate: cloneDetails.CollectionStartDate,
+			CollectionEndDate:   cloneDetails.CollectionEndDate,
+			Timezone:            "Asia/Singapore",
+			CreatedBy:           7,
+		}
+		campaignService.On("Create", ctx, expected).Return(entities.Campaign{ID: valueobjects.CampaignID(2), Title: "cloned campaign"}, nil)
+		response, err := campaignUseCase.Clone(ctx, 1, cloneDetails)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.ID != 2 {
+			t.Errorf("unexpected campaign id : got - %v ; want - 2", response.ID)
+		}
+	})
+	t.Run("when error occured while getting cloned campaign", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		campaignService.On("Get", ctx, valueobjects.CampaignID(1)).Return(entities.Campaign{}, errors.New("db error"))
+		if _, err := campaignUseCase.Clone(ctx, 1, cloneDetails); err == nil || err.Error() != "db error" {
+			t.Errorf("unexpected error : got - %v ; want - db error", err)
+		}
+	})
+}
+
+func TestCampaignUseCase_GetList_Search(t *testing.T) {
+	t.Run("When campaigns are searched, the fields matching the search are highlighted", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		filter := entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 10}, Query: "Mooncake"}
+		campaignService.On("GetList", ctx, filter).Return([]entities.Campaign{
+			{ID: 7, Title: "Mid Autumn", ListingDesc: "Snow skin mooncakes", OnboardTitle: "Mooncake festival"},
+			{ID: 8, Title: "Lanterns"},
+		}, int64(2), nil)
+
+		response, err := campaignUseCase.GetList(ctx, filter)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		want := map[string]string{
+			"listing_description": "Snow skin <em>mooncake</em>s",
+			"onboarding_title":    "<em>Mooncake</em> festival",
+		}
+		if !reflect.DeepEqual(response.Data.Campaigns[0].Highlights, want) {
+			t.Errorf("unexpected highlights : got - %v ; want - %v", response.Data.Campaigns[0].Highlights, want)
+		}
+		if response.Data.Campaigns[1].Highlights != nil {
+			t.Errorf("unexpected highlights : got - %v ; want - nil", response.Data.Campaigns[1].Highlights)
+		}
+	})
+}
+
+func TestCampaignUseCase_GetSummary(t *testing.T) {
+	t.Run("When campaigns are summarised, every status is counted and the soon window is a week ahead", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		filter := entities.CampaignListFilter{CampaignType: "preorder"}
+		campaignService.On("GetSummary", ctx, filter, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(func(ctx context.Context, filter entities.CampaignListFilter, soonFrom, soonTo time.Time) entities.CampaignSummary {
+				if !soonTo.Equal(soonFrom.AddDate(0, 0, 7)) {
+					t.Errorf("unexpected soon window : got - %v to %v", soonFrom, soonTo)
+				}
+				return entities.CampaignSummary{
+					Total:        5,
+					StatusCounts: []entities.CampaignStatusCount{{StatusCode: 2, Count: 4}, {StatusCode: 9, Count: 1}},
+					StartingSoon: 1,
+				}
+			}, nil)
+		campaignCodeService.On("GetList", ctx).Return([]entities.CampaignCode{
+			{StatusCode: 1, StatusValue: "Scheduled"}, {StatusCode: 2, StatusValue: "Active"}}, nil)
+
+		response, err := campaignUseCase.GetSummary(ctx, filter)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		want := []dto.CampaignStatusCountDTO{
+			{StatusCode: 1, StatusValue: "Scheduled", Count: 0},
+			{StatusCode: 2, StatusValue: "Active", Count: 4},
+			{StatusCode: 9, Count: 1},
+		}
+		if !reflect.DeepEqual(response.Data.Statuses, want) {
+			t.Errorf("unexpected statuses : got - %+v ; want - %+v", response.Data.Statuses, want)
+		}
+		if response.Data.Total != 5 || response.Data.StartingSoon != 1 || response.Data.SoonDays != 7 {
+			t.Errorf("unexpected summary : got - %+v", response.Data)
+		}
+	})
+}
+
+func TestCampaignUseCase_GetListByCursor(t *testing.T) {
+	t.Run("When the page is got by cursor, the cursors of the pages around it are returned", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		filter := entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 10}, Cursor: "next"}
+		campaignService.On("GetListByCursor", ctx, filter).Return([]entities.Campaign{{ID: 7, Title: "Lunar New Year"}},
+			entities.PageCursors{Next: "after-7", Prev: "before-7"}, int64(25), nil)
+
+		response, err := campaignUseCase.GetListByCursor(ctx, filter)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.Data.Count != 25 || response.Data.NextCursor != "after-7" || response.Data.PrevCursor != "before-7" ||
+			len(response.Data.Campaigns) != 1 {
+			t.Errorf("unexpected response : got - %+v", response.Data)
+		}
+	})
+
+	t.Run("When the cursor is invalid, the error is returned", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		filter := entities.CampaignListFilter{PaginationConfig: entities.PaginationConfig{Limit: 10}, Cursor: "garbage"}
+		campaignService.On("GetListByCursor", ctx, filter).Return(nil, entities.PageCursors{}, int64(0),
+			valueobjects.ErrCampaignCursorInvalid)
+
+		_, err := campaignUseCase.GetListByCursor(ctx, filter)
+		if !errors.Is(err, valueobjects.ErrCampaignCursorInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignCursorInvalid)
+		}
+	})
+}
+
+func TestCampaignUseCase_GetStoreCampaigns(t *testing.T) {
+	t.Run("When status name is given, it filters by the status code and keeps the offset", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		campaignCodeService.On("GetByValue", ctx, "Active").Return(
+			entities.CampaignCode{StatusCode: valueobjects.CampaignStatusCode(2), StatusValue: "Active"}, nil)
+		campaignService.On("GetListByStore", ctx, int64(84), entities.StoreCampaignFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 2, Offset: 10, Status: 2, StatusValue: "Active"},
+		}).Return([]entities.Campaign{{ID: 7, Title: "Lunar New Year"}}, int64(11), nil)
+
+		response, err := campaignUseCase.GetStoreCampaigns(ctx, 84, entities.StoreCampaignFilter{
+			PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 2, Offset: 10, StatusValue: "Active"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.Data.Count != 11 || response.Data.Offset != 10 || len(response.Data.Campaigns) != 1 {
+			t.Errorf("unexpected response : got - %+v", response.Data)
+		}
+	})
+}
+
+func TestCampaignUseCase_GetProductCampaigns(t *testing.T) {
+	t.Run("When status name is unknown, it is an invalid status", func(t *testing.T) {
+		ctx := context.Background()
+		campaignCodeService := mocks.NewCampaignCodes(t)
+		campaignUseCase := NewCampaignUseCase(mocks.NewCampaigns(t), mocks.NewCampaignStatusHistory(t), campaignCodeService, entities.OverlapConfig{})
+		campaignCodeService.On("GetByValue", ctx, "Paused").Return(entities.CampaignCode{},
+			fmt.Errorf("%w: Paused", valueobjects.ErrCampaignCodeNotExists))
+
+		_, err := campaignUseCase.GetProductCampaigns(ctx, 501, entities.ProductCampaignFilter{
+			StoreCampaignFilter: entities.StoreCampaignFilter{PaginationConfig: entities.PaginationConfig{StatusValue: "Paused"}},
+		})
+		if !errors.Is(err, valueobjects.ErrCampaignStatusInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignStatusInvalid)
+		}
+	})
+
+	t.Run("When sku is given, it lists the campaigns selling the sku", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t), entities.OverlapConfig{})
+		filter := entities.ProductCampaignFilter{
+			StoreCampaignFilter: entities.StoreCampaignFilter{PaginationConfig: entities.PaginationConfig{Limit: 10, Page: 1}},
+			SKUNo:               9001,
+		}
+		campaignService.On("GetListByProduct", ctx, int64(501), filter).
+			Return([]entities.Campaign{{ID: 7}, {ID: 8}}, int64(2), nil)
+
+		response, err := campaignUseCase.GetProductCampaigns(ctx, 501, filter)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.Data.Count != 2 || len(response.Data.Campaigns) != 2 {
+			t.Errorf("unexpected response : got - %+v", response.Data)
+		}
+	})
+}
+
+func TestCampaignUseCase_CheckOverlaps(t *testing.T) {
+	overlaps := []entities.CampaignOverlap{
+		{CampaignID: 3, ProductID: 501, StoreID: 84},
+		{CampaignID: 9, ProductID: 501, StoreID: 84},
+		{CampaignID: 9, ProductID: 502, StoreID: 84},
+	}
+
+	t.Run("When overlap mode warns, it returns the overlapping campaigns", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t),
+			entities.OverlapConfig{Mode: valueobjects.OverlapModeWarn})
+		campaignService.On("GetOverlapping", ctx, valueobjects.CampaignID(7)).Return(overlaps, nil)
+
+		campaignIDs, err := campaignUseCase.CheckOverlaps(ctx, 7, nil)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if !reflect.DeepEqual(campaignIDs, []int64{3, 9}) {
+			t.Errorf("unexpected campaign ids : got - %v ; want - [3 9]", campaignIDs)
+		}
+	})
+
+	t.Run("When overlap mode rejects, overlapping campaigns are an error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t),
+			entities.OverlapConfig{Mode: valueobjects.OverlapModeReject})
+		campaignService.On("GetOverlapping", ctx, valueobjects.CampaignID(7)).Return(overlaps, nil)
+
+		_, err := campaignUseCase.CheckOverlaps(ctx, 7, nil)
+		if !errors.Is(err, valueobjects.ErrCampaignOverlap) {
+			t.Fatalf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCampaignOverlap)
+		}
+		if !strings.HasSuffix(err.Error(), "campaign ids 3, 9") {
+			t.Errorf("unexpected error : got - %v", err)
+		}
+	})
+
+	t.Run("When overlap mode rejects, overlaps the campaign had before the change are no error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t),
+			entities.OverlapConfig{Mode: valueobjects.OverlapModeReject})
+		campaignService.On("GetOverlapping", ctx, valueobjects.CampaignID(7)).Return(overlaps, nil)
+
+		campaignIDs, err := campaignUseCase.CheckOverlaps(ctx, 7, overlaps)
+		if err != nil || len(campaignIDs) != 0 {
+			t.Errorf("unexpected result : got - %v, %v ; want - [], nil", campaignIDs, err)
+		}
+	})
+
+	t.Run("When the change adds a product another campaign already overlaps on, only that campaign is reported", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t),
+			entities.OverlapConfig{Mode: valueobjects.OverlapModeReject})
+		campaignService.On("GetOverlapping", ctx, valueobjects.CampaignID(7)).Return(overlaps, nil)
+
+		_, err := campaignUseCase.CheckOverlaps(ctx, 7, overlaps[:2])
+		if !errors.Is(err, valueobjects.ErrCampaignOverlap) || !strings.HasSuffix(err.Error(), "campaign ids 9") {
+			t.Errorf("unexpected error : got - %v ; want - %v for campaign ids 9", err, valueobjects.ErrCampaignOverlap)
+		}
+	})
+
+	t.Run("When overlap mode rejects and nothing overlaps, it is no error", func(t *testing.T) {
+		ctx := context.Background()
+		campaignService := mocks.NewCampaigns(t)
+		campaignUseCase := NewCampaignUseCase(campaignService, mocks.NewCampaignStatusHistory(t), mocks.NewCampaignCodes(t),
+			entities.OverlapConfig{Mode: valueobjects.OverlapModeReject})
+		campaignService.On("GetOverlapping", ctx, valueobjects.CampaignID(7)).Return([]entities.CampaignOverlap{}, nil)
+
+		campaignIDs, err := campaignUseCase.CheckOverlaps(ctx, 7, nil)
+		if err != nil || len(campaignIDs) != 0 {
+			t.Errorf("unexpected result : got - %v, %v ; want - [], nil", campaignIDs, err)
+		}
+	})
+}
@@ -0,0 +1,321 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+	"campaign-mgmt/app/usecases/util"
+)
+
+// MaxCollectionSlotDays is the longest date range slots are worked out for in one call
+const MaxCollectionSlotDays = 92
+
+type CollectionSlotUseCase struct {
+	campaignRepo         services.Campaigns
+	campaignStoreRepo    services.CampaignStores
+	dailyTimeSlotRepo    services.StoreDailyTimeSlots
+	specificTimeSlotRepo services.StoreSpecificTimeSlots
+	blackoutRepo         services.Blackouts
+	reservationRepo      services.CollectionSlotReservations
+	transactionService   services.TransactionService
+	holdTTL              time.Duration
+	now                  func() time.Time
+}
+
+func NewCollectionSlotUseCase(campaignRepo services.Campaigns, campaignStoreRepo services.CampaignStores,
+	dailyTimeSlotRepo services.StoreDailyTimeSlots, specificTimeSlotRepo services.StoreSpecificTimeSlots,
+	blackoutRepo services.Blackouts, reservationRepo services.CollectionSlotReservations, transactionService services.TransactionService,
+	reservationConfig entities.ReservationConfig) *CollectionSlotUseCase {
+	return &CollectionSlotUseCase{
+		campaignRepo:         campaignRepo,
+		campaignStoreRepo:    campaignStoreRepo,
+		dailyTimeSlotRepo:    dailyTimeSlotRepo,
+		specificTimeSlotRepo: specificTimeSlotRepo,
+		blackoutRepo:         blackoutRepo,
+		reservationRepo:      reservationRepo,
+		transactionService:   transactionService,
+		holdTTL:              reservationConfig.HoldTTL,
+		now:                  time.Now,
+	}
+}
+
+// GetAvailableSlots works out the slots the store offers for the campaign on every date from..to.
+// A zero from starts at today and a zero to ends with the collection end date, both are clipped to the collection dates
+func (c *CollectionSlotUseCase) GetAvailableSlots(ctx context.Context, campaignID, storeID int64, from, to time.Time) (*dto.CollectionSlotAvailability, error) {
+	campaign, err := c.campaignRepo.Get(ctx, valueobjects.CampaignID(campaignID))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.campaignStoreRepo.GetByStoreID(ctx, campaign.ID, storeID); err != nil {
+		return nil, err
+	}
+	loc, err := util.LoadLocation(campaign.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("campaign %d has invalid timezone %q : %v", campaignID, campaign.Timezone, err)
+	}
+
+	from, to, err = c.slotDates(campaign, loc, from, to)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := c.collectionSlots(ctx, campaign, storeID, loc, from, to, c.now())
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToCollectionSlotAvailability(campaign, storeID, from, to, slots)
+	return &response, nil
+}
+
+// ReserveSlot takes quota of the slot for the campaign, a held reservation gives it back when its hold expires.
+// The slot row stays locked from the quota check till the reservation is written so concurrent
+// reservations of the slot can not take more than its quota
+func (c *CollectionSlotUseCase) ReserveSlot(ctx context.Context, reservation entities.CollectionSlotReservation) (*dto.CollectionSlotReservationDTO, error) {
+	if reservation.Quantity < 1 {
+		return nil, fmt.Errorf("%w: quantity must be at least 1", valueobjects.ErrTimeSlotInvalid)
+	}
+	campaign, err := c.campaignRepo.Get(ctx, reservation.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := util.LoadLocation(campaign.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("campaign %d has invalid timezone %q : %v", campaign.ID, campaign.Timezone, err)
+	}
+
+	var created entities.CollectionSlotReservation
+	err = c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		storeID, err := c.reservationRepo.LockSlot(ctx, reservation.Slot.Source, reservation.Slot.SlotID)
+		if err != nil {
+			return err
+		}
+		if _, err := c.campaignStoreRepo.GetByStoreID(ctx, campaign.ID, storeID); err != nil {
+			return err
+		}
+		now := c.now()
+		slots, err := c.collectionSlots(ctx, campaign, storeID, loc, reservation.Slot.Date, reservation.Slot.Date, now)
+		if err != nil {
+			return err
+		}
+		var slot *entities.CollectionSlot
+		for i := range slots {
+			if slots[i].Source == reservation.Slot.Source && slots[i].SlotID == reservation.Slot.SlotID {
+				slot = &slots[i]
+				break
+			}
+		}
+		if slot == nil {
+			return fmt.Errorf("%w: %v for campaign %d", valueobjects.ErrCollectionSlotNotExists, reservation.Slot, campaign.ID)
+		}
+		if slot.RemainingQuota < reservation.Quantity {
+			return fmt.Errorf("%w: %v has %d left, %d asked", valueobjects.ErrCollectionSlotFull,
+				reservation.Slot, slot.RemainingQuota, reservation.Quantity)
+		}
+
+		reservation.StoreID = storeID
+		if reservation.Status == valueobjects.ReservationStatusHeld {
+			reservation.ExpiresAt = now.Add(c.holdTTL)
+		}
+		created, err = c.reservationRepo.Create(ctx, reservation)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToCollectionSlotReservationDTO(created), nil
+}
+
+// ConfirmReservation keeps the quota of a held reservation for good, confirming a confirmed reservation changes nothing
+func (c *CollectionSlotUseCase) ConfirmReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID, userID int64) (*dto.CollectionSlotReservationDTO, error) {
+	reservation, err := c.slotReservation(ctx, slot, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	confirmed, err := c.reservationRepo.Confirm(ctx, reservation.ID, userID, c.now())
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		reservation, err = c.reservationRepo.Get(ctx, reservation.ID)
+		if err != nil {
+			return nil, err
+		}
+		switch reservation.Status {
+		case valueobjects.ReservationStatusConfirmed:
+			return dto.ToCollectionSlotReservationDTO(reservation), nil
+		case valueobjects.ReservationStatusReleased:
+			return nil, fmt.Errorf("%w: %d", valueobjects.ErrReservationReleased, reservationID)
+		default:
+			return nil, fmt.Errorf("%w: %d expired at %v", valueobjects.ErrReservationExpired, reservationID, reservation.ExpiresAt)
+		}
+	}
+	reservation.Status = valueobjects.ReservationStatusConfirmed
+	reservation.ExpiresAt = time.Time{}
+	reservation.UpdatedBy = userID
+	return dto.ToCollectionSlotReservationDTO(reservation), nil
+}
+
+// ReleaseReservation gives the quota of the reservation back
+func (c *CollectionSlotUseCase) ReleaseReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID, userID int64) error {
+	reservation, err := c.slotReservation(ctx, slot, reservationID)
+	if err != nil {
+		return err
+	}
+	released, err := c.reservationRepo.Release(ctx, reservation.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return fmt.Errorf("%w: %d", valueobjects.ErrReservationReleased, reservationID)
+	}
+	return nil
+}
+
+// slotReservation returns the reservation when it was made for the slot
+func (c *CollectionSlotUseCase) slotReservation(ctx context.Context, slot valueobjects.SlotKey, reservationID int64) (entities.CollectionSlotReservation, error) {
+	reservation, err := c.reservationRepo.Get(ctx, valueobjects.ReservationID(reservationID))
+	if err != nil {
+		return reservation, err
+	}
+	if reservation.Slot.String() != slot.String() {
+		return reservation, fmt.Errorf("%w: %d for slot %v", valueobjects.ErrReservationNotExists, reservationID, slot)
+	}
+	return reservation, nil
+}
+
+// slotDates returns the dates the slots are worked out for as midnight UTC, to is before from when
+// the range falls outside the collection dates
+func (c *CollectionSlotUseCase) slotDates(campaign entities.Campaign, loc *time.Location, from, to time.Time) (time.Time, time.Time, error) {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, fmt.Errorf("%w: to date must not be before from date", valueobjects.ErrTimeSlotInvalid)
+	}
+	requestedTo := !to.IsZero()
+	if from.IsZero() {
+		from = civilDate(c.now().In(loc))
+	}
+	if !campaign.CollectionStartDate.IsZero() {
+		if collectionStart := civilDate(campaign.CollectionStartDate.In(loc)); from.Before(collectionStart) {
+			from = collectionStart
+		}
+	}
+	if !campaign.CollectionEndDate.IsZero() {
+		if collectionEnd := civilDate(campaign.CollectionEndDate.In(loc)); to.IsZero() || to.After(collectionEnd) {
+			to = collectionEnd
+		}
+	}
+	lastDate := from.AddDate(0, 0, MaxCollectionSlotDays-1)
+	if to.IsZero() || (!requestedTo && to.After(lastDate)) {
+		to = lastDate
+	}
+	if to.After(lastDate) {
+		return from, to, fmt.Errorf("%w: range can cover at most %d days", valueobjects.ErrTimeSlotInvalid, MaxCollectionSlotDays)
+	}
+	return from, to, nil
+}
+
+// collectionSlots merges the weekly slots of the store with the slots set for particular dates, the slots of a date
+// replace the weekly ones while a closure or a blackout of the store or of every store leaves the date without slots. Slots outside the collection dates or
+// starting within the lead time are left out, the quota of the rest is reduced by the reservations active at now
+func (c *CollectionSlotUseCase) collectionSlots(ctx context.Context, campaign entities.Campaign, storeID int64,
+	loc *time.Location, from, to, now time.Time) ([]entities.CollectionSlot, error) {
+	slots := []entities.CollectionSlot{}
+	if to.Before(from) {
+		return slots, nil
+	}
+	dailySlots, err := c.dailyTimeSlotRepo.GetList(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	specificSlots, err := c.specificTimeSlotRepo.GetList(ctx, storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	dailySlotsByWeekday := map[time.Weekday][]entities.StoreDailyTimeSlot{}
+	for _, slot := range dailySlots {
+		if slot.IsSlotAvailable {
+			dailySlotsByWeekday[slot.DayOfWeek] = append(dailySlotsByWeekday[slot.DayOfWeek], slot)
+		}
+	}
+	specificSlotsByDate := map[string][]entities.StoreSpecificTimeSlot{}
+	for _, slot := range specificSlots {
+		date := slot.Date.Format(valueobjects.DateLayout)
+		specificSlotsByDate[date] = append(specificSlotsByDate[date], slot)
+	}
+	blackouts, err := c.blackoutRepo.GetList(ctx, storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	reservedQuantities, err := c.reservationRepo.GetReservedQuantities(ctx, storeID, from, to, now)
+	if err != nil {
+		return nil, err
+	}
+	reservedBySlot := map[string]int{}
+	for _, reserved := range reservedQuantities {
+		reservedBySlot[reserved.Slot.String()] += reserved.Quantity
+	}
+
+	earliestStart := now.Add(time.Duration(campaign.LeadTime) * 24 * time.Hour)
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		if blackedOut(blackouts, date) {
+			continue
+		}
+		var dateSlots []entities.CollectionSlot
+		if overrides, ok := specificSlotsByDate[date.Format(valueobjects.DateLayout)]; ok {
+			for _, slot := range overrides {
+				if slot.IsClosed {
+					dateSlots = nil
+					break
+				}
+				dateSlots = append(dateSlots, entities.CollectionSlot{StartTime: slot.StartTime, EndTime: slot.EndTime,
+					Source: valueobjects.SlotSourceSpecific, SlotID: slot.ID.ToInt64(), Quota: slot.Quota})
+			}
+		} else {
+			for _, slot := range dailySlotsByWeekday[date.Weekday()] {
+				dateSlots = append(dateSlots, entities.CollectionSlot{StartTime: slot.StartTime, EndTime: slot.EndTime,
+					Source: valueobjects.SlotSourceDaily, SlotID: slot.ID.ToInt64(), Quota: slot.Quota})
+			}
+		}
+
+		midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+		for _, slot := range dateSlots {
+			slot.CampaignID = campaign.ID
+			slot.StoreID = storeID
+			slot.Date = date
+			slot.StartAt = slot.StartTime.On(midnight)
+			slot.EndAt = slot.EndTime.On(midnight)
+			slot.RemainingQuota = slot.Quota - reservedBySlot[slot.Key().String()]
+			if slot.RemainingQuota < 0 {
+				slot.RemainingQuota = 0
+			}
+			if slot.StartAt.Before(earliestStart) {
+				continue
+			}
+			if !campaign.CollectionStartDate.IsZero() && slot.StartAt.Before(campaign.CollectionStartDate) {
+				continue
+			}
+			if !campaign.CollectionEndDate.IsZero() && slot.EndAt.After(campaign.CollectionEndDate) {
+				continue
+			}
+			slots = append(slots, slot)
+		}
+	}
+	return slots, nil
+}
+
+func blackedOut(blackouts []entities.Blackout, date time.Time) bool {
+	for _, blackout := range blackouts {
+		if blackout.Covers(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// civilDate returns the calendar date of t as midnight UTC
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
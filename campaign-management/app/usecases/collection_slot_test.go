@@ -0,0 +1,456 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectionSlotUseCase_GetAvailableSlots(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Singapore")
+	campaign := entities.Campaign{
+		ID:                  3,
+		Timezone:            "Asia/Singapore",
+		CollectionStartDate: time.Date(2024, time.February, 8, 12, 0, 0, 0, loc),
+		CollectionEndDate:   time.Date(2024, time.February, 12, 14, 0, 0, 0, loc),
+		LeadTime:            1,
+	}
+	dailySlots := []entities.StoreDailyTimeSlot{}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		dailySlots = append(dailySlots,
+			entities.StoreDailyTimeSlot{ID: 1, StoreID: 84, DayOfWeek: weekday, StartTime: 540, EndTime: 660, Quota: 10, IsSlotAvailable: true},
+			entities.StoreDailyTimeSlot{ID: 2, StoreID: 84, DayOfWeek: weekday, StartTime: 780, EndTime: 900, Quota: 20, IsSlotAvailable: true},
+			entities.StoreDailyTimeSlot{ID: 3, StoreID: 84, DayOfWeek: weekday, StartTime: 960, EndTime: 1020, Quota: 5},
+		)
+	}
+	firstDate := time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC)
+	lastDate := time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC)
+	specificSlots := []entities.StoreSpecificTimeSlot{
+		{ID: 6, StoreID: 84, Date: time.Date(2024, time.February, 9, 0, 0, 0, 0, time.UTC), IsClosed: true},
+		{ID: 7, StoreID: 84, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), StartTime: 600, EndTime: 720, Quota: 50},
+	}
+
+	t.Run("when weekly slots are merged with the slots of particular dates", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockBlackoutService := mocks.NewBlackouts(t)
+		mockReservationService := mocks.NewCollectionSlotReservations(t)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService, mockDailyTimeSlotService,
+			mockSpecificTimeSlotService, mockBlackoutService, mockReservationService, mocks.NewTransactionService(t), entities.ReservationConfig{})
+		collectionSlotUseCase.now = func() time.Time { return time.Date(2024, time.February, 7, 10, 0, 0, 0, loc) }
+		mockCampaignService.On("Get", ctx, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", ctx, valueobjects.CampaignID(3), int64(84)).Return(entities.CampaignStore{StoreID: 84}, nil)
+		mockDailyTimeSlotService.On("GetList", ctx, int64(84)).Return(dailySlots, nil)
+		mockSpecificTimeSlotService.On("GetList", ctx, int64(84), firstDate, lastDate).Return(specificSlots, nil)
+		mockBlackoutService.On("GetList", ctx, int64(84), firstDate, lastDate).Return([]entities.Blackout{}, nil)
+		reserved := []entities.ReservedQuantity{
+			{Slot: valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 2, Date: firstDate}, Quantity: 25},
+			{Slot: valueobjects.SlotKey{Source: valueobjects.SlotSourceSpecific, SlotID: 7, Date: specificSlots[1].Date}, Quantity: 8},
+		}
+		mockReservationService.On("GetReservedQuantities", ctx, int64(84), firstDate, lastDate, mock.Anything).Return(reserved, nil)
+
+		response, err := collectionSlotUseCase.GetAvailableSlots(ctx, 3, 84, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.From != "2024-02-08" || response.To != "2024-02-12" {
+			t.Errorf("unexpected range : got - %v..%v ; want - 2024-02-08..2024-02-12", response.From, response.To)
+		}
+		expected := []string{
+			"2024-02-08 13:00 daily 2", "2024-02-10 10:00 specific 7", "2024-02-11 09:00 daily 1",
+			"2024-02-11 13:00 daily 2", "2024-02-12 09:00 daily 1",
+		}
+		var got []string
+		for _, slot := range response.Slots {
+			got = append(got, fmt.Sprintf("%s %s %s %d", slot.Date, slot.StartTime, slot.Source, slot.SlotID))
+		}
+		if fmt.Sprint(got) != fmt.Sprint(expected) {
+			t.Errorf("unexpected slots : got - %v ; want - %v", got, expected)
+		}
+		if slot := response.Slots[0]; slot.RemainingQuota != 0 || slot.Slot != "daily-2-2024-02-08" {
+			t.Errorf("unexpected slot : got - %+v", slot)
+		}
+		if slot := response.Slots[1]; slot.RemainingQuota != 42 || !slot.StartAt.Equal(time.Date(2024, time.February, 10, 10, 0, 0, 0, loc)) {
+			t.Errorf("unexpected slot : got - %+v", slot)
+		}
+	})
+
+	t.Run("when slots start within the lead time", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockBlackoutService := mocks.NewBlackouts(t)
+		mockReservationService := mocks.NewCollectionSlotReservations(t)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService, mockDailyTimeSlotService,
+			mockSpecificTimeSlotService, mockBlackoutService, mockReservationService, mocks.NewTransactionService(t), entities.ReservationConfig{})
+		collectionSlotUseCase.now = func() time.Time { return time.Date(2024, time.February, 10, 10, 30, 0, 0, loc) }
+		mockCampaignService.On("Get", ctx, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", ctx, valueobjects.CampaignID(3), int64(84)).Return(entities.CampaignStore{StoreID: 84}, nil)
+		mockDailyTimeSlotService.On("GetList", ctx, int64(84)).Return(dailySlots, nil)
+		mockSpecificTimeSlotService.On("GetList", ctx, int64(84), mock.Anything, mock.Anything).Return([]entities.StoreSpecificTimeSlot{}, nil)
+		mockBlackoutService.On("GetList", ctx, int64(84), mock.Anything, mock.Anything).Return([]entities.Blackout{}, nil)
+		mockReservationService.On("GetReservedQuantities", ctx, int64(84), mock.Anything, mock.Anything, mock.Anything).
+			Return([]entities.ReservedQuantity{}, nil)
+
+		response, err := collectionSlotUseCase.GetAvailableSlots(ctx, 3, 84, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response.Slots) != 2 || response.Slots[0].Date != "2024-02-11" || response.Slots[0].StartTime != "13:00" {
+			t.Errorf("unexpected slots : got - %+v", response.Slots)
+		}
+	})
+
+	t.Run("when dates are blacked out", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockBlackoutService := mocks.NewBlackouts(t)
+		mockReservationService := mocks.NewCollectionSlotReservations(t)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService, mockDailyTimeSlotService,
+			mockSpecificTimeSlotService, mockBlackoutService, mockReservationService, mocks.NewTransactionService(t), entities.ReservationConfig{})
+		collectionSlotUseCase.now = func() time.Time { return time.Date(2024, time.February, 7, 10, 0, 0, 0, loc) }
+		mockCampaignService.On("Get", ctx, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", ctx, valueobjects.CampaignID(3), int64(84)).Return(entities.CampaignStore{StoreID: 84}, nil)
+		mockDailyTimeSlotService.On("GetList", ctx, int64(84)).Return(dailySlots, nil)
+		mockSpecificTimeSlotService.On("GetList", ctx, int64(84), firstDate, lastDate).Return(specificSlots, nil)
+		blackouts := []entities.Blackout{
+			{ID: 1, StartDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)},
+			{ID: 2, StoreID: 84, StartDate: time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		}
+		mockBlackoutService.On("GetList", ctx, int64(84), firstDate, lastDate).Return(blackouts, nil)
+		mockReservationService.On("GetReservedQuantities", ctx, int64(84), firstDate, lastDate, mock.Anything).
+			Return([]entities.ReservedQuantity{}, nil)
+
+		response, err := collectionSlotUseCase.GetAvailableSlots(ctx, 3, 84, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		expected := []string{"2024-02-08 13:00 daily 2", "2024-02-11 09:00 daily 1", "2024-02-11 13:00 daily 2"}
+		var got []string
+		for _, slot := range response.Slots {
+			got = append(got, fmt.Sprintf("%s %s %s %d", slot.Date, slot.StartTime, slot.Source, slot.SlotID))
+		}
+		if fmt.Sprint(got) != fmt.Sprint(expected) {
+			t.Errorf("unexpected slots : got - %v ; want - %v", got, expected)
+		}
+	})
+
+	t.Run("when store is not part of the campaign", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService,
+			mocks.NewStoreDailyTimeSlots(t), mocks.NewStoreSpecificTimeSlots(t), mocks.NewBlackouts(t), mocks.NewCollectionSlotReservations(t),
+			mocks.NewTransactionService(t), entities.ReservationConfig{})
+		mockCampaignService.On("Get", ctx, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", ctx, valueobjects.CampaignID(3), int64(99)).
+			Return(entities.CampaignStore{}, fmt.Errorf("%w", valueobjects.ErrStoreNotExists))
+
+		_, err := collectionSlotUseCase.GetAvailableSlots(ctx, 3, 99, time.Time{}, time.Time{})
+		if !errors.Is(err, valueobjects.ErrStoreNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreNotExists)
+		}
+	})
+
+	t.Run("when range is longer than allowed", func(t *testing.T) {
+		ctx := context.Background()
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService,
+			mocks.NewStoreDailyTimeSlots(t), mocks.NewStoreSpecificTimeSlots(t), mocks.NewBlackouts(t), mocks.NewCollectionSlotReservations(t),
+			mocks.NewTransactionService(t), entities.ReservationConfig{})
+		openCampaign := entities.Campaign{ID: 3, Timezone: "Asia/Singapore"}
+		mockCampaignService.On("Get", ctx, valueobjects.CampaignID(3)).Return(openCampaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", ctx, valueobjects.CampaignID(3), int64(84)).Return(entities.CampaignStore{StoreID: 84}, nil)
+
+		_, err := collectionSlotUseCase.GetAvailableSlots(ctx, 3, 84, firstDate, firstDate.AddDate(1, 0, 0))
+		if !errors.Is(err, valueobjects.ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrTimeSlotInvalid)
+		}
+	})
+}
+
+// fakeTransactions runs every function in its own transaction and releases the slot locks taken in it when it ends. It
+// only stands in for the database, that MySQL locks the slot row is covered by TestCollectionSlotReservationService_LockSlot
+type fakeTransactions struct {
+	mocks.TransactionService
+}
+
+type fakeTxKey struct{}
+
+type fakeTx struct {
+	locks []*sync.Mutex
+}
+
+func (f *fakeTransactions) RunWithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := &fakeTx{}
+	defer func() {
+		for _, lock := range tx.locks {
+			lock.Unlock()
+		}
+	}()
+	return fn(context.WithValue(ctx, fakeTxKey{}, tx))
+}
+
+// fakeReservations keeps the reservations in memory, a slot lock is a mutex held till the fake transaction ends in place
+// of the row lock LockSlot takes
+type fakeReservations struct {
+	mocks.CollectionSlotReservations
+	mu           sync.Mutex
+	slotLocks    map[string]*sync.Mutex
+	reservations []entities.CollectionSlotReservation
+}
+
+func (f *fakeReservations) LockSlot(ctx context.Context, source valueobjects.SlotSource, slotID int64) (int64, error) {
+	tx, ok := ctx.Value(fakeTxKey{}).(*fakeTx)
+	if !ok {
+		return 0, fmt.Errorf("%w: slot lock needs a transaction", valueobjects.ErrReservationCantCreate)
+	}
+	f.mu.Lock()
+	key := fmt.Sprintf("%s-%d", source, slotID)
+	lock, ok := f.slotLocks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		f.slotLocks[key] = lock
+	}
+	f.mu.Unlock()
+	lock.Lock()
+	tx.locks = append(tx.locks, lock)
+	return 84, nil
+}
+
+func (f *fakeReservations) GetReservedQuantities(ctx context.Context, storeID int64, from, to, now time.Time) ([]entities.ReservedQuantity, error) {
+	f.mu.Lock()
+	quantities := []entities.ReservedQuantity{}
+	for _, reservation := range f.reservations {
+		if reservation.IsActive(now) {
+			quantities = append(quantities, entities.ReservedQuantity{Slot: reservation.Slot, Quantity: reservation.Quantity})
+		}
+	}
+	f.mu.Unlock()
+	// give other reservations the chance to run between the quota check and the insert
+	runtime.Gosched()
+	return quantities, nil
+}
+
+func (f *fakeReservations) Create(ctx context.Context, reservation entities.CollectionSlotReservation) (entities.CollectionSlotReservation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	reservation.ID = valueobjects.ReservationID(len(f.reservations) + 1)
+	f.reservations = append(f.reservations, reservation)
+	return reservation, nil
+}
+
+func TestCollectionSlotUseCase_ReserveSlot(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Singapore")
+	campaign := entities.Campaign{ID: 3, Timezone: "Asia/Singapore"}
+	slotDate := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 1, Date: slotDate}
+	dailySlots := []entities.StoreDailyTimeSlot{
+		{ID: 1, StoreID: 84, DayOfWeek: time.Saturday, StartTime: 540, EndTime: 660, Quota: 10, IsSlotAvailable: true},
+	}
+	now := time.Date(2024, time.February, 7, 10, 0, 0, 0, loc)
+
+	newUseCase := func(t *testing.T, reservations *fakeReservations) *CollectionSlotUseCase {
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockBlackoutService := mocks.NewBlackouts(t)
+		mockCampaignService.On("Get", mock.Anything, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", mock.Anything, valueobjects.CampaignID(3), int64(84)).
+			Return(entities.CampaignStore{StoreID: 84}, nil)
+		mockDailyTimeSlotService.On("GetList", mock.Anything, int64(84)).Return(dailySlots, nil)
+		mockSpecificTimeSlotService.On("GetList", mock.Anything, int64(84), slotDate, slotDate).
+			Return([]entities.StoreSpecificTimeSlot{}, nil)
+		mockBlackoutService.On("GetList", mock.Anything, int64(84), slotDate, slotDate).Return([]entities.Blackout{}, nil)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService, mockDailyTimeSlotService,
+			mockSpecificTimeSlotService, mockBlackoutService, reservations, &fakeTransactions{}, entities.ReservationConfig{HoldTTL: 10 * time.Minute})
+		collectionSlotUseCase.now = func() time.Time { return now }
+		return collectionSlotUseCase
+	}
+
+	// the fakes serialise on the slot lock the way the row lock would, so this shows the use case reads the quota and
+	// inserts under the lock, not that the database takes it
+	t.Run("when reservations of a slot run concurrently under the slot lock, the quota is not oversold", func(t *testing.T) {
+		reservations := &fakeReservations{slotLocks: map[string]*sync.Mutex{}}
+		collectionSlotUseCase := newUseCase(t, reservations)
+
+		const attempts = 40
+		var wg sync.WaitGroup
+		var reserved, full, failed int32
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				status := valueobjects.ReservationStatusHeld
+				if i%2 == 0 {
+					status = valueobjects.ReservationStatusConfirmed
+				}
+				_, err := collectionSlotUseCase.ReserveSlot(context.Background(), entities.CollectionSlotReservation{
+					CampaignID: 3, Slot: slotKey, Quantity: 1, Status: status,
+				})
+				switch {
+				case err == nil:
+					atomic.AddInt32(&reserved, 1)
+				case errors.Is(err, valueobjects.ErrCollectionSlotFull):
+					atomic.AddInt32(&full, 1)
+				default:
+					atomic.AddInt32(&failed, 1)
+					t.Errorf("unexpected error : got - %v", err)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		if reserved != 10 || full != attempts-10 || failed != 0 {
+			t.Errorf("unexpected outcome : got - %d reserved, %d full ; want - 10 reserved, %d full", reserved, full, attempts-10)
+		}
+		if len(reservations.reservations) != 10 {
+			t.Errorf("unexpected stored reservations : got - %d ; want - 10", len(reservations.reservations))
+		}
+	})
+
+	t.Run("when expired holds give their quota back", func(t *testing.T) {
+		reservations := &fakeReservations{slotLocks: map[string]*sync.Mutex{}}
+		for i := 0; i < 10; i++ {
+			reservations.reservations = append(reservations.reservations, entities.CollectionSlotReservation{
+				Slot: slotKey, Quantity: 1, Status: valueobjects.ReservationStatusHeld, ExpiresAt: now.Add(-time.Minute),
+			})
+		}
+		collectionSlotUseCase := newUseCase(t, reservations)
+
+		response, err := collectionSlotUseCase.ReserveSlot(context.Background(), entities.CollectionSlotReservation{
+			CampaignID: 3, Slot: slotKey, Quantity: 10, Status: valueobjects.ReservationStatusHeld,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.StoreID != 84 || response.ExpiresAt == nil || !response.ExpiresAt.Equal(now.Add(10*time.Minute)) {
+			t.Errorf("unexpected reservation : got - %+v", response)
+		}
+	})
+
+	t.Run("when slot is not offered on the date", func(t *testing.T) {
+		reservations := &fakeReservations{slotLocks: map[string]*sync.Mutex{}}
+		mockCampaignService := mocks.NewCampaigns(t)
+		mockCampaignStoreService := mocks.NewCampaignStores(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockBlackoutService := mocks.NewBlackouts(t)
+		otherDate := slotDate.AddDate(0, 0, 1)
+		mockCampaignService.On("Get", mock.Anything, valueobjects.CampaignID(3)).Return(campaign, nil)
+		mockCampaignStoreService.On("GetByStoreID", mock.Anything, valueobjects.CampaignID(3), int64(84)).
+			Return(entities.CampaignStore{StoreID: 84}, nil)
+		mockDailyTimeSlotService.On("GetList", mock.Anything, int64(84)).Return(dailySlots, nil)
+		mockSpecificTimeSlotService.On("GetList", mock.Anything, int64(84), otherDate, otherDate).
+			Return([]entities.StoreSpecificTimeSlot{}, nil)
+		mockBlackoutService.On("GetList", mock.Anything, int64(84), otherDate, otherDate).Return([]entities.Blackout{}, nil)
+		collectionSlotUseCase := NewCollectionSlotUseCase(mockCampaignService, mockCampaignStoreService, mockDailyTimeSlotService,
+			mockSpecificTimeSlotService, mockBlackoutService, reservations, &fakeTransactions{}, entities.ReservationConfig{HoldTTL: time.Minute})
+		collectionSlotUseCase.now = func() time.Time { return now }
+
+		_, err := collectionSlotUseCase.ReserveSlot(context.Background(), entities.CollectionSlotReservation{
+			CampaignID: 3, Slot: valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 1, Date: otherDate}, Quantity: 1,
+		})
+		if !errors.Is(err, valueobjects.ErrCollectionSlotNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrCollectionSlotNotExists)
+		}
+	})
+}
+
+func TestCollectionSlotUseCase_ConfirmReservation(t *testing.T) {
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 1, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+	now := time.Date(2024, time.February, 7, 2, 0, 0, 0, time.UTC)
+	held := entities.CollectionSlotReservation{ID: 5, CampaignID: 3, StoreID: 84, Slot: slotKey, Quantity: 2,
+		Status: valueobjects.ReservationStatusHeld, ExpiresAt: now.Add(-time.Minute)}
+
+	tests := []struct {
+		name        string
+		slot        valueobjects.SlotKey
+		confirmed   bool
+		stored      entities.CollectionSlotReservation
+		expectedErr error
+	}{
+		{name: "when hold is confirmed", slot: slotKey, confirmed: true},
+		{name: "when reservation was confirmed before", slot: slotKey,
+			stored: entities.CollectionSlotReservation{ID: 5, Slot: slotKey, Status: valueobjects.ReservationStatusConfirmed}},
+		{name: "when hold expired", slot: slotKey, stored: held, expectedErr: valueobjects.ErrReservationExpired},
+		{name: "when reservation was released", slot: slotKey,
+			stored:      entities.CollectionSlotReservation{ID: 5, Slot: slotKey, Status: valueobjects.ReservationStatusReleased},
+			expectedErr: valueobjects.ErrReservationReleased},
+		{name: "when reservation belongs to another slot", slot: valueobjects.SlotKey{Source: valueobjects.SlotSourceDaily, SlotID: 2, Date: slotKey.Date},
+			expectedErr: valueobjects.ErrReservationNotExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockReservationService := mocks.NewCollectionSlotReservations(t)
+			collectionSlotUseCase := NewCollectionSlotUseCase(mocks.NewCampaigns(t), mocks.NewCampaignStores(t), mocks.NewStoreDailyTimeSlots(t),
+				mocks.NewStoreSpecificTimeSlots(t), mocks.NewBlackouts(t), mockReservationService, mocks.NewTransactionService(t), entities.ReservationConfig{})
+			collectionSlotUseCase.now = func() time.Time { return now }
+			mockReservationService.On("Get", ctx, valueobjects.ReservationID(5)).Return(held, nil).Once()
+			if tt.slot == slotKey {
+				mockReservationService.On("Confirm", ctx, valueobjects.ReservationID(5), int64(7), now).Return(tt.confirmed, nil)
+				if !tt.confirmed {
+					mockReservationService.On("Get", ctx, valueobjects.ReservationID(5)).Return(tt.stored, nil).Once()
+				}
+			}
+
+			response, err := collectionSlotUseCase.ConfirmReservation(ctx, tt.slot, 5, 7)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+			if err == nil && (response.Status != string(valueobjects.ReservationStatusConfirmed) || response.ExpiresAt != nil) {
+				t.Errorf("unexpected reservation : got - %+v", response)
+			}
+		})
+	}
+}
+
+func TestCollectionSlotUseCase_ReleaseReservation(t *testing.T) {
+	slotKey := valueobjects.SlotKey{Source: valueobjects.SlotSourceSpecific, SlotID: 4, Date: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)}
+	reservation := entities.CollectionSlotReservation{ID: 5, Slot: slotKey, Quantity: 1, Status: valueobjects.ReservationStatusConfirmed}
+
+	tests := []struct {
+		name        string
+		released    bool
+		expectedErr error
+	}{
+		{name: "when reservation is released", released: true},
+		{name: "when reservation was released before", expectedErr: valueobjects.ErrReservationReleased},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockReservationService := mocks.NewCollectionSlotReservations(t)
+			collectionSlotUseCase := NewCollectionSlotUseCase(mocks.NewCampaigns(t), mocks.NewCampaignStores(t), mocks.NewStoreDailyTimeSlots(t),
+				mocks.NewStoreSpecificTimeSlots(t), mocks.NewBlackouts(t), mockReservationService, mocks.NewTransactionService(t), entities.ReservationConfig{})
+			mockReservationService.On("Get", ctx, valueobjects.ReservationID(5)).Return(reservation, nil)
+			mockReservationService.On("Release", ctx, valueobjects.ReservationID(5), int64(7)).Return(tt.released, nil)
+
+			err := collectionSlotUseCase.ReleaseReservation(ctx, slotKey, 5, 7)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"net/http"
+)
+
+// BlackoutDTO ..
+type BlackoutDTO struct {
+	// Blackout identifier
+	ID int64 `json:"blackout_id"`
+	// Store the blackout applies to, 0 for every store
+	StoreID int64 `json:"store_id"`
+	// First blacked out date, YYYY-MM-DD
+	StartDate string `json:"start_date"`
+	// Last blacked out date, YYYY-MM-DD
+	EndDate string `json:"end_date"`
+	// Why collections are closed
+	Reason string `json:"reason"`
+	// Identifier of the calendar event the blackout was imported from
+	UID string `json:"uid,omitempty"`
+}
+
+type BlackoutResponse struct {
+	ListResponseFields
+	Data *BlackoutDTO `json:"data"`
+}
+
+type BlackoutListResponse struct {
+	ListResponseFields
+	Data []*BlackoutDTO `json:"data"`
+}
+
+// BlackoutImportResult ..
+type BlackoutImportResult struct {
+	// Number of events added as new blackouts
+	Created int `json:"created"`
+	// Number of events that updated the blackouts imported from them before
+	Updated int `json:"updated"`
+	// Blackouts of the imported events
+	Blackouts []*BlackoutDTO `json:"blackouts"`
+}
+
+type BlackoutImportResponse struct {
+	ListResponseFields
+	Data *BlackoutImportResult `json:"data"`
+}
+
+func ToBlackoutDTO(blackout entities.Blackout) *BlackoutDTO {
+	return &BlackoutDTO{
+		ID:        blackout.ID.ToInt64(),
+		StoreID:   blackout.StoreID,
+		StartDate: blackout.StartDate.Format(valueobjects.DateLayout),
+		EndDate:   blackout.EndDate.Format(valueobjects.DateLayout),
+		Reason:    blackout.Reason,
+		UID:       blackout.UID,
+	}
+}
+
+func ToBlackoutResponse(blackout *BlackoutDTO) BlackoutResponse {
+	return BlackoutResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: blackout,
+	}
+}
+
+func ToBlackoutListResponse(blackouts []*BlackoutDTO) BlackoutListResponse {
+	return BlackoutListResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: blackouts,
+	}
+}
+
+func ToBlackoutImportResponse(result *BlackoutImportResult) BlackoutImportResponse {
+	return BlackoutImportResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: result,
+	}
+}
@@ -1,133 +1,247 @@
-package dto
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"net/http"
-	"time"
-)
-
-// CampaignDTO ..
-// swagger:response CampaignDTO
-type CampaignDTO struct {
-	// Campaign identifier
-	ID int64 `json:"id"`
-	// Campaign Title
-	Title string `json:"campaign_title"`
-	// Campaign Name
-	Name string `json:"name"`
-	// Campaign Status
-	StatusCode int `json:"campaign_status_code"`
-	// Campaign Type
-	CampaignType string `json:"campaign_type"`
-	// Campaign listing screen title
-	ListingTitle string `json:"listing_title"`
-	// Campaign listing screen description
-	ListingDesc string `json:"listing_description"`
-	// Campaign listing screen image path
-	ListingImagePath string `json:"listing_image_path"`
-	// Campaign onboarding screen title
-	OnboardTitle string `json:"onboarding_title"`
-	// Campaign obboarding screen description
-	OnboardDesc string `json:"onboarding_description"`
-	// Campaign onboarding screen image path
-	OnboardImagePath string `json:"onboard_image_path"`
-	// Campaign landing screen image path
-	LandingImagePath string `json:"landing_image_path"`
-	// Campaign order start date
-	OrderStartDate string `json:"order_start_date"`
-	// Campaign order end date
-	OrderEndDate string `json:"order_end_date"`
-	// Campaign collection start date
-	CollectionStartDate string `json:"collection_start_date"`
-	// Campaign collection end date
-	CollectionEndDate string `json:"collection_end_date"`
-	// Campaign lead time in days
-	LeadTime int `json:"lead_time"`
-	// Offer Identifier
-	OfferID int64 `json:"offer_id"`
-	// Tag Identifier
-	TagID int64 `json:"tag_id"`
-	// Is campaign published flag
-	IsCampaignPublished bool `json:"is_campaign_published"`
-	// Product Details.
-	CampaignProducts []*CampaignProducts `json:"campaign_products,omitempty"`
-	// Stores Details.
-	CampaignStores []*CampaignStores `json:"campaign_stores,omitempty"`
-}
-
-func formatDate(date time.Time) string {
-	if date.IsZero() {
-		return ""
-	} else {
-		return date.Format("2006-01-02 15:04:05")
-	}
-}
-
-func ToCampaignDTO(campaignEntity entities.Campaign) CampaignDTO {
-	return CampaignDTO{
-		ID:                  campaignEntity.ID.ToInt64(),
-		Title:               campaignEntity.Title,
-		Name:                campaignEntity.Title,
-		StatusCode:          int(campaignEntity.StatusCode),
-		CampaignType:        string(campaignEntity.CampaignType),
-		ListingTitle:        campaignEntity.ListingTitle,
-		ListingDesc:         campaignEntity.ListingDesc,
-		ListingImagePath:    campaignEntity.ListingImagePath,
-		OnboardTitle:        campaignEntity.OnboardTitle,
-		OnboardDesc:         campaignEntity.OnboardDesc,
-		OnboardImagePath:    campaignEntity.OnboardImagePath,
-		LandingImagePath:    campaignEntity.LandingImagePath,
-		OrderStartDate:      formatDate(campaignEntity.OrderStartDate),
-		OrderEndDate:        formatDate(campaignEntity.OrderEndDate),
-		CollectionStartDate: formatDate(campaignEntity.CollectionStartDate),
-		CollectionEndDate:   formatDate(campaignEntity.CollectionEndDate),
-		LeadTime:            campaignEntity.LeadTime,
-		OfferID:             campaignEntity.OfferID,
-		TagID:               campaignEntity.TagID,
-		IsCampaignPublished: campaignEntity.IsCampaignPublished,
-	}
-}
-
-type CampaignListResponse struct {
-	ListResponseFields
-	Data DataList `json:"data"`
-}
-
-type CampaignResponse struct {
-	ListResponseFields
-	Data CampaignDTO `json:"data"`
-}
-
-type DataList struct {
-	PaginationFields
-	Campaigns []CampaignDTO `json:"campaigns"`
-}
-
-func ToCampaignDataList(entries []entities.Campaign, count int64, paginationData entities.PaginationConfig) DataList {
-	var campaigns = make([]CampaignDTO, 0)
-	for _, entry := range entries {
-		campaign := ToCampaignDTO(entry)
-		campaigns = append(campaigns, campaign)
-	}
-
-	return DataList{
-		PaginationFields{Count: count, Limit: paginationData.Limit, Offset: 0},
-		campaigns,
-	}
-}
-func ToCampaignListResponse(dataList DataList) CampaignListResponse {
-	return CampaignListResponse{
-		ListResponseFields{http.StatusOK, "SUCCESS"},
-		dataList,
-	}
-}
-
-func ToCampaignResponse(Campaign CampaignDTO) CampaignResponse {
-	return CampaignResponse{
-		ListResponseFields: ListResponseFields{
-			Code:   http.StatusOK,
-			Status: "SUCCESS",
-		},
-		Data: Campaign,
-	}
-}
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/usecases/util"
+	"net/http"
+	"time"
+)
+
+// CampaignDTO ..
+// swagger:response CampaignDTO
+type CampaignDTO struct {
+	// Campaign identifier
+	ID int64 `json:"id"`
+	// Campaign Title
+	Title string `json:"campaign_title"`
+	// Campaign Name
+	Name string `json:"name"`
+	// Campaign Status
+	StatusCode int `json:"campaign_status_code"`
+	// Campaign Type
+	CampaignType string `json:"campaign_type"`
+	// Campaign listing screen title
+	ListingTitle string `json:"listing_title"`
+	// Campaign listing screen description
+	ListingDesc string `json:"listing_description"`
+	// Campaign listing screen image path
+	ListingImagePath string `json:"listing_image_path"`
+	// Campaign onboarding screen title
+	OnboardTitle string `json:"onboarding_title"`
+	// Campaign obboarding screen description
+	OnboardDesc string `json:"onboarding_description"`
+	// Campaign onboarding screen image path
+	OnboardImagePath string `json:"onboard_image_path"`
+	// Campaign landing screen image path
+	LandingImagePath string `json:"landing_image_path"`
+	// Campaign order start date
+	OrderStartDate string `json:"order_start_date"`
+	// Campaign order end date
+	OrderEndDate string `json:"order_end_date"`
+	// Campaign collection start date
+	CollectionStartDate string `json:"collection_start_date"`
+	// Campaign collection end date
+	CollectionEndDate string `json:"collection_end_date"`
+	// Timezone the campaign dates are rendered in
+	Timezone string `json:"timezone"`
+	// Campaign lead time in days
+	LeadTime int `json:"lead_time"`
+	// Offer Identifier
+	OfferID int64 `json:"offer_id"`
+	// Tag Identifier
+	TagID int64 `json:"tag_id"`
+	// Is campaign published flag
+	IsCampaignPublished bool `json:"is_campaign_published"`
+	// Campaign deletion date, only set when deleted campaigns are listed
+	DeletedAt string `json:"deleted_at,omitempty"`
+	// Snippets of the fields matching a search with the matching words in <em>, keyed by the field name
+	Highlights map[string]string `json:"highlights,omitempty"`
+	// Product Details.
+	CampaignProducts []*CampaignProducts `json:"campaign_products,omitempty"`
+	// Stores Details.
+	CampaignStores []*CampaignStores `json:"campaign_stores,omitempty"`
+}
+
+func formatDate(date time.Time, loc *time.Location) string {
+	if date.IsZero() {
+		return ""
+	} else {
+		return date.In(loc).Format(util.DateTimeLayout)
+	}
+}
+
+func ToCampaignDTO(campaignEntity entities.Campaign) CampaignDTO {
+	// a timezone the host does not know renders in UTC
+	loc, err := util.LoadLocation(campaignEntity.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return CampaignDTO{
+		ID:                  campaignEntity.ID.ToInt64(),
+		Title:               campaignEntity.Title,
+		Name:                campaignEntity.Title,
+		StatusCode:          int(campaignEntity.StatusCode),
+		CampaignType:        string(campaignEntity.CampaignType),
+		ListingTitle:        campaignEntity.ListingTitle,
+		ListingDesc:         campaignEntity.ListingDesc,
+		ListingImagePath:    campaignEntity.ListingImagePath,
+		OnboardTitle:        campaignEntity.OnboardTitle,
+		OnboardDesc:         campaignEntity.OnboardDesc,
+		OnboardImagePath:    campaignEntity.OnboardImagePath,
+		LandingImagePath:    campaignEntity.LandingImagePath,
+		OrderStartDate:      formatDate(campaignEntity.OrderStartDate, loc),
+		OrderEndDate:        formatDate(campaignEntity.OrderEndDate, loc),
+		CollectionStartDate: formatDate(campaignEntity.CollectionStartDate, loc),
+		CollectionEndDate:   formatDate(campaignEntity.CollectionEndDate, loc),
+		Timezone:            campaignEntity.Timezone,
+		LeadTime:            campaignEntity.LeadTime,
+		OfferID:             campaignEntity.OfferID,
+		TagID:               campaignEntity.TagID,
+		IsCampaignPublished: campaignEntity.IsCampaignPublished,
+		DeletedAt:           formatDate(campaignEntity.DeletedAt, loc),
+	}
+}
+
+// CampaignStatusUpdateDTO ..
+type CampaignStatusUpdateDTO struct {
+	// Number of campaigns moved to active
+	Published int64 `json:"published"`
+	// Number of campaigns moved to inactive
+	Deactivated int64 `json:"deactivated"`
+}
+
+type CampaignListResponse struct {
+	ListResponseFields
+	Data DataList `json:"data"`
+}
+
+type CampaignResponse struct {
+	ListResponseFields
+	Data CampaignDTO `json:"data"`
+}
+
+type DataList struct {
+	PaginationFields
+	Campaigns []CampaignDTO `json:"campaigns"`
+}
+
+func ToCampaignDataList(entries []entities.Campaign, count int64, paginationData entities.PaginationConfig) DataList {
+	var campaigns = make([]CampaignDTO, 0)
+	for _, entry := range entries {
+		campaign := ToCampaignDTO(entry)
+		campaigns = append(campaigns, campaign)
+	}
+
+	return DataList{
+		PaginationFields{Count: count, Limit: paginationData.Limit, Offset: paginationData.Offset},
+		campaigns,
+	}
+}
+
+// highlightWidth is the length in characters of the snippets of a campaign search
+const highlightWidth = 160
+
+// WithHighlights sets the snippets of the searchable fields of each campaign containing any of the search terms
+func WithHighlights(campaigns []CampaignDTO, terms []string) []CampaignDTO {
+	for i, campaign := range campaigns {
+		highlights := map[string]string{}
+		for field, text := range map[string]string{
+			"campaign_title":         campaign.Title,
+			"listing_title":          campaign.ListingTitle,
+			"listing_description":    campaign.ListingDesc,
+			"onboarding_title":       campaign.OnboardTitle,
+			"onboarding_description": campaign.OnboardDesc,
+		} {
+			if snippet, ok := util.Highlight(text, terms, highlightWidth); ok {
+				highlights[field] = snippet
+			}
+		}
+		if len(highlights) > 0 {
+			campaigns[i].Highlights = highlights
+		}
+	}
+	return campaigns
+}
+
+type CampaignSummaryResponse struct {
+	ListResponseFields
+	Data CampaignSummaryDTO `json:"data"`
+}
+
+// CampaignSummaryDTO ..
+// swagger:response CampaignSummaryDTO
+type CampaignSummaryDTO struct {
+	// Number of campaigns matching the filters
+	Total int64 `json:"total"`
+	// Number of campaigns in each status, statuses without campaigns included
+	Statuses []CampaignStatusCountDTO `json:"statuses"`
+	// Number of days ahead the campaigns starting and ending soon are counted over
+	SoonDays int `json:"soon_days"`
+	// Number of campaigns taking orders from within the soon days
+	StartingSoon int64 `json:"starting_soon"`
+	// Number of campaigns closing orders within the soon days
+	EndingSoon int64 `json:"ending_soon"`
+	// Number of campaigns without any store
+	WithoutStores int64 `json:"without_stores"`
+	// Number of campaigns without any product
+	WithoutProducts int64 `json:"without_products"`
+}
+
+type CampaignStatusCountDTO struct {
+	StatusCode  int64  `json:"status_code"`
+	StatusValue string `json:"status_value"`
+	Count       int64  `json:"count"`
+}
+
+// ToCampaignSummaryResponse lists a count for every campaign code in code order, a status left without a code is
+// listed after them by its status code alone
+func ToCampaignSummaryResponse(summary entities.CampaignSummary, campaignCodes []entities.CampaignCode, soonDays int) CampaignSummaryResponse {
+	counts := map[int64]int64{}
+	for _, statusCount := range summary.StatusCounts {
+		counts[statusCount.StatusCode.Code()] = statusCount.Count
+	}
+	statuses := []CampaignStatusCountDTO{}
+	for _, campaignCode := range campaignCodes {
+		statusCode := campaignCode.StatusCode.Code()
+		statuses = append(statuses, CampaignStatusCountDTO{
+			StatusCode:  statusCode,
+			StatusValue: campaignCode.StatusValue,
+			Count:       counts[statusCode],
+		})
+		delete(counts, statusCode)
+	}
+	for _, statusCount := range summary.StatusCounts {
+		if count, ok := counts[statusCount.StatusCode.Code()]; ok {
+			statuses = append(statuses, CampaignStatusCountDTO{StatusCode: statusCount.StatusCode.Code(), Count: count})
+		}
+	}
+	return CampaignSummaryResponse{
+		ListResponseFields{http.StatusOK, "SUCCESS"},
+		CampaignSummaryDTO{
+			Total:           summary.Total,
+			Statuses:        statuses,
+			SoonDays:        soonDays,
+			StartingSoon:    summary.StartingSoon,
+			EndingSoon:      summary.EndingSoon,
+			WithoutStores:   summary.WithoutStores,
+			WithoutProducts: summary.WithoutProducts,
+		},
+	}
+}
+
+func ToCampaignListResponse(dataList DataList) CampaignListResponse {
+	return CampaignListResponse{
+		ListResponseFields{http.StatusOK, "SUCCESS"},
+		dataList,
+	}
+}
+
+func ToCampaignResponse(Campaign CampaignDTO) CampaignResponse {
+	return CampaignResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: Campaign,
+	}
+}
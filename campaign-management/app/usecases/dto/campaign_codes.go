@@ -1,8 +1,53 @@
-package dto
-
-// CampaignCodeDTO ..
-// swagger:response CampaignCodeDTO
-type CampaignCodeDTO struct {
-	StatusCode  int64  `json:"status_code"`
-	StatusValue string `json:"status_value"`
-}
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"net/http"
+)
+
+// CampaignCodeDTO ..
+// swagger:response CampaignCodeDTO
+type CampaignCodeDTO struct {
+	StatusCode  int64  `json:"status_code"`
+	StatusValue string `json:"status_value"`
+	// System statuses are managed by the status job and can not be renamed
+	System bool `json:"system"`
+}
+
+type CampaignCodeResponse struct {
+	ListResponseFields
+	Data *CampaignCodeDTO `json:"data"`
+}
+
+type CampaignCodeListResponse struct {
+	ListResponseFields
+	Data []*CampaignCodeDTO `json:"data"`
+}
+
+func ToCampaignCodeDTO(campaignCode entities.CampaignCode) *CampaignCodeDTO {
+	return &CampaignCodeDTO{
+		StatusCode:  campaignCode.StatusCode.Code(),
+		StatusValue: campaignCode.StatusValue,
+		System:      campaignCode.StatusCode.IsSystem(),
+	}
+}
+
+func ToCampaignCodeResponse(campaignCode *CampaignCodeDTO) CampaignCodeResponse {
+	return CampaignCodeResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: campaignCode,
+	}
+}
+
+func ToCampaignCodeListResponse(campaignCodes []*CampaignCodeDTO) CampaignCodeListResponse {
+	return CampaignCodeListResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: campaignCodes,
+	}
+}
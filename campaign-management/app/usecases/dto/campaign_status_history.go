@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"net/http"
+	"time"
+)
+
+// CampaignStatusHistoryDTO ..
+type CampaignStatusHistoryDTO struct {
+	// Status history identifier
+	ID int64 `json:"id"`
+	// Campaign identifier
+	CampaignID int64 `json:"campaign_id"`
+	// Status code before the change
+	OldStatusCode int64 `json:"old_status_code"`
+	// Status before the change
+	OldStatus string `json:"old_status"`
+	// Status code after the change
+	NewStatusCode int64 `json:"new_status_code"`
+	// Status after the change
+	NewStatus string `json:"new_status"`
+	// User id who changed the status, or scheduler
+	Actor string `json:"actor"`
+	// Time of the change in UTC
+	ChangedAt string `json:"changed_at"`
+}
+
+type CampaignStatusHistoryResponse struct {
+	ListResponseFields
+	Data []*CampaignStatusHistoryDTO `json:"data"`
+}
+
+func ToCampaignStatusHistoryDTO(history entities.CampaignStatusHistory) *CampaignStatusHistoryDTO {
+	return &CampaignStatusHistoryDTO{
+		ID:            history.ID.ToInt64(),
+		CampaignID:    history.CampaignID.ToInt64(),
+		OldStatusCode: history.OldStatusCode,
+		OldStatus:     history.OldStatusValue,
+		NewStatusCode: history.NewStatusCode,
+		NewStatus:     history.NewStatusValue,
+		Actor:         history.Actor,
+		ChangedAt:     formatDate(history.ChangedAt, time.UTC),
+	}
+}
+
+func ToCampaignStatusHistoryResponse(history []*CampaignStatusHistoryDTO) CampaignStatusHistoryResponse {
+	return CampaignStatusHistoryResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: history,
+	}
+}
@@ -1,20 +1,64 @@
-package dto
-
-import "campaign-mgmt/app/domain/entities"
-
-type CampaignStoresDTO struct {
-	CampaignID int64             `json:"campaign_id"`
-	Stores     []*CampaignStores `json:"stores"`
-}
-
-type CampaignStores struct {
-	ID      int64 `json:"campaign_store_id"`
-	StoreID int64 `json:"store_id"`
-}
-
-func ToCampaignStoreDTO(storeEntity entities.CampaignStore) *CampaignStores {
-	return &CampaignStores{
-		ID:      storeEntity.ID.ToInt64(),
-		StoreID: storeEntity.StoreID,
-	}
-}
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"net/http"
+)
+
+type CampaignStoresDTO struct {
+	CampaignID int64             `json:"campaign_id"`
+	Stores     []*CampaignStores `json:"stores"`
+}
+
+type CampaignStores struct {
+	ID      int64 `json:"campaign_store_id"`
+	StoreID int64 `json:"store_id"`
+	// Name of the store, left out when the store is not registered
+	StoreName string `json:"store_name,omitempty"`
+	// Region of the store, left out when the store is not registered
+	Region string `json:"region,omitempty"`
+}
+
+func ToCampaignStoreDTO(storeEntity entities.CampaignStore) *CampaignStores {
+	return &CampaignStores{
+		ID:      storeEntity.ID.ToInt64(),
+		StoreID: storeEntity.StoreID,
+	}
+}
+
+// ToRegisteredCampaignStoreDTO adds the name and region of the store when it is among the registered stores
+func ToRegisteredCampaignStoreDTO(storeEntity entities.CampaignStore, registered map[int64]entities.Store) *CampaignStores {
+	campaignStore := ToCampaignStoreDTO(storeEntity)
+	if store, ok := registered[storeEntity.StoreID]; ok {
+		campaignStore.StoreName = store.Name
+		campaignStore.Region = store.Region
+	}
+	return campaignStore
+}
+
+type CampaignStoreListResponse struct {
+	ListResponseFields
+	Data CampaignStoreDataList `json:"data"`
+}
+
+type CampaignStoreDataList struct {
+	PaginationFields
+	CampaignID int64             `json:"campaign_id"`
+	Stores     []*CampaignStores `json:"stores"`
+}
+
+func ToCampaignStoreListResponse(campaignID int64, entries []entities.CampaignStore, registered map[int64]entities.Store,
+	count int64, paginationData entities.PaginationConfig) CampaignStoreListResponse {
+	stores := make([]*CampaignStores, 0)
+	for _, entry := range entries {
+		stores = append(stores, ToRegisteredCampaignStoreDTO(entry, registered))
+	}
+	return CampaignStoreListResponse{
+		ListResponseFields{http.StatusOK, "SUCCESS"},
+		CampaignStoreDataList{
+			PaginationFields{Count: count, Limit: paginationData.Limit, Offset: paginationData.Offset},
+			campaignID,
+			stores,
+		},
+	}
+}
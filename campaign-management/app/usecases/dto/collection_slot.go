@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"net/http"
+	"time"
+)
+
+// CollectionSlotDTO ..
+type CollectionSlotDTO struct {
+	// Key to make reservations of the slot with, source-slotid-date
+	Slot string `json:"slot"`
+	// Date of the slot, YYYY-MM-DD
+	Date string `json:"date"`
+	// Slot start in store local time, HH:MM
+	StartTime string `json:"start_time"`
+	// Slot end in store local time, HH:MM
+	EndTime string `json:"end_time"`
+	// Slot start as a point in time
+	StartAt time.Time `json:"start_at"`
+	// Slot end as a point in time
+	EndAt time.Time `json:"end_at"`
+	// Where the slot comes from, daily for the weekly slots or specific for the slots of the date
+	Source string `json:"source"`
+	// Identifier of the daily or specific time slot
+	SlotID int64 `json:"slot_id"`
+	// Number of collections the slot takes
+	Quota int `json:"quota"`
+	// Number of collections the slot can still take
+	RemainingQuota int `json:"remaining_quota"`
+}
+
+// CollectionSlotAvailability ..
+type CollectionSlotAvailability struct {
+	// Campaign identifier
+	CampaignID int64 `json:"campaign_id"`
+	// Store identifier
+	StoreID int64 `json:"store_id"`
+	// Timezone of the campaign the slot times are in
+	Timezone string `json:"timezone"`
+	// First date slots were worked out for, YYYY-MM-DD
+	From string `json:"from"`
+	// Last date slots were worked out for, YYYY-MM-DD
+	To    string               `json:"to"`
+	Slots []*CollectionSlotDTO `json:"slots"`
+}
+
+type CollectionSlotAvailabilityResponse struct {
+	ListResponseFields
+	Data *CollectionSlotAvailability `json:"data"`
+}
+
+func ToCollectionSlotDTO(slot entities.CollectionSlot) *CollectionSlotDTO {
+	return &CollectionSlotDTO{
+		Slot:           slot.Key().String(),
+		Date:           slot.Date.Format(valueobjects.DateLayout),
+		StartTime:      slot.StartTime.String(),
+		EndTime:        slot.EndTime.String(),
+		StartAt:        slot.StartAt,
+		EndAt:          slot.EndAt,
+		Source:         string(slot.Source),
+		SlotID:         slot.SlotID,
+		Quota:          slot.Quota,
+		RemainingQuota: slot.RemainingQuota,
+	}
+}
+
+func ToCollectionSlotAvailability(campaign entities.Campaign, storeID int64, from, to time.Time,
+	slots []entities.CollectionSlot) CollectionSlotAvailability {
+	availability := CollectionSlotAvailability{
+		CampaignID: campaign.ID.ToInt64(),
+		StoreID:    storeID,
+		Timezone:   campaign.Timezone,
+		From:       from.Format(valueobjects.DateLayout),
+		To:         to.Format(valueobjects.DateLayout),
+		Slots:      []*CollectionSlotDTO{},
+	}
+	for _, slot := range slots {
+		availability.Slots = append(availability.Slots, ToCollectionSlotDTO(slot))
+	}
+	return availability
+}
+
+func ToCollectionSlotAvailabilityResponse(availability *CollectionSlotAvailability) CollectionSlotAvailabilityResponse {
+	return CollectionSlotAvailabilityResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: availability,
+	}
+}
+
+// CollectionSlotReservationDTO ..
+type CollectionSlotReservationDTO struct {
+	// Reservation identifier
+	ID int64 `json:"reservation_id"`
+	// Campaign identifier
+	CampaignID int64 `json:"campaign_id"`
+	// Store identifier
+	StoreID int64 `json:"store_id"`
+	// Key of the reserved slot, source-slotid-date
+	Slot string `json:"slot"`
+	// Date of the reserved slot, YYYY-MM-DD
+	Date string `json:"date"`
+	// Number of collections reserved
+	Quantity int `json:"quantity"`
+	// held, confirmed or released
+	Status string `json:"status"`
+	// When a held reservation gives its quota back unless confirmed
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Reference of the caller e.g. an order number
+	Reference string `json:"reference,omitempty"`
+}
+
+type CollectionSlotReservationResponse struct {
+	ListResponseFields
+	Data *CollectionSlotReservationDTO `json:"data"`
+}
+
+func ToCollectionSlotReservationDTO(reservation entities.CollectionSlotReservation) *CollectionSlotReservationDTO {
+	reservationDTO := &CollectionSlotReservationDTO{
+		ID:         reservation.ID.ToInt64(),
+		CampaignID: reservation.CampaignID.ToInt64(),
+		StoreID:    reservation.StoreID,
+		Slot:       reservation.Slot.String(),
+		Date:       reservation.Slot.Date.Format(valueobjects.DateLayout),
+		Quantity:   reservation.Quantity,
+		Status:     string(reservation.Status),
+		Reference:  reservation.Reference,
+	}
+	if !reservation.ExpiresAt.IsZero() {
+		expiresAt := reservation.ExpiresAt
+		reservationDTO.ExpiresAt = &expiresAt
+	}
+	return reservationDTO
+}
+
+func ToCollectionSlotReservationResponse(reservation *CollectionSlotReservationDTO) CollectionSlotReservationResponse {
+	return CollectionSlotReservationResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: reservation,
+	}
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"net/http"
+)
+
+// SlotTemplateDTO ..
+type SlotTemplateDTO struct {
+	// Slot template identifier
+	ID int64 `json:"slot_template_id"`
+	// Name of the template
+	Name string `json:"name"`
+	// Weekdays the template makes slots on
+	DaysOfWeek []string `json:"days_of_week"`
+	// Start of the first slot in store local time, HH:MM
+	StartTime string `json:"start_time"`
+	// End of the last slot in store local time, HH:MM
+	EndTime string `json:"end_time"`
+	// Length of every slot in minutes
+	SlotMinutes int `json:"slot_minutes"`
+	// Number of collections every slot takes
+	Quota int `json:"quota"`
+	// Whether the slots are offered to customers
+	IsSlotAvailable bool `json:"is_slot_available"`
+}
+
+type SlotTemplateResponse struct {
+	ListResponseFields
+	Data *SlotTemplateDTO `json:"data"`
+}
+
+type SlotTemplateListResponse struct {
+	ListResponseFields
+	Data []*SlotTemplateDTO `json:"data"`
+}
+
+// SlotTemplateApplyResult ..
+type SlotTemplateApplyResult struct {
+	// Slot template identifier
+	TemplateID int64 `json:"slot_template_id"`
+	// Whether the slots were only worked out and not created
+	DryRun bool `json:"dry_run"`
+	// Stores the template was applied to
+	StoreIDs []int64 `json:"store_ids"`
+	// Slots created, or that would be created on a dry run
+	Slots []*StoreDailyTimeSlotDTO `json:"slots"`
+}
+
+type SlotTemplateApplyResponse struct {
+	ListResponseFields
+	Data *SlotTemplateApplyResult `json:"data"`
+}
+
+func ToSlotTemplateDTO(template entities.SlotTemplate) *SlotTemplateDTO {
+	days := []string{}
+	for _, dayOfWeek := range template.DaysOfWeek {
+		days = append(days, valueobjects.WeekdayName(dayOfWeek))
+	}
+	return &SlotTemplateDTO{
+		ID:              template.ID.ToInt64(),
+		Name:            template.Name,
+		DaysOfWeek:      days,
+		StartTime:       template.StartTime.String(),
+		EndTime:         template.EndTime.String(),
+		SlotMinutes:     template.SlotMinutes,
+		Quota:           template.Quota,
+		IsSlotAvailable: template.IsSlotAvailable,
+	}
+}
+
+func ToSlotTemplateResponse(template *SlotTemplateDTO) SlotTemplateResponse {
+	return SlotTemplateResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: template,
+	}
+}
+
+func ToSlotTemplateListResponse(templates []*SlotTemplateDTO) SlotTemplateListResponse {
+	return SlotTemplateListResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: templates,
+	}
+}
+
+func ToSlotTemplateApplyResponse(result *SlotTemplateApplyResult) SlotTemplateApplyResponse {
+	return SlotTemplateApplyResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: result,
+	}
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"net/http"
+)
+
+// StoreDTO ..
+type StoreDTO struct {
+	// Store ID campaigns refer to the store by
+	ID int64 `json:"store_id"`
+	// Store name
+	Name string `json:"name"`
+	// Store address
+	Address string `json:"address"`
+	// Region the store belongs to
+	Region string `json:"region"`
+	// IANA timezone of the store
+	Timezone string `json:"timezone"`
+	// Whether the store can be added to campaigns
+	IsActive bool `json:"is_active"`
+}
+
+type StoreResponse struct {
+	ListResponseFields
+	Data *StoreDTO `json:"data"`
+}
+
+type StoreListResponse struct {
+	ListResponseFields
+	Data StoreDataList `json:"data"`
+}
+
+type StoreDataList struct {
+	PaginationFields
+	Stores []*StoreDTO `json:"stores"`
+}
+
+func ToStoreDTO(store entities.Store) *StoreDTO {
+	return &StoreDTO{
+		ID:       store.ID.ToInt64(),
+		Name:     store.Name,
+		Address:  store.Address,
+		Region:   store.Region,
+		Timezone: store.Timezone,
+		IsActive: store.IsActive,
+	}
+}
+
+func ToStoreResponse(store *StoreDTO) StoreResponse {
+	return StoreResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: store,
+	}
+}
+
+func ToStoreListResponse(entries []entities.Store, count int64, paginationData entities.PaginationConfig) StoreListResponse {
+	stores := make([]*StoreDTO, 0)
+	for _, entry := range entries {
+		stores = append(stores, ToStoreDTO(entry))
+	}
+	return StoreListResponse{
+		ListResponseFields{http.StatusOK, "SUCCESS"},
+		StoreDataList{
+			PaginationFields{Count: count, Limit: paginationData.Limit, Offset: paginationData.Offset},
+			stores,
+		},
+	}
+}
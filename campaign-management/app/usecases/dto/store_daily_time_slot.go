@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"net/http"
+)
+
+// StoreDailyTimeSlotDTO ..
+type StoreDailyTimeSlotDTO struct {
+	// Daily time slot identifier
+	ID int64 `json:"daily_time_slot_id"`
+	// Store identifier
+	StoreID int64 `json:"store_id"`
+	// Weekday the slot repeats on
+	DayOfWeek string `json:"day_of_week"`
+	// Slot start in store local time, HH:MM
+	StartTime string `json:"start_time"`
+	// Slot end in store local time, HH:MM
+	EndTime string `json:"end_time"`
+	// Number of collections the slot takes
+	Quota int `json:"quota"`
+	// Whether the slot is offered to customers
+	IsSlotAvailable bool `json:"is_slot_available"`
+}
+
+type StoreDailyTimeSlotResponse struct {
+	ListResponseFields
+	Data *StoreDailyTimeSlotDTO `json:"data"`
+}
+
+type StoreDailyTimeSlotListResponse struct {
+	ListResponseFields
+	Data []*StoreDailyTimeSlotDTO `json:"data"`
+}
+
+func ToStoreDailyTimeSlotDTO(slot entities.StoreDailyTimeSlot) *StoreDailyTimeSlotDTO {
+	return &StoreDailyTimeSlotDTO{
+		ID:              slot.ID.ToInt64(),
+		StoreID:         slot.StoreID,
+		DayOfWeek:       valueobjects.WeekdayName(slot.DayOfWeek),
+		StartTime:       slot.StartTime.String(),
+		EndTime:         slot.EndTime.String(),
+		Quota:           slot.Quota,
+		IsSlotAvailable: slot.IsSlotAvailable,
+	}
+}
+
+func ToStoreDailyTimeSlotResponse(slot *StoreDailyTimeSlotDTO) StoreDailyTimeSlotResponse {
+	return StoreDailyTimeSlotResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: slot,
+	}
+}
+
+func ToStoreDailyTimeSlotListResponse(slots []*StoreDailyTimeSlotDTO) StoreDailyTimeSlotListResponse {
+	return StoreDailyTimeSlotListResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: slots,
+	}
+}
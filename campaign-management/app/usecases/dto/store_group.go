@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"net/http"
+)
+
+// StoreGroupDTO ..
+type StoreGroupDTO struct {
+	// Store group ID
+	ID int64 `json:"store_group_id"`
+	// Store group name
+	Name string `json:"name"`
+	// Store group description
+	Description string `json:"description"`
+	// Stores of the group
+	Stores []int64 `json:"stores"`
+}
+
+type StoreGroupResponse struct {
+	ListResponseFields
+	Data *StoreGroupDTO `json:"data"`
+}
+
+type StoreGroupListResponse struct {
+	ListResponseFields
+	Data StoreGroupDataList `json:"data"`
+}
+
+type StoreGroupDataList struct {
+	PaginationFields
+	StoreGroups []*StoreGroupDTO `json:"store_groups"`
+}
+
+func ToStoreGroupDTO(storeGroup entities.StoreGroup) *StoreGroupDTO {
+	stores := storeGroup.StoreIDs
+	if stores == nil {
+		stores = []int64{}
+	}
+	return &StoreGroupDTO{
+		ID:          storeGroup.ID.ToInt64(),
+		Name:        storeGroup.Name,
+		Description: storeGroup.Description,
+		Stores:      stores,
+	}
+}
+
+func ToStoreGroupResponse(storeGroup *StoreGroupDTO) StoreGroupResponse {
+	return StoreGroupResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: storeGroup,
+	}
+}
+
+func ToStoreGroupListResponse(entries []entities.StoreGroup, count int64, paginationData entities.PaginationConfig) StoreGroupListResponse {
+	storeGroups := make([]*StoreGroupDTO, 0)
+	for _, entry := range entries {
+		storeGroups = append(storeGroups, ToStoreGroupDTO(entry))
+	}
+	return StoreGroupListResponse{
+		ListResponseFields{http.StatusOK, "SUCCESS"},
+		StoreGroupDataList{
+			PaginationFields{Count: count, Limit: paginationData.Limit, Offset: paginationData.Offset},
+			storeGroups,
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"net/http"
+)
+
+// StoreSpecificTimeSlotDTO ..
+type StoreSpecificTimeSlotDTO struct {
+	// Specific time slot identifier
+	ID int64 `json:"specific_time_slot_id"`
+	// Store identifier
+	StoreID int64 `json:"store_id"`
+	// Date the slot applies to, YYYY-MM-DD
+	Date string `json:"date"`
+	// Slot start in store local time, HH:MM, left out for a closed date
+	StartTime string `json:"start_time,omitempty"`
+	// Slot end in store local time, HH:MM, left out for a closed date
+	EndTime string `json:"end_time,omitempty"`
+	// Number of collections the slot takes
+	Quota int `json:"quota"`
+	// Whether the store is closed the whole date
+	IsClosed bool `json:"is_closed"`
+}
+
+type StoreSpecificTimeSlotResponse struct {
+	ListResponseFields
+	Data *StoreSpecificTimeSlotDTO `json:"data"`
+}
+
+type StoreSpecificTimeSlotListResponse struct {
+	ListResponseFields
+	Data []*StoreSpecificTimeSlotDTO `json:"data"`
+}
+
+func ToStoreSpecificTimeSlotDTO(slot entities.StoreSpecificTimeSlot) *StoreSpecificTimeSlotDTO {
+	slotDTO := &StoreSpecificTimeSlotDTO{
+		ID:       slot.ID.ToInt64(),
+		StoreID:  slot.StoreID,
+		Date:     slot.Date.Format(valueobjects.DateLayout),
+		Quota:    slot.Quota,
+		IsClosed: slot.IsClosed,
+	}
+	if !slot.IsClosed {
+		slotDTO.StartTime = slot.StartTime.String()
+		slotDTO.EndTime = slot.EndTime.String()
+	}
+	return slotDTO
+}
+
+func ToStoreSpecificTimeSlotResponse(slot *StoreSpecificTimeSlotDTO) StoreSpecificTimeSlotResponse {
+	return StoreSpecificTimeSlotResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: slot,
+	}
+}
+
+func ToStoreSpecificTimeSlotListResponse(slots []*StoreSpecificTimeSlotDTO) StoreSpecificTimeSlotListResponse {
+	return StoreSpecificTimeSlotListResponse{
+		ListResponseFields: ListResponseFields{
+			Code:   http.StatusOK,
+			Status: "SUCCESS",
+		},
+		Data: slots,
+	}
+}
@@ -0,0 +1,222 @@
+package params
+
+import (
+	"bufio"
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlackoutForm ..
+// swagger:model BlackoutForm
+type BlackoutForm struct {
+	// Store the blackout applies to, 0 or left out for every store
+	StoreID int64 `json:"store_id" validate:"gte=0"`
+	// First blacked out date, YYYY-MM-DD
+	StartDate string `json:"start_date" validate:"required"`
+	// Last blacked out date, YYYY-MM-DD, the start date when left out
+	EndDate string `json:"end_date"`
+	// Why collections are closed e.g. the name of the holiday
+	Reason string `json:"reason" validate:"max=200"`
+}
+
+func ToBlackoutEntity(form BlackoutForm, blackoutID, userID int64) (entities.Blackout, error) {
+	startDate, err := valueobjects.ParseDate(form.StartDate)
+	if err != nil {
+		return entities.Blackout{}, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutInvalid, err)
+	}
+	endDate := startDate
+	if form.EndDate != "" {
+		if endDate, err = valueobjects.ParseDate(form.EndDate); err != nil {
+			return entities.Blackout{}, fmt.Errorf("%w: %v", valueobjects.ErrBlackoutInvalid, err)
+		}
+	}
+	blackout := entities.Blackout{
+		ID:        valueobjects.BlackoutID(blackoutID),
+		StoreID:   form.StoreID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    form.Reason,
+	}
+	if blackoutID > 0 {
+		blackout.UpdatedBy = userID
+	} else {
+		blackout.CreatedBy = userID
+	}
+	return blackout, nil
+}
+
+var icsDurationPattern = regexp.MustCompile(`^P(?:(\d+)W|(\d+)D)`)
+
+// ParseBlackoutCalendar reads the events of an iCalendar file as blackouts of the store, each event blacks out every
+// date it touches. Dates are taken as written in the file. Cancelled events are left out and recurring events are
+// refused as only their first occurrence could be read
+func ParseBlackoutCalendar(r io.Reader, storeID, userID int64) ([]entities.Blackout, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: unable to read calendar : %v", valueobjects.ErrBlackoutInvalid, err)
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("%w: calendar must start with BEGIN:VCALENDAR", valueobjects.ErrBlackoutInvalid)
+	}
+
+	blackouts := []entities.Blackout{}
+	var event map[string]icsProperty
+	for _, line := range lines {
+		name, property, ok := parseICSLine(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(property.value, "VEVENT"):
+			event = map[string]icsProperty{}
+		case name == "END" && strings.EqualFold(property.value, "VEVENT") && event != nil:
+			blackout, skip, err := toCalendarBlackout(event)
+			if err != nil {
+				return nil, err
+			}
+			if !skip {
+				blackout.StoreID = storeID
+				blackout.CreatedBy = userID
+				blackouts = append(blackouts, blackout)
+			}
+			event = nil
+		case event != nil:
+			if _, seen := event[name]; !seen {
+				event[name] = property
+			}
+		}
+	}
+	if len(blackouts) == 0 {
+		return nil, fmt.Errorf("%w: calendar has no events", valueobjects.ErrBlackoutInvalid)
+	}
+	return blackouts, nil
+}
+
+type icsProperty struct {
+	params string
+	value  string
+}
+
+// parseICSLine splits a content line into its upper cased name, its parameters and its value
+func parseICSLine(line string) (string, icsProperty, bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", icsProperty{}, false
+	}
+	name, params := line[:colon], ""
+	if semicolon := strings.Index(name, ";"); semicolon >= 0 {
+		name, params = name[:semicolon], name[semicolon+1:]
+	}
+	return strings.ToUpper(name), icsProperty{params: strings.ToUpper(params), value: line[colon+1:]}, true
+}
+
+func toCalendarBlackout(event map[string]icsProperty) (entities.Blackout, bool, error) {
+	uid := event["UID"].value
+	if uid == "" {
+		return entities.Blackout{}, false, fmt.Errorf("%w: calendar event without UID", valueobjects.ErrBlackoutInvalid)
+	}
+	if strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+		return entities.Blackout{}, true, nil
+	}
+	if _, ok := event["RRULE"]; ok {
+		return entities.Blackout{}, false, fmt.Errorf("%w: event %s recurs, recurring events are not supported", valueobjects.ErrBlackoutInvalid, uid)
+	}
+	if _, ok := event["RDATE"]; ok {
+		return entities.Blackout{}, false, fmt.Errorf("%w: event %s recurs, recurring events are not supported", valueobjects.ErrBlackoutInvalid, uid)
+	}
+	start, ok := event["DTSTART"]
+	if !ok {
+		return entities.Blackout{}, false, fmt.Errorf("%w: event %s has no DTSTART", valueobjects.ErrBlackoutInvalid, uid)
+	}
+	startDate, allDay, _, err := parseICSDate(start)
+	if err != nil {
+		return entities.Blackout{}, false, fmt.Errorf("%w: event %s : %v", valueobjects.ErrBlackoutInvalid, uid, err)
+	}
+
+	// the end of an event is exclusive, an all day event or one ending at midnight does not touch its end date
+	endDate := startDate
+	if end, ok := event["DTEND"]; ok {
+		date, _, midnight, err := parseICSDate(end)
+		if err != nil {
+			return entities.Blackout{}, false, fmt.Errorf("%w: event %s : %v", valueobjects.ErrBlackoutInvalid, uid, err)
+		}
+		if allDay || midnight {
+			date = date.AddDate(0, 0, -1)
+		}
+		if date.After(endDate) {
+			endDate = date
+		}
+	} else if duration, ok := event["DURATION"]; ok && allDay {
+		match := icsDurationPattern.FindStringSubmatch(duration.value)
+		if match == nil {
+			return entities.Blackout{}, false, fmt.Errorf("%w: event %s has unsupported duration %q", valueobjects.ErrBlackoutInvalid, uid, duration.value)
+		}
+		days, _ := strconv.Atoi(match[2])
+		if match[1] != "" {
+			weeks, _ := strconv.Atoi(match[1])
+			days = weeks * 7
+		}
+		if days > 1 {
+			endDate = startDate.AddDate(0, 0, days-1)
+		}
+	}
+
+	return entities.Blackout{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    truncateRunes(unescapeICSText(event["SUMMARY"].value), 200),
+		UID:       uid,
+	}, false, nil
+}
+
+// parseICSDate returns the date of a DATE or DATE-TIME value as midnight UTC, whether it is a DATE
+// and whether a DATE-TIME falls on midnight
+func parseICSDate(property icsProperty) (time.Time, bool, bool, error) {
+	value := strings.TrimSuffix(property.value, "Z")
+	if strings.Contains(property.params, "VALUE=DATE") && !strings.Contains(property.params, "VALUE=DATE-TIME") || len(value) == 8 {
+		date, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, false, false, fmt.Errorf("date %q must be YYYYMMDD", property.value)
+		}
+		return date, true, false, nil
+	}
+	dateTime, err := time.Parse("20060102T150405", value)
+	if err != nil {
+		return time.Time{}, false, false, fmt.Errorf("date time %q must be YYYYMMDDTHHMMSS", property.value)
+	}
+	year, month, day := dateTime.Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return date, false, dateTime.Equal(date), nil
+}
+
+var icsTextReplacer = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ")
+
+func unescapeICSText(value string) string {
+	return strings.TrimSpace(icsTextReplacer.Replace(value))
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit])
+}
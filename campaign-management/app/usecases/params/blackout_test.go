@@ -0,0 +1,63 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseBlackoutCalendar(t *testing.T) {
+	calendar := func(events ...string) string {
+		return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Holidays//EN\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n"
+	}
+	date := func(day int) time.Time {
+		return time.Date(2024, time.February, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("when events are read as the dates they touch", func(t *testing.T) {
+		blackouts, err := ParseBlackoutCalendar(strings.NewReader(calendar(
+			"BEGIN:VEVENT\r\nUID:cny-2024\r\nSUMMARY:Lunar New Year\\, day one\r\n  and two\r\nDTSTART;VALUE=DATE:20240210\r\nDTEND;VALUE=DATE:20240212\r\nEND:VEVENT\r\n",
+			"BEGIN:VEVENT\r\nUID:stock-take\r\nSUMMARY:Stock take\r\nDTSTART:20240214T090000Z\r\nDTEND:20240215T000000Z\r\nEND:VEVENT\r\n",
+			"BEGIN:VEVENT\r\nUID:renovation\r\nDTSTART;VALUE=DATE:20240220\r\nDURATION:P1W\r\nEND:VEVENT\r\n",
+			"BEGIN:VEVENT\r\nUID:moved\r\nSTATUS:CANCELLED\r\nDTSTART;VALUE=DATE:20240201\r\nEND:VEVENT\r\n",
+		)), 84, 7)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(blackouts) != 3 {
+			t.Fatalf("unexpected blackout count : got - %d ; want - 3", len(blackouts))
+		}
+		if b := blackouts[0]; !b.StartDate.Equal(date(10)) || !b.EndDate.Equal(date(11)) || b.Reason != "Lunar New Year, day one and two" ||
+			b.UID != "cny-2024" || b.StoreID != 84 || b.CreatedBy != 7 {
+			t.Errorf("unexpected blackout : got - %+v", b)
+		}
+		if b := blackouts[1]; !b.StartDate.Equal(date(14)) || !b.EndDate.Equal(date(14)) {
+			t.Errorf("unexpected blackout : got - %+v", b)
+		}
+		if b := blackouts[2]; !b.StartDate.Equal(date(20)) || !b.EndDate.Equal(date(26)) {
+			t.Errorf("unexpected blackout : got - %+v", b)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		calendar string
+	}{
+		{name: "when file is not a calendar", calendar: "store,date\r\n84,2024-02-10\r\n"},
+		{name: "when calendar has no events", calendar: calendar()},
+		{name: "when event recurs",
+			calendar: calendar("BEGIN:VEVENT\r\nUID:cny\r\nDTSTART;VALUE=DATE:20240210\r\nRRULE:FREQ=YEARLY\r\nEND:VEVENT\r\n")},
+		{name: "when event has no UID", calendar: calendar("BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20240210\r\nEND:VEVENT\r\n")},
+		{name: "when event date is malformed", calendar: calendar("BEGIN:VEVENT\r\nUID:cny\r\nDTSTART:2024-02-10\r\nEND:VEVENT\r\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBlackoutCalendar(strings.NewReader(tt.calendar), 84, 7)
+			if !errors.Is(err, valueobjects.ErrBlackoutInvalid) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrBlackoutInvalid)
+			}
+		})
+	}
+}
@@ -1,198 +1,337 @@
-package params
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-	"campaign-mgmt/app/usecases/util"
-	"time"
-)
-
-// CampaignCreationForm ..
-// swagger:model CampaignCreationForm
-type CampaignCreationForm struct {
-	// Campaign Title
-	Title string `json:"title" validate:"required"`
-	// Campaign Status code
-	StatusCode int `json:"campaign_status_code" validate:"oneof=1 2 3"`
-	// Campaign Type
-	CampaignType string `json:"campaign_type"  validate:"omitempty,oneof=deli cash&carry"`
-	// Listing screen title
-	ListingTitle string `json:"listing_title"`
-	// Listing screen description
-	ListingDesc string `json:"listing_description"`
-	// Listing screen image path
-	ListingImagePath string `json:"listing_image_path" validate:"omitempty,url"`
-	// Onboarding title
-	OnboardTitle string `json:"onboarding_title"`
-	// Onboarding Description
-	OnboardDesc string `json:"onboarding_description"`
-	// Onboarding image path
-	OnboardImagePath string `json:"onboarding_image_path" validate:"omitempty,url"`
-	// Landing screen image path
-	LandingImagePath string `json:"landing_image_path"  validate:"omitempty,url"`
-	// Order start date
-	OrderStartDate string `json:"order_start_date" example:"2023-12-31 12:00:00"`
-	// Order end date
-	OrderEndDate string `json:"order_end_date" example:"2023-12-31 12:00:00"`
-	// Collection start date
-	CollectionStartDate string `json:"collection_start_date" example:"2023-12-31 12:00:00"`
-	// Collection end date
-	CollectionEndDate string `json:"collection_end_date" example:"2023-12-31 12:00:00"`
-	// Lead time in days
-	LeadTime int `json:"lead_time"`
-	// Offer Id
-	OfferID int64 `json:"offer_id"`
-	// Tag Id
-	TagID int64 `json:"tag_id"`
-	// Is campaign published flag
-	IsCampaignPublished bool `json:"is_campaign_published"`
-	// List of campaign stores
-	Stores []int64 `json:"stores"`
-	// List of campaign products
-	Products []CampaignProduct `json:"products"`
-}
-
-// CampaignUpdateForm ..
-// swagger:model CampaignUpdateForm
-type CampaignUpdateForm struct {
-	// Campaign Title
-	Title string `json:"title"`
-	// Campaign Status code
-	StatusCode int `json:"campaign_status_code" validate:"oneof=1 2 3"`
-	// Campaign Type
-	CampaignType string `json:"campaign_type" validate:"omitempty,oneof=deli cash&carry"`
-	// Listing screen title
-	ListingTitle string `json:"listing_title"`
-	// Listing screen description
-	ListingDesc string `json:"listing_description"`
-	// Listing screen image path
-	ListingImagePath string `json:"listing_image_path" validate:"omitempty,url"`
-	// Onboarding title
-	OnboardTitle string `json:"onboarding_title"`
-	// Onboarding Description
-	OnboardDesc string `json:"onboarding_description"`
-	// Onboarding image path
-	OnboardImagePath string `json:"onboarding_image_path" validate:"omitempty,url"`
-	// Landing screen image path
-	LandingImagePath string `json:"landing_image_path"  validate:"omitempty,url"`
-	// Order start date
-	OrderStartDate string `json:"order_start_date" example:"2023-12-31 12:00:00"`
-	// Order end date
-	OrderEndDate string `json:"order_end_date" example:"2023-12-31 12:00:00"`
-	// Collection start date
-	CollectionStartDate string `json:"collection_start_date" example:"2023-12-31 12:00:00"`
-	// Collection end date
-	CollectionEndDate string `json:"collection_end_date" example:"2023-12-31 12:00:00"`
-	// Lead time in days
-	LeadTime int `json:"lead_time"`
-	// Offer Id
-	OfferID int64 `json:"offer_id"`
-	// Tag Id
-	TagID int64 `json:"tag_id"`
-	// Is campaign published flag
-	IsCampaignPublished bool `json:"is_campaign_published"`
-	// List of campaign stores
-	Stores []int64 `json:"stores"`
-	// List of campaign products
-	Products []UpdateCampaignProduct `json:"products" validate:"dive"`
-}
-
-type CampaignDates struct {
-	OrderStartDate      string
-	OrderEndDate        string
-	CollectionStartDate string
-	CollectionEndDate   string
-}
-
-func ToCampaignEntity(campaign CampaignCreationForm) (entities.Campaign, error) {
-	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
-	var err error
-	orderStartDate, err = util.ToDateTime(campaign.OrderStartDate)
-	if err != nil {
-		return entities.Campaign{}, err
-	}
-	orderEndDate, err = util.ToDateTime(campaign.OrderEndDate)
-	if err != nil {
-		return entities.Campaign{}, err
-	}
-	collectionStartDate, err = util.ToDateTime(campaign.CollectionStartDate)
-	if err != nil {
-		return entities.Campaign{}, err
-	}
-	collectionEndDate, err = util.ToDateTime(campaign.CollectionEndDate)
-	if err != nil {
-		return entities.Campaign{}, err
-	}
-
-	return entities.Campaign{
-		Title:               campaign.Title,
-		StatusCode:          int64(campaign.StatusCode),
-		CampaignType:        valueobjects.CampaignType(campaign.CampaignType),
-		ListingTitle:        campaign.ListingTitle,
-		ListingDesc:         campaign.ListingDesc,
-		ListingImagePath:    campaign.ListingImagePath,
-		OnboardTitle:        campaign.OnboardTitle,
-		OnboardDesc:         campaign.OnboardDesc,
-		OnboardImagePath:    campaign.OnboardImagePath,
-		LandingImagePath:    campaign.LandingImagePath,
-		OrderStartDate:      orderStartDate,
-		OrderEndDate:        orderEndDate,
-		CollectionStartDate: collectionStartDate,
-		CollectionEndDate:   collectionEndDate,
-		OfferID:             campaign.OfferID,
-		TagID:               campaign.TagID,
-		LeadTime:            campaign.LeadTime,
-		IsCampaignPublished: campaign.IsCampaignPublished,
-	}, nil
-}
-
-func ToUpdateCampaignEntity(campaign CampaignUpdateForm, campaignID int64) (entities.Campaign, error) {
-	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
-	var err error
-	if campaign.OrderStartDate != "" {
-		orderStartDate, err = util.ToDateTime(campaign.OrderStartDate)
-		if err != nil {
-			return entities.Campaign{}, err
-		}
-	}
-	if campaign.OrderEndDate != "" {
-		orderEndDate, err = util.ToDateTime(campaign.OrderEndDate)
-		if err != nil {
-			return entities.Campaign{}, err
-		}
-	}
-	if campaign.CollectionStartDate != "" {
-		collectionStartDate, err = util.ToDateTime(campaign.CollectionStartDate)
-		if err != nil {
-			return entities.Campaign{}, err
-		}
-	}
-	if campaign.CollectionEndDate != "" {
-		collectionEndDate, err = util.ToDateTime(campaign.CollectionEndDate)
-		if err != nil {
-			return entities.Campaign{}, err
-		}
-	}
-
-	return entities.Campaign{
-		ID:                  valueobjects.CampaignID(campaignID),
-		Title:               campaign.Title,
-		StatusCode:          int64(campaign.StatusCode),
-		CampaignType:        valueobjects.CampaignType(campaign.CampaignType),
-		ListingTitle:        campaign.ListingTitle,
-		ListingDesc:         campaign.ListingDesc,
-		ListingImagePath:    campaign.ListingImagePath,
-		OnboardTitle:        campaign.OnboardTitle,
-		OnboardDesc:         campaign.OnboardDesc,
-		OnboardImagePath:    campaign.OnboardImagePath,
-		LandingImagePath:    campaign.LandingImagePath,
-		OrderStartDate:      orderStartDate,
-		OrderEndDate:        orderEndDate,
-		CollectionStartDate: collectionStartDate,
-		CollectionEndDate:   collectionEndDate,
-		OfferID:             campaign.OfferID,
-		TagID:               campaign.TagID,
-		LeadTime:            campaign.LeadTime,
-		IsCampaignPublished: campaign.IsCampaignPublished,
-	}, nil
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/util"
+	"time"
+)
+
+// CampaignCreationForm ..
+// swagger:model CampaignCreationForm
+type CampaignCreationForm struct {
+	// Campaign Title
+	Title string `json:"title" validate:"required"`
+	// Campaign Type
+	CampaignType string `json:"campaign_type"  validate:"omitempty,oneof=deli cash&carry"`
+	// Listing screen title
+	ListingTitle string `json:"listing_title"`
+	// Listing screen description
+	ListingDesc string `json:"listing_description"`
+	// Listing screen image path
+	ListingImagePath string `json:"listing_image_path" validate:"omitempty,url"`
+	// Onboarding title
+	OnboardTitle string `json:"onboarding_title"`
+	// Onboarding Description
+	OnboardDesc string `json:"onboarding_description"`
+	// Onboarding image path
+	OnboardImagePath string `json:"onboarding_image_path" validate:"omitempty,url"`
+	// Landing screen image path
+	LandingImagePath string `json:"landing_image_path"  validate:"omitempty,url"`
+	// Order start date
+	OrderStartDate string `json:"order_start_date" example:"2023-12-31 12:00:00"`
+	// Order end date
+	OrderEndDate string `json:"order_end_date" example:"2023-12-31 12:00:00"`
+	// Collection start date
+	CollectionStartDate string `json:"collection_start_date" example:"2023-12-31 12:00:00"`
+	// Collection end date
+	CollectionEndDate string `json:"collection_end_date" example:"2023-12-31 12:00:00"`
+	// IANA timezone the dates are given in, defaults to the business timezone
+	Timezone string `json:"timezone" validate:"omitempty,timezone" example:"Asia/Singapore"`
+	// Lead time in days
+	LeadTime int `json:"lead_time"`
+	// Offer Id
+	OfferID int64 `json:"offer_id"`
+	// Tag Id
+	TagID int64 `json:"tag_id"`
+	// Is campaign published flag
+	IsCampaignPublished bool `json:"is_campaign_published"`
+	// List of campaign stores
+	Stores []int64 `json:"stores"`
+	// Store groups whose active stores are added along with the stores
+	StoreGroupIDs []int64 `json:"store_group_ids"`
+	// List of campaign products
+	Products []CampaignProduct `json:"products"`
+}
+
+// CampaignUpdateForm ..
+// swagger:model CampaignUpdateForm
+type CampaignUpdateForm struct {
+	// Campaign Title
+	Title string `json:"title"`
+	// Campaign Status code
+	StatusCode int `json:"campaign_status_code" validate:"gt=0"`
+	// Campaign Type
+	CampaignType string `json:"campaign_type" validate:"omitempty,oneof=deli cash&carry"`
+	// Listing screen title
+	ListingTitle string `json:"listing_title"`
+	// Listing screen description
+	ListingDesc string `json:"listing_description"`
+	// Listing screen image path
+	ListingImagePath string `json:"listing_image_path" validate:"omitempty,url"`
+	// Onboarding title
+	OnboardTitle string `json:"onboarding_title"`
+	// Onboarding Description
+	OnboardDesc string `json:"onboarding_description"`
+	// Onboarding image path
+	OnboardImagePath string `json:"onboarding_image_path" validate:"omitempty,url"`
+	// Landing screen image path
+	LandingImagePath string `json:"landing_image_path"  validate:"omitempty,url"`
+	// Order start date
+	OrderStartDate string `json:"order_start_date" example:"2023-12-31 12:00:00"`
+	// Order end date
+	OrderEndDate string `json:"order_end_date" example:"2023-12-31 12:00:00"`
+	// Collection start date
+	CollectionStartDate string `json:"collection_start_date" example:"2023-12-31 12:00:00"`
+	// Collection end date
+	CollectionEndDate string `json:"collection_end_date" example:"2023-12-31 12:00:00"`
+	// IANA timezone the dates are given in, defaults to the business timezone
+	Timezone string `json:"timezone" validate:"omitempty,timezone" example:"Asia/Singapore"`
+	// Lead time in days
+	LeadTime int `json:"lead_time"`
+	// Offer Id
+	OfferID int64 `json:"offer_id"`
+	// Tag Id
+	TagID int64 `json:"tag_id"`
+	// Is campaign published flag
+	IsCampaignPublished bool `json:"is_campaign_published"`
+	// List of campaign stores
+	Stores []int64 `json:"stores"`
+	// Store groups whose active stores are added along with the stores
+	StoreGroupIDs []int64 `json:"store_group_ids"`
+	// List of campaign products, products are left as they are when it is omitted
+	Products *[]UpdateCampaignProduct `json:"products" validate:"omitempty,dive"`
+}
+
+// CampaignCloneForm ..
+// swagger:model CampaignCloneForm
+type CampaignCloneForm struct {
+	// Title of the new campaign
+	Title string `json:"title" validate:"required"`
+	// Order start date
+	OrderStartDate string `json:"order_start_date" validate:"required" example:"2023-12-31 12:00:00"`
+	// Order end date
+	OrderEndDate string `json:"order_end_date" validate:"required" example:"2023-12-31 12:00:00"`
+	// Collection start date
+	CollectionStartDate string `json:"collection_start_date" validate:"required" example:"2023-12-31 12:00:00"`
+	// Collection end date
+	CollectionEndDate string `json:"collection_end_date" validate:"required" example:"2023-12-31 12:00:00"`
+	// IANA timezone the dates are given in, defaults to the timezone of the cloned campaign
+	Timezone string `json:"timezone" validate:"omitempty,timezone" example:"Asia/Singapore"`
+}
+
+type CampaignDates struct {
+	OrderStartDate      string
+	OrderEndDate        string
+	CollectionStartDate string
+	CollectionEndDate   string
+	Timezone            string
+	StoreIDs            []int64
+}
+
+func ToCampaignEntity(campaign CampaignCreationForm, timezone string) (entities.Campaign, error) {
+	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
+	loc, err := util.LoadLocation(timezone)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	orderStartDate, err = util.ToDateTime(campaign.OrderStartDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	orderEndDate, err = util.ToDateTime(campaign.OrderEndDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	collectionStartDate, err = util.ToDateTime(campaign.CollectionStartDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	collectionEndDate, err = util.ToDateTime(campaign.CollectionEndDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+
+	return entities.Campaign{
+		Title:               campaign.Title,
+		CampaignType:        valueobjects.CampaignType(campaign.CampaignType),
+		ListingTitle:        campaign.ListingTitle,
+		ListingDesc:         campaign.ListingDesc,
+		ListingImagePath:    campaign.ListingImagePath,
+		OnboardTitle:        campaign.OnboardTitle,
+		OnboardDesc:         campaign.OnboardDesc,
+		OnboardImagePath:    campaign.OnboardImagePath,
+		LandingImagePath:    campaign.LandingImagePath,
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderEndDate,
+		CollectionStartDate: collectionStartDate,
+		CollectionEndDate:   collectionEndDate,
+		Timezone:            timezone,
+		OfferID:             campaign.OfferID,
+		TagID:               campaign.TagID,
+		LeadTime:            campaign.LeadTime,
+		IsCampaignPublished: campaign.IsCampaignPublished,
+	}, nil
+}
+
+func ToUpdateCampaignEntity(campaign CampaignUpdateForm, campaignID int64, timezone string) (entities.Campaign, error) {
+	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
+	loc, err := util.LoadLocation(timezone)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	if campaign.OrderStartDate != "" {
+		orderStartDate, err = util.ToDateTime(campaign.OrderStartDate, loc)
+		if err != nil {
+			return entities.Campaign{}, err
+		}
+	}
+	if campaign.OrderEndDate != "" {
+		orderEndDate, err = util.ToDateTime(campaign.OrderEndDate, loc)
+		if err != nil {
+			return entities.Campaign{}, err
+		}
+	}
+	if campaign.CollectionStartDate != "" {
+		collectionStartDate, err = util.ToDateTime(campaign.CollectionStartDate, loc)
+		if err != nil {
+			return entities.Campaign{}, err
+		}
+	}
+	if campaign.CollectionEndDate != "" {
+		collectionEndDate, err = util.ToDateTime(campaign.CollectionEndDate, loc)
+		if err != nil {
+			return entities.Campaign{}, err
+		}
+	}
+
+	return entities.Campaign{
+		ID:                  valueobjects.CampaignID(campaignID),
+		Title:               campaign.Title,
+		StatusCode:          int64(campaign.StatusCode),
+		CampaignType:        valueobjects.CampaignType(campaign.CampaignType),
+		ListingTitle:        campaign.ListingTitle,
+		ListingDesc:         campaign.ListingDesc,
+		ListingImagePath:    campaign.ListingImagePath,
+		OnboardTitle:        campaign.OnboardTitle,
+		OnboardDesc:         campaign.OnboardDesc,
+		OnboardImagePath:    campaign.OnboardImagePath,
+		LandingImagePath:    campaign.LandingImagePath,
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderEndDate,
+		CollectionStartDate: collectionStartDate,
+		CollectionEndDate:   collectionEndDate,
+		Timezone:            timezone,
+		OfferID:             campaign.OfferID,
+		TagID:               campaign.TagID,
+		LeadTime:            campaign.LeadTime,
+		IsCampaignPublished: campaign.IsCampaignPublished,
+	}, nil
+}
+
+// ToCloneCampaignEntity holds the fields a clone overrides, everything else is copied from the cloned campaign
+func ToCloneCampaignEntity(campaign CampaignCloneForm, timezone string) (entities.Campaign, error) {
+	var orderStartDate, orderEndDate, collectionStartDate, collectionEndDate time.Time
+	loc, err := util.LoadLocation(timezone)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	orderStartDate, err = util.ToDateTime(campaign.OrderStartDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	orderEndDate, err = util.ToDateTime(campaign.OrderEndDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	collectionStartDate, err = util.ToDateTime(campaign.CollectionStartDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+	collectionEndDate, err = util.ToDateTime(campaign.CollectionEndDate, loc)
+	if err != nil {
+		return entities.Campaign{}, err
+	}
+
+	return entities.Campaign{
+		Title:               campaign.Title,
+		OrderStartDate:      orderStartDate,
+		OrderEndDate:        orderEndDate,
+		CollectionStartDate: collectionStartDate,
+		CollectionEndDate:   collectionEndDate,
+		Timezone:            timezone,
+	}, nil
+}
+
+// CampaignSortColumns are the columns the campaign list can be sorted by
+var CampaignSortColumns = []string{"campaign_id", "title", "status_code", "order_start_date", "order_end_date",
+	"collection_start_date", "collection_end_date", "created_at", "updated_at"}
+
+// CampaignListFilter pages by Cursor in place of Page when it is set, an empty cursor being the first page
+type CampaignListFilter struct {
+	Pagination
+	Cursor         *string
+	Query          string
+	CampaignType   string
+	IsPublished    *bool
+	OrderFrom      time.Time
+	OrderTo        time.Time
+	CollectionFrom time.Time
+	CollectionTo   time.Time
+	CreatedBy      int64
+	StoreID        int64
+	ProductID      int64
+}
+
+func ToCampaignListFilterEntity(filter CampaignListFilter) entities.CampaignListFilter {
+	pagination := ToPaginationEntity(filter.Pagination)
+	pagination.Offset = offset(filter.Pagination)
+	var cursor string
+	if filter.Cursor != nil {
+		pagination.Offset = 0
+		cursor = *filter.Cursor
+	}
+	return entities.CampaignListFilter{
+		PaginationConfig: pagination,
+		Cursor:           cursor,
+		Query:            filter.Query,
+		CampaignType:     filter.CampaignType,
+		IsPublished:      filter.IsPublished,
+		OrderFrom:        filter.OrderFrom,
+		OrderTo:          filter.OrderTo,
+		CollectionFrom:   filter.CollectionFrom,
+		CollectionTo:     filter.CollectionTo,
+		CreatedBy:        filter.CreatedBy,
+		StoreID:          filter.StoreID,
+		ProductID:        filter.ProductID,
+	}
+}
+
+type StoreCampaignFilter struct {
+	Pagination
+	IsPublished     *bool
+	At              time.Time
+	IncludeProducts bool
+}
+
+func ToStoreCampaignFilterEntity(filter StoreCampaignFilter) entities.StoreCampaignFilter {
+	pagination := ToPaginationEntity(filter.Pagination)
+	pagination.Offset = offset(filter.Pagination)
+	return entities.StoreCampaignFilter{
+		PaginationConfig: pagination,
+		IsPublished:      filter.IsPublished,
+		At:               filter.At,
+	}
+}
+
+type ProductCampaignFilter struct {
+	StoreCampaignFilter
+	SKUNo int64
+}
+
+func ToProductCampaignFilterEntity(filter ProductCampaignFilter) entities.ProductCampaignFilter {
+	return entities.ProductCampaignFilter{
+		StoreCampaignFilter: ToStoreCampaignFilterEntity(filter.StoreCampaignFilter),
+		SKUNo:               filter.SKUNo,
+	}
+}
@@ -1,8 +1,22 @@
-package params
-
-// CampaignCodeForm ..
-// swagger:model CampaignCodeForm
-type CampaignCodeForm struct {
-	StatusCode  int64  `json:"status_code"`
-	StatusValue string `json:"status_value"`
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"strings"
+)
+
+// CampaignCodeForm ..
+// swagger:model CampaignCodeForm
+type CampaignCodeForm struct {
+	StatusValue string `json:"status_value" validate:"required,max=100"`
+}
+
+func ToCampaignCodeEntity(form CampaignCodeForm, statusCode, userID int64) entities.CampaignCode {
+	return entities.CampaignCode{
+		StatusCode:  valueobjects.CampaignStatusCode(statusCode),
+		StatusValue: strings.TrimSpace(form.StatusValue),
+		CreatedBy:   userID,
+		UpdatedBy:   userID,
+	}
+}
@@ -1,57 +1,102 @@
-package params
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-)
-
-type CampaignProductCreationForm struct {
-	//campaign Id
-	CampaignID int64 `json:"campaign_id" validate:"required"`
-	// List of campaign products
-	Products []CampaignProduct `json:"products" validate:"required,dive"`
-	// Created by user id
-	CreatedBy int64 `json:"created_by" validate:"required"`
-}
-
-type CampaignProduct struct {
-	ProductID   int64  `json:"product_id" validate:"required"`
-	SKUNo       int64  `json:"SKU_no"`
-	SerialNo    int    `json:"serial_no"`
-	SequenceNo  int    `json:"sequence_no"`
-	ProductType string `json:"product_type" validate:"omitempty,oneof=cd ncd"`
-}
-
-type UpdateCampaignProduct struct {
-	ID          int64  `json:"campaign_product_id"`
-	ProductID   int64  `json:"product_id"`
-	SKUNo       int64  `json:"SKU_no"`
-	SerialNo    int    `json:"serial_no"`
-	SequenceNo  int    `json:"sequence_no"`
-	ProductType string `json:"product_type" validate:"omitempty,oneof=cd ncd"`
-}
-
-func ToCampaignProductEntity(product CampaignProduct, campaignID, userID int64) entities.CampaignProduct {
-	return entities.CampaignProduct{
-		CampaignID:  valueobjects.CampaignID(campaignID),
-		ProductID:   product.ProductID,
-		SKUNo:       product.SKUNo,
-		SerialNo:    product.SerialNo,
-		SequenceNo:  product.SequenceNo,
-		ProductType: product.ProductType,
-		CreatedBy:   userID,
-	}
-}
-
-func ToUpdateCampaignProductEntity(product UpdateCampaignProduct, campaignID, userID int64) entities.CampaignProduct {
-	return entities.CampaignProduct{
-		ID:          valueobjects.CampaignProductID(product.ID),
-		CampaignID:  valueobjects.CampaignID(campaignID),
-		ProductID:   product.ProductID,
-		SKUNo:       product.SKUNo,
-		SerialNo:    product.SerialNo,
-		SequenceNo:  product.SequenceNo,
-		ProductType: product.ProductType,
-		UpdatedBy:   userID,
-	}
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+type CampaignProductCreationForm struct {
+	//campaign Id
+	CampaignID int64 `json:"campaign_id" validate:"required"`
+	// List of campaign products
+	Products []CampaignProduct `json:"products" validate:"required,dive"`
+	// Created by user id
+	CreatedBy int64 `json:"created_by" validate:"required"`
+}
+
+type CampaignProduct struct {
+	ProductID   int64  `json:"product_id" validate:"required"`
+	SKUNo       int64  `json:"SKU_no"`
+	SerialNo    int    `json:"serial_no"`
+	SequenceNo  int    `json:"sequence_no"`
+	ProductType string `json:"product_type" validate:"omitempty,oneof=cd ncd"`
+}
+
+type UpdateCampaignProduct struct {
+	ID          int64  `json:"campaign_product_id"`
+	ProductID   int64  `json:"product_id" validate:"required_without=ID"`
+	SKUNo       int64  `json:"SKU_no"`
+	SerialNo    int    `json:"serial_no"`
+	SequenceNo  int    `json:"sequence_no"`
+	ProductType string `json:"product_type" validate:"omitempty,oneof=cd ncd"`
+}
+
+type CampaignProductUpdateForm struct {
+	ProductID   int64  `json:"product_id"`
+	SKUNo       int64  `json:"SKU_no"`
+	SerialNo    int    `json:"serial_no"`
+	SequenceNo  int    `json:"sequence_no" validate:"gte=0"`
+	ProductType string `json:"product_type" validate:"omitempty,oneof=cd ncd"`
+}
+
+type CampaignProductOrderForm struct {
+	// campaign product ids in the new order, sequence_no is assigned from the position
+	CampaignProductIDs []int64 `json:"campaign_product_ids" validate:"required,unique,dive,gt=0"`
+}
+
+func ToCampaignProductUpdateEntity(product CampaignProductUpdateForm, campaignProductID, campaignID, userID int64) entities.CampaignProduct {
+	return ToUpdateCampaignProductEntity(UpdateCampaignProduct{
+		ID:          campaignProductID,
+		ProductID:   product.ProductID,
+		SKUNo:       product.SKUNo,
+		SerialNo:    product.SerialNo,
+		SequenceNo:  product.SequenceNo,
+		ProductType: product.ProductType,
+	}, campaignID, userID)
+}
+
+func ToCampaignProductEntity(product CampaignProduct, campaignID, userID int64) entities.CampaignProduct {
+	return entities.CampaignProduct{
+		CampaignID:  valueobjects.CampaignID(campaignID),
+		ProductID:   product.ProductID,
+		SKUNo:       product.SKUNo,
+		SerialNo:    product.SerialNo,
+		SequenceNo:  product.SequenceNo,
+		ProductType: product.ProductType,
+		CreatedBy:   userID,
+	}
+}
+
+func ToUpdateCampaignProductEntity(product UpdateCampaignProduct, campaignID, userID int64) entities.CampaignProduct {
+	return entities.CampaignProduct{
+		ID:          valueobjects.CampaignProductID(product.ID),
+		CampaignID:  valueobjects.CampaignID(campaignID),
+		ProductID:   product.ProductID,
+		SKUNo:       product.SKUNo,
+		SerialNo:    product.SerialNo,
+		SequenceNo:  product.SequenceNo,
+		ProductType: product.ProductType,
+		UpdatedBy:   userID,
+	}
+}
+
+// CampaignProductSortColumns are the columns the campaign product list can be sorted by
+var CampaignProductSortColumns = []string{"campaign_product_id", "product_id", "sku_no", "serial_no", "sequence_no", "product_type", "created_at"}
+
+type CampaignProductFilter struct {
+	Pagination
+	ProductID   int64
+	SKUNo       int64
+	ProductType string `validate:"omitempty,oneof=cd ncd"`
+}
+
+func ToCampaignProductFilterEntity(filter CampaignProductFilter) entities.CampaignProductFilter {
+	pagination := ToPaginationEntity(filter.Pagination)
+	pagination.Offset = offset(filter.Pagination)
+	return entities.CampaignProductFilter{
+		PaginationConfig: pagination,
+		ProductID:        filter.ProductID,
+		SKUNo:            filter.SKUNo,
+		ProductType:      filter.ProductType,
+	}
+}
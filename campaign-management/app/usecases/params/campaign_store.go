@@ -1,35 +1,54 @@
-package params
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"campaign-mgmt/app/domain/valueobjects"
-)
-
-// CampaignStoresForm ..
-// swagger:model CampaignStoresForm
-type CampaignStoresForm struct {
-	// List of campaign stores
-	Stores []int64 `json:"stores" validate:"required"`
-}
-
-type UpdateCampaignStore struct {
-	ID      int64 `json:"campaign_store_id" validate:"required"`
-	StoreID int64 `json:"store_id" validate:"required"`
-}
-
-func ToCampaignStoreEntity(storeID, campaignID, userID int64) entities.CampaignStore {
-	return entities.CampaignStore{
-		CampaignID: valueobjects.CampaignID(campaignID),
-		StoreID:    storeID,
-		CreatedBy:  userID,
-	}
-}
-
-func ToUpdateCampaignStoreEntity(store UpdateCampaignStore, campaignID, userID int64) entities.CampaignStore {
-	return entities.CampaignStore{
-		ID:         valueobjects.CampaignStoreID(store.ID),
-		CampaignID: valueobjects.CampaignID(campaignID),
-		StoreID:    store.StoreID,
-		UpdatedBy:  userID,
-	}
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+// CampaignStoresForm ..
+// swagger:model CampaignStoresForm
+type CampaignStoresForm struct {
+	// List of campaign stores
+	Stores []int64 `json:"stores" validate:"required_without=StoreGroupIDs"`
+	// Store groups whose active stores are added along with the stores
+	StoreGroupIDs []int64 `json:"store_group_ids"`
+}
+
+type UpdateCampaignStore struct {
+	ID      int64 `json:"campaign_store_id" validate:"required"`
+	StoreID int64 `json:"store_id" validate:"required"`
+}
+
+func ToCampaignStoreEntity(storeID, campaignID, userID int64) entities.CampaignStore {
+	return entities.CampaignStore{
+		CampaignID: valueobjects.CampaignID(campaignID),
+		StoreID:    storeID,
+		CreatedBy:  userID,
+	}
+}
+
+func ToUpdateCampaignStoreEntity(store UpdateCampaignStore, campaignID, userID int64) entities.CampaignStore {
+	return entities.CampaignStore{
+		ID:         valueobjects.CampaignStoreID(store.ID),
+		CampaignID: valueobjects.CampaignID(campaignID),
+		StoreID:    store.StoreID,
+		UpdatedBy:  userID,
+	}
+}
+
+// CampaignStoreSortColumns are the columns the campaign store list can be sorted by
+var CampaignStoreSortColumns = []string{"campaign_store_id", "store_id", "created_at"}
+
+type CampaignStoreFilter struct {
+	Pagination
+	StoreID int64
+}
+
+func ToCampaignStoreFilterEntity(filter CampaignStoreFilter) entities.CampaignStoreFilter {
+	pagination := ToPaginationEntity(filter.Pagination)
+	pagination.Offset = offset(filter.Pagination)
+	return entities.CampaignStoreFilter{
+		PaginationConfig: pagination,
+		StoreID:          filter.StoreID,
+	}
+}
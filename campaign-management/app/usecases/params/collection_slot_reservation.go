@@ -0,0 +1,38 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+// CollectionSlotReservationForm ..
+// swagger:model CollectionSlotReservationForm
+type CollectionSlotReservationForm struct {
+	// Campaign the collections are for
+	CampaignID int64 `json:"campaign_id" validate:"required,gt=0"`
+	// Number of collections to reserve, 1 when left out
+	Quantity int `json:"quantity" validate:"gte=0"`
+	// Reserve for good instead of holding the quota until confirmed
+	Confirm bool `json:"confirm"`
+	// Reference of the caller e.g. an order number
+	Reference string `json:"reference" validate:"max=100"`
+}
+
+func ToCollectionSlotReservationEntity(form CollectionSlotReservationForm, slot valueobjects.SlotKey, userID int64) entities.CollectionSlotReservation {
+	quantity := form.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	status := valueobjects.ReservationStatusHeld
+	if form.Confirm {
+		status = valueobjects.ReservationStatusConfirmed
+	}
+	return entities.CollectionSlotReservation{
+		CampaignID: valueobjects.CampaignID(form.CampaignID),
+		Slot:       slot,
+		Quantity:   quantity,
+		Status:     status,
+		Reference:  form.Reference,
+		CreatedBy:  userID,
+	}
+}
@@ -1,23 +1,88 @@
-package params
-
-import (
-	"campaign-mgmt/app/domain/entities"
-)
-
-type Pagination struct {
-	Limit  int    `json:"limit"`
-	Page   int    `json:"page"`
-	Sort   string `json:"sort"`
-	Name   string `json:"name"`
-	Status int64  `json:"status"`
-}
-
-func ToPaginationEntity(paginationData Pagination) entities.PaginationConfig {
-	return entities.PaginationConfig{
-		Limit:  paginationData.Limit,
-		Sort:   paginationData.Sort,
-		Page:   paginationData.Page,
-		Name:   paginationData.Name,
-		Status: paginationData.Status,
-	}
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"fmt"
+	"strings"
+)
+
+type Pagination struct {
+	Limit          int                  `json:"limit"`
+	Page           int                  `json:"page"`
+	Sort           string               `json:"sort"`
+	SortFields     []entities.SortField `json:"-"`
+	Name           string               `json:"name"`
+	Status         int64                `json:"status"`
+	StatusValue    string               `json:"status_value"`
+	IncludeDeleted bool                 `json:"include_deleted"`
+}
+
+func ToPaginationEntity(paginationData Pagination) entities.PaginationConfig {
+	return entities.PaginationConfig{
+		Limit:          paginationData.Limit,
+		SortFields:     paginationData.SortFields,
+		Page:           paginationData.Page,
+		Name:           paginationData.Name,
+		Status:         paginationData.Status,
+		StatusValue:    paginationData.StatusValue,
+		IncludeDeleted: paginationData.IncludeDeleted,
+	}
+}
+
+// ToSortFields validates sort given as comma separated columns, each prefixed with - to sort it descending, against
+// the sortable columns, e.g. "-order_start_date,title". A column may also be given as "column asc" or "column desc"
+func ToSortFields(sort string, columns []string) ([]entities.SortField, error) {
+	if strings.TrimSpace(sort) == "" {
+		return nil, nil
+	}
+	sortFields := []entities.SortField{}
+	seen := map[string]bool{}
+	for _, item := range strings.Split(sort, ",") {
+		item = strings.TrimPrefix(strings.TrimSpace(item), "+")
+		sortField := entities.SortField{}
+		if strings.HasPrefix(item, "-") {
+			sortField.Desc = true
+			item = item[1:]
+		}
+		fields := strings.Fields(item)
+		if len(fields) == 0 || len(fields) > 2 || (sortField.Desc && len(fields) == 2) {
+			return nil, fmt.Errorf("%w: %q", valueobjects.ErrSortInvalid, sort)
+		}
+		if len(fields) == 2 {
+			direction := strings.ToLower(fields[1])
+			if direction != "asc" && direction != "desc" {
+				return nil, fmt.Errorf("%w: unknown direction %q", valueobjects.ErrSortInvalid, fields[1])
+			}
+			sortField.Desc = direction == "desc"
+		}
+		if !contains(columns, fields[0]) {
+			return nil, fmt.Errorf("%w: unknown field %q, sortable fields are %s", valueobjects.ErrSortInvalid, fields[0],
+				strings.Join(columns, ", "))
+		}
+		if seen[fields[0]] {
+			return nil, fmt.Errorf("%w: field %q given more than once", valueobjects.ErrSortInvalid, fields[0])
+		}
+		seen[fields[0]] = true
+		sortField.Column = fields[0]
+		sortFields = append(sortFields, sortField)
+	}
+	return sortFields, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// offset returns the row offset of the page, pages start from 1
+func offset(paginationData Pagination) int {
+	if paginationData.Page < 1 {
+		return 0
+	}
+	return (paginationData.Page - 1) * paginationData.Limit
+}
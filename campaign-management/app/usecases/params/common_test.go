@@ -1,30 +1,73 @@
-package params
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	"testing"
-
-	. "github.com/smartystreets/goconvey/convey"
-)
-
-func Test_ToPaginationEntity(t *testing.T) {
-	t.Run("test conversion : success scenario", func(t *testing.T) {
-		request := Pagination{
-			Limit:  20,
-			Page:   1,
-			Sort:   "asc",
-			Name:   "campaign",
-			Status: 1,
-		}
-		expectedResponse := entities.PaginationConfig{
-			Limit:  20,
-			Page:   1,
-			Sort:   "asc",
-			Name:   "campaign",
-			Status: 1,
-		}
-
-		response := ToPaginationEntity(request)
-		ShouldEqual(response, expectedResponse)
-	})
-}
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_ToPaginationEntity(t *testing.T) {
+	t.Run("test conversion : success scenario", func(t *testing.T) {
+		request := Pagination{
+			Limit:      20,
+			Page:       1,
+			Sort:       "title asc",
+			SortFields: []entities.SortField{{Column: "title"}},
+			Name:       "campaign",
+			Status:     1,
+		}
+		expectedResponse := entities.PaginationConfig{
+			Limit:      20,
+			Page:       1,
+			SortFields: []entities.SortField{{Column: "title"}},
+			Name:       "campaign",
+			Status:     1,
+		}
+
+		response := ToPaginationEntity(request)
+		ShouldEqual(response, expectedResponse)
+	})
+}
+
+func Test_ToSortFields(t *testing.T) {
+	columns := []string{"order_start_date", "title", "created_at"}
+	tests := []struct {
+		name     string
+		sort     string
+		expected []entities.SortField
+		wantErr  bool
+	}{
+		{name: "descending and ascending columns", sort: "-order_start_date,title",
+			expected: []entities.SortField{{Column: "order_start_date", Desc: true}, {Column: "title"}}},
+		{name: "column with direction", sort: "created_at desc", expected: []entities.SortField{{Column: "created_at", Desc: true}}},
+		{name: "column without direction", sort: "title", expected: []entities.SortField{{Column: "title"}}},
+		{name: "upper case direction", sort: "created_at DESC", expected: []entities.SortField{{Column: "created_at", Desc: true}}},
+		{name: "unknown direction", sort: "title up", wantErr: true},
+		{name: "spaces around columns", sort: " title , -created_at ",
+			expected: []entities.SortField{{Column: "title"}, {Column: "created_at", Desc: true}}},
+		{name: "no sort", sort: "", expected: nil},
+		{name: "unknown column", sort: "title,listing_description", wantErr: true},
+		{name: "repeated column", sort: "title,-title", wantErr: true},
+		{name: "prefix and direction", sort: "-title desc", wantErr: true},
+		{name: "empty column", sort: "title,", wantErr: true},
+		{name: "injected clause", sort: "title; drop table campaigns", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sortFields, err := ToSortFields(tt.sort, columns)
+			if tt.wantErr {
+				if !errors.Is(err, valueobjects.ErrSortInvalid) {
+					t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSortInvalid)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(sortFields, tt.expected) {
+				t.Errorf("unexpected sort fields : got - %v, %v ; want - %v", sortFields, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"time"
+)
+
+// SlotTemplateForm ..
+// swagger:model SlotTemplateForm
+type SlotTemplateForm struct {
+	// Name of the template
+	Name string `json:"name" validate:"required,max=100"`
+	// Weekdays the template makes slots on
+	DaysOfWeek []string `json:"days_of_week" validate:"required,min=1,unique,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	// Start of the first slot in store local time, HH:MM
+	StartTime string `json:"start_time" validate:"required"`
+	// End of the last slot in store local time, HH:MM, 24:00 for slots running till midnight
+	EndTime string `json:"end_time" validate:"required"`
+	// Length of every slot in minutes, start to end must divide into whole slots
+	SlotMinutes int `json:"slot_minutes" validate:"required,gte=5,lte=1440"`
+	// Number of collections every slot takes
+	Quota int `json:"quota" validate:"gte=0"`
+	// Whether the slots are offered to customers, true when left out
+	IsSlotAvailable *bool `json:"is_slot_available"`
+}
+
+// SlotTemplateApplyForm ..
+// swagger:model SlotTemplateApplyForm
+type SlotTemplateApplyForm struct {
+	// Stores to make the weekly slots for, at most 500 in one request
+	StoreIDs []int64 `json:"store_ids" validate:"required,min=1,max=500,unique,dive,gt=0"`
+	// Only work out the slots that would be created
+	DryRun bool `json:"dry_run"`
+}
+
+func ToSlotTemplateEntity(form SlotTemplateForm, templateID, userID int64) (entities.SlotTemplate, error) {
+	daysOfWeek := []time.Weekday{}
+	for _, name := range form.DaysOfWeek {
+		dayOfWeek, err := valueobjects.ParseWeekday(name)
+		if err != nil {
+			return entities.SlotTemplate{}, err
+		}
+		daysOfWeek = append(daysOfWeek, dayOfWeek)
+	}
+	startTime, err := valueobjects.ParseTimeOfDay(form.StartTime)
+	if err != nil {
+		return entities.SlotTemplate{}, err
+	}
+	endTime, err := valueobjects.ParseTimeOfDay(form.EndTime)
+	if err != nil {
+		return entities.SlotTemplate{}, err
+	}
+	isSlotAvailable := true
+	if form.IsSlotAvailable != nil {
+		isSlotAvailable = *form.IsSlotAvailable
+	}
+	template := entities.SlotTemplate{
+		ID:              valueobjects.SlotTemplateID(templateID),
+		Name:            form.Name,
+		DaysOfWeek:      daysOfWeek,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		SlotMinutes:     form.SlotMinutes,
+		Quota:           form.Quota,
+		IsSlotAvailable: isSlotAvailable,
+	}
+	if templateID > 0 {
+		template.UpdatedBy = userID
+	} else {
+		template.CreatedBy = userID
+	}
+	return template, nil
+}
@@ -0,0 +1,75 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+// StoreForm ..
+// swagger:model StoreForm
+type StoreForm struct {
+	// Store name
+	Name string `json:"name" validate:"required,max=200"`
+	// Store address
+	Address string `json:"address" validate:"max=500"`
+	// Region the store belongs to
+	Region string `json:"region" validate:"max=100"`
+	// IANA timezone of the store
+	Timezone string `json:"timezone" validate:"omitempty,timezone" example:"Asia/Singapore"`
+	// Whether the store can be added to campaigns, true when left out
+	IsActive *bool `json:"is_active"`
+}
+
+// StoreCreationForm ..
+// swagger:model StoreCreationForm
+type StoreCreationForm struct {
+	// Store ID campaigns refer to the store by
+	StoreID int64 `json:"store_id" validate:"required,gt=0"`
+	StoreForm
+}
+
+// StoreSortColumns are the columns the store list can be sorted by
+var StoreSortColumns = []string{"store_id", "name", "region", "created_at"}
+
+type StoreFilter struct {
+	Pagination
+	Region   string
+	IsActive *bool
+}
+
+func ToStoreEntity(form StoreCreationForm, userID int64) entities.Store {
+	store := toStore(form.StoreForm, form.StoreID)
+	store.CreatedBy = userID
+	return store
+}
+
+func ToUpdateStoreEntity(form StoreForm, storeID, userID int64) entities.Store {
+	store := toStore(form, storeID)
+	store.UpdatedBy = userID
+	return store
+}
+
+func toStore(form StoreForm, storeID int64) entities.Store {
+	isActive := true
+	if form.IsActive != nil {
+		isActive = *form.IsActive
+	}
+	return entities.Store{
+		ID:       valueobjects.StoreID(storeID),
+		Name:     form.Name,
+		Address:  form.Address,
+		Region:   form.Region,
+		Timezone: form.Timezone,
+		IsActive: isActive,
+	}
+}
+
+func ToStoreFilterEntity(filter StoreFilter) entities.StoreFilter {
+	pagination := ToPaginationEntity(filter.Pagination)
+	pagination.Offset = offset(filter.Pagination)
+	return entities.StoreFilter{
+		PaginationConfig: pagination,
+		Region:           filter.Region,
+		IsActive:         filter.IsActive,
+	}
+}
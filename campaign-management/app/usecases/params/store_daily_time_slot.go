@@ -0,0 +1,55 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+// StoreDailyTimeSlotForm ..
+// swagger:model StoreDailyTimeSlotForm
+type StoreDailyTimeSlotForm struct {
+	// Weekday the slot repeats on
+	DayOfWeek string `json:"day_of_week" validate:"required,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	// Slot start in store local time, HH:MM
+	StartTime string `json:"start_time" validate:"required"`
+	// Slot end in store local time, HH:MM, 24:00 for slots running till midnight
+	EndTime string `json:"end_time" validate:"required"`
+	// Number of collections the slot takes
+	Quota int `json:"quota" validate:"gte=0"`
+	// Whether the slot is offered to customers, true when left out
+	IsSlotAvailable *bool `json:"is_slot_available"`
+}
+
+func ToStoreDailyTimeSlotEntity(form StoreDailyTimeSlotForm, slotID, storeID, userID int64) (entities.StoreDailyTimeSlot, error) {
+	dayOfWeek, err := valueobjects.ParseWeekday(form.DayOfWeek)
+	if err != nil {
+		return entities.StoreDailyTimeSlot{}, err
+	}
+	startTime, err := valueobjects.ParseTimeOfDay(form.StartTime)
+	if err != nil {
+		return entities.StoreDailyTimeSlot{}, err
+	}
+	endTime, err := valueobjects.ParseTimeOfDay(form.EndTime)
+	if err != nil {
+		return entities.StoreDailyTimeSlot{}, err
+	}
+	isSlotAvailable := true
+	if form.IsSlotAvailable != nil {
+		isSlotAvailable = *form.IsSlotAvailable
+	}
+	slot := entities.StoreDailyTimeSlot{
+		ID:              valueobjects.DailyTimeSlotID(slotID),
+		StoreID:         storeID,
+		DayOfWeek:       dayOfWeek,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		Quota:           form.Quota,
+		IsSlotAvailable: isSlotAvailable,
+	}
+	if slotID > 0 {
+		slot.UpdatedBy = userID
+	} else {
+		slot.CreatedBy = userID
+	}
+	return slot, nil
+}
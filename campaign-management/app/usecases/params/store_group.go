@@ -0,0 +1,69 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+)
+
+// StoreGroupForm ..
+// swagger:model StoreGroupForm
+type StoreGroupForm struct {
+	// Store group name
+	Name string `json:"name" validate:"required,max=200"`
+	// Store group description
+	Description string `json:"description" validate:"max=500"`
+}
+
+// StoreGroupCreationForm ..
+// swagger:model StoreGroupCreationForm
+type StoreGroupCreationForm struct {
+	StoreGroupForm
+	// Stores of the group, every store must be registered and active
+	Stores []int64 `json:"stores"`
+}
+
+// StoreGroupStoresForm ..
+// swagger:model StoreGroupStoresForm
+type StoreGroupStoresForm struct {
+	// Stores to add to the group, every store must be registered and active
+	Stores []int64 `json:"stores" validate:"required,min=1"`
+}
+
+// StoreGroupSortColumns are the columns the store group list can be sorted by
+var StoreGroupSortColumns = []string{"store_group_id", "name", "created_at"}
+
+func ToStoreGroupEntity(form StoreGroupCreationForm, userID int64) entities.StoreGroup {
+	return entities.StoreGroup{
+		Name:        form.Name,
+		Description: form.Description,
+		StoreIDs:    form.Stores,
+		CreatedBy:   userID,
+	}
+}
+
+func ToUpdateStoreGroupEntity(form StoreGroupForm, storeGroupID, userID int64) entities.StoreGroup {
+	return entities.StoreGroup{
+		ID:          valueobjects.StoreGroupID(storeGroupID),
+		Name:        form.Name,
+		Description: form.Description,
+		UpdatedBy:   userID,
+	}
+}
+
+func ToStoreGroupMemberEntities(storeIDs []int64, storeGroupID, userID int64) []entities.StoreGroupMember {
+	members := []entities.StoreGroupMember{}
+	for _, storeID := range storeIDs {
+		members = append(members, entities.StoreGroupMember{
+			StoreGroupID: valueobjects.StoreGroupID(storeGroupID),
+			StoreID:      storeID,
+			CreatedBy:    userID,
+		})
+	}
+	return members
+}
+
+func ToStoreGroupPaginationEntity(pagination Pagination) entities.PaginationConfig {
+	paginationConfig := ToPaginationEntity(pagination)
+	paginationConfig.Offset = offset(pagination)
+	return paginationConfig
+}
@@ -0,0 +1,150 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	"fmt"
+	"time"
+)
+
+// MaxSpecificTimeSlotBulkDays is the longest date range a single bulk request may cover
+const MaxSpecificTimeSlotBulkDays = 92
+
+// StoreSpecificTimeSlotForm ..
+// swagger:model StoreSpecificTimeSlotForm
+type StoreSpecificTimeSlotForm struct {
+	// Date the slot applies to, YYYY-MM-DD
+	Date string `json:"date" validate:"required"`
+	// Slot start in store local time, HH:MM, left out for a closed date
+	StartTime string `json:"start_time" validate:"required_unless=IsClosed true"`
+	// Slot end in store local time, HH:MM, 24:00 for slots running till midnight, left out for a closed date
+	EndTime string `json:"end_time" validate:"required_unless=IsClosed true"`
+	// Number of collections the slot takes
+	Quota int `json:"quota" validate:"gte=0"`
+	// Marks the store closed the whole date
+	IsClosed bool `json:"is_closed"`
+}
+
+// StoreSpecificTimeSlotTimeForm ..
+// swagger:model StoreSpecificTimeSlotTimeForm
+type StoreSpecificTimeSlotTimeForm struct {
+	// Slot start in store local time, HH:MM
+	StartTime string `json:"start_time" validate:"required"`
+	// Slot end in store local time, HH:MM, 24:00 for slots running till midnight
+	EndTime string `json:"end_time" validate:"required"`
+	// Number of collections the slot takes
+	Quota int `json:"quota" validate:"gte=0"`
+}
+
+// StoreSpecificTimeSlotBulkForm ..
+// swagger:model StoreSpecificTimeSlotBulkForm
+type StoreSpecificTimeSlotBulkForm struct {
+	// First date of the range, YYYY-MM-DD
+	StartDate string `json:"start_date" validate:"required"`
+	// Last date of the range, YYYY-MM-DD, inclusive
+	EndDate string `json:"end_date" validate:"required"`
+	// Weekdays of the range to fill, every date of the range when left out
+	DaysOfWeek []string `json:"days_of_week" validate:"omitempty,unique,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	// Marks the store closed on every date of the range
+	IsClosed bool `json:"is_closed"`
+	// Slots added to every date of the range, left out for a closure
+	Slots []StoreSpecificTimeSlotTimeForm `json:"slots" validate:"required_unless=IsClosed true,dive"`
+}
+
+func ToStoreSpecificTimeSlotEntity(form StoreSpecificTimeSlotForm, slotID, storeID, userID int64) (entities.StoreSpecificTimeSlot, error) {
+	date, err := valueobjects.ParseDate(form.Date)
+	if err != nil {
+		return entities.StoreSpecificTimeSlot{}, err
+	}
+	slot := entities.StoreSpecificTimeSlot{
+		ID:       valueobjects.SpecificTimeSlotID(slotID),
+		StoreID:  storeID,
+		Date:     date,
+		IsClosed: form.IsClosed,
+	}
+	if form.IsClosed {
+		if form.StartTime != "" || form.EndTime != "" || form.Quota != 0 {
+			return entities.StoreSpecificTimeSlot{}, fmt.Errorf("%w: a closed date takes no times or quota", valueobjects.ErrTimeSlotInvalid)
+		}
+	} else {
+		if slot.StartTime, err = valueobjects.ParseTimeOfDay(form.StartTime); err != nil {
+			return entities.StoreSpecificTimeSlot{}, err
+		}
+		if slot.EndTime, err = valueobjects.ParseTimeOfDay(form.EndTime); err != nil {
+			return entities.StoreSpecificTimeSlot{}, err
+		}
+		slot.Quota = form.Quota
+	}
+	if slotID > 0 {
+		slot.UpdatedBy = userID
+	} else {
+		slot.CreatedBy = userID
+	}
+	return slot, nil
+}
+
+// ToStoreSpecificTimeSlotEntities expands the bulk form into the slots of every matching date of the range
+func ToStoreSpecificTimeSlotEntities(form StoreSpecificTimeSlotBulkForm, storeID, userID int64) ([]entities.StoreSpecificTimeSlot, error) {
+	startDate, err := valueobjects.ParseDate(form.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := valueobjects.ParseDate(form.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("%w: end date %s is before start date %s", valueobjects.ErrTimeSlotInvalid, form.EndDate, form.StartDate)
+	}
+	if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > MaxSpecificTimeSlotBulkDays {
+		return nil, fmt.Errorf("%w: range of %d days is longer than %d days", valueobjects.ErrTimeSlotInvalid, days, MaxSpecificTimeSlotBulkDays)
+	}
+	if form.IsClosed && len(form.Slots) > 0 {
+		return nil, fmt.Errorf("%w: a closed date takes no slots", valueobjects.ErrTimeSlotInvalid)
+	}
+	if !form.IsClosed && len(form.Slots) == 0 {
+		return nil, fmt.Errorf("%w: slots are required unless the dates are closed", valueobjects.ErrTimeSlotInvalid)
+	}
+
+	weekdays := map[time.Weekday]bool{}
+	for _, name := range form.DaysOfWeek {
+		weekday, err := valueobjects.ParseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		weekdays[weekday] = true
+	}
+
+	template := []entities.StoreSpecificTimeSlot{}
+	if form.IsClosed {
+		template = append(template, entities.StoreSpecificTimeSlot{IsClosed: true})
+	}
+	for _, timeForm := range form.Slots {
+		startTime, err := valueobjects.ParseTimeOfDay(timeForm.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		endTime, err := valueobjects.ParseTimeOfDay(timeForm.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		template = append(template, entities.StoreSpecificTimeSlot{StartTime: startTime, EndTime: endTime, Quota: timeForm.Quota})
+	}
+
+	slots := []entities.StoreSpecificTimeSlot{}
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		if len(weekdays) > 0 && !weekdays[date.Weekday()] {
+			continue
+		}
+		for _, slot := range template {
+			slot.StoreID = storeID
+			slot.Date = date
+			slot.CreatedBy = userID
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("%w: no date of the range falls on the given days of week", valueobjects.ErrTimeSlotInvalid)
+	}
+	return slots, nil
+}
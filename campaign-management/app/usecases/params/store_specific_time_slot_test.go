@@ -0,0 +1,71 @@
+package params
+
+import (
+	"campaign-mgmt/app/domain/valueobjects"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestToStoreSpecificTimeSlotEntities(t *testing.T) {
+	t.Run("when the slots are repeated on the given weekdays of the range", func(t *testing.T) {
+		slots, err := ToStoreSpecificTimeSlotEntities(StoreSpecificTimeSlotBulkForm{
+			StartDate:  "2024-02-05",
+			EndDate:    "2024-02-11",
+			DaysOfWeek: []string{"saturday", "sunday"},
+			Slots: []StoreSpecificTimeSlotTimeForm{
+				{StartTime: "09:00", EndTime: "12:00", Quota: 20},
+				{StartTime: "13:00", EndTime: "18:00", Quota: 30},
+			},
+		}, 84, 7)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 4 {
+			t.Fatalf("unexpected slot count : got - %d ; want - 4", len(slots))
+		}
+		saturday := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+		if !slots[0].Date.Equal(saturday) || slots[0].StartTime != 540 || slots[1].Quota != 30 ||
+			!slots[3].Date.Equal(saturday.AddDate(0, 0, 1)) || slots[3].StoreID != 84 || slots[3].CreatedBy != 7 {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+	})
+
+	t.Run("when every date of the range is closed", func(t *testing.T) {
+		slots, err := ToStoreSpecificTimeSlotEntities(StoreSpecificTimeSlotBulkForm{
+			StartDate: "2024-02-10", EndDate: "2024-02-12", IsClosed: true,
+		}, 84, 7)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(slots) != 3 || !slots[2].IsClosed {
+			t.Errorf("unexpected slots : got - %+v", slots)
+		}
+	})
+
+	invalidForms := map[string]StoreSpecificTimeSlotBulkForm{
+		"range ends before it starts": {StartDate: "2024-02-10", EndDate: "2024-02-09", IsClosed: true},
+		"range is too long":           {StartDate: "2024-01-01", EndDate: "2024-12-31", IsClosed: true},
+		"closure with slots": {StartDate: "2024-02-10", EndDate: "2024-02-10", IsClosed: true,
+			Slots: []StoreSpecificTimeSlotTimeForm{{StartTime: "09:00", EndTime: "12:00"}}},
+		"open dates without slots":   {StartDate: "2024-02-10", EndDate: "2024-02-10", Slots: []StoreSpecificTimeSlotTimeForm{}},
+		"no date on the weekdays":    {StartDate: "2024-02-10", EndDate: "2024-02-10", DaysOfWeek: []string{"monday"}, IsClosed: true},
+		"date in a different format": {StartDate: "10/02/2024", EndDate: "2024-02-10", IsClosed: true},
+	}
+	for name, form := range invalidForms {
+		t.Run("when "+name, func(t *testing.T) {
+			if _, err := ToStoreSpecificTimeSlotEntities(form, 84, 7); !errors.Is(err, valueobjects.ErrTimeSlotInvalid) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrTimeSlotInvalid)
+			}
+		})
+	}
+}
+
+func TestToStoreSpecificTimeSlotEntity(t *testing.T) {
+	t.Run("when a closure carries times", func(t *testing.T) {
+		_, err := ToStoreSpecificTimeSlotEntity(StoreSpecificTimeSlotForm{Date: "2024-02-10", StartTime: "09:00", IsClosed: true}, 0, 84, 7)
+		if !errors.Is(err, valueobjects.ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrTimeSlotInvalid)
+		}
+	})
+}
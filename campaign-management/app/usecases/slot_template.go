@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type SlotTemplateUseCase struct {
+	templateRepo       services.SlotTemplates
+	dailyTimeSlotRepo  services.StoreDailyTimeSlots
+	storeRepo          services.Stores
+	transactionService services.TransactionService
+}
+
+func NewSlotTemplateUseCase(templateRepo services.SlotTemplates, dailyTimeSlotRepo services.StoreDailyTimeSlots,
+	storeRepo services.Stores, transactionService services.TransactionService) *SlotTemplateUseCase {
+	return &SlotTemplateUseCase{
+		templateRepo:       templateRepo,
+		dailyTimeSlotRepo:  dailyTimeSlotRepo,
+		storeRepo:          storeRepo,
+		transactionService: transactionService,
+	}
+}
+
+func (c *SlotTemplateUseCase) GetTemplates(ctx context.Context) ([]*dto.SlotTemplateDTO, error) {
+	templates, err := c.templateRepo.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.SlotTemplateDTO{}
+	for _, template := range templates {
+		response = append(response, dto.ToSlotTemplateDTO(template))
+	}
+	return response, nil
+}
+
+func (c *SlotTemplateUseCase) GetTemplate(ctx context.Context, templateID int64) (*dto.SlotTemplateDTO, error) {
+	template, err := c.templateRepo.Get(ctx, valueobjects.SlotTemplateID(templateID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToSlotTemplateDTO(template), nil
+}
+
+func (c *SlotTemplateUseCase) CreateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error) {
+	if err := validateSlotTemplate(template); err != nil {
+		return nil, err
+	}
+	created, err := c.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToSlotTemplateDTO(created), nil
+}
+
+func (c *SlotTemplateUseCase) UpdateTemplate(ctx context.Context, template entities.SlotTemplate) (*dto.SlotTemplateDTO, error) {
+	if _, err := c.templateRepo.Get(ctx, template.ID); err != nil {
+		return nil, err
+	}
+	if err := validateSlotTemplate(template); err != nil {
+		return nil, err
+	}
+	if err := c.templateRepo.Update(ctx, template); err != nil {
+		return nil, err
+	}
+	return dto.ToSlotTemplateDTO(template), nil
+}
+
+func (c *SlotTemplateUseCase) DeleteTemplate(ctx context.Context, templateID, userID int64) error {
+	return c.templateRepo.Delete(ctx, valueobjects.SlotTemplateID(templateID), userID)
+}
+
+// ApplyTemplate makes the weekly slots of the template for every store in one transaction, nothing is created
+// when a store is unknown or inactive or a slot would overlap a slot the store already has. A dry run goes
+// through the same checks and returns the slots without creating them
+func (c *SlotTemplateUseCase) ApplyTemplate(ctx context.Context, templateID int64, storeIDs []int64, dryRun bool, userID int64) (*dto.SlotTemplateApplyResult, error) {
+	template, err := c.templateRepo.Get(ctx, valueobjects.SlotTemplateID(templateID))
+	if err != nil {
+		return nil, err
+	}
+	slots := []entities.StoreDailyTimeSlot{}
+	err = c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := activeStoresByID(ctx, c.storeRepo, storeIDs); err != nil {
+			return err
+		}
+		for _, storeID := range storeIDs {
+			storeSlots := template.DailySlots(storeID, userID)
+			if err := c.checkOverlaps(ctx, storeID, storeSlots); err != nil {
+				return err
+			}
+			slots = append(slots, storeSlots...)
+		}
+		if dryRun || len(slots) == 0 {
+			return nil
+		}
+		created, err := c.dailyTimeSlotRepo.CreateMultiple(ctx, slots)
+		if err != nil {
+			return err
+		}
+		slots = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.SlotTemplateApplyResult{
+		TemplateID: templateID,
+		DryRun:     dryRun,
+		StoreIDs:   storeIDs,
+		Slots:      []*dto.StoreDailyTimeSlotDTO{},
+	}
+	for _, slot := range slots {
+		result.Slots = append(result.Slots, dto.ToStoreDailyTimeSlotDTO(slot))
+	}
+	return result, nil
+}
+
+// checkOverlaps makes sure none of the slots shares time with a slot the store has on the same weekday, the
+// weekdays stay locked until the transaction ends so the slots are written against what was checked
+func (c *SlotTemplateUseCase) checkOverlaps(ctx context.Context, storeID int64, slots []entities.StoreDailyTimeSlot) error {
+	existing := map[time.Weekday][]entities.StoreDailyTimeSlot{}
+	for _, slot := range slots {
+		if _, ok := existing[slot.DayOfWeek]; ok {
+			continue
+		}
+		daySlots, err := c.dailyTimeSlotRepo.LockDay(ctx, storeID, slot.DayOfWeek)
+		if err != nil {
+			return err
+		}
+		existing[slot.DayOfWeek] = daySlots
+	}
+	for _, slot := range slots {
+		for _, other := range existing[slot.DayOfWeek] {
+			if valueobjects.TimeRangesOverlap(slot.StartTime, slot.EndTime, other.StartTime, other.EndTime) {
+				return fmt.Errorf("%w: store %d %s %v-%v overlaps slot %d %v-%v", valueobjects.ErrDailyTimeSlotOverlap, storeID,
+					valueobjects.WeekdayName(slot.DayOfWeek), slot.StartTime, slot.EndTime, other.ID, other.StartTime, other.EndTime)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSlotTemplate makes sure the time between start and end divides into whole slots
+func validateSlotTemplate(template entities.SlotTemplate) error {
+	if template.EndTime <= template.StartTime {
+		return fmt.Errorf("%w: end time %v must be after start time %v", valueobjects.ErrTimeSlotInvalid, template.EndTime, template.StartTime)
+	}
+	if template.SlotMinutes <= 0 {
+		return fmt.Errorf("%w: slot minutes must be positive", valueobjects.ErrTimeSlotInvalid)
+	}
+	if minutes := int(template.EndTime - template.StartTime); minutes%template.SlotMinutes != 0 {
+		return fmt.Errorf("%w: %v-%v does not divide into slots of %d minutes", valueobjects.ErrTimeSlotInvalid,
+			template.StartTime, template.EndTime, template.SlotMinutes)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSlotTemplateUseCase_CreateTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    entities.SlotTemplate
+		expectedErr error
+	}{
+		{name: "when template ends before it starts",
+			template: entities.SlotTemplate{DaysOfWeek: []time.Weekday{time.Monday}, StartTime: 600, EndTime: 600, SlotMinutes: 60}, expectedErr: valueobjects.ErrTimeSlotInvalid},
+		{name: "when template does not divide into whole slots",
+			template: entities.SlotTemplate{DaysOfWeek: []time.Weekday{time.Monday}, StartTime: 600, EndTime: 690, SlotMinutes: 60}, expectedErr: valueobjects.ErrTimeSlotInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slotTemplateUseCase := NewSlotTemplateUseCase(mocks.NewSlotTemplates(t), mocks.NewStoreDailyTimeSlots(t), mocks.NewStores(t), mocks.NewTransactionService(t))
+			_, err := slotTemplateUseCase.CreateTemplate(context.Background(), tt.template)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("unexpected error : got - %v ; want - %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestSlotTemplateUseCase_ApplyTemplate(t *testing.T) {
+	template := entities.SlotTemplate{ID: 4, Name: "weekday", DaysOfWeek: []time.Weekday{time.Monday, time.Tuesday},
+		StartTime: 600, EndTime: 780, SlotMinutes: 60, Quota: 30, IsSlotAvailable: true}
+	expected := func(storeIDs ...int64) []entities.StoreDailyTimeSlot {
+		slots := []entities.StoreDailyTimeSlot{}
+		for _, storeID := range storeIDs {
+			for _, dayOfWeek := range template.DaysOfWeek {
+				for _, start := range []valueobjects.TimeOfDay{600, 660, 720} {
+					slots = append(slots, entities.StoreDailyTimeSlot{StoreID: storeID, DayOfWeek: dayOfWeek, StartTime: start,
+						EndTime: start + 60, Quota: 30, IsSlotAvailable: true, CreatedBy: 7})
+				}
+			}
+		}
+		return slots
+	}
+	runInTransaction := func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}
+
+	t.Run("when slots created for every store", func(t *testing.T) {
+		ctx := context.Background()
+		mockTemplateService := mocks.NewSlotTemplates(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		slotTemplateUseCase := NewSlotTemplateUseCase(mockTemplateService, mockDailyTimeSlotService, newOpenStoreRegistry(t), mockTransactionService)
+		mockTemplateService.On("Get", ctx, valueobjects.SlotTemplateID(4)).Return(template, nil)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return([]entities.StoreDailyTimeSlot{
+			{ID: 1, StoreID: 84, DayOfWeek: time.Monday, StartTime: 780, EndTime: 840},
+		}, nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Tuesday).Return([]entities.StoreDailyTimeSlot{}, nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(85), time.Monday).Return([]entities.StoreDailyTimeSlot{}, nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(85), time.Tuesday).Return([]entities.StoreDailyTimeSlot{}, nil)
+		mockDailyTimeSlotService.On("CreateMultiple", ctx, expected(84, 85)).
+			Return(func(ctx context.Context, slots []entities.StoreDailyTimeSlot) []entities.StoreDailyTimeSlot {
+				for i := range slots {
+					slots[i].ID = valueobjects.DailyTimeSlotID(i + 10)
+				}
+				return slots
+			}, nil)
+
+		result, err := slotTemplateUseCase.ApplyTemplate(ctx, 4, []int64{84, 85}, false, 7)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if result.DryRun || len(result.Slots) != 12 || result.Slots[0].ID != 10 || result.Slots[11].StoreID != 85 {
+			t.Errorf("unexpected result : got - %+v", result)
+		}
+	})
+
+	t.Run("when dry run returns the slots without creating them", func(t *testing.T) {
+		ctx := context.Background()
+		mockTemplateService := mocks.NewSlotTemplates(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		slotTemplateUseCase := NewSlotTemplateUseCase(mockTemplateService, mockDailyTimeSlotService, newOpenStoreRegistry(t), mockTransactionService)
+		mockTemplateService.On("Get", ctx, valueobjects.SlotTemplateID(4)).Return(template, nil)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), mock.Anything).Return([]entities.StoreDailyTimeSlot{}, nil)
+
+		result, err := slotTemplateUseCase.ApplyTemplate(ctx, 4, []int64{84}, true, 7)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if !result.DryRun || len(result.Slots) != 6 || result.Slots[0].StartTime != "10:00" || result.Slots[5].DayOfWeek != "tuesday" {
+			t.Errorf("unexpected result : got - %+v", result)
+		}
+		mockDailyTimeSlotService.AssertNotCalled(t, "CreateMultiple", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when a slot overlaps a slot of the store", func(t *testing.T) {
+		ctx := context.Background()
+		mockTemplateService := mocks.NewSlotTemplates(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		slotTemplateUseCase := NewSlotTemplateUseCase(mockTemplateService, mockDailyTimeSlotService, newOpenStoreRegistry(t), mockTransactionService)
+		mockTemplateService.On("Get", ctx, valueobjects.SlotTemplateID(4)).Return(template, nil)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), mock.Anything).Return([]entities.StoreDailyTimeSlot{}, nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(85), time.Monday).Return([]entities.StoreDailyTimeSlot{}, nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(85), time.Tuesday).Return([]entities.StoreDailyTimeSlot{
+			{ID: 1, StoreID: 85, DayOfWeek: time.Tuesday, StartTime: 750, EndTime: 840},
+		}, nil)
+
+		_, err := slotTemplateUseCase.ApplyTemplate(ctx, 4, []int64{84, 85}, false, 7)
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotOverlap) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotOverlap)
+		}
+	})
+
+	t.Run("when a store is not active in the registry", func(t *testing.T) {
+		ctx := context.Background()
+		mockTemplateService := mocks.NewSlotTemplates(t)
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockStoreService := mocks.NewStores(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		slotTemplateUseCase := NewSlotTemplateUseCase(mockTemplateService, mockDailyTimeSlotService, mockStoreService, mockTransactionService)
+		mockTemplateService.On("Get", ctx, valueobjects.SlotTemplateID(4)).Return(template, nil)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockStoreService.On("GetByIDs", ctx, []int64{84, 85}).Return([]entities.Store{
+			{ID: 84, IsActive: true},
+			{ID: 85, IsActive: false},
+		}, nil)
+
+		_, err := slotTemplateUseCase.ApplyTemplate(ctx, 4, []int64{84, 85}, false, 7)
+		if !errors.Is(err, valueobjects.ErrStoreUnknown) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreUnknown)
+		}
+		mockDailyTimeSlotService.AssertNotCalled(t, "LockDay", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when template not exists", func(t *testing.T) {
+		ctx := context.Background()
+		mockTemplateService := mocks.NewSlotTemplates(t)
+		slotTemplateUseCase := NewSlotTemplateUseCase(mockTemplateService, mocks.NewStoreDailyTimeSlots(t), mocks.NewStores(t), mocks.NewTransactionService(t))
+		mockTemplateService.On("Get", ctx, valueobjects.SlotTemplateID(4)).
+			Return(entities.SlotTemplate{}, valueobjects.ErrSlotTemplateNotExists)
+
+		_, err := slotTemplateUseCase.ApplyTemplate(ctx, 4, []int64{84}, true, 7)
+		if !errors.Is(err, valueobjects.ErrSlotTemplateNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSlotTemplateNotExists)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type StoreUseCase struct {
+	storeRepo services.Stores
+}
+
+func NewStoreUseCase(storeRepo services.Stores) *StoreUseCase {
+	return &StoreUseCase{
+		storeRepo: storeRepo,
+	}
+}
+
+func (c *StoreUseCase) GetStores(ctx context.Context, filter entities.StoreFilter) (*dto.StoreListResponse, error) {
+	stores, count, err := c.storeRepo.GetList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToStoreListResponse(stores, count, filter.PaginationConfig)
+	return &response, nil
+}
+
+func (c *StoreUseCase) GetStore(ctx context.Context, storeID int64) (*dto.StoreDTO, error) {
+	store, err := c.storeRepo.Get(ctx, valueobjects.StoreID(storeID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDTO(store), nil
+}
+
+func (c *StoreUseCase) CreateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error) {
+	_, err := c.storeRepo.Get(ctx, store.ID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %d", valueobjects.ErrRegisteredStoreExists, store.ID)
+	}
+	if !errors.Is(err, valueobjects.ErrRegisteredStoreNotExists) {
+		return nil, err
+	}
+	created, err := c.storeRepo.Create(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDTO(created), nil
+}
+
+func (c *StoreUseCase) UpdateStore(ctx context.Context, store entities.Store) (*dto.StoreDTO, error) {
+	if _, err := c.storeRepo.Get(ctx, store.ID); err != nil {
+		return nil, err
+	}
+	if err := c.storeRepo.Update(ctx, store); err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDTO(store), nil
+}
+
+func (c *StoreUseCase) DeleteStore(ctx context.Context, storeID, userID int64) error {
+	return c.storeRepo.Delete(ctx, valueobjects.StoreID(storeID), userID)
+}
+
+// registeredStoresByID returns the registry details of the stores by store id, stores not registered are left out
+func registeredStoresByID(ctx context.Context, storeRepo services.Stores, storeIDs []int64) (map[int64]entities.Store, error) {
+	stores, err := storeRepo.GetByIDs(ctx, storeIDs)
+	if err != nil {
+		return nil, err
+	}
+	registered := map[int64]entities.Store{}
+	for _, store := range stores {
+		registered[store.ID.ToInt64()] = store
+	}
+	return registered, nil
+}
+
+// activeStoresByID refuses stores that are not registered or not active, naming every such store
+func activeStoresByID(ctx context.Context, storeRepo services.Stores, storeIDs []int64) (map[int64]entities.Store, error) {
+	registered, err := registeredStoresByID(ctx, storeRepo, storeIDs)
+	if err != nil {
+		return nil, err
+	}
+	unknown := map[int64]bool{}
+	for _, storeID := range storeIDs {
+		if store, ok := registered[storeID]; !ok || !store.IsActive {
+			unknown[storeID] = true
+		}
+	}
+	if len(unknown) == 0 {
+		return registered, nil
+	}
+	unknownIDs := []int64{}
+	for storeID := range unknown {
+		unknownIDs = append(unknownIDs, storeID)
+	}
+	sort.Slice(unknownIDs, func(i, j int) bool { return unknownIDs[i] < unknownIDs[j] })
+	names := []string{}
+	for _, storeID := range unknownIDs {
+		names = append(names, strconv.FormatInt(storeID, 10))
+	}
+	return nil, fmt.Errorf("%w: %s", valueobjects.ErrStoreUnknown, strings.Join(names, ", "))
+}
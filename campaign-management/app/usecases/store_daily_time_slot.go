@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type StoreDailyTimeSlotUseCase struct {
+	dailyTimeSlotRepo  services.StoreDailyTimeSlots
+	storeRepo          services.Stores
+	transactionService services.TransactionService
+}
+
+func NewStoreDailyTimeSlotUseCase(dailyTimeSlotRepo services.StoreDailyTimeSlots, storeRepo services.Stores,
+	transactionService services.TransactionService) *StoreDailyTimeSlotUseCase {
+	return &StoreDailyTimeSlotUseCase{
+		dailyTimeSlotRepo:  dailyTimeSlotRepo,
+		storeRepo:          storeRepo,
+		transactionService: transactionService,
+	}
+}
+
+func (c *StoreDailyTimeSlotUseCase) GetSlots(ctx context.Context, storeID int64) ([]*dto.StoreDailyTimeSlotDTO, error) {
+	slots, err := c.dailyTimeSlotRepo.GetList(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.StoreDailyTimeSlotDTO{}
+	for _, slot := range slots {
+		response = append(response, dto.ToStoreDailyTimeSlotDTO(slot))
+	}
+	return response, nil
+}
+
+func (c *StoreDailyTimeSlotUseCase) GetSlot(ctx context.Context, storeID, slotID int64) (*dto.StoreDailyTimeSlotDTO, error) {
+	slot, err := c.dailyTimeSlotRepo.Get(ctx, storeID, valueobjects.DailyTimeSlotID(slotID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDailyTimeSlotDTO(slot), nil
+}
+
+func (c *StoreDailyTimeSlotUseCase) CreateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error) {
+	if err := validateSlotTimes(slot); err != nil {
+		return nil, err
+	}
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		if err := c.validateSlot(ctx, slot); err != nil {
+			return err
+		}
+		created, err := c.dailyTimeSlotRepo.Create(ctx, slot)
+		if err != nil {
+			return err
+		}
+		slot = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDailyTimeSlotDTO(slot), nil
+}
+
+func (c *StoreDailyTimeSlotUseCase) UpdateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) (*dto.StoreDailyTimeSlotDTO, error) {
+	if err := validateSlotTimes(slot); err != nil {
+		return nil, err
+	}
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := c.dailyTimeSlotRepo.Get(ctx, slot.StoreID, slot.ID); err != nil {
+			return err
+		}
+		if err := c.validateSlot(ctx, slot); err != nil {
+			return err
+		}
+		return c.dailyTimeSlotRepo.Update(ctx, slot)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreDailyTimeSlotDTO(slot), nil
+}
+
+func (c *StoreDailyTimeSlotUseCase) DeleteSlot(ctx context.Context, storeID, slotID, userID int64) error {
+	return c.dailyTimeSlotRepo.Delete(ctx, storeID, valueobjects.DailyTimeSlotID(slotID), userID)
+}
+
+// validateSlotTimes makes sure the slot ends after it starts
+func validateSlotTimes(slot entities.StoreDailyTimeSlot) error {
+	if slot.EndTime <= slot.StartTime {
+		return fmt.Errorf("%w: end time %v must be after start time %v", valueobjects.ErrTimeSlotInvalid, slot.EndTime, slot.StartTime)
+	}
+	return nil
+}
+
+// validateSlot makes sure the store is registered and active and the slot shares no time with the other slots of the
+// store on the same weekday. It runs in the transaction writing the slot, the slots of the weekday stay locked until
+// the slot is written so two writers cannot both find the time free
+func (c *StoreDailyTimeSlotUseCase) validateSlot(ctx context.Context, slot entities.StoreDailyTimeSlot) error {
+	if _, err := activeStoresByID(ctx, c.storeRepo, []int64{slot.StoreID}); err != nil {
+		return err
+	}
+	slots, err := c.dailyTimeSlotRepo.LockDay(ctx, slot.StoreID, slot.DayOfWeek)
+	if err != nil {
+		return err
+	}
+	for _, existing := range slots {
+		if existing.ID == slot.ID {
+			continue
+		}
+		if valueobjects.TimeRangesOverlap(slot.StartTime, slot.EndTime, existing.StartTime, existing.EndTime) {
+			return fmt.Errorf("%w: %s %v-%v overlaps slot %d %v-%v", valueobjects.ErrDailyTimeSlotOverlap,
+				valueobjects.WeekdayName(slot.DayOfWeek), slot.StartTime, slot.EndTime, existing.ID, existing.StartTime, existing.EndTime)
+		}
+	}
+	return nil
+}
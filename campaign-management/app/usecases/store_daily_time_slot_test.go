@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// newDailyTimeSlotUseCase returns the use case over the slot repo with every store registered and the work run in the
+// transaction mock
+func newDailyTimeSlotUseCase(t *testing.T, dailyTimeSlotRepo *mocks.StoreDailyTimeSlots) *StoreDailyTimeSlotUseCase {
+	mockTransactionService := mocks.NewTransactionService(t)
+	mockTransactionService.On("RunWithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	return NewStoreDailyTimeSlotUseCase(dailyTimeSlotRepo, newOpenStoreRegistry(t), mockTransactionService)
+}
+
+func TestStoreDailyTimeSlotUseCase_CreateSlot(t *testing.T) {
+	existingSlots := []entities.StoreDailyTimeSlot{
+		{ID: 1, StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 600, Quota: 10},
+	}
+
+	t.Run("when slot created successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		slot := entities.StoreDailyTimeSlot{StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660, Quota: 5, IsSlotAvailable: true}
+		created := slot
+		created.ID = 3
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return(existingSlots, nil)
+		mockDailyTimeSlotService.On("Create", ctx, slot).Return(created, nil)
+		response, err := dailyTimeSlotUseCase.CreateSlot(ctx, slot)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.ID != 3 || response.DayOfWeek != "monday" || response.StartTime != "10:00" || response.EndTime != "11:00" {
+			t.Errorf("unexpected response : got - %+v", response)
+		}
+	})
+
+	t.Run("when slot overlaps another slot of the same day", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return(existingSlots, nil)
+		_, err := dailyTimeSlotUseCase.CreateSlot(ctx, entities.StoreDailyTimeSlot{StoreID: 84, DayOfWeek: time.Monday, StartTime: 570, EndTime: 630})
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotOverlap) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotOverlap)
+		}
+	})
+
+	t.Run("when store is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockStoreRegistry := mocks.NewStores(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		dailyTimeSlotUseCase := NewStoreDailyTimeSlotUseCase(mockDailyTimeSlotService, mockStoreRegistry, mockTransactionService)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		mockStoreRegistry.On("GetByIDs", ctx, []int64{99}).Return([]entities.Store{}, nil)
+		_, err := dailyTimeSlotUseCase.CreateSlot(ctx, entities.StoreDailyTimeSlot{StoreID: 99, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660})
+		if !errors.Is(err, valueobjects.ErrStoreUnknown) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreUnknown)
+		}
+		mockDailyTimeSlotService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when slot cannot be committed", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		dailyTimeSlotUseCase := NewStoreDailyTimeSlotUseCase(mockDailyTimeSlotService, newOpenStoreRegistry(t), mockTransactionService)
+		slot := entities.StoreDailyTimeSlot{StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660}
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+			return errors.New("commit failed")
+		})
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return(existingSlots, nil)
+		mockDailyTimeSlotService.On("Create", ctx, slot).Return(slot, nil)
+		if _, err := dailyTimeSlotUseCase.CreateSlot(ctx, slot); err == nil {
+			t.Errorf("unexpected error : got - nil ; want - commit failed")
+		}
+	})
+
+	t.Run("when slot ends before it starts", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		_, err := dailyTimeSlotUseCase.CreateSlot(ctx, entities.StoreDailyTimeSlot{StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 600})
+		if !errors.Is(err, valueobjects.ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrTimeSlotInvalid)
+		}
+	})
+}
+
+func TestStoreDailyTimeSlotUseCase_UpdateSlot(t *testing.T) {
+	existingSlots := []entities.StoreDailyTimeSlot{
+		{ID: 1, StoreID: 84, DayOfWeek: time.Monday, StartTime: 540, EndTime: 600, Quota: 10},
+		{ID: 2, StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 660, Quota: 10},
+	}
+
+	t.Run("when slot is moved within its own time", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		slot := entities.StoreDailyTimeSlot{ID: 2, StoreID: 84, DayOfWeek: time.Monday, StartTime: 600, EndTime: 690, Quota: 8, UpdatedBy: 7}
+		mockDailyTimeSlotService.On("Get", ctx, int64(84), valueobjects.DailyTimeSlotID(2)).Return(existingSlots[1], nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return(existingSlots, nil)
+		mockDailyTimeSlotService.On("Update", ctx, slot).Return(nil)
+		response, err := dailyTimeSlotUseCase.UpdateSlot(ctx, slot)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if response.EndTime != "11:30" || response.Quota != 8 {
+			t.Errorf("unexpected response : got - %+v", response)
+		}
+	})
+
+	t.Run("when slot is moved over another slot", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		slot := entities.StoreDailyTimeSlot{ID: 2, StoreID: 84, DayOfWeek: time.Monday, StartTime: 590, EndTime: 660}
+		mockDailyTimeSlotService.On("Get", ctx, int64(84), valueobjects.DailyTimeSlotID(2)).Return(existingSlots[1], nil)
+		mockDailyTimeSlotService.On("LockDay", ctx, int64(84), time.Monday).Return(existingSlots, nil)
+		_, err := dailyTimeSlotUseCase.UpdateSlot(ctx, slot)
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotOverlap) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotOverlap)
+		}
+	})
+
+	t.Run("when slot not exists", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		mockDailyTimeSlotService.On("Get", ctx, int64(84), valueobjects.DailyTimeSlotID(9)).
+			Return(entities.StoreDailyTimeSlot{}, fmt.Errorf("%w: 9", valueobjects.ErrDailyTimeSlotNotExists))
+		_, err := dailyTimeSlotUseCase.UpdateSlot(ctx, entities.StoreDailyTimeSlot{ID: 9, StoreID: 84, StartTime: 600, EndTime: 660})
+		if !errors.Is(err, valueobjects.ErrDailyTimeSlotNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrDailyTimeSlotNotExists)
+		}
+		mockDailyTimeSlotService.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestStoreDailyTimeSlotUseCase_GetSlots(t *testing.T) {
+	t.Run("when store has no slots", func(t *testing.T) {
+		ctx := context.Background()
+		mockDailyTimeSlotService := mocks.NewStoreDailyTimeSlots(t)
+		dailyTimeSlotUseCase := newDailyTimeSlotUseCase(t, mockDailyTimeSlotService)
+		mockDailyTimeSlotService.On("GetList", ctx, int64(84)).Return([]entities.StoreDailyTimeSlot{}, nil)
+		response, err := dailyTimeSlotUseCase.GetSlots(ctx, 84)
+		if err != nil || response == nil || len(response) != 0 {
+			t.Errorf("unexpected response : got - %v, %v ; want empty list", response, err)
+		}
+	})
+}
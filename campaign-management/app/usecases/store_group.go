@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type StoreGroupUseCase struct {
+	storeGroupRepo     services.StoreGroups
+	storeRepo          services.Stores
+	transactionService services.TransactionService
+}
+
+func NewStoreGroupUseCase(storeGroupRepo services.StoreGroups, storeRepo services.Stores,
+	transactionService services.TransactionService) *StoreGroupUseCase {
+	return &StoreGroupUseCase{
+		storeGroupRepo:     storeGroupRepo,
+		storeRepo:          storeRepo,
+		transactionService: transactionService,
+	}
+}
+
+func (c *StoreGroupUseCase) GetStoreGroups(ctx context.Context, pagination entities.PaginationConfig) (*dto.StoreGroupListResponse, error) {
+	storeGroups, count, err := c.storeGroupRepo.GetList(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToStoreGroupListResponse(storeGroups, count, pagination)
+	return &response, nil
+}
+
+func (c *StoreGroupUseCase) GetStoreGroup(ctx context.Context, storeGroupID int64) (*dto.StoreGroupDTO, error) {
+	storeGroup, err := c.storeGroupRepo.Get(ctx, valueobjects.StoreGroupID(storeGroupID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreGroupDTO(storeGroup), nil
+}
+
+// CreateStoreGroup creates the group with its stores, every store must be registered and active
+func (c *StoreGroupUseCase) CreateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error) {
+	if err := c.checkName(ctx, storeGroup); err != nil {
+		return nil, err
+	}
+	if _, err := activeStoresByID(ctx, c.storeRepo, storeGroup.StoreIDs); err != nil {
+		return nil, err
+	}
+	var created entities.StoreGroup
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = c.storeGroupRepo.Create(ctx, storeGroup)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreGroupDTO(created), nil
+}
+
+// UpdateStoreGroup renames the group, its stores are managed through AddStores and RemoveStore
+func (c *StoreGroupUseCase) UpdateStoreGroup(ctx context.Context, storeGroup entities.StoreGroup) (*dto.StoreGroupDTO, error) {
+	existing, err := c.storeGroupRepo.Get(ctx, storeGroup.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkName(ctx, storeGroup); err != nil {
+		return nil, err
+	}
+	if err := c.storeGroupRepo.Update(ctx, storeGroup); err != nil {
+		return nil, err
+	}
+	storeGroup.StoreIDs = existing.StoreIDs
+	return dto.ToStoreGroupDTO(storeGroup), nil
+}
+
+func (c *StoreGroupUseCase) DeleteStoreGroup(ctx context.Context, storeGroupID, userID int64) error {
+	return c.storeGroupRepo.Delete(ctx, valueobjects.StoreGroupID(storeGroupID), userID)
+}
+
+// AddStores makes the stores members of the group, every store must be registered and active
+func (c *StoreGroupUseCase) AddStores(ctx context.Context, storeGroupID int64, members []entities.StoreGroupMember) (*dto.StoreGroupDTO, error) {
+	if _, err := c.storeGroupRepo.Get(ctx, valueobjects.StoreGroupID(storeGroupID)); err != nil {
+		return nil, err
+	}
+	storeIDs := []int64{}
+	for _, member := range members {
+		storeIDs = append(storeIDs, member.StoreID)
+	}
+	if _, err := activeStoresByID(ctx, c.storeRepo, storeIDs); err != nil {
+		return nil, err
+	}
+	if err := c.storeGroupRepo.AddMembers(ctx, members); err != nil {
+		return nil, err
+	}
+	return c.GetStoreGroup(ctx, storeGroupID)
+}
+
+func (c *StoreGroupUseCase) RemoveStore(ctx context.Context, storeGroupID, storeID int64) error {
+	if _, err := c.storeGroupRepo.Get(ctx, valueobjects.StoreGroupID(storeGroupID)); err != nil {
+		return err
+	}
+	return c.storeGroupRepo.RemoveMember(ctx, valueobjects.StoreGroupID(storeGroupID), storeID)
+}
+
+// checkName refuses a name another store group already has
+func (c *StoreGroupUseCase) checkName(ctx context.Context, storeGroup entities.StoreGroup) error {
+	exists, err := c.storeGroupRepo.Exists(ctx, storeGroup.ID, storeGroup.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("%w: %s", valueobjects.ErrStoreGroupExists, storeGroup.Name)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStoreGroupUseCase_CreateStoreGroup(t *testing.T) {
+	storeGroup := entities.StoreGroup{Name: "All Hypermarts", StoreIDs: []int64{84, 85}, CreatedBy: 7}
+	runInTransaction := func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}
+
+	t.Run("when store group is created with its stores", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		storeGroupUseCase := NewStoreGroupUseCase(mockStoreGroupService, newOpenStoreRegistry(t), mockTransactionService)
+		mockStoreGroupService.On("Exists", ctx, valueobjects.StoreGroupID(0), "All Hypermarts").Return(false, nil)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(runInTransaction)
+		mockStoreGroupService.On("Create", ctx, storeGroup).Return(entities.StoreGroup{ID: 3, Name: "All Hypermarts", StoreIDs: []int64{84, 85}}, nil)
+
+		result, err := storeGroupUseCase.CreateStoreGroup(ctx, storeGroup)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if result.ID != 3 || len(result.Stores) != 2 {
+			t.Errorf("unexpected store group : got - %+v", result)
+		}
+	})
+
+	t.Run("when name is taken by another store group", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		storeGroupUseCase := NewStoreGroupUseCase(mockStoreGroupService, mocks.NewStores(t), mocks.NewTransactionService(t))
+		mockStoreGroupService.On("Exists", ctx, valueobjects.StoreGroupID(0), "All Hypermarts").Return(true, nil)
+
+		_, err := storeGroupUseCase.CreateStoreGroup(ctx, storeGroup)
+		if !errors.Is(err, valueobjects.ErrStoreGroupExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreGroupExists)
+		}
+	})
+
+	t.Run("when stores are not registered or not active", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		mockStoreRegistry := mocks.NewStores(t)
+		storeGroupUseCase := NewStoreGroupUseCase(mockStoreGroupService, mockStoreRegistry, mocks.NewTransactionService(t))
+		mockStoreGroupService.On("Exists", ctx, valueobjects.StoreGroupID(0), "All Hypermarts").Return(false, nil)
+		mockStoreRegistry.On("GetByIDs", ctx, []int64{84, 85}).Return([]entities.Store{{ID: 84, IsActive: true}}, nil)
+
+		_, err := storeGroupUseCase.CreateStoreGroup(ctx, storeGroup)
+		if !errors.Is(err, valueobjects.ErrStoreUnknown) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreUnknown)
+		}
+	})
+}
+
+func TestStoreGroupUseCase_AddStores(t *testing.T) {
+	t.Run("when store group does not exist", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreGroupService := mocks.NewStoreGroups(t)
+		storeGroupUseCase := NewStoreGroupUseCase(mockStoreGroupService, mocks.NewStores(t), mocks.NewTransactionService(t))
+		mockStoreGroupService.On("Get", ctx, valueobjects.StoreGroupID(3)).
+			Return(entities.StoreGroup{}, valueobjects.ErrStoreGroupNotExists)
+
+		_, err := storeGroupUseCase.AddStores(ctx, 3, []entities.StoreGroupMember{{StoreGroupID: 3, StoreID: 84}})
+		if !errors.Is(err, valueobjects.ErrStoreGroupNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreGroupNotExists)
+		}
+	})
+}
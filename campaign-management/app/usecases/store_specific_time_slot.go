@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services"
+	"campaign-mgmt/app/domain/valueobjects"
+	"campaign-mgmt/app/usecases/dto"
+)
+
+type StoreSpecificTimeSlotUseCase struct {
+	specificTimeSlotRepo services.StoreSpecificTimeSlots
+	storeRepo            services.Stores
+	transactionService   services.TransactionService
+}
+
+func NewStoreSpecificTimeSlotUseCase(specificTimeSlotRepo services.StoreSpecificTimeSlots, storeRepo services.Stores,
+	transactionService services.TransactionService) *StoreSpecificTimeSlotUseCase {
+	return &StoreSpecificTimeSlotUseCase{
+		specificTimeSlotRepo: specificTimeSlotRepo,
+		storeRepo:            storeRepo,
+		transactionService:   transactionService,
+	}
+}
+
+func (c *StoreSpecificTimeSlotUseCase) GetSlots(ctx context.Context, storeID int64, from, to time.Time) ([]*dto.StoreSpecificTimeSlotDTO, error) {
+	slots, err := c.specificTimeSlotRepo.GetList(ctx, storeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.StoreSpecificTimeSlotDTO{}
+	for _, slot := range slots {
+		response = append(response, dto.ToStoreSpecificTimeSlotDTO(slot))
+	}
+	return response, nil
+}
+
+func (c *StoreSpecificTimeSlotUseCase) GetSlot(ctx context.Context, storeID, slotID int64) (*dto.StoreSpecificTimeSlotDTO, error) {
+	slot, err := c.specificTimeSlotRepo.Get(ctx, storeID, valueobjects.SpecificTimeSlotID(slotID))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreSpecificTimeSlotDTO(slot), nil
+}
+
+// CreateSlots adds the slots of a store in one go, either all of them are created or none
+func (c *StoreSpecificTimeSlotUseCase) CreateSlots(ctx context.Context, slots []entities.StoreSpecificTimeSlot) ([]*dto.StoreSpecificTimeSlotDTO, error) {
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("%w: no slots given", valueobjects.ErrTimeSlotInvalid)
+	}
+	if err := validateSpecificSlotTimes(slots); err != nil {
+		return nil, err
+	}
+	var created []entities.StoreSpecificTimeSlot
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		if err := c.validateSlots(ctx, slots); err != nil {
+			return err
+		}
+		var err error
+		created, err = c.specificTimeSlotRepo.CreateMultiple(ctx, slots)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := []*dto.StoreSpecificTimeSlotDTO{}
+	for _, slot := range created {
+		response = append(response, dto.ToStoreSpecificTimeSlotDTO(slot))
+	}
+	return response, nil
+}
+
+func (c *StoreSpecificTimeSlotUseCase) UpdateSlot(ctx context.Context, slot entities.StoreSpecificTimeSlot) (*dto.StoreSpecificTimeSlotDTO, error) {
+	slots := []entities.StoreSpecificTimeSlot{slot}
+	if err := validateSpecificSlotTimes(slots); err != nil {
+		return nil, err
+	}
+	err := c.transactionService.RunWithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := c.specificTimeSlotRepo.Get(ctx, slot.StoreID, slot.ID); err != nil {
+			return err
+		}
+		if err := c.validateSlots(ctx, slots); err != nil {
+			return err
+		}
+		return c.specificTimeSlotRepo.Update(ctx, slot)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToStoreSpecificTimeSlotDTO(slot), nil
+}
+
+func (c *StoreSpecificTimeSlotUseCase) DeleteSlot(ctx context.Context, storeID, slotID, userID int64) error {
+	return c.specificTimeSlotRepo.Delete(ctx, storeID, valueobjects.SpecificTimeSlotID(slotID), userID)
+}
+
+// validateSpecificSlotTimes makes sure every open slot ends after it starts
+func validateSpecificSlotTimes(slots []entities.StoreSpecificTimeSlot) error {
+	for _, slot := range slots {
+		if !slot.IsClosed && slot.EndTime <= slot.StartTime {
+			return fmt.Errorf("%w: end time %v must be after start time %v", valueobjects.ErrTimeSlotInvalid, slot.EndTime, slot.StartTime)
+		}
+	}
+	return nil
+}
+
+// validateSlots makes sure the store is registered and active and that on each date the store either has a closure
+// alone or open slots sharing no time, counting both the stored slots and the given ones. It runs in the transaction
+// the slots are written in, the stored slots of the dates stay locked so two writers cannot both find the time free
+func (c *StoreSpecificTimeSlotUseCase) validateSlots(ctx context.Context, slots []entities.StoreSpecificTimeSlot) error {
+	if _, err := activeStoresByID(ctx, c.storeRepo, []int64{slots[0].StoreID}); err != nil {
+		return err
+	}
+	from, to := slots[0].Date, slots[0].Date
+	for _, slot := range slots {
+		if slot.Date.Before(from) {
+			from = slot.Date
+		}
+		if slot.Date.After(to) {
+			to = slot.Date
+		}
+	}
+
+	existing, err := c.specificTimeSlotRepo.LockDates(ctx, slots[0].StoreID, from, to)
+	if err != nil {
+		return err
+	}
+	replaced := map[valueobjects.SpecificTimeSlotID]bool{}
+	for _, slot := range slots {
+		if slot.ID > 0 {
+			replaced[slot.ID] = true
+		}
+	}
+	slotsByDate := map[string][]entities.StoreSpecificTimeSlot{}
+	for _, slot := range existing {
+		if replaced[slot.ID] {
+			continue
+		}
+		date := slot.Date.Format(valueobjects.DateLayout)
+		slotsByDate[date] = append(slotsByDate[date], slot)
+	}
+
+	for _, slot := range slots {
+		date := slot.Date.Format(valueobjects.DateLayout)
+		for _, other := range slotsByDate[date] {
+			switch {
+			case slot.IsClosed || other.IsClosed:
+				return fmt.Errorf("%w: %s has a closure along with other slots", valueobjects.ErrSpecificTimeSlotConflict, date)
+			case valueobjects.TimeRangesOverlap(slot.StartTime, slot.EndTime, other.StartTime, other.EndTime):
+				return fmt.Errorf("%w: %s %v-%v overlaps %v-%v", valueobjects.ErrSpecificTimeSlotConflict,
+					date, slot.StartTime, slot.EndTime, other.StartTime, other.EndTime)
+			}
+		}
+		slotsByDate[date] = append(slotsByDate[date], slot)
+	}
+	return nil
+}
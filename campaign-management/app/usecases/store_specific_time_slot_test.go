@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func newSpecificTimeSlotUseCase(t *testing.T, specificTimeSlotRepo *mocks.StoreSpecificTimeSlots) *StoreSpecificTimeSlotUseCase {
+	mockTransactionService := mocks.NewTransactionService(t)
+	mockTransactionService.On("RunWithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	return NewStoreSpecificTimeSlotUseCase(specificTimeSlotRepo, newOpenStoreRegistry(t), mockTransactionService)
+}
+
+func TestStoreSpecificTimeSlotUseCase_CreateSlots(t *testing.T) {
+	firstDate := time.Date(2024, time.February, 8, 0, 0, 0, 0, time.UTC)
+	secondDate := firstDate.AddDate(0, 0, 1)
+	existingSlots := []entities.StoreSpecificTimeSlot{
+		{ID: 1, StoreID: 84, Date: firstDate, StartTime: 540, EndTime: 600, Quota: 10},
+	}
+
+	t.Run("when slots of a date range created successfully", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		slots := []entities.StoreSpecificTimeSlot{
+			{StoreID: 84, Date: firstDate, StartTime: 600, EndTime: 660, Quota: 5},
+			{StoreID: 84, Date: secondDate, StartTime: 600, EndTime: 660, Quota: 5},
+		}
+		created := []entities.StoreSpecificTimeSlot{slots[0], slots[1]}
+		created[0].ID, created[1].ID = 2, 3
+		mockSpecificTimeSlotService.On("LockDates", ctx, int64(84), firstDate, secondDate).Return(existingSlots, nil)
+		mockSpecificTimeSlotService.On("CreateMultiple", ctx, slots).Return(created, nil)
+		response, err := specificTimeSlotUseCase.CreateSlots(ctx, slots)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if len(response) != 2 || response[1].ID != 3 || response[1].Date != "2024-02-09" || response[1].StartTime != "10:00" {
+			t.Errorf("unexpected response : got - %+v", response)
+		}
+	})
+
+	t.Run("when closure shares its date with a stored slot", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		mockSpecificTimeSlotService.On("LockDates", ctx, int64(84), firstDate, firstDate).Return(existingSlots, nil)
+		_, err := specificTimeSlotUseCase.CreateSlots(ctx, []entities.StoreSpecificTimeSlot{{StoreID: 84, Date: firstDate, IsClosed: true}})
+		if !errors.Is(err, valueobjects.ErrSpecificTimeSlotConflict) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSpecificTimeSlotConflict)
+		}
+		mockSpecificTimeSlotService.AssertNotCalled(t, "CreateMultiple", mock.Anything, mock.Anything)
+	})
+
+	t.Run("when given slots overlap each other", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		mockSpecificTimeSlotService.On("LockDates", ctx, int64(84), secondDate, secondDate).Return([]entities.StoreSpecificTimeSlot{}, nil)
+		_, err := specificTimeSlotUseCase.CreateSlots(ctx, []entities.StoreSpecificTimeSlot{
+			{StoreID: 84, Date: secondDate, StartTime: 600, EndTime: 660},
+			{StoreID: 84, Date: secondDate, StartTime: 630, EndTime: 690},
+		})
+		if !errors.Is(err, valueobjects.ErrSpecificTimeSlotConflict) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSpecificTimeSlotConflict)
+		}
+	})
+
+	t.Run("when store is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		mockStoreService := mocks.NewStores(t)
+		mockTransactionService := mocks.NewTransactionService(t)
+		specificTimeSlotUseCase := NewStoreSpecificTimeSlotUseCase(mockSpecificTimeSlotService, mockStoreService, mockTransactionService)
+		mockTransactionService.On("RunWithTransaction", ctx, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		mockStoreService.On("GetByIDs", ctx, []int64{99}).Return([]entities.Store{}, nil)
+		_, err := specificTimeSlotUseCase.CreateSlots(ctx, []entities.StoreSpecificTimeSlot{{StoreID: 99, Date: firstDate, StartTime: 600, EndTime: 660}})
+		if !errors.Is(err, valueobjects.ErrStoreUnknown) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrStoreUnknown)
+		}
+		mockSpecificTimeSlotService.AssertNotCalled(t, "LockDates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("when slot ends before it starts", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		_, err := specificTimeSlotUseCase.CreateSlots(ctx, []entities.StoreSpecificTimeSlot{{StoreID: 84, Date: firstDate, StartTime: 660, EndTime: 600}})
+		if !errors.Is(err, valueobjects.ErrTimeSlotInvalid) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrTimeSlotInvalid)
+		}
+	})
+}
+
+func TestStoreSpecificTimeSlotUseCase_UpdateSlot(t *testing.T) {
+	date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+	existingSlots := []entities.StoreSpecificTimeSlot{
+		{ID: 1, StoreID: 84, Date: date, StartTime: 540, EndTime: 600, Quota: 10},
+	}
+
+	t.Run("when the only slot of a date is turned into a closure", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		slot := entities.StoreSpecificTimeSlot{ID: 1, StoreID: 84, Date: date, IsClosed: true, UpdatedBy: 7}
+		mockSpecificTimeSlotService.On("Get", ctx, int64(84), valueobjects.SpecificTimeSlotID(1)).Return(existingSlots[0], nil)
+		mockSpecificTimeSlotService.On("LockDates", ctx, int64(84), date, date).Return(existingSlots, nil)
+		mockSpecificTimeSlotService.On("Update", ctx, slot).Return(nil)
+		response, err := specificTimeSlotUseCase.UpdateSlot(ctx, slot)
+		if err != nil {
+			t.Errorf("unexpected error : got - %v ; want - nil", err)
+		}
+		if !response.IsClosed || response.StartTime != "" {
+			t.Errorf("unexpected response : got - %+v", response)
+		}
+	})
+
+	t.Run("when slot not exists", func(t *testing.T) {
+		ctx := context.Background()
+		mockSpecificTimeSlotService := mocks.NewStoreSpecificTimeSlots(t)
+		specificTimeSlotUseCase := newSpecificTimeSlotUseCase(t, mockSpecificTimeSlotService)
+		mockSpecificTimeSlotService.On("Get", ctx, int64(84), valueobjects.SpecificTimeSlotID(9)).
+			Return(entities.StoreSpecificTimeSlot{}, fmt.Errorf("%w: 9", valueobjects.ErrSpecificTimeSlotNotExists))
+		_, err := specificTimeSlotUseCase.UpdateSlot(ctx, entities.StoreSpecificTimeSlot{ID: 9, StoreID: 84, Date: date, StartTime: 600, EndTime: 660})
+		if !errors.Is(err, valueobjects.ErrSpecificTimeSlotNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrSpecificTimeSlotNotExists)
+		}
+		mockSpecificTimeSlotService.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
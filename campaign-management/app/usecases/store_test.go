@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/services/mocks"
+	"campaign-mgmt/app/domain/valueobjects"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestStoreUseCase_CreateStore(t *testing.T) {
+	store := entities.Store{ID: 84, Name: "Orchard", Region: "Central", IsActive: true, CreatedBy: 7}
+
+	t.Run("when store is not registered yet", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewStores(t)
+		storeUseCase := NewStoreUseCase(mockStoreService)
+		mockStoreService.On("Get", ctx, valueobjects.StoreID(84)).
+			Return(entities.Store{}, fmt.Errorf("%w: record not found", valueobjects.ErrRegisteredStoreNotExists))
+		mockStoreService.On("Create", ctx, store).Return(store, nil)
+
+		result, err := storeUseCase.CreateStore(ctx, store)
+		if err != nil {
+			t.Fatalf("unexpected error : got - %v ; want - nil", err)
+		}
+		if result.ID != 84 || result.Name != "Orchard" || result.Region != "Central" || !result.IsActive {
+			t.Errorf("unexpected store : got - %+v", result)
+		}
+	})
+
+	t.Run("when store is already registered", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewStores(t)
+		storeUseCase := NewStoreUseCase(mockStoreService)
+		mockStoreService.On("Get", ctx, valueobjects.StoreID(84)).Return(store, nil)
+
+		_, err := storeUseCase.CreateStore(ctx, store)
+		if !errors.Is(err, valueobjects.ErrRegisteredStoreExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrRegisteredStoreExists)
+		}
+	})
+}
+
+func TestStoreUseCase_UpdateStore(t *testing.T) {
+	t.Run("when store is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		mockStoreService := mocks.NewStores(t)
+		storeUseCase := NewStoreUseCase(mockStoreService)
+		mockStoreService.On("Get", ctx, valueobjects.StoreID(84)).
+			Return(entities.Store{}, fmt.Errorf("%w: record not found", valueobjects.ErrRegisteredStoreNotExists))
+
+		_, err := storeUseCase.UpdateStore(ctx, entities.Store{ID: 84, Name: "Orchard", UpdatedBy: 7})
+		if !errors.Is(err, valueobjects.ErrRegisteredStoreNotExists) {
+			t.Errorf("unexpected error : got - %v ; want - %v", err, valueobjects.ErrRegisteredStoreNotExists)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package util
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	HighlightStart = "<em>"
+	HighlightEnd   = "</em>"
+	ellipsis       = "…"
+)
+
+// SearchTerms splits a search query into the words it matches on, lower cased and without repeats
+func SearchTerms(query string) []string {
+	terms := []string{}
+	seen := map[string]bool{}
+	for _, term := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// Highlight returns a snippet of at most width characters of the text around the first of the terms it contains, with
+// the text HTML escaped and each term in the snippet wrapped in <em>. Terms are matched ignoring case and the snippet
+// is cut with an ellipsis where it does not reach the end of the text. The text is not highlighted when it contains
+// none of the terms
+func Highlight(text string, terms []string, width int) (string, bool) {
+	runes := []rune(text)
+	matches := findTerms(runes, terms)
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	start, end := 0, len(runes)
+	if end > width {
+		first := matches[0]
+		start = first[0] - (width-(first[1]-first[0]))/2
+		if start < 0 {
+			start = 0
+		}
+		end = start + width
+		if end > len(runes) {
+			end = len(runes)
+			start = end - width
+		}
+	}
+
+	var snippet strings.Builder
+	if start > 0 {
+		snippet.WriteString(ellipsis)
+	}
+	position := start
+	for _, match := range matches {
+		if match[0] < start || match[1] > end {
+			continue
+		}
+		snippet.WriteString(html.EscapeString(string(runes[position:match[0]])))
+		snippet.WriteString(HighlightStart + html.EscapeString(string(runes[match[0]:match[1]])) + HighlightEnd)
+		position = match[1]
+	}
+	snippet.WriteString(html.EscapeString(string(runes[position:end])))
+	if end < len(runes) {
+		snippet.WriteString(ellipsis)
+	}
+	return snippet.String(), true
+}
+
+// findTerms returns the start and end of the terms in the text in text order, a longer term wins over a shorter one
+// starting at the same place
+func findTerms(runes []rune, terms []string) [][2]int {
+	termRunes := [][]rune{}
+	for _, term := range terms {
+		if term != "" {
+			termRunes = append(termRunes, []rune(term))
+		}
+	}
+	sort.SliceStable(termRunes, func(i, j int) bool { return len(termRunes[i]) > len(termRunes[j]) })
+
+	matches := [][2]int{}
+	for i := 0; i < len(runes); i++ {
+		for _, term := range termRunes {
+			if hasTermAt(runes, i, term) {
+				matches = append(matches, [2]int{i, i + len(term)})
+				i += len(term) - 1
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func hasTermAt(runes []rune, at int, term []rune) bool {
+	if at+len(term) > len(runes) {
+		return false
+	}
+	for i, r := range term {
+		if unicode.ToLower(runes[at+i]) != unicode.ToLower(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_SearchTerms(t *testing.T) {
+	t.Run("When query has punctuation and repeats, the words are kept once lower cased", func(t *testing.T) {
+		terms := SearchTerms("Mooncake, snow-skin MOONCAKE!")
+		if !reflect.DeepEqual(terms, []string{"mooncake", "snow", "skin"}) {
+			t.Errorf("unexpected terms : got - %v", terms)
+		}
+	})
+}
+
+func Test_Highlight(t *testing.T) {
+	t.Run("When text contains the terms, each is wrapped ignoring case", func(t *testing.T) {
+		snippet, ok := Highlight("Snow skin Mooncakes & teas", []string{"mooncake", "snow"}, 100)
+		want := "<em>Snow</em> skin <em>Mooncake</em>s &amp; teas"
+		if !ok || snippet != want {
+			t.Errorf("unexpected snippet : got - %q, %v ; want - %q", snippet, ok, want)
+		}
+	})
+
+	t.Run("When text is longer than the width, the snippet is cut around the first term", func(t *testing.T) {
+		snippet, ok := Highlight("Celebrate the festival with our handmade mooncake selection this autumn", []string{"mooncake"}, 20)
+		want := "…dmade <em>mooncake</em> selec…"
+		if !ok || snippet != want {
+			t.Errorf("unexpected snippet : got - %q, %v ; want - %q", snippet, ok, want)
+		}
+	})
+
+	t.Run("When the first term is near the start, the snippet starts with the text", func(t *testing.T) {
+		snippet, ok := Highlight("Mooncake selection for the mid autumn festival", []string{"mooncake"}, 20)
+		want := "<em>Mooncake</em> selection f…"
+		if !ok || snippet != want {
+			t.Errorf("unexpected snippet : got - %q, %v ; want - %q", snippet, ok, want)
+		}
+	})
+
+	t.Run("When text contains none of the terms, it is not highlighted", func(t *testing.T) {
+		if snippet, ok := Highlight("Christmas hampers", []string{"mooncake"}, 20); ok || snippet != "" {
+			t.Errorf("unexpected snippet : got - %q, %v", snippet, ok)
+		}
+	})
+}
@@ -1,50 +1,72 @@
-package util
-
-import (
-	"context"
-	"errors"
-	"strconv"
-	"time"
-)
-
-func ToDateTime(dtStr string) (time.Time, error) {
-	layout := "2006-01-02 15:04:05"
-	if dtStr != "" {
-		parsedDate, err := time.Parse(layout, dtStr)
-		if err != nil {
-			return time.Time{}, err
-		}
-		return parsedDate, nil
-	}
-	return time.Time{}, nil
-}
-
-// Set Difference: A - B
-func Difference(a, b []int64) (diff []int64) {
-	m := make(map[int64]bool)
-	for _, item := range b {
-		m[item] = true
-	}
-	for _, item := range a {
-		if _, ok := m[item]; !ok {
-			diff = append(diff, item)
-		}
-	}
-	return
-}
-
-func GetUserID(ctx context.Context) (int, error) {
-	userId := ctx.Value("userId")
-	if userId == nil {
-		return 0, errors.New("invalid user id")
-	} else {
-		switch userId.(type) {
-		case int:
-			return ctx.Value("userId").(int), nil
-		case string:
-			return strconv.Atoi(ctx.Value("userId").(string))
-		default:
-			return 0, errors.New("invalid user id")
-		}
-	}
-}
+package util
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	DateTimeLayout       = "2006-01-02 15:04:05"
+	dateTimeMinuteLayout = "2006-01-02 15:04"
+)
+
+// ToDateTime parses a wall clock date time, with or without seconds, in the given location
+func ToDateTime(dtStr string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if dtStr != "" {
+		parsedDate, err := time.ParseInLocation(DateTimeLayout, dtStr, loc)
+		if err != nil {
+			if parsedMinute, minuteErr := time.ParseInLocation(dateTimeMinuteLayout, dtStr, loc); minuteErr == nil {
+				return parsedMinute, nil
+			}
+			return time.Time{}, err
+		}
+		return parsedDate, nil
+	}
+	return time.Time{}, nil
+}
+
+// LoadLocation returns the location for an IANA timezone name, an empty name is UTC
+func LoadLocation(timezone string) (*time.Location, error) {
+	return time.LoadLocation(timezone)
+}
+
+// Set Difference: A - B
+func Difference(a, b []int64) (diff []int64) {
+	m := make(map[int64]bool)
+	for _, item := range b {
+		m[item] = true
+	}
+	for _, item := range a {
+		if _, ok := m[item]; !ok {
+			diff = append(diff, item)
+		}
+	}
+	return
+}
+
+func GetUserID(ctx context.Context) (int, error) {
+	userId := ctx.Value("userId")
+	if userId == nil {
+		return 0, errors.New("invalid user id")
+	} else {
+		switch userId.(type) {
+		case int:
+			return ctx.Value("userId").(int), nil
+		case string:
+			return strconv.Atoi(ctx.Value("userId").(string))
+		default:
+			return 0, errors.New("invalid user id")
+		}
+	}
+}
+
+// IsAdmin reports whether the verified token of the request puts the user in the admin group
+func IsAdmin(ctx context.Context) bool {
+	isAdmin, _ := ctx.Value("isAdmin").(bool)
+	return isAdmin
+}
@@ -1,234 +1,359 @@
-package main
-
-import (
-	"campaign-mgmt/app/domain/entities"
-	repo "campaign-mgmt/app/infrastructure/mysql"
-	"campaign-mgmt/app/middlewares"
-	presentation "campaign-mgmt/app/presentation/http"
-	"campaign-mgmt/app/usecases"
-	"campaign-mgmt/docs"
-	_ "campaign-mgmt/docs"
-	"crypto/tls"
-	"crypto/x509"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"time"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
-	"github.com/go-chi/cors"
-	logger "github.com/sirupsen/logrus"
-	httpSwagger "github.com/swaggo/http-swagger"
-	"gorm.io/driver/mysql"
-	"gorm.io/gorm"
-)
-
-type MysqlRepoServices struct {
-	CampaignRepoService          *repo.CampaignService
-	CampaignProductRepoService   *repo.CampaignProductService
-	CampaignStoreRepoService     *repo.CampaignStoreService
-	CampaignCodeService          *repo.CampaignCodeService
-	StoreDailyTimeSlotService    *repo.StoreDailyTimeSlotService
-	StoreSpecificTimeSlotService *repo.StoreSpecificTimeSlotService
-	TransactionService           *repo.TransactionService
-}
-
-// @securityDefinitions.apikey ApiKeyAuth
-// @in header
-// @name Authorization
-// @title           Campaign Management Swagger API
-// @version         2.0
-// @description     This is a Campaign Management Server, Which provides set of APIs to create and manage campaigns, for adding products and stores against particular campaign and also APIs for time slot management.
-func main() {
-	logger.SetOutput(os.Stdout)
-	logger.SetReportCaller(false)
-	os.Setenv("DB_USER", "root")
-	os.Setenv("DB_PASSWORD", "Password@1")
-	os.Setenv("DB_HOST", "localhost")
-	os.Setenv("DB_PORT", "3306")
-	os.Setenv("DB_NAME", "campaigns")
-	os.Setenv("OKTA_AUDIENCE", "DBP_Back_Office")
-	os.Setenv("OKTA_ISSUER", "https://ntucenterprise.oktapreview.com/oauth2/aus11bm2mb1uNA9FN1d7")
-	logger.Info("Starting campaign management service..")
-
-	conf, err := InitConfig()
-	if err != nil {
-		logger.Fatalf("Unable to initialise config, err : %v", err)
-	}
-	r := chi.NewRouter()
-	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
-	r.Use(middlewares.OktaAuthenticator)
-
-	r.Use(cors.Handler(cors.Options{
-		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-	}))
-
-	r.Get("/swagger/*", httpSwagger.Handler(
-		httpSwagger.URL("http://localhost:8080/swagger/doc.json"),
-	))
-
-	db, err := newDBConnection(conf.MYSQLConfig)
-	if err != nil {
-		logger.Fatalf("Error occurred while initiating database connection : %v", err)
-	}
-
-	var defaultCampaignStatusDBEntry = []map[string]interface{}{
-		{"StatusCode": 1, "StatusValue": "InActive"},
-		{"StatusCode": 2, "StatusValue": "Active"},
-		{"StatusCode": 3, "StatusValue": "Scheduled"},
-	}
-	repos := registerRepoServices(db, defaultCampaignStatusDBEntry)
-
-	campaignUseCase := usecases.NewCampaignUseCase(repos.CampaignRepoService)
-	storeUseCase := usecases.NewCampaignStoreUseCase(repos.CampaignStoreRepoService)
-	productUseCase := usecases.NewCampaignProductUseCase(repos.CampaignProductRepoService)
-
-	campaignHandler := presentation.NewCampaignController(campaignUseCase, storeUseCase, productUseCase, repos.TransactionService, conf)
-	campaignHandler.Init(r)
-	productHandler := presentation.NewCampaignProductController(productUseCase, repos.TransactionService, conf)
-	productHandler.Init(r)
-	storeHandler := presentation.NewCampaignStoreController(campaignUseCase, storeUseCase)
-	storeHandler.Init(r)
-
-	logger.Info("Campaign management server started")
-	logger.Info("visit http://localhost:8080/swagger/index.html  for swagger documentation")
-	http.ListenAndServe(":8080", r)
-}
-
-func InitConfig() (*entities.AppCfg, error) {
-	var conf entities.AppCfg
-
-	// programmatically set swagger info
-	docs.SwaggerInfo.Title = "Campaign Management Swagger API"
-	docs.SwaggerInfo.Description = "This is a Campaign Management Server," +
-		" Which provides set of APIs to create and manage campaigns, for adding products and stores " +
-		"against particular campaign and also APIs for time slot management. "
-	docs.SwaggerInfo.Version = "2.0"
-	docs.SwaggerInfo.Host = "localhost:8080"
-	docs.SwaggerInfo.BasePath = "/"
-	docs.SwaggerInfo.Schemes = []string{"http", "https"}
-
-	conf.MYSQLConfig = entities.MYSQLConfig{
-		User:            os.Getenv("DB_USER"),
-		Password:        os.Getenv("DB_PASSWORD"),
-		Host:            os.Getenv("DB_HOST"),
-		Port:            os.Getenv("DB_PORT"),
-		Database:        os.Getenv("DB_NAME"),
-		DBRetryAttempts: 3,
-	}
-	conf.PaginationConfig = entities.PaginationConfig{
-		Limit:  20,
-		Offset: 0,
-		Page:   1,
-		Sort:   "created_at asc",
-		Name:   "",
-	}
-
-	conf.ValidationParam = entities.ValidationParam{
-		MaxLeadTime:       20,
-		MaxDateDifference: 28,
-	}
-	return &conf, nil
-}
-
-func newDBConnection(mysqlConf entities.MYSQLConfig) (*gorm.DB, error) {
-	var err error
-	var connection *gorm.DB
-	for i := 0; i < mysqlConf.DBRetryAttempts; i++ {
-		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
-			mysqlConf.User,
-			mysqlConf.Password,
-			mysqlConf.Host,
-			mysqlConf.Port,
-			mysqlConf.Database,
-		)
-
-		// tlsConf := createTLSConf()
-		// err = gomysql.RegisterTLSConfig("custom", &tlsConf)
-		// if err != nil {
-		// 	logger.Fatalf("Error %s when RegisterTLSConfig\n", err)
-		// 	return nil, err
-		// }
-
-		connection, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
-		if err == nil {
-			// Get generic database object sql.DB to use its functions
-			sqlDB, err := connection.DB()
-			if err == nil {
-				// SetMaxIdleConns sets the maximum number of connections in the idle connection pool.
-				sqlDB.SetMaxIdleConns(10)
-
-				// SetMaxOpenConns sets the maximum number of open connections to the database.
-				sqlDB.SetMaxOpenConns(100)
-
-				// SetConnMaxLifetime sets the maximum amount of time a connection may be reused.
-				sqlDB.SetConnMaxLifetime(time.Hour)
-			}
-			return connection, nil
-		}
-		time.Sleep(time.Millisecond * 500)
-	}
-	return nil, err
-}
-
-func registerRepoServices(db *gorm.DB, defaultCampaignStatusDBEntry []map[string]interface{}) *MysqlRepoServices {
-	var repos MysqlRepoServices
-	repos.CampaignRepoService = repo.NewCampaignService(db)
-	if err := repos.CampaignRepoService.Migrate(); err != nil {
-		logger.Fatal(err)
-	}
-	repos.CampaignProductRepoService = repo.NewCampaignProductService(db)
-	if err := repos.CampaignProductRepoService.Migrate(); err != nil {
-		logger.Fatal(err)
-	}
-	repos.CampaignStoreRepoService = repo.NewCampaignStoreService(db)
-	if err := repos.CampaignStoreRepoService.Migrate(); err != nil {
-		logger.Fatal(err)
-	}
-
-	repos.CampaignCodeService = repo.NewCampaignCodeService(db)
-	if err := repos.CampaignCodeService.Migrate(defaultCampaignStatusDBEntry); err != nil {
-		logger.Fatal(err)
-	}
-	repos.StoreDailyTimeSlotService = repo.NewStoreDailyTimeSlotService(db)
-	if err := repos.StoreDailyTimeSlotService.Migrate(); err != nil {
-		logger.Fatal(err)
-	}
-	repos.StoreSpecificTimeSlotService = repo.NewStoreSpecificTimeSlotService(db)
-	if err := repos.StoreSpecificTimeSlotService.Migrate(); err != nil {
-		logger.Fatal(err)
-	}
-	repos.TransactionService = repo.NewTransactionService(db)
-	return &repos
-}
-
-func createTLSConf() tls.Config {
-	rootCertPool := x509.NewCertPool()
-	pem, err := ioutil.ReadFile("./server-ca.pem")
-	if err != nil {
-		logger.Fatal(err)
-	}
-	if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
-		logger.Fatal("Failed to append PEM.")
-	}
-	clientCert := make([]tls.Certificate, 0, 1)
-
-	certs, err := tls.LoadX509KeyPair("./client-cert.pem", "./client-key.pem")
-	if err != nil {
-		logger.Fatal(err)
-	}
-
-	clientCert = append(clientCert, certs)
-
-	return tls.Config{
-		RootCAs:            rootCertPool,
-		Certificates:       clientCert,
-		InsecureSkipVerify: true,
-	}
-}
+package main
+
+import (
+	"campaign-mgmt/app/domain/entities"
+	"campaign-mgmt/app/domain/valueobjects"
+	repo "campaign-mgmt/app/infrastructure/mysql"
+	"campaign-mgmt/app/middlewares"
+	presentation "campaign-mgmt/app/presentation/http"
+	"campaign-mgmt/app/presentation/scheduler"
+	"campaign-mgmt/app/usecases"
+	"campaign-mgmt/docs"
+	_ "campaign-mgmt/docs"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	logger "github.com/sirupsen/logrus"
+	httpSwagger "github.com/swaggo/http-swagger"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type MysqlRepoServices struct {
+	CampaignRepoService          *repo.CampaignService
+	CampaignProductRepoService   *repo.CampaignProductService
+	CampaignStoreRepoService     *repo.CampaignStoreService
+	CampaignCodeService          *repo.CampaignCodeService
+	StoreDailyTimeSlotService    *repo.StoreDailyTimeSlotService
+	StoreSpecificTimeSlotService *repo.StoreSpecificTimeSlotService
+	ReservationService           *repo.CollectionSlotReservationService
+	SlotTemplateService          *repo.SlotTemplateService
+	BlackoutService              *repo.BlackoutService
+	StoreService                 *repo.StoreService
+	StoreGroupService            *repo.StoreGroupService
+	CampaignStatusJobRunService  *repo.CampaignStatusJobRunService
+	CampaignStatusHistoryService *repo.CampaignStatusHistoryService
+	LockService                  *repo.LockService
+	TransactionService           *repo.TransactionService
+}
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+// @title           Campaign Management Swagger API
+// @version         2.0
+// @description     This is a Campaign Management Server, Which provides set of APIs to create and manage campaigns, for adding products and stores against particular campaign and also APIs for time slot management.
+func main() {
+	logger.SetOutput(os.Stdout)
+	logger.SetReportCaller(false)
+	os.Setenv("DB_USER", "root")
+	os.Setenv("DB_PASSWORD", "Password@1")
+	os.Setenv("DB_HOST", "localhost")
+	os.Setenv("DB_PORT", "3306")
+	os.Setenv("DB_NAME", "campaigns")
+	os.Setenv("OKTA_AUDIENCE", "DBP_Back_Office")
+	os.Setenv("OKTA_ISSUER", "https://ntucenterprise.oktapreview.com/oauth2/aus11bm2mb1uNA9FN1d7")
+	logger.Info("Starting campaign management service..")
+
+	conf, err := InitConfig()
+	if err != nil {
+		logger.Fatalf("Unable to initialise config, err : %v", err)
+	}
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.RealIP)
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Use(middlewares.OktaAuthenticator)
+
+	r.Use(cors.Handler(cors.Options{
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
+		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+	}))
+
+	r.Get("/swagger/*", httpSwagger.Handler(
+		httpSwagger.URL("http://localhost:8080/swagger/doc.json"),
+	))
+
+	db, err := newDBConnection(conf.MYSQLConfig)
+	if err != nil {
+		logger.Fatalf("Error occurred while initiating database connection : %v", err)
+	}
+
+	repos := registerRepoServices(db, conf.TimezoneConfig.BusinessTimezone)
+
+	campaignUseCase := usecases.NewCampaignUseCase(repos.CampaignRepoService, repos.CampaignStatusHistoryService, repos.CampaignCodeService,
+		conf.OverlapConfig)
+	storeUseCase := usecases.NewCampaignStoreUseCase(repos.CampaignStoreRepoService, repos.StoreService, repos.StoreGroupService)
+	productUseCase := usecases.NewCampaignProductUseCase(repos.CampaignProductRepoService)
+	blackoutUseCase := usecases.NewBlackoutUseCase(repos.BlackoutService, repos.TransactionService)
+
+	campaignHandler := presentation.NewCampaignController(campaignUseCase, storeUseCase, productUseCase, blackoutUseCase, repos.TransactionService, conf)
+	campaignHandler.Init(r)
+	productHandler := presentation.NewCampaignProductController(campaignUseCase, productUseCase, repos.TransactionService, conf)
+	productHandler.Init(r)
+	storeHandler := presentation.NewCampaignStoreController(campaignUseCase, storeUseCase, blackoutUseCase, repos.TransactionService, conf)
+	storeHandler.Init(r)
+	statusHistoryUseCase := usecases.NewCampaignStatusHistoryUseCase(repos.CampaignStatusHistoryService)
+	statusHistoryHandler := presentation.NewCampaignStatusHistoryController(campaignUseCase, statusHistoryUseCase)
+	statusHistoryHandler.Init(r)
+	campaignCodeUseCase := usecases.NewCampaignCodeUseCase(repos.CampaignCodeService)
+	campaignCodeHandler := presentation.NewCampaignCodeController(campaignCodeUseCase)
+	campaignCodeHandler.Init(r)
+	dailyTimeSlotUseCase := usecases.NewStoreDailyTimeSlotUseCase(repos.StoreDailyTimeSlotService, repos.StoreService, repos.TransactionService)
+	dailyTimeSlotHandler := presentation.NewStoreDailyTimeSlotController(dailyTimeSlotUseCase)
+	dailyTimeSlotHandler.Init(r)
+	specificTimeSlotUseCase := usecases.NewStoreSpecificTimeSlotUseCase(repos.StoreSpecificTimeSlotService, repos.StoreService, repos.TransactionService)
+	specificTimeSlotHandler := presentation.NewStoreSpecificTimeSlotController(specificTimeSlotUseCase)
+	specificTimeSlotHandler.Init(r)
+	slotTemplateUseCase := usecases.NewSlotTemplateUseCase(repos.SlotTemplateService, repos.StoreDailyTimeSlotService, repos.StoreService, repos.TransactionService)
+	slotTemplateHandler := presentation.NewSlotTemplateController(slotTemplateUseCase)
+	slotTemplateHandler.Init(r)
+	collectionSlotUseCase := usecases.NewCollectionSlotUseCase(repos.CampaignRepoService, repos.CampaignStoreRepoService,
+		repos.StoreDailyTimeSlotService, repos.StoreSpecificTimeSlotService, repos.BlackoutService, repos.ReservationService, repos.TransactionService,
+		conf.ReservationConfig)
+	collectionSlotHandler := presentation.NewCollectionSlotController(campaignUseCase, collectionSlotUseCase)
+	collectionSlotHandler.Init(r)
+	blackoutHandler := presentation.NewBlackoutController(blackoutUseCase)
+	blackoutHandler.Init(r)
+	registryUseCase := usecases.NewStoreUseCase(repos.StoreService)
+	registryHandler := presentation.NewStoreController(registryUseCase, conf)
+	registryHandler.Init(r)
+	storeGroupUseCase := usecases.NewStoreGroupUseCase(repos.StoreGroupService, repos.StoreService, repos.TransactionService)
+	storeGroupHandler := presentation.NewStoreGroupController(storeGroupUseCase, conf)
+	storeGroupHandler.Init(r)
+
+	if conf.SchedulerConfig.Enabled {
+		jobRunUseCase := usecases.NewCampaignStatusJobRunUseCase(repos.CampaignStatusJobRunService)
+		statusScheduler := scheduler.NewCampaignStatusScheduler(campaignUseCase, jobRunUseCase, repos.LockService, repos.TransactionService, conf.SchedulerConfig)
+		go statusScheduler.Start(context.Background())
+	}
+
+	logger.Info("Campaign management server started")
+	logger.Info("visit http://localhost:8080/swagger/index.html  for swagger documentation")
+	http.ListenAndServe(":8080", r)
+}
+
+func InitConfig() (*entities.AppCfg, error) {
+	var conf entities.AppCfg
+
+	// programmatically set swagger info
+	docs.SwaggerInfo.Title = "Campaign Management Swagger API"
+	docs.SwaggerInfo.Description = "This is a Campaign Management Server," +
+		" Which provides set of APIs to create and manage campaigns, for adding products and stores " +
+		"against particular campaign and also APIs for time slot management. "
+	docs.SwaggerInfo.Version = "2.0"
+	docs.SwaggerInfo.Host = "localhost:8080"
+	docs.SwaggerInfo.BasePath = "/"
+	docs.SwaggerInfo.Schemes = []string{"http", "https"}
+
+	conf.MYSQLConfig = entities.MYSQLConfig{
+		User:            os.Getenv("DB_USER"),
+		Password:        os.Getenv("DB_PASSWORD"),
+		Host:            os.Getenv("DB_HOST"),
+		Port:            os.Getenv("DB_PORT"),
+		Database:        os.Getenv("DB_NAME"),
+		DBRetryAttempts: 3,
+	}
+	conf.PaginationConfig = entities.PaginationConfig{
+		Limit:  20,
+		Offset: 0,
+		Page:   1,
+		Sort:   "created_at asc",
+		Name:   "",
+	}
+
+	conf.ValidationParam = entities.ValidationParam{
+		MaxLeadTime:       20,
+		MaxDateDifference: 28,
+	}
+
+	businessTimezone := os.Getenv("BUSINESS_TIMEZONE")
+	if businessTimezone == "" {
+		businessTimezone = "Asia/Singapore"
+	}
+	if _, err := time.LoadLocation(businessTimezone); err != nil {
+		return nil, fmt.Errorf("invalid BUSINESS_TIMEZONE %q : %v", businessTimezone, err)
+	}
+	conf.TimezoneConfig = entities.TimezoneConfig{
+		BusinessTimezone: businessTimezone,
+	}
+
+	schedulerInterval := time.Minute
+	if interval := os.Getenv("STATUS_SCHEDULER_INTERVAL"); interval != "" {
+		parsedInterval, err := time.ParseDuration(interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid STATUS_SCHEDULER_INTERVAL %q : %v", interval, err)
+		}
+		if parsedInterval <= 0 {
+			return nil, fmt.Errorf("invalid STATUS_SCHEDULER_INTERVAL %q : must be positive", interval)
+		}
+		schedulerInterval = parsedInterval
+	}
+	conf.SchedulerConfig = entities.SchedulerConfig{
+		Enabled:  os.Getenv("STATUS_SCHEDULER_ENABLED") != "false",
+		Interval: schedulerInterval,
+		LockName: "campaign-status-scheduler",
+	}
+
+	holdTTL := 10 * time.Minute
+	if ttl := os.Getenv("RESERVATION_HOLD_TTL"); ttl != "" {
+		parsedTTL, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RESERVATION_HOLD_TTL %q : %v", ttl, err)
+		}
+		if parsedTTL <= 0 {
+			return nil, fmt.Errorf("invalid RESERVATION_HOLD_TTL %q : must be positive", ttl)
+		}
+		holdTTL = parsedTTL
+	}
+	conf.ReservationConfig = entities.ReservationConfig{
+		HoldTTL: holdTTL,
+	}
+
+	overlapMode := valueobjects.OverlapModeWarn
+	if mode := os.Getenv("CAMPAIGN_OVERLAP_MODE"); mode != "" {
+		overlapMode = valueobjects.OverlapMode(mode)
+		if overlapMode != valueobjects.OverlapModeWarn && overlapMode != valueobjects.OverlapModeReject {
+			return nil, fmt.Errorf("invalid CAMPAIGN_OVERLAP_MODE %q : must be warn or reject", mode)
+		}
+	}
+	conf.OverlapConfig = entities.OverlapConfig{
+		Mode: overlapMode,
+	}
+	return &conf, nil
+}
+
+func newDBConnection(mysqlConf entities.MYSQLConfig) (*gorm.DB, error) {
+	var err error
+	var connection *gorm.DB
+	for i := 0; i < mysqlConf.DBRetryAttempts; i++ {
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local",
+			mysqlConf.User,
+			mysqlConf.Password,
+			mysqlConf.Host,
+			mysqlConf.Port,
+			mysqlConf.Database,
+		)
+
+		// tlsConf := createTLSConf()
+		// err = gomysql.RegisterTLSConfig("custom", &tlsConf)
+		// if err != nil {
+		// 	logger.Fatalf("Error %s when RegisterTLSConfig\n", err)
+		// 	return nil, err
+		// }
+
+		connection, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		if err == nil {
+			// Get generic database object sql.DB to use its functions
+			sqlDB, err := connection.DB()
+			if err == nil {
+				// SetMaxIdleConns sets the maximum number of connections in the idle connection pool.
+				sqlDB.SetMaxIdleConns(10)
+
+				// SetMaxOpenConns sets the maximum number of open connections to the database.
+				sqlDB.SetMaxOpenConns(100)
+
+				// SetConnMaxLifetime sets the maximum amount of time a connection may be reused.
+				sqlDB.SetConnMaxLifetime(time.Hour)
+			}
+			return connection, nil
+		}
+		time.Sleep(time.Millisecond * 500)
+	}
+	return nil, err
+}
+
+func registerRepoServices(db *gorm.DB, businessTimezone string) *MysqlRepoServices {
+	var repos MysqlRepoServices
+	repos.CampaignRepoService = repo.NewCampaignService(db, businessTimezone)
+	if err := repos.CampaignRepoService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.CampaignProductRepoService = repo.NewCampaignProductService(db)
+	if err := repos.CampaignProductRepoService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.CampaignStoreRepoService = repo.NewCampaignStoreService(db)
+	if err := repos.CampaignStoreRepoService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+
+	repos.CampaignCodeService = repo.NewCampaignCodeService(db)
+	if err := repos.CampaignCodeService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.StoreDailyTimeSlotService = repo.NewStoreDailyTimeSlotService(db)
+	if err := repos.StoreDailyTimeSlotService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.StoreSpecificTimeSlotService = repo.NewStoreSpecificTimeSlotService(db)
+	if err := repos.StoreSpecificTimeSlotService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.ReservationService = repo.NewCollectionSlotReservationService(db)
+	if err := repos.ReservationService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.SlotTemplateService = repo.NewSlotTemplateService(db)
+	if err := repos.SlotTemplateService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.BlackoutService = repo.NewBlackoutService(db)
+	if err := repos.BlackoutService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.StoreService = repo.NewStoreService(db)
+	if err := repos.StoreService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.StoreGroupService = repo.NewStoreGroupService(db)
+	if err := repos.StoreGroupService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.CampaignStatusJobRunService = repo.NewCampaignStatusJobRunService(db)
+	if err := repos.CampaignStatusJobRunService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.CampaignStatusHistoryService = repo.NewCampaignStatusHistoryService(db)
+	if err := repos.CampaignStatusHistoryService.Migrate(); err != nil {
+		logger.Fatal(err)
+	}
+	repos.LockService = repo.NewLockService(db)
+	repos.TransactionService = repo.NewTransactionService(db)
+	return &repos
+}
+
+func createTLSConf() tls.Config {
+	rootCertPool := x509.NewCertPool()
+	pem, err := ioutil.ReadFile("./server-ca.pem")
+	if err != nil {
+		logger.Fatal(err)
+	}
+	if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
+		logger.Fatal("Failed to append PEM.")
+	}
+	clientCert := make([]tls.Certificate, 0, 1)
+
+	certs, err := tls.LoadX509KeyPair("./client-cert.pem", "./client-key.pem")
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	clientCert = append(clientCert, certs)
+
+	return tls.Config{
+		RootCAs:            rootCertPool,
+		Certificates:       clientCert,
+		InsecureSkipVerify: true,
+	}
+}
@@ -1,1175 +1,5951 @@
-// Package docs GENERATED BY SWAG; DO NOT EDIT
-// This file was generated by swaggo/swag
-package docs
-
-import "github.com/swaggo/swag"
-
-const docTemplate = `{
-    "schemes": {{ marshal .Schemes }},
-    "swagger": "2.0",
-    "info": {
-        "description": "{{escape .Description}}",
-        "title": "{{.Title}}",
-        "contact": {},
-        "version": "{{.Version}}"
-    },
-    "host": "{{.Host}}",
-    "basePath": "{{.BasePath}}",
-    "paths": {
-        "/campaigns": {
-            "get": {
-                "description": "API to get details of all campaigns",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign"
-                ],
-                "summary": "Get list of all campaigns",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page Number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort Type [created_at asc/created_at desc]",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Campaign Name",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Campaign Status [InActive/Active/Scheduled]",
-                        "name": "status",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CampaignListResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to create new campaign",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign"
-                ],
-                "summary": "Create a campaign",
-                "parameters": [
-                    {
-                        "description": "Add campaign details",
-                        "name": "campaign",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/params.CampaignCreationForm"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CampaignDTO"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/products": {
-            "post": {
-                "description": "API to create new campaign products",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign products"
-                ],
-                "summary": "Create a campaign products",
-                "parameters": [
-                    {
-                        "description": "Add campaign products details",
-                        "name": "campaign",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/params.CampaignProductCreationForm"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.CampaignProducts"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/update-status": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to update the status of campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign"
-                ],
-                "summary": "Update status of campaign",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/{campaign_id}/products": {
-            "delete": {
-                "description": "API to delete all products under a specified campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign products"
-                ],
-                "summary": "Delete particular campaign products",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "campaign_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/{campaign_id}/products/{id}": {
-            "delete": {
-                "description": "API to delete particular product under a specified campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign products"
-                ],
-                "summary": "Delete particular campaign product by product id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "campaign_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/{campaign_id}/stores": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to insert new stores under given campaign id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign stores"
-                ],
-                "summary": "add stores for specific campaign",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "campaign_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Store Details",
-                        "name": "stores",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/params.CampaignStoresForm"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CampaignStoresDTO"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to delete all stores under specified campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign stores"
-                ],
-                "summary": "Delete all stores under partilcular campaign",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "campaign_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/{campaign_id}/stores/{id}": {
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to delete particular store under specified campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign stores"
-                ],
-                "summary": "Delete specified store with given store id under partilcular campaign",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "campaign_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Campaign Store ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/campaigns/{id}": {
-            "get": {
-                "description": "API to get details of particular campaign",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign"
-                ],
-                "summary": "Get campaign details by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Omit Products",
-                        "name": "omit_products",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Omit Stores",
-                        "name": "omit_stores",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CampaignResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "API to update an existing campaign",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "campaign"
-                ],
-                "summary": "Update campaign details",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Campaign ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "campaign details",
-                        "name": "campaign",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/params.CampaignUpdateForm"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.Response"
-                        }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "dto.CampaignDTO": {
-            "type": "object",
-            "properties": {
-                "campaign_products": {
-                    "description": "Product Details.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.CampaignProducts"
-                    }
-                },
-                "campaign_status_code": {
-                    "description": "Campaign Status",
-                    "type": "integer"
-                },
-                "campaign_stores": {
-                    "description": "Stores Details.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.CampaignStores"
-                    }
-                },
-                "campaign_title": {
-                    "description": "Campaign Title",
-                    "type": "string"
-                },
-                "campaign_type": {
-                    "description": "Campaign Type",
-                    "type": "string"
-                },
-                "collection_end_date": {
-                    "description": "Campaign collection end date",
-                    "type": "string"
-                },
-                "collection_start_date": {
-                    "description": "Campaign collection start date",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "Campaign identifier",
-                    "type": "integer"
-                },
-                "is_campaign_published": {
-                    "description": "Is campaign published flag",
-                    "type": "boolean"
-                },
-                "landing_image_path": {
-                    "description": "Campaign landing screen image path",
-                    "type": "string"
-                },
-                "lead_time": {
-                    "description": "Campaign lead time in days",
-                    "type": "integer"
-                },
-                "listing_description": {
-                    "description": "Campaign listing screen description",
-                    "type": "string"
-                },
-                "listing_image_path": {
-                    "description": "Campaign listing screen image path",
-                    "type": "string"
-                },
-                "listing_title": {
-                    "description": "Campaign listing screen title",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "Campaign Name",
-                    "type": "string"
-                },
-                "offer_id": {
-                    "description": "Offer Identifier",
-                    "type": "integer"
-                },
-                "onboard_image_path": {
-                    "description": "Campaign onboarding screen image path",
-                    "type": "string"
-                },
-                "onboarding_description": {
-                    "description": "Campaign obboarding screen description",
-                    "type": "string"
-                },
-                "onboarding_title": {
-                    "description": "Campaign onboarding screen title",
-                    "type": "string"
-                },
-                "order_end_date": {
-                    "description": "Campaign order end date",
-                    "type": "string"
-                },
-                "order_start_date": {
-                    "description": "Campaign order start date",
-                    "type": "string"
-                },
-                "tag_id": {
-                    "description": "Tag Identifier",
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.CampaignListResponse": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "data": {
-                    "$ref": "#/definitions/dto.DataList"
-                },
-                "status": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.CampaignProducts": {
-            "type": "object",
-            "properties": {
-                "campaign_product_id": {
-                    "type": "integer"
-                },
-                "product_id": {
-                    "type": "integer"
-                },
-                "product_type": {
-                    "type": "string"
-                },
-                "sequence_no": {
-                    "type": "integer"
-                },
-                "serial_no": {
-                    "type": "integer"
-                },
-                "sku_no": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.CampaignResponse": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "data": {
-                    "$ref": "#/definitions/dto.CampaignDTO"
-                },
-                "status": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.CampaignStores": {
-            "type": "object",
-            "properties": {
-                "campaign_store_id": {
-                    "type": "integer"
-                },
-                "store_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.CampaignStoresDTO": {
-            "type": "object",
-            "properties": {
-                "campaign_id": {
-                    "type": "integer"
-                },
-                "stores": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.CampaignStores"
-                    }
-                }
-            }
-        },
-        "dto.DataList": {
-            "type": "object",
-            "properties": {
-                "campaigns": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.CampaignDTO"
-                    }
-                },
-                "count": {
-                    "type": "integer"
-                },
-                "limit": {
-                    "type": "integer"
-                },
-                "offset": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.Response": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "params.CampaignCreationForm": {
-            "type": "object",
-            "required": [
-                "title"
-            ],
-            "properties": {
-                "campaign_status_code": {
-                    "description": "Campaign Status code",
-                    "type": "integer",
-                    "enum": [
-                        1,
-                        2,
-                        3
-                    ]
-                },
-                "campaign_type": {
-                    "description": "Campaign Type",
-                    "type": "string",
-                    "enum": [
-                        "deli",
-                        "cash\u0026carry"
-                    ]
-                },
-                "collection_end_date": {
-                    "description": "Collection end date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "collection_start_date": {
-                    "description": "Collection start date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "is_campaign_published": {
-                    "description": "Is campaign published flag",
-                    "type": "boolean"
-                },
-                "landing_image_path": {
-                    "description": "Landing screen image path",
-                    "type": "string"
-                },
-                "lead_time": {
-                    "description": "Lead time in days",
-                    "type": "integer"
-                },
-                "listing_description": {
-                    "description": "Listing screen description",
-                    "type": "string"
-                },
-                "listing_image_path": {
-                    "description": "Listing screen image path",
-                    "type": "string"
-                },
-                "listing_title": {
-                    "description": "Listing screen title",
-                    "type": "string"
-                },
-                "offer_id": {
-                    "description": "Offer Id",
-                    "type": "integer"
-                },
-                "onboarding_description": {
-                    "description": "Onboarding Description",
-                    "type": "string"
-                },
-                "onboarding_image_path": {
-                    "description": "Onboarding image path",
-                    "type": "string"
-                },
-                "onboarding_title": {
-                    "description": "Onboarding title",
-                    "type": "string"
-                },
-                "order_end_date": {
-                    "description": "Order end date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "order_start_date": {
-                    "description": "Order start date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "products": {
-                    "description": "List of campaign products",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/params.CampaignProduct"
-                    }
-                },
-                "stores": {
-                    "description": "List of campaign stores",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "tag_id": {
-                    "description": "Tag Id",
-                    "type": "integer"
-                },
-                "title": {
-                    "description": "Campaign Title",
-                    "type": "string"
-                }
-            }
-        },
-        "params.CampaignProduct": {
-            "type": "object",
-            "required": [
-                "product_id"
-            ],
-            "properties": {
-                "SKU_no": {
-                    "type": "integer"
-                },
-                "product_id": {
-                    "type": "integer"
-                },
-                "product_type": {
-                    "type": "string",
-                    "enum": [
-                        "cd",
-                        "ncd"
-                    ]
-                },
-                "sequence_no": {
-                    "type": "integer"
-                },
-                "serial_no": {
-                    "type": "integer"
-                }
-            }
-        },
-        "params.CampaignProductCreationForm": {
-            "type": "object",
-            "required": [
-                "campaign_id",
-                "created_by",
-                "products"
-            ],
-            "properties": {
-                "campaign_id": {
-                    "description": "campaign Id",
-                    "type": "integer"
-                },
-                "created_by": {
-                    "description": "Created by user id",
-                    "type": "integer"
-                },
-                "products": {
-                    "description": "List of campaign products",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/params.CampaignProduct"
-                    }
-                }
-            }
-        },
-        "params.CampaignStoresForm": {
-            "type": "object",
-            "required": [
-                "stores"
-            ],
-            "properties": {
-                "stores": {
-                    "description": "List of campaign stores",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                }
-            }
-        },
-        "params.CampaignUpdateForm": {
-            "type": "object",
-            "properties": {
-                "campaign_status_code": {
-                    "description": "Campaign Status code",
-                    "type": "integer",
-                    "enum": [
-                        1,
-                        2,
-                        3
-                    ]
-                },
-                "campaign_type": {
-                    "description": "Campaign Type",
-                    "type": "string",
-                    "enum": [
-                        "deli",
-                        "cash\u0026carry"
-                    ]
-                },
-                "collection_end_date": {
-                    "description": "Collection end date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "collection_start_date": {
-                    "description": "Collection start date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "is_campaign_published": {
-                    "description": "Is campaign published flag",
-                    "type": "boolean"
-                },
-                "landing_image_path": {
-                    "description": "Landing screen image path",
-                    "type": "string"
-                },
-                "lead_time": {
-                    "description": "Lead time in days",
-                    "type": "integer"
-                },
-                "listing_description": {
-                    "description": "Listing screen description",
-                    "type": "string"
-                },
-                "listing_image_path": {
-                    "description": "Listing screen image path",
-                    "type": "string"
-                },
-                "listing_title": {
-                    "description": "Listing screen title",
-                    "type": "string"
-                },
-                "offer_id": {
-                    "description": "Offer Id",
-                    "type": "integer"
-                },
-                "onboarding_description": {
-                    "description": "Onboarding Description",
-                    "type": "string"
-                },
-                "onboarding_image_path": {
-                    "description": "Onboarding image path",
-                    "type": "string"
-                },
-                "onboarding_title": {
-                    "description": "Onboarding title",
-                    "type": "string"
-                },
-                "order_end_date": {
-                    "description": "Order end date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "order_start_date": {
-                    "description": "Order start date",
-                    "type": "string",
-                    "example": "2023-12-31 12:00:00"
-                },
-                "products": {
-                    "description": "List of campaign products",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/params.UpdateCampaignProduct"
-                    }
-                },
-                "stores": {
-                    "description": "List of campaign stores",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "tag_id": {
-                    "description": "Tag Id",
-                    "type": "integer"
-                },
-                "title": {
-                    "description": "Campaign Title",
-                    "type": "string"
-                }
-            }
-        },
-        "params.UpdateCampaignProduct": {
-            "type": "object",
-            "properties": {
-                "SKU_no": {
-                    "type": "integer"
-                },
-                "campaign_product_id": {
-                    "type": "integer"
-                },
-                "product_id": {
-                    "type": "integer"
-                },
-                "product_type": {
-                    "type": "string",
-                    "enum": [
-                        "cd",
-                        "ncd"
-                    ]
-                },
-                "sequence_no": {
-                    "type": "integer"
-                },
-                "serial_no": {
-                    "type": "integer"
-                }
-            }
-        }
-    },
-    "securityDefinitions": {
-        "ApiKeyAuth": {
-            "description": "This is a Campaign Management Server, Which provides set of APIs to create and manage campaigns, for adding products and stores against particular campaign and also APIs for time slot management.",
-            "type": "apiKey",
-            "name": "Authorization",
-            "in": "header"
-        }
-    }
-}`
-
-// SwaggerInfo holds exported Swagger Info so clients can modify it
-var SwaggerInfo = &swag.Spec{
-	Version:          "",
-	Host:             "",
-	BasePath:         "",
-	Schemes:          []string{},
-	Title:            "",
-	Description:      "",
-	InfoInstanceName: "swagger",
-	SwaggerTemplate:  docTemplate,
-}
-
-func init() {
-	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
-}
+// Package docs GENERATED BY SWAG; DO NOT EDIT
+// This file was generated by swaggo/swag
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "contact": {},
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/blackouts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get the blackouts of every store, along with the ones of particular store when store_id is given, optionally limited to a date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Get blackouts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BlackoutListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to close collections on a date range, at particular store or at every store when store_id is left out.\nCollection slots are not offered on blacked out dates and a campaign can not collect on a date blacked out for every store or for one of its stores",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Add blackout",
+                "parameters": [
+                    {
+                        "description": "Blackout details",
+                        "name": "blackout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.BlackoutForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BlackoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blackouts/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add the events of an iCalendar (.ics) file as blackouts, of particular store or of every store when store_id is left out.\nEvery event blacks out each date it touches, cancelled events are left out and recurring events are refused.\nEvents imported before are matched by their UID and updated, so importing the same calendar again adds nothing",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Import blackouts from calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "iCalendar file content",
+                        "name": "calendar",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BlackoutImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/blackouts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular blackout",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Get blackout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blackout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BlackoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update particular blackout",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Update blackout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blackout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blackout details",
+                        "name": "blackout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.BlackoutForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BlackoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular blackout",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blackouts"
+                ],
+                "summary": "Delete blackout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blackout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaign-codes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get every status a campaign can be in, system statuses are used by the status job and can not be renamed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign codes"
+                ],
+                "summary": "Get list of campaign statuses",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignCodeListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add a new campaign status like Cancelled or Completed, the status code is generated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign codes"
+                ],
+                "summary": "Add campaign status",
+                "parameters": [
+                    {
+                        "description": "Campaign status details",
+                        "name": "campaign_code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignCodeForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaign-codes/{status_code}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to rename a campaign status added through the API, system statuses can not be renamed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign codes"
+                ],
+                "summary": "Rename campaign status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Status Code",
+                        "name": "status_code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campaign status details",
+                        "name": "campaign_code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignCodeForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns": {
+            "get": {
+                "description": "API to get details of all campaigns",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get list of all campaigns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Status, any status value listed by /campaign-codes",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List deleted campaigns too, only allowed for users of the admin group",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Type",
+                        "name": "campaign_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Published campaigns only when true, unpublished campaigns only when false",
+                        "name": "is_published",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns taking orders on or after this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "order_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns taking orders on or before this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "order_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns open for collection on or after this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "collection_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns open for collection on or before this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "collection_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the user who created the campaigns",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaigns selling at this store",
+                        "name": "store_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaigns selling this product",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page by cursor in place of page, empty for the first page then the next_cursor or prev_cursor of the last response",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search the titles and descriptions, the campaigns are ranked by relevance and the matching fields highlighted. Not available with cursor",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to create new campaign, the stores given must be registered and active and the active stores of store_group_ids are added along with them.\nCampaigns selling one of its products at one of its stores in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the campaign is not created when the overlap mode rejects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Create a campaign",
+                "parameters": [
+                    {
+                        "description": "Add campaign details",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignCreationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignDTO"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/products": {
+            "post": {
+                "description": "API to create new campaign products. Campaigns selling an added product at a store of the campaign in overlapping order dates\nare listed in the X-Overlapping-Campaign-Ids header, or the products are not added when the overlap mode rejects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Create a campaign products",
+                "parameters": [
+                    {
+                        "description": "Add campaign products details",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignProductCreationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CampaignProducts"
+                            }
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/summary": {
+            "get": {
+                "description": "API to get the number of campaigns in each status, starting or ending in the next 7 days and without stores\nor products, among the campaigns matching the same filters as the campaign list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get summary of campaigns",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Campaign Name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Status, any status value listed by /campaign-codes",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count deleted campaigns too, only allowed for users of the admin group",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Type",
+                        "name": "campaign_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Published campaigns only when true, unpublished campaigns only when false",
+                        "name": "is_published",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns taking orders on or after this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "order_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns taking orders on or before this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "order_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns open for collection on or after this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "collection_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns open for collection on or before this date time [2023-12-31 12:00:00] in the business timezone",
+                        "name": "collection_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the user who created the campaigns",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaigns selling at this store",
+                        "name": "store_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaigns selling this product",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search the titles and descriptions",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/update-status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update the status of campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Update status of campaign",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/products": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get a page of products under specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Get products of campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [column asc/column desc], column is one of campaign_product_id, product_id, sku_no, serial_no, sequence_no, product_type, created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU Number",
+                        "name": "sku_no",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Product Type [cd/ncd]",
+                        "name": "product_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "API to delete all products under a specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Delete particular campaign products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/products/order": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to rewrite sequence_no of all products under specified campaign, the products are numbered from 1 in the given order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Reorder campaign products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ordered campaign product ids",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignProductOrderForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CampaignProducts"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/products/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update product details and sequence of a product under specified campaign, fields left empty are not changed.\nCampaigns selling a changed product at a store of the campaign in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the change is undone when the overlap mode rejects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Update a campaign product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaign Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product Details",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignProductUpdateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignProducts"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "API to delete particular product under a specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign products"
+                ],
+                "summary": "Delete particular campaign product by product id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/status-history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get every status change of particular campaign with the user id or scheduler who made it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get status history of campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignStatusHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/stores": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get a page of stores under specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign stores"
+                ],
+                "summary": "Get stores of campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [column asc/column desc], column is one of campaign_store_id, store_id, created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignStoreListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to insert new stores under given campaign id, every store must be registered and active. The active stores of store_group_ids are added too, leaving out the stores the campaign already has.\nCampaigns selling a product of the campaign at an added store in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the stores are not added when the overlap mode rejects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign stores"
+                ],
+                "summary": "add stores for specific campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Store Details",
+                        "name": "stores",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignStoresForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignStoresDTO"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete all stores under specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign stores"
+                ],
+                "summary": "Delete all stores under partilcular campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/stores/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular store under specified campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign stores"
+                ],
+                "summary": "Delete specified store with given store id under partilcular campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Campaign Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{campaign_id}/stores/{store_id}/slots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get the collection slots specified store offers for the campaign with the quota left on each.\nThe slots set for particular dates replace the weekly slots, slots outside the collection dates or within the lead time are left out.\nfrom defaults to today and to to the collection end date, a range covers at most 92 days",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Get collection slots of campaign store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CollectionSlotAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{id}": {
+            "get": {
+                "description": "API to get details of particular campaign",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get campaign details by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Omit Products",
+                        "name": "omit_products",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Omit Stores",
+                        "name": "omit_stores",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update an existing campaign, the campaign keeps the stores given along with the active stores of store_group_ids.\nCampaigns the update newly overlaps, selling one of its products at one of its stores in overlapping order dates, are listed in the X-Overlapping-Campaign-Ids header, or the update is undone when the overlap mode rejects. Overlaps the campaign already had are not reported",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Update campaign details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "campaign details",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignUpdateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete a campaign along with its stores and products, it can be brought back with the restore API",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Delete campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{id}/clone": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to create a copy of a campaign along with its stores and products, under a new title and dates.\nCampaigns the copy overlaps are listed in the X-Overlapping-Campaign-Ids header, or the copy is not created when the overlap mode rejects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Clone a campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New campaign title and dates",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CampaignCloneForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignDTO"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/campaigns/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to bring back a deleted campaign along with the stores and products deleted with it\nCampaigns selling one of its products at one of its stores in overlapping order dates are listed in the X-Overlapping-Campaign-Ids header, or the campaign stays deleted when the overlap mode rejects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Restore deleted campaign",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Campaign ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        },
+                        "headers": {
+                            "X-Overlapping-Campaign-Ids": {
+                                "type": "string",
+                                "description": "Comma separated ids of the overlapping campaigns"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/campaigns": {
+            "get": {
+                "description": "API to get a page of the campaigns selling specified product, narrowed down to one SKU of the product with sku_no.\nPass at=now to list the campaigns live right now, a campaign is live from its order start date until its collection end date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get campaigns of product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU Number",
+                        "name": "sku_no",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Status, any status value listed by /campaign-codes",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Published campaigns only when true, unpublished campaigns only when false",
+                        "name": "is_published",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns live at this date time [2023-12-31 12:00:00] in the business timezone, or now",
+                        "name": "at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Embed the products of each campaign",
+                        "name": "include_products",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slot-templates": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get all slot templates",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Get slot templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlotTemplateListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add a template of weekly slots, start to end is cut into slots of slot_minutes on each of the weekdays",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Add slot template",
+                "parameters": [
+                    {
+                        "description": "Slot template details",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.SlotTemplateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlotTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slot-templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular slot template",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Get slot template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlotTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update particular slot template, slots made from it before are left as they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Update slot template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slot template details",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.SlotTemplateForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlotTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular slot template, slots made from it are left as they are",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Delete slot template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slot-templates/{id}/apply": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to create the weekly slots of the template for the given stores in one go, either the slots of every store are created or none.\nUnknown or inactive stores and slots overlapping a slot a store already has fail the request. With dry_run the slots are returned without being created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot templates"
+                ],
+                "summary": "Apply slot template to stores",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Slot Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stores to apply the template to",
+                        "name": "apply",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.SlotTemplateApplyForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlotTemplateApplyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slots/{slot}/reservations": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to take quota of a collection slot for a campaign, slot is the key returned with the campaign store slots.\nThe reservation is held until its hold expires unless confirmed, with confirm it is reserved for good right away",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot reservations"
+                ],
+                "summary": "Reserve collection slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot key, source-slotid-date",
+                        "name": "slot",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reservation details",
+                        "name": "reservation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.CollectionSlotReservationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CollectionSlotReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slots/{slot}/reservations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to give the quota of a held or confirmed reservation back",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot reservations"
+                ],
+                "summary": "Release collection slot reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot key, source-slotid-date",
+                        "name": "slot",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/slots/{slot}/reservations/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to keep the quota of a held reservation for good, a hold can not be confirmed once expired",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slot reservations"
+                ],
+                "summary": "Confirm collection slot reservation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slot key, source-slotid-date",
+                        "name": "slot",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Reservation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CollectionSlotReservationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/store-groups": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get a page of the store groups with their stores",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Get store groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [column asc/column desc], column is one of store_group_id, name, created_at",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreGroupListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to create a named group of stores, pass its id as store_group_ids to add all its stores to a campaign",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Create store group",
+                "parameters": [
+                    {
+                        "description": "Store group details",
+                        "name": "store_group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreGroupCreationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/store-groups/{store_group_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular store group with its stores",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Get store group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store Group ID",
+                        "name": "store_group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to rename particular store group, its stores are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Update store group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store Group ID",
+                        "name": "store_group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Store group details",
+                        "name": "store_group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreGroupForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular store group, campaigns keep the stores added through it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Delete store group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store Group ID",
+                        "name": "store_group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/store-groups/{store_group_id}/stores": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add stores to particular store group, stores already in the group are left as they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Add stores to store group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store Group ID",
+                        "name": "store_group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stores",
+                        "name": "stores",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreGroupStoresForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/store-groups/{store_group_id}/stores/{store_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to remove a store from particular store group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store groups"
+                ],
+                "summary": "Remove store from store group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store Group ID",
+                        "name": "store_group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get a page of the registered stores",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stores"
+                ],
+                "summary": "Get stores",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [column asc/column desc], column is one of store_id, name, region, created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Active stores only when true, inactive stores only when false",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to register a store under the store id campaigns refer to it by, only active stores can be added to campaigns",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stores"
+                ],
+                "summary": "Register store",
+                "parameters": [
+                    {
+                        "description": "Store details",
+                        "name": "store",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreCreationForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular registered store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stores"
+                ],
+                "summary": "Get store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update particular registered store, a store made inactive stays on the campaigns it was added to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stores"
+                ],
+                "summary": "Update store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Store details",
+                        "name": "store",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to remove particular store from the registry, campaigns keep the store but can no longer add it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stores"
+                ],
+                "summary": "Delete store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/campaigns": {
+            "get": {
+                "description": "API to get a page of the campaigns specified store takes part in. Pass at=now to list the campaigns live at the store right now,\na campaign is live from its order start date until its collection end date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Get campaigns of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort [comma separated columns, each prefixed with - to sort it descending, e.g. -order_start_date,title], column is one of campaign_id, title, status_code, order_start_date, order_end_date, collection_start_date, collection_end_date, created_at, updated_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign Status, any status value listed by /campaign-codes",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Published campaigns only when true, unpublished campaigns only when false",
+                        "name": "is_published",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaigns live at this date time [2023-12-31 12:00:00] in the business timezone, or now",
+                        "name": "at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Embed the products of each campaign",
+                        "name": "include_products",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/daily-slots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get the weekly repeating collection slots of specified store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Get daily time slots of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreDailyTimeSlotListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add a weekly repeating collection slot to specified store, slots of the same weekday can not overlap",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Add daily time slot to store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot details",
+                        "name": "slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreDailyTimeSlotForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreDailyTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/daily-slots/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular weekly repeating collection slot of specified store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Get daily time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Daily Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreDailyTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update particular weekly repeating collection slot of specified store, slots of the same weekday can not overlap",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Update daily time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Daily Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot details",
+                        "name": "slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreDailyTimeSlotForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreDailyTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular weekly repeating collection slot of specified store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Delete daily time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Daily Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/specific-slots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get the slots and closures of specified store for particular dates, optionally limited to a date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Get specific date time slots of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreSpecificTimeSlotListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add a slot for a particular date to specified store, or with is_closed to close the store the whole date.\nSlots of a date replace the weekly slots of that weekday, slots of the same date can not overlap and a closure can not share its date with slots.\nThe store must be registered and active",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Add specific date time slot to store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot details",
+                        "name": "slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreSpecificTimeSlotForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreSpecificTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/specific-slots/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to add the same slots, or a closure with is_closed, to every date of a range of at most 92 days, optionally only on some weekdays.\nEither every slot is added or none, the store must be registered and active",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Add time slots to store for a date range",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Date range and time slot details",
+                        "name": "slots",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreSpecificTimeSlotBulkForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreSpecificTimeSlotListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/stores/{store_id}/specific-slots/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to get particular slot or closure of specified store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Get specific date time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Specific Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreSpecificTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to update particular slot or closure of specified store, slots of the same date can not overlap and a closure can not share its date with slots.\nThe store must be registered and active",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Update specific date time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Specific Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot details",
+                        "name": "slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/params.StoreSpecificTimeSlotForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StoreSpecificTimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API to delete particular slot or closure of specified store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "store time slots"
+                ],
+                "summary": "Delete specific date time slot of store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store ID",
+                        "name": "store_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Specific Time Slot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.BlackoutDTO": {
+            "type": "object",
+            "properties": {
+                "blackout_id": {
+                    "description": "Blackout identifier",
+                    "type": "integer"
+                },
+                "end_date": {
+                    "description": "Last blacked out date, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Why collections are closed",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "First blacked out date, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store the blackout applies to, 0 for every store",
+                    "type": "integer"
+                },
+                "uid": {
+                    "description": "Identifier of the calendar event the blackout was imported from",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlackoutImportResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.BlackoutImportResult"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlackoutImportResult": {
+            "type": "object",
+            "properties": {
+                "blackouts": {
+                    "description": "Blackouts of the imported events",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BlackoutDTO"
+                    }
+                },
+                "created": {
+                    "description": "Number of events added as new blackouts",
+                    "type": "integer"
+                },
+                "updated": {
+                    "description": "Number of events that updated the blackouts imported from them before",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.BlackoutListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BlackoutDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlackoutResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.BlackoutDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignCodeDTO": {
+            "type": "object",
+            "properties": {
+                "status_code": {
+                    "type": "integer"
+                },
+                "status_value": {
+                    "type": "string"
+                },
+                "system": {
+                    "description": "System statuses are managed by the status job and can not be renamed",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CampaignCodeListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignCodeDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignCodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CampaignCodeDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignDTO": {
+            "type": "object",
+            "properties": {
+                "campaign_products": {
+                    "description": "Product Details.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignProducts"
+                    }
+                },
+                "campaign_status_code": {
+                    "description": "Campaign Status",
+                    "type": "integer"
+                },
+                "campaign_stores": {
+                    "description": "Stores Details.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignStores"
+                    }
+                },
+                "campaign_title": {
+                    "description": "Campaign Title",
+                    "type": "string"
+                },
+                "campaign_type": {
+                    "description": "Campaign Type",
+                    "type": "string"
+                },
+                "collection_end_date": {
+                    "description": "Campaign collection end date",
+                    "type": "string"
+                },
+                "collection_start_date": {
+                    "description": "Campaign collection start date",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "Campaign deletion date, only set when deleted campaigns are listed",
+                    "type": "string"
+                },
+                "highlights": {
+                    "description": "Snippets of the fields matching a search with the matching words in \u003cem\u003e, keyed by the field name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "Campaign identifier",
+                    "type": "integer"
+                },
+                "is_campaign_published": {
+                    "description": "Is campaign published flag",
+                    "type": "boolean"
+                },
+                "landing_image_path": {
+                    "description": "Campaign landing screen image path",
+                    "type": "string"
+                },
+                "lead_time": {
+                    "description": "Campaign lead time in days",
+                    "type": "integer"
+                },
+                "listing_description": {
+                    "description": "Campaign listing screen description",
+                    "type": "string"
+                },
+                "listing_image_path": {
+                    "description": "Campaign listing screen image path",
+                    "type": "string"
+                },
+                "listing_title": {
+                    "description": "Campaign listing screen title",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Campaign Name",
+                    "type": "string"
+                },
+                "offer_id": {
+                    "description": "Offer Identifier",
+                    "type": "integer"
+                },
+                "onboard_image_path": {
+                    "description": "Campaign onboarding screen image path",
+                    "type": "string"
+                },
+                "onboarding_description": {
+                    "description": "Campaign obboarding screen description",
+                    "type": "string"
+                },
+                "onboarding_title": {
+                    "description": "Campaign onboarding screen title",
+                    "type": "string"
+                },
+                "order_end_date": {
+                    "description": "Campaign order end date",
+                    "type": "string"
+                },
+                "order_start_date": {
+                    "description": "Campaign order start date",
+                    "type": "string"
+                },
+                "tag_id": {
+                    "description": "Tag Identifier",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "Timezone the campaign dates are rendered in",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.DataList"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignProductDataList": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignProducts"
+                    }
+                }
+            }
+        },
+        "dto.CampaignProductListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CampaignProductDataList"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignProducts": {
+            "type": "object",
+            "properties": {
+                "campaign_product_id": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_type": {
+                    "type": "string"
+                },
+                "sequence_no": {
+                    "type": "integer"
+                },
+                "serial_no": {
+                    "type": "integer"
+                },
+                "sku_no": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CampaignResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CampaignDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStatusCountDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "status_value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStatusHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "User id who changed the status, or scheduler",
+                    "type": "string"
+                },
+                "campaign_id": {
+                    "description": "Campaign identifier",
+                    "type": "integer"
+                },
+                "changed_at": {
+                    "description": "Time of the change in UTC",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Status history identifier",
+                    "type": "integer"
+                },
+                "new_status": {
+                    "description": "Status after the change",
+                    "type": "string"
+                },
+                "new_status_code": {
+                    "description": "Status code after the change",
+                    "type": "integer"
+                },
+                "old_status": {
+                    "description": "Status before the change",
+                    "type": "string"
+                },
+                "old_status_code": {
+                    "description": "Status code before the change",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CampaignStatusHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignStatusHistoryDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStoreDataList": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "stores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignStores"
+                    }
+                }
+            }
+        },
+        "dto.CampaignStoreListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CampaignStoreDataList"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStores": {
+            "type": "object",
+            "properties": {
+                "campaign_store_id": {
+                    "type": "integer"
+                },
+                "region": {
+                    "description": "Region of the store, left out when the store is not registered",
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "integer"
+                },
+                "store_name": {
+                    "description": "Name of the store, left out when the store is not registered",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStoresDTO": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "integer"
+                },
+                "stores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignStores"
+                    }
+                }
+            }
+        },
+        "dto.CampaignSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "ending_soon": {
+                    "description": "Number of campaigns closing orders within the soon days",
+                    "type": "integer"
+                },
+                "soon_days": {
+                    "description": "Number of days ahead the campaigns starting and ending soon are counted over",
+                    "type": "integer"
+                },
+                "starting_soon": {
+                    "description": "Number of campaigns taking orders from within the soon days",
+                    "type": "integer"
+                },
+                "statuses": {
+                    "description": "Number of campaigns in each status, statuses without campaigns included",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignStatusCountDTO"
+                    }
+                },
+                "total": {
+                    "description": "Number of campaigns matching the filters",
+                    "type": "integer"
+                },
+                "without_products": {
+                    "description": "Number of campaigns without any product",
+                    "type": "integer"
+                },
+                "without_stores": {
+                    "description": "Number of campaigns without any store",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CampaignSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CampaignSummaryDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CollectionSlotAvailability": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "description": "Campaign identifier",
+                    "type": "integer"
+                },
+                "from": {
+                    "description": "First date slots were worked out for, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CollectionSlotDTO"
+                    }
+                },
+                "store_id": {
+                    "description": "Store identifier",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "Timezone of the campaign the slot times are in",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "Last date slots were worked out for, YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CollectionSlotAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CollectionSlotAvailability"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CollectionSlotDTO": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "Date of the slot, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "end_at": {
+                    "description": "Slot end as a point in time",
+                    "type": "string"
+                },
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM",
+                    "type": "string"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer"
+                },
+                "remaining_quota": {
+                    "description": "Number of collections the slot can still take",
+                    "type": "integer"
+                },
+                "slot": {
+                    "description": "Key to make reservations of the slot with, source-slotid-date",
+                    "type": "string"
+                },
+                "slot_id": {
+                    "description": "Identifier of the daily or specific time slot",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "Where the slot comes from, daily for the weekly slots or specific for the slots of the date",
+                    "type": "string"
+                },
+                "start_at": {
+                    "description": "Slot start as a point in time",
+                    "type": "string"
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CollectionSlotReservationDTO": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "description": "Campaign identifier",
+                    "type": "integer"
+                },
+                "date": {
+                    "description": "Date of the reserved slot, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "When a held reservation gives its quota back unless confirmed",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Number of collections reserved",
+                    "type": "integer"
+                },
+                "reference": {
+                    "description": "Reference of the caller e.g. an order number",
+                    "type": "string"
+                },
+                "reservation_id": {
+                    "description": "Reservation identifier",
+                    "type": "integer"
+                },
+                "slot": {
+                    "description": "Key of the reserved slot, source-slotid-date",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "held, confirmed or released",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store identifier",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CollectionSlotReservationResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.CollectionSlotReservationDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DataList": {
+            "type": "object",
+            "properties": {
+                "campaigns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CampaignDTO"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SlotTemplateApplyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.SlotTemplateApplyResult"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SlotTemplateApplyResult": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "Whether the slots were only worked out and not created",
+                    "type": "boolean"
+                },
+                "slot_template_id": {
+                    "description": "Slot template identifier",
+                    "type": "integer"
+                },
+                "slots": {
+                    "description": "Slots created, or that would be created on a dry run",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StoreDailyTimeSlotDTO"
+                    }
+                },
+                "store_ids": {
+                    "description": "Stores the template was applied to",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "dto.SlotTemplateDTO": {
+            "type": "object",
+            "properties": {
+                "days_of_week": {
+                    "description": "Weekdays the template makes slots on",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_time": {
+                    "description": "End of the last slot in store local time, HH:MM",
+                    "type": "string"
+                },
+                "is_slot_available": {
+                    "description": "Whether the slots are offered to customers",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name of the template",
+                    "type": "string"
+                },
+                "quota": {
+                    "description": "Number of collections every slot takes",
+                    "type": "integer"
+                },
+                "slot_minutes": {
+                    "description": "Length of every slot in minutes",
+                    "type": "integer"
+                },
+                "slot_template_id": {
+                    "description": "Slot template identifier",
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "Start of the first slot in store local time, HH:MM",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SlotTemplateListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SlotTemplateDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SlotTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.SlotTemplateDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "Store address",
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "Whether the store can be added to campaigns",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Store name",
+                    "type": "string"
+                },
+                "region": {
+                    "description": "Region the store belongs to",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store ID campaigns refer to the store by",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA timezone of the store",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreDailyTimeSlotDTO": {
+            "type": "object",
+            "properties": {
+                "daily_time_slot_id": {
+                    "description": "Daily time slot identifier",
+                    "type": "integer"
+                },
+                "day_of_week": {
+                    "description": "Weekday the slot repeats on",
+                    "type": "string"
+                },
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM",
+                    "type": "string"
+                },
+                "is_slot_available": {
+                    "description": "Whether the slot is offered to customers",
+                    "type": "boolean"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store identifier",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.StoreDailyTimeSlotListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StoreDailyTimeSlotDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreDailyTimeSlotResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreDailyTimeSlotDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreDataList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "stores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StoreDTO"
+                    }
+                }
+            }
+        },
+        "dto.StoreGroupDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Store group description",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Store group name",
+                    "type": "string"
+                },
+                "store_group_id": {
+                    "description": "Store group ID",
+                    "type": "integer"
+                },
+                "stores": {
+                    "description": "Stores of the group",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "dto.StoreGroupDataList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "store_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StoreGroupDTO"
+                    }
+                }
+            }
+        },
+        "dto.StoreGroupListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreGroupDataList"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreGroupResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreGroupDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreDataList"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreSpecificTimeSlotDTO": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "Date the slot applies to, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM, left out for a closed date",
+                    "type": "string"
+                },
+                "is_closed": {
+                    "description": "Whether the store is closed the whole date",
+                    "type": "boolean"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer"
+                },
+                "specific_time_slot_id": {
+                    "description": "Specific time slot identifier",
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM, left out for a closed date",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store identifier",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.StoreSpecificTimeSlotListResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StoreSpecificTimeSlotDTO"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StoreSpecificTimeSlotResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/dto.StoreSpecificTimeSlotDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "params.BlackoutForm": {
+            "type": "object",
+            "required": [
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "Last blacked out date, YYYY-MM-DD, the start date when left out",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Why collections are closed e.g. the name of the holiday",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "start_date": {
+                    "description": "First blacked out date, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "store_id": {
+                    "description": "Store the blackout applies to, 0 or left out for every store",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "params.CampaignCloneForm": {
+            "type": "object",
+            "required": [
+                "collection_end_date",
+                "collection_start_date",
+                "order_end_date",
+                "order_start_date",
+                "title"
+            ],
+            "properties": {
+                "collection_end_date": {
+                    "description": "Collection end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "collection_start_date": {
+                    "description": "Collection start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "order_end_date": {
+                    "description": "Order end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "order_start_date": {
+                    "description": "Order start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "timezone": {
+                    "description": "IANA timezone the dates are given in, defaults to the timezone of the cloned campaign",
+                    "type": "string",
+                    "example": "Asia/Singapore"
+                },
+                "title": {
+                    "description": "Title of the new campaign",
+                    "type": "string"
+                }
+            }
+        },
+        "params.CampaignCodeForm": {
+            "type": "object",
+            "required": [
+                "status_value"
+            ],
+            "properties": {
+                "status_value": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "params.CampaignCreationForm": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "campaign_type": {
+                    "description": "Campaign Type",
+                    "type": "string",
+                    "enum": [
+                        "deli",
+                        "cash\u0026carry"
+                    ]
+                },
+                "collection_end_date": {
+                    "description": "Collection end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "collection_start_date": {
+                    "description": "Collection start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "is_campaign_published": {
+                    "description": "Is campaign published flag",
+                    "type": "boolean"
+                },
+                "landing_image_path": {
+                    "description": "Landing screen image path",
+                    "type": "string"
+                },
+                "lead_time": {
+                    "description": "Lead time in days",
+                    "type": "integer"
+                },
+                "listing_description": {
+                    "description": "Listing screen description",
+                    "type": "string"
+                },
+                "listing_image_path": {
+                    "description": "Listing screen image path",
+                    "type": "string"
+                },
+                "listing_title": {
+                    "description": "Listing screen title",
+                    "type": "string"
+                },
+                "offer_id": {
+                    "description": "Offer Id",
+                    "type": "integer"
+                },
+                "onboarding_description": {
+                    "description": "Onboarding Description",
+                    "type": "string"
+                },
+                "onboarding_image_path": {
+                    "description": "Onboarding image path",
+                    "type": "string"
+                },
+                "onboarding_title": {
+                    "description": "Onboarding title",
+                    "type": "string"
+                },
+                "order_end_date": {
+                    "description": "Order end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "order_start_date": {
+                    "description": "Order start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "products": {
+                    "description": "List of campaign products",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/params.CampaignProduct"
+                    }
+                },
+                "store_group_ids": {
+                    "description": "Store groups whose active stores are added along with the stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "stores": {
+                    "description": "List of campaign stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tag_id": {
+                    "description": "Tag Id",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA timezone the dates are given in, defaults to the business timezone",
+                    "type": "string",
+                    "example": "Asia/Singapore"
+                },
+                "title": {
+                    "description": "Campaign Title",
+                    "type": "string"
+                }
+            }
+        },
+        "params.CampaignProduct": {
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "SKU_no": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_type": {
+                    "type": "string",
+                    "enum": [
+                        "cd",
+                        "ncd"
+                    ]
+                },
+                "sequence_no": {
+                    "type": "integer"
+                },
+                "serial_no": {
+                    "type": "integer"
+                }
+            }
+        },
+        "params.CampaignProductCreationForm": {
+            "type": "object",
+            "required": [
+                "campaign_id",
+                "created_by",
+                "products"
+            ],
+            "properties": {
+                "campaign_id": {
+                    "description": "campaign Id",
+                    "type": "integer"
+                },
+                "created_by": {
+                    "description": "Created by user id",
+                    "type": "integer"
+                },
+                "products": {
+                    "description": "List of campaign products",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/params.CampaignProduct"
+                    }
+                }
+            }
+        },
+        "params.CampaignProductOrderForm": {
+            "type": "object",
+            "required": [
+                "campaign_product_ids"
+            ],
+            "properties": {
+                "campaign_product_ids": {
+                    "description": "campaign product ids in the new order, sequence_no is assigned from the position",
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "params.CampaignProductUpdateForm": {
+            "type": "object",
+            "properties": {
+                "SKU_no": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_type": {
+                    "type": "string",
+                    "enum": [
+                        "cd",
+                        "ncd"
+                    ]
+                },
+                "sequence_no": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "serial_no": {
+                    "type": "integer"
+                }
+            }
+        },
+        "params.CampaignStoresForm": {
+            "type": "object",
+            "properties": {
+                "store_group_ids": {
+                    "description": "Store groups whose active stores are added along with the stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "stores": {
+                    "description": "List of campaign stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "params.CampaignUpdateForm": {
+            "type": "object",
+            "properties": {
+                "campaign_status_code": {
+                    "description": "Campaign Status code",
+                    "type": "integer"
+                },
+                "campaign_type": {
+                    "description": "Campaign Type",
+                    "type": "string",
+                    "enum": [
+                        "deli",
+                        "cash\u0026carry"
+                    ]
+                },
+                "collection_end_date": {
+                    "description": "Collection end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "collection_start_date": {
+                    "description": "Collection start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "is_campaign_published": {
+                    "description": "Is campaign published flag",
+                    "type": "boolean"
+                },
+                "landing_image_path": {
+                    "description": "Landing screen image path",
+                    "type": "string"
+                },
+                "lead_time": {
+                    "description": "Lead time in days",
+                    "type": "integer"
+                },
+                "listing_description": {
+                    "description": "Listing screen description",
+                    "type": "string"
+                },
+                "listing_image_path": {
+                    "description": "Listing screen image path",
+                    "type": "string"
+                },
+                "listing_title": {
+                    "description": "Listing screen title",
+                    "type": "string"
+                },
+                "offer_id": {
+                    "description": "Offer Id",
+                    "type": "integer"
+                },
+                "onboarding_description": {
+                    "description": "Onboarding Description",
+                    "type": "string"
+                },
+                "onboarding_image_path": {
+                    "description": "Onboarding image path",
+                    "type": "string"
+                },
+                "onboarding_title": {
+                    "description": "Onboarding title",
+                    "type": "string"
+                },
+                "order_end_date": {
+                    "description": "Order end date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "order_start_date": {
+                    "description": "Order start date",
+                    "type": "string",
+                    "example": "2023-12-31 12:00:00"
+                },
+                "products": {
+                    "description": "List of campaign products, products are left as they are when it is omitted",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/params.UpdateCampaignProduct"
+                    }
+                },
+                "store_group_ids": {
+                    "description": "Store groups whose active stores are added along with the stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "stores": {
+                    "description": "List of campaign stores",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tag_id": {
+                    "description": "Tag Id",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA timezone the dates are given in, defaults to the business timezone",
+                    "type": "string",
+                    "example": "Asia/Singapore"
+                },
+                "title": {
+                    "description": "Campaign Title",
+                    "type": "string"
+                }
+            }
+        },
+        "params.CollectionSlotReservationForm": {
+            "type": "object",
+            "required": [
+                "campaign_id"
+            ],
+            "properties": {
+                "campaign_id": {
+                    "description": "Campaign the collections are for",
+                    "type": "integer"
+                },
+                "confirm": {
+                    "description": "Reserve for good instead of holding the quota until confirmed",
+                    "type": "boolean"
+                },
+                "quantity": {
+                    "description": "Number of collections to reserve, 1 when left out",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "reference": {
+                    "description": "Reference of the caller e.g. an order number",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "params.SlotTemplateApplyForm": {
+            "type": "object",
+            "required": [
+                "store_ids"
+            ],
+            "properties": {
+                "dry_run": {
+                    "description": "Only work out the slots that would be created",
+                    "type": "boolean"
+                },
+                "store_ids": {
+                    "description": "Stores to make the weekly slots for, at most 500 in one request",
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "params.SlotTemplateForm": {
+            "type": "object",
+            "required": [
+                "days_of_week",
+                "end_time",
+                "name",
+                "slot_minutes",
+                "start_time"
+            ],
+            "properties": {
+                "days_of_week": {
+                    "description": "Weekdays the template makes slots on",
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_time": {
+                    "description": "End of the last slot in store local time, HH:MM, 24:00 for slots running till midnight",
+                    "type": "string"
+                },
+                "is_slot_available": {
+                    "description": "Whether the slots are offered to customers, true when left out",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name of the template",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "quota": {
+                    "description": "Number of collections every slot takes",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "slot_minutes": {
+                    "description": "Length of every slot in minutes, start to end must divide into whole slots",
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 5
+                },
+                "start_time": {
+                    "description": "Start of the first slot in store local time, HH:MM",
+                    "type": "string"
+                }
+            }
+        },
+        "params.StoreCreationForm": {
+            "type": "object",
+            "required": [
+                "name",
+                "store_id"
+            ],
+            "properties": {
+                "address": {
+                    "description": "Store address",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "is_active": {
+                    "description": "Whether the store can be added to campaigns, true when left out",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Store name",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "region": {
+                    "description": "Region the store belongs to",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "store_id": {
+                    "description": "Store ID campaigns refer to the store by",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA timezone of the store",
+                    "type": "string",
+                    "example": "Asia/Singapore"
+                }
+            }
+        },
+        "params.StoreDailyTimeSlotForm": {
+            "type": "object",
+            "required": [
+                "day_of_week",
+                "end_time",
+                "start_time"
+            ],
+            "properties": {
+                "day_of_week": {
+                    "description": "Weekday the slot repeats on",
+                    "type": "string",
+                    "enum": [
+                        "monday",
+                        "tuesday",
+                        "wednesday",
+                        "thursday",
+                        "friday",
+                        "saturday",
+                        "sunday"
+                    ]
+                },
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM, 24:00 for slots running till midnight",
+                    "type": "string"
+                },
+                "is_slot_available": {
+                    "description": "Whether the slot is offered to customers, true when left out",
+                    "type": "boolean"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM",
+                    "type": "string"
+                }
+            }
+        },
+        "params.StoreForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "description": "Store address",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "is_active": {
+                    "description": "Whether the store can be added to campaigns, true when left out",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Store name",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "region": {
+                    "description": "Region the store belongs to",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "timezone": {
+                    "description": "IANA timezone of the store",
+                    "type": "string",
+                    "example": "Asia/Singapore"
+                }
+            }
+        },
+        "params.StoreGroupCreationForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Store group description",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "description": "Store group name",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "stores": {
+                    "description": "Stores of the group, every store must be registered and active",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "params.StoreGroupForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Store group description",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "description": "Store group name",
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "params.StoreGroupStoresForm": {
+            "type": "object",
+            "required": [
+                "stores"
+            ],
+            "properties": {
+                "stores": {
+                    "description": "Stores to add to the group, every store must be registered and active",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "params.StoreSpecificTimeSlotBulkForm": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "days_of_week": {
+                    "description": "Weekdays of the range to fill, every date of the range when left out",
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_date": {
+                    "description": "Last date of the range, YYYY-MM-DD, inclusive",
+                    "type": "string"
+                },
+                "is_closed": {
+                    "description": "Marks the store closed on every date of the range",
+                    "type": "boolean"
+                },
+                "slots": {
+                    "description": "Slots added to every date of the range, left out for a closure",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/params.StoreSpecificTimeSlotTimeForm"
+                    }
+                },
+                "start_date": {
+                    "description": "First date of the range, YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "params.StoreSpecificTimeSlotForm": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "description": "Date the slot applies to, YYYY-MM-DD",
+                    "type": "string"
+                },
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM, 24:00 for slots running till midnight, left out for a closed date",
+                    "type": "string"
+                },
+                "is_closed": {
+                    "description": "Marks the store closed the whole date",
+                    "type": "boolean"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM, left out for a closed date",
+                    "type": "string"
+                }
+            }
+        },
+        "params.StoreSpecificTimeSlotTimeForm": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "start_time"
+            ],
+            "properties": {
+                "end_time": {
+                    "description": "Slot end in store local time, HH:MM, 24:00 for slots running till midnight",
+                    "type": "string"
+                },
+                "quota": {
+                    "description": "Number of collections the slot takes",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "start_time": {
+                    "description": "Slot start in store local time, HH:MM",
+                    "type": "string"
+                }
+            }
+        },
+        "params.UpdateCampaignProduct": {
+            "type": "object",
+            "properties": {
+                "SKU_no": {
+                    "type": "integer"
+                },
+                "campaign_product_id": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_type": {
+                    "type": "string",
+                    "enum": [
+                        "cd",
+                        "ncd"
+                    ]
+                },
+                "sequence_no": {
+                    "type": "integer"
+                },
+                "serial_no": {
+                    "type": "integer"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "This is a Campaign Management Server, Which provides set of APIs to create and manage campaigns, for adding products and stores against particular campaign and also APIs for time slot management.",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "",
+	Host:             "",
+	BasePath:         "",
+	Schemes:          []string{},
+	Title:            "",
+	Description:      "",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}